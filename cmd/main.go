@@ -2,17 +2,30 @@ package main
 
 import (
 	"context"
+	stdsql "database/sql"
 	"fmt"
 	"log"
 	"net"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/huynhthanhthao/hrm_user_service/ent"
 	"github.com/huynhthanhthao/hrm_user_service/ent/migrate"
+	_ "github.com/huynhthanhthao/hrm_user_service/ent/runtime"
+	"github.com/huynhthanhthao/hrm_user_service/internal/adminunit"
+	"github.com/huynhthanhthao/hrm_user_service/internal/audit"
+	"github.com/huynhthanhthao/hrm_user_service/internal/avatar"
+	"github.com/huynhthanhthao/hrm_user_service/internal/fieldcrypt"
 	userGrpc "github.com/huynhthanhthao/hrm_user_service/internal/grpc"
 	"github.com/huynhthanhthao/hrm_user_service/internal/handler"
 	"github.com/huynhthanhthao/hrm_user_service/internal/router"
 	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	"github.com/huynhthanhthao/hrm_user_service/internal/storage"
 
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
@@ -32,10 +45,12 @@ var (
 )
 
 func main() {
-	client := initEntClient()
+	client, db := initEntClient()
 	defer client.Close()
 
 	runMigration(client)
+	loadKeyring()
+	loadAdminUnits()
 
 	hrServiceClients, err := NewHRServiceClients()
 	if err != nil {
@@ -54,12 +69,37 @@ func main() {
 		log.Fatalf("failed to initialize UserService: %v", err)
 	}
 
-	go startGRPCServer(userService)
-	startHTTPServer(client, hrServiceClients, permissionServiceClients)
+	// "main migrate-data <tên>..." chạy lại các migration dữ liệu (ví dụ reencrypt_pii sau khi đổi key) rồi thoát
+	if len(os.Args) > 1 && os.Args[1] == "migrate-data" {
+		runDataMigrationCommand(userService, os.Args[2:])
+		return
+	}
+	// Migration cần xong trước khi phục vụ request chạy đồng bộ, phần còn lại chạy nền
+	runPendingDataMigrations(db, userService, true)
+	go runPendingDataMigrations(db, userService, false)
+
+	authService, err := service.NewAuthService(client, hrServiceClients, permissionServiceClients)
+	if err != nil {
+		log.Fatalf("failed to initialize AuthService: %v", err)
+	}
+
+	store, err := NewStorage()
+	if err != nil {
+		log.Fatalf("failed to initialize storage: %v", err)
+	}
+
+	avatarService, err := service.NewAvatarService(client, store)
+	if err != nil {
+		log.Fatalf("failed to initialize AvatarService: %v", err)
+	}
+
+	go startPurgeJob(userService, avatarService)
+	go startGRPCServer(userService, authService, avatarService)
+	startHTTPServer(client, hrServiceClients, permissionServiceClients, store)
 }
 
 // Initialize Ent client
-func initEntClient() *ent.Client {
+func initEntClient() (*ent.Client, *stdsql.DB) {
 	host := os.Getenv("DB_HOST")
 	port := os.Getenv("DB_PORT")
 	user := os.Getenv("DB_USER")
@@ -75,13 +115,25 @@ func initEntClient() *ent.Client {
 	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
 		host, port, user, password, dbname, sslmode)
 
-	client, err := ent.Open("postgres", dsn)
+	drv, err := entsql.Open(dialect.Postgres, dsn)
 	if err != nil {
 		log.Fatalf("failed opening connection to postgres: %v", err)
 	}
 
+	// pg_trgm phục vụ index GIN cho tìm kiếm user, phải có trước khi migrate
+	if _, err := drv.DB().ExecContext(context.Background(), "CREATE EXTENSION IF NOT EXISTS pg_trgm"); err != nil {
+		log.Fatalf("failed creating pg_trgm extension: %v", err)
+	}
+	if err := dropLegacyIndexes(drv.DB(), ""); err != nil {
+		log.Fatal(err)
+	}
+
+	client := ent.NewClient(ent.Driver(drv))
+	// Ghi audit log cho mọi thay đổi trên User và Account
+	client.Use(audit.Hook())
+
 	log.Println("Connected to PostgreSQL")
-	return client
+	return client, drv.DB()
 }
 
 func init() {
@@ -95,6 +147,7 @@ func init() {
 	fmt.Printf("Loaded GRPC_PORT: %s", os.Getenv("GRPC_PORT"))
 	httpPort = ":" + os.Getenv("HTTP_PORT")
 	grpcPort = ":" + os.Getenv("GRPC_PORT")
+	avatar.DefaultURLPrefix = strings.TrimSuffix(os.Getenv("PUBLIC_BASE_URL"), "/")
 }
 
 // Run schema migration
@@ -105,11 +158,322 @@ func runMigration(client *ent.Client) {
 	}
 }
 
-// Start gRPC server
-func startGRPCServer(userService *service.UserService) {
-	grpcServer := grpc.NewServer()
+// legacyIndexes là các unique constraint/index mà schema không còn khai báo; migrate không tự
+// xóa index (không dùng WithDropIndex) nên phải xóa tường minh. Ent cũ tạo unique của cột dạng
+// constraint, bản mới dạng index, nên thử cả hai.
+// after là migration dữ liệu phải ghi nhận xong trước khi xóa (rỗng: xóa ngay khi khởi động).
+var legacyIndexes = []struct{ table, name, after string }{
+	// Username chỉ duy nhất trong các bản ghi chưa bị xóa mềm
+	{"accounts", "accounts_username_key", ""},
+	// Phone, email chỉ duy nhất trong các bản ghi chưa bị xóa mềm; phone, email, CCCD được mã hóa nên
+	// tính duy nhất chuyển sang blind index (*_hash). Dòng cũ chỉ có blind index sau reencrypt_pii,
+	// trước đó index cũ trên plaintext vẫn là thứ duy nhất chặn trùng.
+	{"users", "users_phone_key", "reencrypt_pii"},
+	{"users", "users_email_key", "reencrypt_pii"},
+	{"users", "user_phone", "reencrypt_pii"},
+	{"users", "user_email", "reencrypt_pii"},
+	{"profiles", "profile_national_id", "reencrypt_pii"},
+}
+
+// dropLegacyIndexes xóa các index cũ chờ migration dữ liệu after; gọi lại nhiều lần không sao
+func dropLegacyIndexes(db *stdsql.DB, after string) error {
+	ctx := context.Background()
+	for _, idx := range legacyIndexes {
+		if idx.after != after {
+			continue
+		}
+		stmts := []string{
+			fmt.Sprintf(`ALTER TABLE IF EXISTS %q DROP CONSTRAINT IF EXISTS %q`, idx.table, idx.name),
+			fmt.Sprintf(`DROP INDEX IF EXISTS %q`, idx.name),
+		}
+		for _, stmt := range stmts {
+			if _, err := db.ExecContext(ctx, stmt); err != nil {
+				return fmt.Errorf("failed dropping legacy index %s: %w", idx.name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// dataMigration là migration dữ liệu chạy một lần, ghi nhận trong bảng data_migrations
+type dataMigration struct {
+	name string
+	// Chạy đồng bộ lúc khởi động, trước khi phục vụ request: tính năng mới cần dữ liệu này ngay khi có hiệu lực
+	blocking bool
+	run      func(ctx context.Context, userService *service.UserService) error
+}
+
+// Thứ tự có ý nghĩa: lịch sử phiên bản lấy dữ liệu đã chuẩn hóa và mã hóa
+var dataMigrations = []dataMigration{
+	// Membership cho user tạo trước khi có membership, theo tổ chức bên HR service.
+	// Chạy trước khi phục vụ request vì admin theo tổ chức không thấy user chưa có membership.
+	// User gọi HR lỗi được thử lại ở lần khởi động sau (đăng nhập cũng tạo membership còn thiếu).
+	{"backfill_memberships", true, func(ctx context.Context, userService *service.UserService) error {
+		report, err := userService.BackfillMemberships(ctx)
+		if report != nil {
+			logBackfill("Created default membership", report)
+			if err == nil && len(report.Failed) > 0 {
+				err = fmt.Errorf("%d users could not be checked against the HR service", len(report.Failed))
+			}
+		}
+		return err
+	}},
+	// search_text của user tạo trước khi có cột
+	{"rebuild_search_text", false, func(ctx context.Context, userService *service.UserService) error {
+		report, err := userService.RebuildSearchText(ctx)
+		if report != nil {
+			logBackfill("Rebuilt search text", report)
+		}
+		return err
+	}},
+	// Chuẩn hóa số điện thoại cũ sang E.164; các số trùng sau chuẩn hóa được giữ nguyên để xử lý thủ công
+	{"normalize_phones", false, func(ctx context.Context, userService *service.UserService) error {
+		report, err := userService.NormalizePhones(ctx)
+		if report != nil {
+			logPhoneMigration(report)
+		}
+		return err
+	}},
+	// Mã hóa dữ liệu cũ còn là plaintext; sau khi đổi key hiện tại chạy lại bằng "migrate-data reencrypt_pii"
+	{"reencrypt_pii", false, func(ctx context.Context, userService *service.UserService) error {
+		report, err := userService.ReencryptPII(ctx)
+		if report != nil {
+			logReencryption(report)
+			// Chưa ghi nhận để giữ index cũ trên plaintext đến khi mọi dòng có blind index
+			if err == nil && len(report.Failed) > 0 {
+				err = fmt.Errorf("%d rows could not be re-encrypted", len(report.Failed))
+			}
+		}
+		return err
+	}},
+	// User tạo trước khi có lịch sử phiên bản được lấy thông tin hiện tại làm phiên bản đầu tiên
+	{"backfill_user_versions", false, func(ctx context.Context, userService *service.UserService) error {
+		report, err := userService.BackfillUserVersions(ctx)
+		if report != nil {
+			logBackfill("Recorded initial version", report)
+		}
+		return err
+	}},
+}
+
+// dataMigrationLockKey là khóa advisory để chỉ một instance chạy migration dữ liệu
+const dataMigrationLockKey = 72_101_032
+
+// runPendingDataMigrations chạy các migration dữ liệu (blocking hoặc không) chưa ghi nhận trong data_migrations.
+// Migration lỗi được ghi log và thử lại ở lần khởi động sau.
+// Nhóm blocking chờ khóa nếu instance khác đang chạy để không instance nào phục vụ request trước khi xong;
+// nhóm còn lại chạy nền và bỏ qua nếu instance khác đang chạy.
+func runPendingDataMigrations(db *stdsql.DB, userService *service.UserService, blocking bool) {
+	ctx := context.Background()
+	if _, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS data_migrations (
+		name text PRIMARY KEY,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)`); err != nil {
+		logger.Printf("failed creating data_migrations table: %v", err)
+		return
+	}
+
+	// Khóa gắn với phiên nên giữ một kết nối riêng đến khi chạy xong
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		logger.Printf("failed to acquire connection for data migrations: %v", err)
+		return
+	}
+	defer conn.Close()
+	var locked bool
+	if blocking {
+		_, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", dataMigrationLockKey)
+		locked = err == nil
+	} else {
+		err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", dataMigrationLockKey).Scan(&locked)
+	}
+	if err != nil {
+		logger.Printf("failed to lock data migrations: %v", err)
+		return
+	}
+	if !locked {
+		logger.Println("Data migrations are running on another instance, skipped")
+		return
+	}
+	defer conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", dataMigrationLockKey)
+
+	for _, m := range dataMigrations {
+		if m.blocking != blocking {
+			continue
+		}
+		var applied bool
+		if err := conn.QueryRowContext(ctx,
+			"SELECT EXISTS (SELECT 1 FROM data_migrations WHERE name = $1)", m.name,
+		).Scan(&applied); err != nil {
+			logger.Printf("failed to check data migration %s: %v", m.name, err)
+			return
+		}
+		if !applied {
+			if err := m.run(ctx, userService); err != nil {
+				logger.Printf("data migration %s failed, will retry on next start: %v", m.name, err)
+				continue
+			}
+			if _, err := conn.ExecContext(ctx, "INSERT INTO data_migrations (name) VALUES ($1)", m.name); err != nil {
+				logger.Printf("failed to record data migration %s: %v", m.name, err)
+				continue
+			}
+		}
+		if err := dropLegacyIndexes(db, m.name); err != nil {
+			logger.Print(err)
+		}
+	}
+}
+
+// runDataMigrationCommand chạy các migration dữ liệu theo tên, kể cả khi đã ghi nhận
+func runDataMigrationCommand(userService *service.UserService, names []string) {
+	if len(names) == 0 {
+		log.Fatalf("usage: main migrate-data <name>... (available: %s)", dataMigrationNames())
+	}
+	for _, name := range names {
+		idx := slices.IndexFunc(dataMigrations, func(m dataMigration) bool { return m.name == name })
+		if idx < 0 {
+			log.Fatalf("unknown data migration %q (available: %s)", name, dataMigrationNames())
+		}
+		if err := dataMigrations[idx].run(context.Background(), userService); err != nil {
+			log.Fatalf("data migration %s failed: %v", name, err)
+		}
+	}
+}
+
+func dataMigrationNames() string {
+	names := make([]string, 0, len(dataMigrations))
+	for _, m := range dataMigrations {
+		names = append(names, m.name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func logFailedRows(failed []service.FailedRow) {
+	for _, f := range failed {
+		log.Printf("WARNING: skipped %s %d: %v", f.Table, f.ID, f.Err)
+	}
+}
+
+func logBackfill(action string, report *service.BackfillReport) {
+	if report.Updated > 0 {
+		log.Printf("%s for %d users", action, report.Updated)
+	}
+	logFailedRows(report.Failed)
+}
+
+func logPhoneMigration(report *service.PhoneMigrationReport) {
+	if report.Normalized > 0 {
+		log.Printf("Normalized phone numbers of %d users", report.Normalized)
+	}
+	for _, d := range report.Duplicates {
+		log.Printf("WARNING: users %v share phone %s after normalization, left unchanged", d.UserIDs, d.Phone)
+	}
+	for _, inv := range report.Invalid {
+		log.Printf("WARNING: user %d has invalid phone %q: %v", inv.UserID, inv.Phone, inv.Err)
+	}
+	logFailedRows(report.Failed)
+}
+
+func logReencryption(report *service.ReencryptReport) {
+	if report.Users > 0 || report.Profiles > 0 || report.Versions > 0 {
+		log.Printf("Re-encrypted personal data of %d users, %d profiles and %d user versions with key %q",
+			report.Users, report.Profiles, report.Versions, report.KeyID)
+	}
+	for _, id := range report.Conflicts {
+		log.Printf("WARNING: user %d was not re-encrypted: phone or email conflicts with another user", id)
+	}
+	logFailedRows(report.Failed)
+}
 
-	userGrpcServer := userGrpc.NewUserGRPCServer(userService)
+// Nạp keyring mã hóa dữ liệu cá nhân từ FIELD_ENCRYPTION_KEYRING (bắt buộc)
+func loadKeyring() {
+	path := os.Getenv("FIELD_ENCRYPTION_KEYRING")
+	if path == "" {
+		log.Fatalf("FIELD_ENCRYPTION_KEYRING is not set")
+	}
+	kr, err := fieldcrypt.LoadKeyring(path)
+	if err != nil {
+		log.Fatalf("failed to load field encryption keyring: %v", err)
+	}
+	fieldcrypt.Replace(kr)
+}
+
+// Nạp danh mục đơn vị hành chính từ ADMIN_UNITS_FILE (nếu có) và tự nạp lại khi file thay đổi.
+// Không cấu hình thì dùng danh mục nhúng sẵn.
+func loadAdminUnits() {
+	path := os.Getenv("ADMIN_UNITS_FILE")
+	if path == "" {
+		logger.Println("ADMIN_UNITS_FILE is not set: using the embedded partial dataset, unknown ward codes are not validated")
+		return
+	}
+	if err := adminunit.LoadFile(path); err != nil {
+		log.Fatalf("failed to load administrative units: %v", err)
+	}
+
+	interval := time.Minute
+	if v := os.Getenv("ADMIN_UNITS_RELOAD_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			log.Fatalf("invalid ADMIN_UNITS_RELOAD_INTERVAL: %q", v)
+		}
+		interval = d
+	}
+	go adminunit.Watch(context.Background(), path, interval, func(err error) {
+		if err != nil {
+			logger.Printf("failed to reload administrative units: %v", err)
+			return
+		}
+		logger.Printf("Reloaded administrative units from %s", path)
+	})
+}
+
+// Định kỳ xóa vĩnh viễn các user đã bị xóa mềm quá USER_PURGE_RETENTION_DAYS ngày (0 để tắt)
+func startPurgeJob(userService *service.UserService, avatarService *service.AvatarService) {
+	days := 30
+	if v := os.Getenv("USER_PURGE_RETENTION_DAYS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			logger.Fatalf("invalid USER_PURGE_RETENTION_DAYS: %v", err)
+		}
+		days = n
+	}
+	if days <= 0 {
+		logger.Println("User purge job disabled")
+		return
+	}
+	retention := time.Duration(days) * 24 * time.Hour
+
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for ; ; <-ticker.C {
+		n, err := userService.PurgeDeletedUsers(context.Background(), retention, avatarService.DeleteAvatarFiles)
+		if err != nil {
+			logger.Printf("user purge job failed: %v", err)
+		}
+		if n > 0 {
+			logger.Printf("Purged %d deleted users", n)
+		}
+	}
+}
+
+// Start gRPC server
+func startGRPCServer(userService *service.UserService, authService *service.AuthService, avatarService *service.AvatarService) {
+	// Service nội bộ gọi gRPC phải gửi x-service-token (để trống thì chỉ nhận access token của người dùng)
+	serviceToken := os.Getenv("INTERNAL_SERVICE_TOKEN")
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			userGrpc.AuthInterceptor(authService, serviceToken),
+			userGrpc.PIIMaskInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			userGrpc.AuthStreamInterceptor(authService, serviceToken),
+			userGrpc.PIIMaskStreamInterceptor(),
+		),
+		// UploadAvatar gửi cả file trong một message
+		grpc.MaxRecvMsgSize(avatar.MaxFileSize+1<<20),
+	)
+
+	userGrpcServer := userGrpc.NewUserGRPCServer(userService, avatarService)
 	userPb.RegisterUserServiceServer(grpcServer, userGrpcServer)
 
 	reflection.Register(grpcServer)
@@ -127,8 +491,8 @@ func startGRPCServer(userService *service.UserService) {
 }
 
 // Start HTTP server
-func startHTTPServer(client *ent.Client, hrServiceClients *service.HRServiceClients, permissionServiceClients *service.PermissionServiceClients) {
-	r := router.SetupRouter(client, hrServiceClients, permissionServiceClients)
+func startHTTPServer(client *ent.Client, hrServiceClients *service.HRServiceClients, permissionServiceClients *service.PermissionServiceClients, store storage.Storage) {
+	r := router.SetupRouter(client, hrServiceClients, permissionServiceClients, store)
 
 	r.Use(handler.Logger())
 
@@ -176,3 +540,32 @@ func NewPermissionServiceClients() (*service.PermissionServiceClients, error) {
 		PermExt:  permPb.NewExtServiceClient(conn),
 	}, nil
 }
+
+// NewStorage chọn backend lưu file theo STORAGE_DRIVER: local (mặc định) hoặc s3
+func NewStorage() (storage.Storage, error) {
+	switch driver := os.Getenv("STORAGE_DRIVER"); driver {
+	case "", "local":
+		dir := os.Getenv("STORAGE_LOCAL_DIR")
+		if dir == "" {
+			dir = "./uploads"
+		}
+		publicURL := os.Getenv("STORAGE_PUBLIC_URL")
+		if publicURL == "" {
+			publicURL = "http://localhost" + httpPort + storage.LocalMountPath
+		}
+		return storage.NewLocalStorage(dir, publicURL)
+	case "s3":
+		useSSL, _ := strconv.ParseBool(os.Getenv("S3_USE_SSL"))
+		return storage.NewS3Storage(context.Background(), storage.S3Config{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SECRET_KEY"),
+			Bucket:    os.Getenv("S3_BUCKET"),
+			Region:    os.Getenv("S3_REGION"),
+			UseSSL:    useSSL,
+			PublicURL: os.Getenv("STORAGE_PUBLIC_URL"),
+		})
+	default:
+		return nil, fmt.Errorf("unsupported STORAGE_DRIVER %q", driver)
+	}
+}
@@ -21,6 +21,20 @@ type AccountCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ac *AccountCreate) SetDeletedAt(t time.Time) *AccountCreate {
+	ac.mutation.SetDeletedAt(t)
+	return ac
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableDeletedAt(t *time.Time) *AccountCreate {
+	if t != nil {
+		ac.SetDeletedAt(*t)
+	}
+	return ac
+}
+
 // SetUsername sets the "username" field.
 func (ac *AccountCreate) SetUsername(s string) *AccountCreate {
 	ac.mutation.SetUsername(s)
@@ -47,6 +61,20 @@ func (ac *AccountCreate) SetNillableStatus(a *account.Status) *AccountCreate {
 	return ac
 }
 
+// SetLastLoginAt sets the "last_login_at" field.
+func (ac *AccountCreate) SetLastLoginAt(t time.Time) *AccountCreate {
+	ac.mutation.SetLastLoginAt(t)
+	return ac
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableLastLoginAt(t *time.Time) *AccountCreate {
+	if t != nil {
+		ac.SetLastLoginAt(*t)
+	}
+	return ac
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ac *AccountCreate) SetCreatedAt(t time.Time) *AccountCreate {
 	ac.mutation.SetCreatedAt(t)
@@ -99,7 +127,9 @@ func (ac *AccountCreate) Mutation() *AccountMutation {
 
 // Save creates the Account in the database.
 func (ac *AccountCreate) Save(ctx context.Context) (*Account, error) {
-	ac.defaults()
+	if err := ac.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
 }
 
@@ -126,19 +156,26 @@ func (ac *AccountCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (ac *AccountCreate) defaults() {
+func (ac *AccountCreate) defaults() error {
 	if _, ok := ac.mutation.Status(); !ok {
 		v := account.DefaultStatus
 		ac.mutation.SetStatus(v)
 	}
 	if _, ok := ac.mutation.CreatedAt(); !ok {
+		if account.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized account.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := account.DefaultCreatedAt()
 		ac.mutation.SetCreatedAt(v)
 	}
 	if _, ok := ac.mutation.UpdatedAt(); !ok {
+		if account.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized account.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := account.DefaultUpdatedAt()
 		ac.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -213,6 +250,10 @@ func (ac *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := ac.mutation.DeletedAt(); ok {
+		_spec.SetField(account.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := ac.mutation.Username(); ok {
 		_spec.SetField(account.FieldUsername, field.TypeString, value)
 		_node.Username = value
@@ -225,6 +266,10 @@ func (ac *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		_spec.SetField(account.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := ac.mutation.LastLoginAt(); ok {
+		_spec.SetField(account.FieldLastLoginAt, field.TypeTime, value)
+		_node.LastLoginAt = &value
+	}
 	if value, ok := ac.mutation.CreatedAt(); ok {
 		_spec.SetField(account.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -19,8 +19,9 @@ import (
 // AccountUpdate is the builder for updating Account entities.
 type AccountUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AccountMutation
+	hooks     []Hook
+	mutation  *AccountMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AccountUpdate builder.
@@ -29,6 +30,26 @@ func (au *AccountUpdate) Where(ps ...predicate.Account) *AccountUpdate {
 	return au
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (au *AccountUpdate) SetDeletedAt(t time.Time) *AccountUpdate {
+	au.mutation.SetDeletedAt(t)
+	return au
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableDeletedAt(t *time.Time) *AccountUpdate {
+	if t != nil {
+		au.SetDeletedAt(*t)
+	}
+	return au
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (au *AccountUpdate) ClearDeletedAt() *AccountUpdate {
+	au.mutation.ClearDeletedAt()
+	return au
+}
+
 // SetUsername sets the "username" field.
 func (au *AccountUpdate) SetUsername(s string) *AccountUpdate {
 	au.mutation.SetUsername(s)
@@ -71,6 +92,26 @@ func (au *AccountUpdate) SetNillableStatus(a *account.Status) *AccountUpdate {
 	return au
 }
 
+// SetLastLoginAt sets the "last_login_at" field.
+func (au *AccountUpdate) SetLastLoginAt(t time.Time) *AccountUpdate {
+	au.mutation.SetLastLoginAt(t)
+	return au
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableLastLoginAt(t *time.Time) *AccountUpdate {
+	if t != nil {
+		au.SetLastLoginAt(*t)
+	}
+	return au
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (au *AccountUpdate) ClearLastLoginAt() *AccountUpdate {
+	au.mutation.ClearLastLoginAt()
+	return au
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (au *AccountUpdate) SetCreatedAt(t time.Time) *AccountUpdate {
 	au.mutation.SetCreatedAt(t)
@@ -115,7 +156,9 @@ func (au *AccountUpdate) ClearUser() *AccountUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (au *AccountUpdate) Save(ctx context.Context) (int, error) {
-	au.defaults()
+	if err := au.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
 }
 
@@ -142,11 +185,15 @@ func (au *AccountUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (au *AccountUpdate) defaults() {
+func (au *AccountUpdate) defaults() error {
 	if _, ok := au.mutation.UpdatedAt(); !ok {
+		if account.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized account.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := account.UpdateDefaultUpdatedAt()
 		au.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -172,6 +219,12 @@ func (au *AccountUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (au *AccountUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountUpdate {
+	au.modifiers = append(au.modifiers, modifiers...)
+	return au
+}
+
 func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := au.check(); err != nil {
 		return n, err
@@ -184,6 +237,12 @@ func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := au.mutation.DeletedAt(); ok {
+		_spec.SetField(account.FieldDeletedAt, field.TypeTime, value)
+	}
+	if au.mutation.DeletedAtCleared() {
+		_spec.ClearField(account.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := au.mutation.Username(); ok {
 		_spec.SetField(account.FieldUsername, field.TypeString, value)
 	}
@@ -193,6 +252,12 @@ func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.Status(); ok {
 		_spec.SetField(account.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := au.mutation.LastLoginAt(); ok {
+		_spec.SetField(account.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if au.mutation.LastLoginAtCleared() {
+		_spec.ClearField(account.FieldLastLoginAt, field.TypeTime)
+	}
 	if value, ok := au.mutation.CreatedAt(); ok {
 		_spec.SetField(account.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -228,6 +293,7 @@ func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(au.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{account.Label}
@@ -243,9 +309,30 @@ func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // AccountUpdateOne is the builder for updating a single Account entity.
 type AccountUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AccountMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (auo *AccountUpdateOne) SetDeletedAt(t time.Time) *AccountUpdateOne {
+	auo.mutation.SetDeletedAt(t)
+	return auo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableDeletedAt(t *time.Time) *AccountUpdateOne {
+	if t != nil {
+		auo.SetDeletedAt(*t)
+	}
+	return auo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (auo *AccountUpdateOne) ClearDeletedAt() *AccountUpdateOne {
+	auo.mutation.ClearDeletedAt()
+	return auo
 }
 
 // SetUsername sets the "username" field.
@@ -290,6 +377,26 @@ func (auo *AccountUpdateOne) SetNillableStatus(a *account.Status) *AccountUpdate
 	return auo
 }
 
+// SetLastLoginAt sets the "last_login_at" field.
+func (auo *AccountUpdateOne) SetLastLoginAt(t time.Time) *AccountUpdateOne {
+	auo.mutation.SetLastLoginAt(t)
+	return auo
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableLastLoginAt(t *time.Time) *AccountUpdateOne {
+	if t != nil {
+		auo.SetLastLoginAt(*t)
+	}
+	return auo
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (auo *AccountUpdateOne) ClearLastLoginAt() *AccountUpdateOne {
+	auo.mutation.ClearLastLoginAt()
+	return auo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (auo *AccountUpdateOne) SetCreatedAt(t time.Time) *AccountUpdateOne {
 	auo.mutation.SetCreatedAt(t)
@@ -347,7 +454,9 @@ func (auo *AccountUpdateOne) Select(field string, fields ...string) *AccountUpda
 
 // Save executes the query and returns the updated Account entity.
 func (auo *AccountUpdateOne) Save(ctx context.Context) (*Account, error) {
-	auo.defaults()
+	if err := auo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
 }
 
@@ -374,11 +483,15 @@ func (auo *AccountUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (auo *AccountUpdateOne) defaults() {
+func (auo *AccountUpdateOne) defaults() error {
 	if _, ok := auo.mutation.UpdatedAt(); !ok {
+		if account.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized account.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := account.UpdateDefaultUpdatedAt()
 		auo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -404,6 +517,12 @@ func (auo *AccountUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (auo *AccountUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountUpdateOne {
+	auo.modifiers = append(auo.modifiers, modifiers...)
+	return auo
+}
+
 func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err error) {
 	if err := auo.check(); err != nil {
 		return _node, err
@@ -433,6 +552,12 @@ func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err e
 			}
 		}
 	}
+	if value, ok := auo.mutation.DeletedAt(); ok {
+		_spec.SetField(account.FieldDeletedAt, field.TypeTime, value)
+	}
+	if auo.mutation.DeletedAtCleared() {
+		_spec.ClearField(account.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := auo.mutation.Username(); ok {
 		_spec.SetField(account.FieldUsername, field.TypeString, value)
 	}
@@ -442,6 +567,12 @@ func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err e
 	if value, ok := auo.mutation.Status(); ok {
 		_spec.SetField(account.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := auo.mutation.LastLoginAt(); ok {
+		_spec.SetField(account.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if auo.mutation.LastLoginAtCleared() {
+		_spec.ClearField(account.FieldLastLoginAt, field.TypeTime)
+	}
 	if value, ok := auo.mutation.CreatedAt(); ok {
 		_spec.SetField(account.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -477,6 +608,7 @@ func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(auo.modifiers...)
 	_node = &Account{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/huynhthanhthao/hrm_user_service/ent/attributedefinition"
+)
+
+// AttributeDefinition is the model entity for the AttributeDefinition schema.
+type AttributeDefinition struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID int64 `json:"org_id"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key"`
+	// Label holds the value of the "label" field.
+	Label string `json:"label"`
+	// date lưu dạng chuỗi YYYY-MM-DD
+	Type attributedefinition.Type `json:"type"`
+	// Required holds the value of the "required" field.
+	Required bool `json:"required"`
+	// Chỉ dùng cho type string: giá trị phải thuộc danh sách
+	EnumValues []string `json:"enum_values"`
+	// Chỉ dùng cho type string: regex phải khớp toàn bộ giá trị
+	Pattern *string `json:"pattern"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AttributeDefinition) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attributedefinition.FieldEnumValues:
+			values[i] = new([]byte)
+		case attributedefinition.FieldRequired:
+			values[i] = new(sql.NullBool)
+		case attributedefinition.FieldID, attributedefinition.FieldOrgID:
+			values[i] = new(sql.NullInt64)
+		case attributedefinition.FieldKey, attributedefinition.FieldLabel, attributedefinition.FieldType, attributedefinition.FieldPattern:
+			values[i] = new(sql.NullString)
+		case attributedefinition.FieldCreatedAt, attributedefinition.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AttributeDefinition fields.
+func (ad *AttributeDefinition) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attributedefinition.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ad.ID = int(value.Int64)
+		case attributedefinition.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				ad.OrgID = value.Int64
+			}
+		case attributedefinition.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				ad.Key = value.String
+			}
+		case attributedefinition.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				ad.Label = value.String
+			}
+		case attributedefinition.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				ad.Type = attributedefinition.Type(value.String)
+			}
+		case attributedefinition.FieldRequired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field required", values[i])
+			} else if value.Valid {
+				ad.Required = value.Bool
+			}
+		case attributedefinition.FieldEnumValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field enum_values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ad.EnumValues); err != nil {
+					return fmt.Errorf("unmarshal field enum_values: %w", err)
+				}
+			}
+		case attributedefinition.FieldPattern:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pattern", values[i])
+			} else if value.Valid {
+				ad.Pattern = new(string)
+				*ad.Pattern = value.String
+			}
+		case attributedefinition.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ad.CreatedAt = value.Time
+			}
+		case attributedefinition.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ad.UpdatedAt = value.Time
+			}
+		default:
+			ad.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AttributeDefinition.
+// This includes values selected through modifiers, order, etc.
+func (ad *AttributeDefinition) Value(name string) (ent.Value, error) {
+	return ad.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AttributeDefinition.
+// Note that you need to call AttributeDefinition.Unwrap() before calling this method if this AttributeDefinition
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ad *AttributeDefinition) Update() *AttributeDefinitionUpdateOne {
+	return NewAttributeDefinitionClient(ad.config).UpdateOne(ad)
+}
+
+// Unwrap unwraps the AttributeDefinition entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ad *AttributeDefinition) Unwrap() *AttributeDefinition {
+	_tx, ok := ad.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AttributeDefinition is not a transactional entity")
+	}
+	ad.config.driver = _tx.drv
+	return ad
+}
+
+// String implements the fmt.Stringer.
+func (ad *AttributeDefinition) String() string {
+	var builder strings.Builder
+	builder.WriteString("AttributeDefinition(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ad.ID))
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", ad.OrgID))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(ad.Key)
+	builder.WriteString(", ")
+	builder.WriteString("label=")
+	builder.WriteString(ad.Label)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", ad.Type))
+	builder.WriteString(", ")
+	builder.WriteString("required=")
+	builder.WriteString(fmt.Sprintf("%v", ad.Required))
+	builder.WriteString(", ")
+	builder.WriteString("enum_values=")
+	builder.WriteString(fmt.Sprintf("%v", ad.EnumValues))
+	builder.WriteString(", ")
+	if v := ad.Pattern; v != nil {
+		builder.WriteString("pattern=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ad.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ad.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AttributeDefinitions is a parsable slice of AttributeDefinition.
+type AttributeDefinitions []*AttributeDefinition
@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/attributedefinition"
+)
+
+// AttributeDefinitionCreate is the builder for creating a AttributeDefinition entity.
+type AttributeDefinitionCreate struct {
+	config
+	mutation *AttributeDefinitionMutation
+	hooks    []Hook
+}
+
+// SetOrgID sets the "org_id" field.
+func (adc *AttributeDefinitionCreate) SetOrgID(i int64) *AttributeDefinitionCreate {
+	adc.mutation.SetOrgID(i)
+	return adc
+}
+
+// SetKey sets the "key" field.
+func (adc *AttributeDefinitionCreate) SetKey(s string) *AttributeDefinitionCreate {
+	adc.mutation.SetKey(s)
+	return adc
+}
+
+// SetLabel sets the "label" field.
+func (adc *AttributeDefinitionCreate) SetLabel(s string) *AttributeDefinitionCreate {
+	adc.mutation.SetLabel(s)
+	return adc
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableLabel(s *string) *AttributeDefinitionCreate {
+	if s != nil {
+		adc.SetLabel(*s)
+	}
+	return adc
+}
+
+// SetType sets the "type" field.
+func (adc *AttributeDefinitionCreate) SetType(a attributedefinition.Type) *AttributeDefinitionCreate {
+	adc.mutation.SetType(a)
+	return adc
+}
+
+// SetRequired sets the "required" field.
+func (adc *AttributeDefinitionCreate) SetRequired(b bool) *AttributeDefinitionCreate {
+	adc.mutation.SetRequired(b)
+	return adc
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableRequired(b *bool) *AttributeDefinitionCreate {
+	if b != nil {
+		adc.SetRequired(*b)
+	}
+	return adc
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (adc *AttributeDefinitionCreate) SetEnumValues(s []string) *AttributeDefinitionCreate {
+	adc.mutation.SetEnumValues(s)
+	return adc
+}
+
+// SetPattern sets the "pattern" field.
+func (adc *AttributeDefinitionCreate) SetPattern(s string) *AttributeDefinitionCreate {
+	adc.mutation.SetPattern(s)
+	return adc
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillablePattern(s *string) *AttributeDefinitionCreate {
+	if s != nil {
+		adc.SetPattern(*s)
+	}
+	return adc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (adc *AttributeDefinitionCreate) SetCreatedAt(t time.Time) *AttributeDefinitionCreate {
+	adc.mutation.SetCreatedAt(t)
+	return adc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableCreatedAt(t *time.Time) *AttributeDefinitionCreate {
+	if t != nil {
+		adc.SetCreatedAt(*t)
+	}
+	return adc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (adc *AttributeDefinitionCreate) SetUpdatedAt(t time.Time) *AttributeDefinitionCreate {
+	adc.mutation.SetUpdatedAt(t)
+	return adc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableUpdatedAt(t *time.Time) *AttributeDefinitionCreate {
+	if t != nil {
+		adc.SetUpdatedAt(*t)
+	}
+	return adc
+}
+
+// Mutation returns the AttributeDefinitionMutation object of the builder.
+func (adc *AttributeDefinitionCreate) Mutation() *AttributeDefinitionMutation {
+	return adc.mutation
+}
+
+// Save creates the AttributeDefinition in the database.
+func (adc *AttributeDefinitionCreate) Save(ctx context.Context) (*AttributeDefinition, error) {
+	adc.defaults()
+	return withHooks(ctx, adc.sqlSave, adc.mutation, adc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (adc *AttributeDefinitionCreate) SaveX(ctx context.Context) *AttributeDefinition {
+	v, err := adc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (adc *AttributeDefinitionCreate) Exec(ctx context.Context) error {
+	_, err := adc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (adc *AttributeDefinitionCreate) ExecX(ctx context.Context) {
+	if err := adc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (adc *AttributeDefinitionCreate) defaults() {
+	if _, ok := adc.mutation.Label(); !ok {
+		v := attributedefinition.DefaultLabel
+		adc.mutation.SetLabel(v)
+	}
+	if _, ok := adc.mutation.Required(); !ok {
+		v := attributedefinition.DefaultRequired
+		adc.mutation.SetRequired(v)
+	}
+	if _, ok := adc.mutation.CreatedAt(); !ok {
+		v := attributedefinition.DefaultCreatedAt()
+		adc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := adc.mutation.UpdatedAt(); !ok {
+		v := attributedefinition.DefaultUpdatedAt()
+		adc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (adc *AttributeDefinitionCreate) check() error {
+	if _, ok := adc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "AttributeDefinition.org_id"`)}
+	}
+	if v, ok := adc.mutation.OrgID(); ok {
+		if err := attributedefinition.OrgIDValidator(v); err != nil {
+			return &ValidationError{Name: "org_id", err: fmt.Errorf(`ent: validator failed for field "AttributeDefinition.org_id": %w`, err)}
+		}
+	}
+	if _, ok := adc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "AttributeDefinition.key"`)}
+	}
+	if v, ok := adc.mutation.Key(); ok {
+		if err := attributedefinition.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "AttributeDefinition.key": %w`, err)}
+		}
+	}
+	if _, ok := adc.mutation.Label(); !ok {
+		return &ValidationError{Name: "label", err: errors.New(`ent: missing required field "AttributeDefinition.label"`)}
+	}
+	if _, ok := adc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "AttributeDefinition.type"`)}
+	}
+	if v, ok := adc.mutation.GetType(); ok {
+		if err := attributedefinition.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "AttributeDefinition.type": %w`, err)}
+		}
+	}
+	if _, ok := adc.mutation.Required(); !ok {
+		return &ValidationError{Name: "required", err: errors.New(`ent: missing required field "AttributeDefinition.required"`)}
+	}
+	if _, ok := adc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AttributeDefinition.created_at"`)}
+	}
+	if _, ok := adc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AttributeDefinition.updated_at"`)}
+	}
+	return nil
+}
+
+func (adc *AttributeDefinitionCreate) sqlSave(ctx context.Context) (*AttributeDefinition, error) {
+	if err := adc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := adc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, adc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	adc.mutation.id = &_node.ID
+	adc.mutation.done = true
+	return _node, nil
+}
+
+func (adc *AttributeDefinitionCreate) createSpec() (*AttributeDefinition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AttributeDefinition{config: adc.config}
+		_spec = sqlgraph.NewCreateSpec(attributedefinition.Table, sqlgraph.NewFieldSpec(attributedefinition.FieldID, field.TypeInt))
+	)
+	if value, ok := adc.mutation.OrgID(); ok {
+		_spec.SetField(attributedefinition.FieldOrgID, field.TypeInt64, value)
+		_node.OrgID = value
+	}
+	if value, ok := adc.mutation.Key(); ok {
+		_spec.SetField(attributedefinition.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := adc.mutation.Label(); ok {
+		_spec.SetField(attributedefinition.FieldLabel, field.TypeString, value)
+		_node.Label = value
+	}
+	if value, ok := adc.mutation.GetType(); ok {
+		_spec.SetField(attributedefinition.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := adc.mutation.Required(); ok {
+		_spec.SetField(attributedefinition.FieldRequired, field.TypeBool, value)
+		_node.Required = value
+	}
+	if value, ok := adc.mutation.EnumValues(); ok {
+		_spec.SetField(attributedefinition.FieldEnumValues, field.TypeJSON, value)
+		_node.EnumValues = value
+	}
+	if value, ok := adc.mutation.Pattern(); ok {
+		_spec.SetField(attributedefinition.FieldPattern, field.TypeString, value)
+		_node.Pattern = &value
+	}
+	if value, ok := adc.mutation.CreatedAt(); ok {
+		_spec.SetField(attributedefinition.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := adc.mutation.UpdatedAt(); ok {
+		_spec.SetField(attributedefinition.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// AttributeDefinitionCreateBulk is the builder for creating many AttributeDefinition entities in bulk.
+type AttributeDefinitionCreateBulk struct {
+	config
+	err      error
+	builders []*AttributeDefinitionCreate
+}
+
+// Save creates the AttributeDefinition entities in the database.
+func (adcb *AttributeDefinitionCreateBulk) Save(ctx context.Context) ([]*AttributeDefinition, error) {
+	if adcb.err != nil {
+		return nil, adcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(adcb.builders))
+	nodes := make([]*AttributeDefinition, len(adcb.builders))
+	mutators := make([]Mutator, len(adcb.builders))
+	for i := range adcb.builders {
+		func(i int, root context.Context) {
+			builder := adcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttributeDefinitionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, adcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, adcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, adcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (adcb *AttributeDefinitionCreateBulk) SaveX(ctx context.Context) []*AttributeDefinition {
+	v, err := adcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (adcb *AttributeDefinitionCreateBulk) Exec(ctx context.Context) error {
+	_, err := adcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (adcb *AttributeDefinitionCreateBulk) ExecX(ctx context.Context) {
+	if err := adcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/attributedefinition"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+)
+
+// AttributeDefinitionDelete is the builder for deleting a AttributeDefinition entity.
+type AttributeDefinitionDelete struct {
+	config
+	hooks    []Hook
+	mutation *AttributeDefinitionMutation
+}
+
+// Where appends a list predicates to the AttributeDefinitionDelete builder.
+func (add *AttributeDefinitionDelete) Where(ps ...predicate.AttributeDefinition) *AttributeDefinitionDelete {
+	add.mutation.Where(ps...)
+	return add
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (add *AttributeDefinitionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, add.sqlExec, add.mutation, add.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (add *AttributeDefinitionDelete) ExecX(ctx context.Context) int {
+	n, err := add.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (add *AttributeDefinitionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(attributedefinition.Table, sqlgraph.NewFieldSpec(attributedefinition.FieldID, field.TypeInt))
+	if ps := add.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, add.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	add.mutation.done = true
+	return affected, err
+}
+
+// AttributeDefinitionDeleteOne is the builder for deleting a single AttributeDefinition entity.
+type AttributeDefinitionDeleteOne struct {
+	add *AttributeDefinitionDelete
+}
+
+// Where appends a list predicates to the AttributeDefinitionDelete builder.
+func (addo *AttributeDefinitionDeleteOne) Where(ps ...predicate.AttributeDefinition) *AttributeDefinitionDeleteOne {
+	addo.add.mutation.Where(ps...)
+	return addo
+}
+
+// Exec executes the deletion query.
+func (addo *AttributeDefinitionDeleteOne) Exec(ctx context.Context) error {
+	n, err := addo.add.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{attributedefinition.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (addo *AttributeDefinitionDeleteOne) ExecX(ctx context.Context) {
+	if err := addo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/attributedefinition"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+)
+
+// AttributeDefinitionQuery is the builder for querying AttributeDefinition entities.
+type AttributeDefinitionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []attributedefinition.OrderOption
+	inters     []Interceptor
+	predicates []predicate.AttributeDefinition
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AttributeDefinitionQuery builder.
+func (adq *AttributeDefinitionQuery) Where(ps ...predicate.AttributeDefinition) *AttributeDefinitionQuery {
+	adq.predicates = append(adq.predicates, ps...)
+	return adq
+}
+
+// Limit the number of records to be returned by this query.
+func (adq *AttributeDefinitionQuery) Limit(limit int) *AttributeDefinitionQuery {
+	adq.ctx.Limit = &limit
+	return adq
+}
+
+// Offset to start from.
+func (adq *AttributeDefinitionQuery) Offset(offset int) *AttributeDefinitionQuery {
+	adq.ctx.Offset = &offset
+	return adq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (adq *AttributeDefinitionQuery) Unique(unique bool) *AttributeDefinitionQuery {
+	adq.ctx.Unique = &unique
+	return adq
+}
+
+// Order specifies how the records should be ordered.
+func (adq *AttributeDefinitionQuery) Order(o ...attributedefinition.OrderOption) *AttributeDefinitionQuery {
+	adq.order = append(adq.order, o...)
+	return adq
+}
+
+// First returns the first AttributeDefinition entity from the query.
+// Returns a *NotFoundError when no AttributeDefinition was found.
+func (adq *AttributeDefinitionQuery) First(ctx context.Context) (*AttributeDefinition, error) {
+	nodes, err := adq.Limit(1).All(setContextOp(ctx, adq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{attributedefinition.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (adq *AttributeDefinitionQuery) FirstX(ctx context.Context) *AttributeDefinition {
+	node, err := adq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AttributeDefinition ID from the query.
+// Returns a *NotFoundError when no AttributeDefinition ID was found.
+func (adq *AttributeDefinitionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = adq.Limit(1).IDs(setContextOp(ctx, adq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{attributedefinition.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (adq *AttributeDefinitionQuery) FirstIDX(ctx context.Context) int {
+	id, err := adq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AttributeDefinition entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AttributeDefinition entity is found.
+// Returns a *NotFoundError when no AttributeDefinition entities are found.
+func (adq *AttributeDefinitionQuery) Only(ctx context.Context) (*AttributeDefinition, error) {
+	nodes, err := adq.Limit(2).All(setContextOp(ctx, adq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{attributedefinition.Label}
+	default:
+		return nil, &NotSingularError{attributedefinition.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (adq *AttributeDefinitionQuery) OnlyX(ctx context.Context) *AttributeDefinition {
+	node, err := adq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AttributeDefinition ID in the query.
+// Returns a *NotSingularError when more than one AttributeDefinition ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (adq *AttributeDefinitionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = adq.Limit(2).IDs(setContextOp(ctx, adq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{attributedefinition.Label}
+	default:
+		err = &NotSingularError{attributedefinition.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (adq *AttributeDefinitionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := adq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AttributeDefinitions.
+func (adq *AttributeDefinitionQuery) All(ctx context.Context) ([]*AttributeDefinition, error) {
+	ctx = setContextOp(ctx, adq.ctx, ent.OpQueryAll)
+	if err := adq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AttributeDefinition, *AttributeDefinitionQuery]()
+	return withInterceptors[[]*AttributeDefinition](ctx, adq, qr, adq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (adq *AttributeDefinitionQuery) AllX(ctx context.Context) []*AttributeDefinition {
+	nodes, err := adq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AttributeDefinition IDs.
+func (adq *AttributeDefinitionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if adq.ctx.Unique == nil && adq.path != nil {
+		adq.Unique(true)
+	}
+	ctx = setContextOp(ctx, adq.ctx, ent.OpQueryIDs)
+	if err = adq.Select(attributedefinition.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (adq *AttributeDefinitionQuery) IDsX(ctx context.Context) []int {
+	ids, err := adq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (adq *AttributeDefinitionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, adq.ctx, ent.OpQueryCount)
+	if err := adq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, adq, querierCount[*AttributeDefinitionQuery](), adq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (adq *AttributeDefinitionQuery) CountX(ctx context.Context) int {
+	count, err := adq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (adq *AttributeDefinitionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, adq.ctx, ent.OpQueryExist)
+	switch _, err := adq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (adq *AttributeDefinitionQuery) ExistX(ctx context.Context) bool {
+	exist, err := adq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AttributeDefinitionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (adq *AttributeDefinitionQuery) Clone() *AttributeDefinitionQuery {
+	if adq == nil {
+		return nil
+	}
+	return &AttributeDefinitionQuery{
+		config:     adq.config,
+		ctx:        adq.ctx.Clone(),
+		order:      append([]attributedefinition.OrderOption{}, adq.order...),
+		inters:     append([]Interceptor{}, adq.inters...),
+		predicates: append([]predicate.AttributeDefinition{}, adq.predicates...),
+		// clone intermediate query.
+		sql:       adq.sql.Clone(),
+		path:      adq.path,
+		modifiers: append([]func(*sql.Selector){}, adq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrgID int64 `json:"org_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AttributeDefinition.Query().
+//		GroupBy(attributedefinition.FieldOrgID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (adq *AttributeDefinitionQuery) GroupBy(field string, fields ...string) *AttributeDefinitionGroupBy {
+	adq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AttributeDefinitionGroupBy{build: adq}
+	grbuild.flds = &adq.ctx.Fields
+	grbuild.label = attributedefinition.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrgID int64 `json:"org_id"`
+//	}
+//
+//	client.AttributeDefinition.Query().
+//		Select(attributedefinition.FieldOrgID).
+//		Scan(ctx, &v)
+func (adq *AttributeDefinitionQuery) Select(fields ...string) *AttributeDefinitionSelect {
+	adq.ctx.Fields = append(adq.ctx.Fields, fields...)
+	sbuild := &AttributeDefinitionSelect{AttributeDefinitionQuery: adq}
+	sbuild.label = attributedefinition.Label
+	sbuild.flds, sbuild.scan = &adq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AttributeDefinitionSelect configured with the given aggregations.
+func (adq *AttributeDefinitionQuery) Aggregate(fns ...AggregateFunc) *AttributeDefinitionSelect {
+	return adq.Select().Aggregate(fns...)
+}
+
+func (adq *AttributeDefinitionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range adq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, adq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range adq.ctx.Fields {
+		if !attributedefinition.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if adq.path != nil {
+		prev, err := adq.path(ctx)
+		if err != nil {
+			return err
+		}
+		adq.sql = prev
+	}
+	return nil
+}
+
+func (adq *AttributeDefinitionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AttributeDefinition, error) {
+	var (
+		nodes = []*AttributeDefinition{}
+		_spec = adq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AttributeDefinition).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AttributeDefinition{config: adq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(adq.modifiers) > 0 {
+		_spec.Modifiers = adq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, adq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (adq *AttributeDefinitionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := adq.querySpec()
+	if len(adq.modifiers) > 0 {
+		_spec.Modifiers = adq.modifiers
+	}
+	_spec.Node.Columns = adq.ctx.Fields
+	if len(adq.ctx.Fields) > 0 {
+		_spec.Unique = adq.ctx.Unique != nil && *adq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, adq.driver, _spec)
+}
+
+func (adq *AttributeDefinitionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(attributedefinition.Table, attributedefinition.Columns, sqlgraph.NewFieldSpec(attributedefinition.FieldID, field.TypeInt))
+	_spec.From = adq.sql
+	if unique := adq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if adq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := adq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attributedefinition.FieldID)
+		for i := range fields {
+			if fields[i] != attributedefinition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := adq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := adq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := adq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := adq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (adq *AttributeDefinitionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(adq.driver.Dialect())
+	t1 := builder.Table(attributedefinition.Table)
+	columns := adq.ctx.Fields
+	if len(columns) == 0 {
+		columns = attributedefinition.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if adq.sql != nil {
+		selector = adq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if adq.ctx.Unique != nil && *adq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range adq.modifiers {
+		m(selector)
+	}
+	for _, p := range adq.predicates {
+		p(selector)
+	}
+	for _, p := range adq.order {
+		p(selector)
+	}
+	if offset := adq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := adq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (adq *AttributeDefinitionQuery) Modify(modifiers ...func(s *sql.Selector)) *AttributeDefinitionSelect {
+	adq.modifiers = append(adq.modifiers, modifiers...)
+	return adq.Select()
+}
+
+// AttributeDefinitionGroupBy is the group-by builder for AttributeDefinition entities.
+type AttributeDefinitionGroupBy struct {
+	selector
+	build *AttributeDefinitionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (adgb *AttributeDefinitionGroupBy) Aggregate(fns ...AggregateFunc) *AttributeDefinitionGroupBy {
+	adgb.fns = append(adgb.fns, fns...)
+	return adgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (adgb *AttributeDefinitionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, adgb.build.ctx, ent.OpQueryGroupBy)
+	if err := adgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AttributeDefinitionQuery, *AttributeDefinitionGroupBy](ctx, adgb.build, adgb, adgb.build.inters, v)
+}
+
+func (adgb *AttributeDefinitionGroupBy) sqlScan(ctx context.Context, root *AttributeDefinitionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(adgb.fns))
+	for _, fn := range adgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*adgb.flds)+len(adgb.fns))
+		for _, f := range *adgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*adgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := adgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AttributeDefinitionSelect is the builder for selecting fields of AttributeDefinition entities.
+type AttributeDefinitionSelect struct {
+	*AttributeDefinitionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ads *AttributeDefinitionSelect) Aggregate(fns ...AggregateFunc) *AttributeDefinitionSelect {
+	ads.fns = append(ads.fns, fns...)
+	return ads
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ads *AttributeDefinitionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ads.ctx, ent.OpQuerySelect)
+	if err := ads.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AttributeDefinitionQuery, *AttributeDefinitionSelect](ctx, ads.AttributeDefinitionQuery, ads, ads.inters, v)
+}
+
+func (ads *AttributeDefinitionSelect) sqlScan(ctx context.Context, root *AttributeDefinitionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ads.fns))
+	for _, fn := range ads.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ads.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ads.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ads *AttributeDefinitionSelect) Modify(modifiers ...func(s *sql.Selector)) *AttributeDefinitionSelect {
+	ads.modifiers = append(ads.modifiers, modifiers...)
+	return ads
+}
@@ -0,0 +1,467 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/attributedefinition"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+)
+
+// AttributeDefinitionUpdate is the builder for updating AttributeDefinition entities.
+type AttributeDefinitionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AttributeDefinitionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AttributeDefinitionUpdate builder.
+func (adu *AttributeDefinitionUpdate) Where(ps ...predicate.AttributeDefinition) *AttributeDefinitionUpdate {
+	adu.mutation.Where(ps...)
+	return adu
+}
+
+// SetLabel sets the "label" field.
+func (adu *AttributeDefinitionUpdate) SetLabel(s string) *AttributeDefinitionUpdate {
+	adu.mutation.SetLabel(s)
+	return adu
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableLabel(s *string) *AttributeDefinitionUpdate {
+	if s != nil {
+		adu.SetLabel(*s)
+	}
+	return adu
+}
+
+// SetType sets the "type" field.
+func (adu *AttributeDefinitionUpdate) SetType(a attributedefinition.Type) *AttributeDefinitionUpdate {
+	adu.mutation.SetType(a)
+	return adu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableType(a *attributedefinition.Type) *AttributeDefinitionUpdate {
+	if a != nil {
+		adu.SetType(*a)
+	}
+	return adu
+}
+
+// SetRequired sets the "required" field.
+func (adu *AttributeDefinitionUpdate) SetRequired(b bool) *AttributeDefinitionUpdate {
+	adu.mutation.SetRequired(b)
+	return adu
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableRequired(b *bool) *AttributeDefinitionUpdate {
+	if b != nil {
+		adu.SetRequired(*b)
+	}
+	return adu
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (adu *AttributeDefinitionUpdate) SetEnumValues(s []string) *AttributeDefinitionUpdate {
+	adu.mutation.SetEnumValues(s)
+	return adu
+}
+
+// AppendEnumValues appends s to the "enum_values" field.
+func (adu *AttributeDefinitionUpdate) AppendEnumValues(s []string) *AttributeDefinitionUpdate {
+	adu.mutation.AppendEnumValues(s)
+	return adu
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (adu *AttributeDefinitionUpdate) ClearEnumValues() *AttributeDefinitionUpdate {
+	adu.mutation.ClearEnumValues()
+	return adu
+}
+
+// SetPattern sets the "pattern" field.
+func (adu *AttributeDefinitionUpdate) SetPattern(s string) *AttributeDefinitionUpdate {
+	adu.mutation.SetPattern(s)
+	return adu
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillablePattern(s *string) *AttributeDefinitionUpdate {
+	if s != nil {
+		adu.SetPattern(*s)
+	}
+	return adu
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (adu *AttributeDefinitionUpdate) ClearPattern() *AttributeDefinitionUpdate {
+	adu.mutation.ClearPattern()
+	return adu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (adu *AttributeDefinitionUpdate) SetUpdatedAt(t time.Time) *AttributeDefinitionUpdate {
+	adu.mutation.SetUpdatedAt(t)
+	return adu
+}
+
+// Mutation returns the AttributeDefinitionMutation object of the builder.
+func (adu *AttributeDefinitionUpdate) Mutation() *AttributeDefinitionMutation {
+	return adu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (adu *AttributeDefinitionUpdate) Save(ctx context.Context) (int, error) {
+	adu.defaults()
+	return withHooks(ctx, adu.sqlSave, adu.mutation, adu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (adu *AttributeDefinitionUpdate) SaveX(ctx context.Context) int {
+	affected, err := adu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (adu *AttributeDefinitionUpdate) Exec(ctx context.Context) error {
+	_, err := adu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (adu *AttributeDefinitionUpdate) ExecX(ctx context.Context) {
+	if err := adu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (adu *AttributeDefinitionUpdate) defaults() {
+	if _, ok := adu.mutation.UpdatedAt(); !ok {
+		v := attributedefinition.UpdateDefaultUpdatedAt()
+		adu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (adu *AttributeDefinitionUpdate) check() error {
+	if v, ok := adu.mutation.GetType(); ok {
+		if err := attributedefinition.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "AttributeDefinition.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (adu *AttributeDefinitionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttributeDefinitionUpdate {
+	adu.modifiers = append(adu.modifiers, modifiers...)
+	return adu
+}
+
+func (adu *AttributeDefinitionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := adu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attributedefinition.Table, attributedefinition.Columns, sqlgraph.NewFieldSpec(attributedefinition.FieldID, field.TypeInt))
+	if ps := adu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := adu.mutation.Label(); ok {
+		_spec.SetField(attributedefinition.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := adu.mutation.GetType(); ok {
+		_spec.SetField(attributedefinition.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := adu.mutation.Required(); ok {
+		_spec.SetField(attributedefinition.FieldRequired, field.TypeBool, value)
+	}
+	if value, ok := adu.mutation.EnumValues(); ok {
+		_spec.SetField(attributedefinition.FieldEnumValues, field.TypeJSON, value)
+	}
+	if value, ok := adu.mutation.AppendedEnumValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, attributedefinition.FieldEnumValues, value)
+		})
+	}
+	if adu.mutation.EnumValuesCleared() {
+		_spec.ClearField(attributedefinition.FieldEnumValues, field.TypeJSON)
+	}
+	if value, ok := adu.mutation.Pattern(); ok {
+		_spec.SetField(attributedefinition.FieldPattern, field.TypeString, value)
+	}
+	if adu.mutation.PatternCleared() {
+		_spec.ClearField(attributedefinition.FieldPattern, field.TypeString)
+	}
+	if value, ok := adu.mutation.UpdatedAt(); ok {
+		_spec.SetField(attributedefinition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(adu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, adu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attributedefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	adu.mutation.done = true
+	return n, nil
+}
+
+// AttributeDefinitionUpdateOne is the builder for updating a single AttributeDefinition entity.
+type AttributeDefinitionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AttributeDefinitionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetLabel sets the "label" field.
+func (aduo *AttributeDefinitionUpdateOne) SetLabel(s string) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetLabel(s)
+	return aduo
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableLabel(s *string) *AttributeDefinitionUpdateOne {
+	if s != nil {
+		aduo.SetLabel(*s)
+	}
+	return aduo
+}
+
+// SetType sets the "type" field.
+func (aduo *AttributeDefinitionUpdateOne) SetType(a attributedefinition.Type) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetType(a)
+	return aduo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableType(a *attributedefinition.Type) *AttributeDefinitionUpdateOne {
+	if a != nil {
+		aduo.SetType(*a)
+	}
+	return aduo
+}
+
+// SetRequired sets the "required" field.
+func (aduo *AttributeDefinitionUpdateOne) SetRequired(b bool) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetRequired(b)
+	return aduo
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableRequired(b *bool) *AttributeDefinitionUpdateOne {
+	if b != nil {
+		aduo.SetRequired(*b)
+	}
+	return aduo
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (aduo *AttributeDefinitionUpdateOne) SetEnumValues(s []string) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetEnumValues(s)
+	return aduo
+}
+
+// AppendEnumValues appends s to the "enum_values" field.
+func (aduo *AttributeDefinitionUpdateOne) AppendEnumValues(s []string) *AttributeDefinitionUpdateOne {
+	aduo.mutation.AppendEnumValues(s)
+	return aduo
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (aduo *AttributeDefinitionUpdateOne) ClearEnumValues() *AttributeDefinitionUpdateOne {
+	aduo.mutation.ClearEnumValues()
+	return aduo
+}
+
+// SetPattern sets the "pattern" field.
+func (aduo *AttributeDefinitionUpdateOne) SetPattern(s string) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetPattern(s)
+	return aduo
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillablePattern(s *string) *AttributeDefinitionUpdateOne {
+	if s != nil {
+		aduo.SetPattern(*s)
+	}
+	return aduo
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (aduo *AttributeDefinitionUpdateOne) ClearPattern() *AttributeDefinitionUpdateOne {
+	aduo.mutation.ClearPattern()
+	return aduo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aduo *AttributeDefinitionUpdateOne) SetUpdatedAt(t time.Time) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetUpdatedAt(t)
+	return aduo
+}
+
+// Mutation returns the AttributeDefinitionMutation object of the builder.
+func (aduo *AttributeDefinitionUpdateOne) Mutation() *AttributeDefinitionMutation {
+	return aduo.mutation
+}
+
+// Where appends a list predicates to the AttributeDefinitionUpdate builder.
+func (aduo *AttributeDefinitionUpdateOne) Where(ps ...predicate.AttributeDefinition) *AttributeDefinitionUpdateOne {
+	aduo.mutation.Where(ps...)
+	return aduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aduo *AttributeDefinitionUpdateOne) Select(field string, fields ...string) *AttributeDefinitionUpdateOne {
+	aduo.fields = append([]string{field}, fields...)
+	return aduo
+}
+
+// Save executes the query and returns the updated AttributeDefinition entity.
+func (aduo *AttributeDefinitionUpdateOne) Save(ctx context.Context) (*AttributeDefinition, error) {
+	aduo.defaults()
+	return withHooks(ctx, aduo.sqlSave, aduo.mutation, aduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aduo *AttributeDefinitionUpdateOne) SaveX(ctx context.Context) *AttributeDefinition {
+	node, err := aduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aduo *AttributeDefinitionUpdateOne) Exec(ctx context.Context) error {
+	_, err := aduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aduo *AttributeDefinitionUpdateOne) ExecX(ctx context.Context) {
+	if err := aduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aduo *AttributeDefinitionUpdateOne) defaults() {
+	if _, ok := aduo.mutation.UpdatedAt(); !ok {
+		v := attributedefinition.UpdateDefaultUpdatedAt()
+		aduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aduo *AttributeDefinitionUpdateOne) check() error {
+	if v, ok := aduo.mutation.GetType(); ok {
+		if err := attributedefinition.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "AttributeDefinition.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (aduo *AttributeDefinitionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttributeDefinitionUpdateOne {
+	aduo.modifiers = append(aduo.modifiers, modifiers...)
+	return aduo
+}
+
+func (aduo *AttributeDefinitionUpdateOne) sqlSave(ctx context.Context) (_node *AttributeDefinition, err error) {
+	if err := aduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attributedefinition.Table, attributedefinition.Columns, sqlgraph.NewFieldSpec(attributedefinition.FieldID, field.TypeInt))
+	id, ok := aduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AttributeDefinition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attributedefinition.FieldID)
+		for _, f := range fields {
+			if !attributedefinition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attributedefinition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aduo.mutation.Label(); ok {
+		_spec.SetField(attributedefinition.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := aduo.mutation.GetType(); ok {
+		_spec.SetField(attributedefinition.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := aduo.mutation.Required(); ok {
+		_spec.SetField(attributedefinition.FieldRequired, field.TypeBool, value)
+	}
+	if value, ok := aduo.mutation.EnumValues(); ok {
+		_spec.SetField(attributedefinition.FieldEnumValues, field.TypeJSON, value)
+	}
+	if value, ok := aduo.mutation.AppendedEnumValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, attributedefinition.FieldEnumValues, value)
+		})
+	}
+	if aduo.mutation.EnumValuesCleared() {
+		_spec.ClearField(attributedefinition.FieldEnumValues, field.TypeJSON)
+	}
+	if value, ok := aduo.mutation.Pattern(); ok {
+		_spec.SetField(attributedefinition.FieldPattern, field.TypeString, value)
+	}
+	if aduo.mutation.PatternCleared() {
+		_spec.ClearField(attributedefinition.FieldPattern, field.TypeString)
+	}
+	if value, ok := aduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(attributedefinition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(aduo.modifiers...)
+	_node = &AttributeDefinition{config: aduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attributedefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/huynhthanhthao/hrm_user_service/ent/emergencycontact"
+	"github.com/huynhthanhthao/hrm_user_service/ent/profile"
+)
+
+// EmergencyContact is the model entity for the EmergencyContact schema.
+type EmergencyContact struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name"`
+	// Relationship holds the value of the "relationship" field.
+	Relationship string `json:"relationship"`
+	// Phone holds the value of the "phone" field.
+	Phone string `json:"phone"`
+	// Address holds the value of the "address" field.
+	Address *string `json:"address"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EmergencyContactQuery when eager-loading is set.
+	Edges                      EmergencyContactEdges `json:"edges"`
+	profile_emergency_contacts *int
+	selectValues               sql.SelectValues
+}
+
+// EmergencyContactEdges holds the relations/edges for other nodes in the graph.
+type EmergencyContactEdges struct {
+	// Profile holds the value of the profile edge.
+	Profile *Profile `json:"profile,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProfileOrErr returns the Profile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmergencyContactEdges) ProfileOrErr() (*Profile, error) {
+	if e.Profile != nil {
+		return e.Profile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: profile.Label}
+	}
+	return nil, &NotLoadedError{edge: "profile"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmergencyContact) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emergencycontact.FieldID, emergencycontact.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case emergencycontact.FieldName, emergencycontact.FieldRelationship, emergencycontact.FieldPhone, emergencycontact.FieldAddress:
+			values[i] = new(sql.NullString)
+		case emergencycontact.ForeignKeys[0]: // profile_emergency_contacts
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmergencyContact fields.
+func (ec *EmergencyContact) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emergencycontact.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ec.ID = int(value.Int64)
+		case emergencycontact.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ec.Name = value.String
+			}
+		case emergencycontact.FieldRelationship:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field relationship", values[i])
+			} else if value.Valid {
+				ec.Relationship = value.String
+			}
+		case emergencycontact.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				ec.Phone = value.String
+			}
+		case emergencycontact.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				ec.Address = new(string)
+				*ec.Address = value.String
+			}
+		case emergencycontact.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				ec.Position = int(value.Int64)
+			}
+		case emergencycontact.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field profile_emergency_contacts", value)
+			} else if value.Valid {
+				ec.profile_emergency_contacts = new(int)
+				*ec.profile_emergency_contacts = int(value.Int64)
+			}
+		default:
+			ec.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmergencyContact.
+// This includes values selected through modifiers, order, etc.
+func (ec *EmergencyContact) Value(name string) (ent.Value, error) {
+	return ec.selectValues.Get(name)
+}
+
+// QueryProfile queries the "profile" edge of the EmergencyContact entity.
+func (ec *EmergencyContact) QueryProfile() *ProfileQuery {
+	return NewEmergencyContactClient(ec.config).QueryProfile(ec)
+}
+
+// Update returns a builder for updating this EmergencyContact.
+// Note that you need to call EmergencyContact.Unwrap() before calling this method if this EmergencyContact
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ec *EmergencyContact) Update() *EmergencyContactUpdateOne {
+	return NewEmergencyContactClient(ec.config).UpdateOne(ec)
+}
+
+// Unwrap unwraps the EmergencyContact entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ec *EmergencyContact) Unwrap() *EmergencyContact {
+	_tx, ok := ec.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmergencyContact is not a transactional entity")
+	}
+	ec.config.driver = _tx.drv
+	return ec
+}
+
+// String implements the fmt.Stringer.
+func (ec *EmergencyContact) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmergencyContact(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ec.ID))
+	builder.WriteString("name=")
+	builder.WriteString(ec.Name)
+	builder.WriteString(", ")
+	builder.WriteString("relationship=")
+	builder.WriteString(ec.Relationship)
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(ec.Phone)
+	builder.WriteString(", ")
+	if v := ec.Address; v != nil {
+		builder.WriteString("address=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", ec.Position))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmergencyContacts is a parsable slice of EmergencyContact.
+type EmergencyContacts []*EmergencyContact
@@ -0,0 +1,427 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emergencycontact
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldName, v))
+}
+
+// Relationship applies equality check predicate on the "relationship" field. It's identical to RelationshipEQ.
+func Relationship(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldRelationship, v))
+}
+
+// Phone applies equality check predicate on the "phone" field. It's identical to PhoneEQ.
+func Phone(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldPhone, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldAddress, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldPosition, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldContainsFold(FieldName, v))
+}
+
+// RelationshipEQ applies the EQ predicate on the "relationship" field.
+func RelationshipEQ(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldRelationship, v))
+}
+
+// RelationshipNEQ applies the NEQ predicate on the "relationship" field.
+func RelationshipNEQ(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNEQ(FieldRelationship, v))
+}
+
+// RelationshipIn applies the In predicate on the "relationship" field.
+func RelationshipIn(vs ...string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIn(FieldRelationship, vs...))
+}
+
+// RelationshipNotIn applies the NotIn predicate on the "relationship" field.
+func RelationshipNotIn(vs ...string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotIn(FieldRelationship, vs...))
+}
+
+// RelationshipGT applies the GT predicate on the "relationship" field.
+func RelationshipGT(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGT(FieldRelationship, v))
+}
+
+// RelationshipGTE applies the GTE predicate on the "relationship" field.
+func RelationshipGTE(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGTE(FieldRelationship, v))
+}
+
+// RelationshipLT applies the LT predicate on the "relationship" field.
+func RelationshipLT(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLT(FieldRelationship, v))
+}
+
+// RelationshipLTE applies the LTE predicate on the "relationship" field.
+func RelationshipLTE(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLTE(FieldRelationship, v))
+}
+
+// RelationshipContains applies the Contains predicate on the "relationship" field.
+func RelationshipContains(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldContains(FieldRelationship, v))
+}
+
+// RelationshipHasPrefix applies the HasPrefix predicate on the "relationship" field.
+func RelationshipHasPrefix(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldHasPrefix(FieldRelationship, v))
+}
+
+// RelationshipHasSuffix applies the HasSuffix predicate on the "relationship" field.
+func RelationshipHasSuffix(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldHasSuffix(FieldRelationship, v))
+}
+
+// RelationshipEqualFold applies the EqualFold predicate on the "relationship" field.
+func RelationshipEqualFold(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEqualFold(FieldRelationship, v))
+}
+
+// RelationshipContainsFold applies the ContainsFold predicate on the "relationship" field.
+func RelationshipContainsFold(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldContainsFold(FieldRelationship, v))
+}
+
+// PhoneEQ applies the EQ predicate on the "phone" field.
+func PhoneEQ(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldPhone, v))
+}
+
+// PhoneNEQ applies the NEQ predicate on the "phone" field.
+func PhoneNEQ(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNEQ(FieldPhone, v))
+}
+
+// PhoneIn applies the In predicate on the "phone" field.
+func PhoneIn(vs ...string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIn(FieldPhone, vs...))
+}
+
+// PhoneNotIn applies the NotIn predicate on the "phone" field.
+func PhoneNotIn(vs ...string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotIn(FieldPhone, vs...))
+}
+
+// PhoneGT applies the GT predicate on the "phone" field.
+func PhoneGT(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGT(FieldPhone, v))
+}
+
+// PhoneGTE applies the GTE predicate on the "phone" field.
+func PhoneGTE(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGTE(FieldPhone, v))
+}
+
+// PhoneLT applies the LT predicate on the "phone" field.
+func PhoneLT(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLT(FieldPhone, v))
+}
+
+// PhoneLTE applies the LTE predicate on the "phone" field.
+func PhoneLTE(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLTE(FieldPhone, v))
+}
+
+// PhoneContains applies the Contains predicate on the "phone" field.
+func PhoneContains(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldContains(FieldPhone, v))
+}
+
+// PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
+func PhoneHasPrefix(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldHasPrefix(FieldPhone, v))
+}
+
+// PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
+func PhoneHasSuffix(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldHasSuffix(FieldPhone, v))
+}
+
+// PhoneEqualFold applies the EqualFold predicate on the "phone" field.
+func PhoneEqualFold(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEqualFold(FieldPhone, v))
+}
+
+// PhoneContainsFold applies the ContainsFold predicate on the "phone" field.
+func PhoneContainsFold(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldContainsFold(FieldPhone, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressIsNil applies the IsNil predicate on the "address" field.
+func AddressIsNil() predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIsNull(FieldAddress))
+}
+
+// AddressNotNil applies the NotNil predicate on the "address" field.
+func AddressNotNil() predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotNull(FieldAddress))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLTE(FieldPosition, v))
+}
+
+// HasProfile applies the HasEdge predicate on the "profile" edge.
+func HasProfile() predicate.EmergencyContact {
+	return predicate.EmergencyContact(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileWith applies the HasEdge predicate on the "profile" edge with a given conditions (other predicates).
+func HasProfileWith(preds ...predicate.Profile) predicate.EmergencyContact {
+	return predicate.EmergencyContact(func(s *sql.Selector) {
+		step := newProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmergencyContact) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmergencyContact) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmergencyContact) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.NotPredicates(p))
+}
@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/emergencycontact"
+	"github.com/huynhthanhthao/hrm_user_service/ent/profile"
+)
+
+// EmergencyContactCreate is the builder for creating a EmergencyContact entity.
+type EmergencyContactCreate struct {
+	config
+	mutation *EmergencyContactMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (ecc *EmergencyContactCreate) SetName(s string) *EmergencyContactCreate {
+	ecc.mutation.SetName(s)
+	return ecc
+}
+
+// SetRelationship sets the "relationship" field.
+func (ecc *EmergencyContactCreate) SetRelationship(s string) *EmergencyContactCreate {
+	ecc.mutation.SetRelationship(s)
+	return ecc
+}
+
+// SetPhone sets the "phone" field.
+func (ecc *EmergencyContactCreate) SetPhone(s string) *EmergencyContactCreate {
+	ecc.mutation.SetPhone(s)
+	return ecc
+}
+
+// SetAddress sets the "address" field.
+func (ecc *EmergencyContactCreate) SetAddress(s string) *EmergencyContactCreate {
+	ecc.mutation.SetAddress(s)
+	return ecc
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (ecc *EmergencyContactCreate) SetNillableAddress(s *string) *EmergencyContactCreate {
+	if s != nil {
+		ecc.SetAddress(*s)
+	}
+	return ecc
+}
+
+// SetPosition sets the "position" field.
+func (ecc *EmergencyContactCreate) SetPosition(i int) *EmergencyContactCreate {
+	ecc.mutation.SetPosition(i)
+	return ecc
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (ecc *EmergencyContactCreate) SetNillablePosition(i *int) *EmergencyContactCreate {
+	if i != nil {
+		ecc.SetPosition(*i)
+	}
+	return ecc
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (ecc *EmergencyContactCreate) SetProfileID(id int) *EmergencyContactCreate {
+	ecc.mutation.SetProfileID(id)
+	return ecc
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (ecc *EmergencyContactCreate) SetProfile(p *Profile) *EmergencyContactCreate {
+	return ecc.SetProfileID(p.ID)
+}
+
+// Mutation returns the EmergencyContactMutation object of the builder.
+func (ecc *EmergencyContactCreate) Mutation() *EmergencyContactMutation {
+	return ecc.mutation
+}
+
+// Save creates the EmergencyContact in the database.
+func (ecc *EmergencyContactCreate) Save(ctx context.Context) (*EmergencyContact, error) {
+	ecc.defaults()
+	return withHooks(ctx, ecc.sqlSave, ecc.mutation, ecc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ecc *EmergencyContactCreate) SaveX(ctx context.Context) *EmergencyContact {
+	v, err := ecc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ecc *EmergencyContactCreate) Exec(ctx context.Context) error {
+	_, err := ecc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecc *EmergencyContactCreate) ExecX(ctx context.Context) {
+	if err := ecc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ecc *EmergencyContactCreate) defaults() {
+	if _, ok := ecc.mutation.Position(); !ok {
+		v := emergencycontact.DefaultPosition
+		ecc.mutation.SetPosition(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ecc *EmergencyContactCreate) check() error {
+	if _, ok := ecc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "EmergencyContact.name"`)}
+	}
+	if v, ok := ecc.mutation.Name(); ok {
+		if err := emergencycontact.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.name": %w`, err)}
+		}
+	}
+	if _, ok := ecc.mutation.Relationship(); !ok {
+		return &ValidationError{Name: "relationship", err: errors.New(`ent: missing required field "EmergencyContact.relationship"`)}
+	}
+	if v, ok := ecc.mutation.Relationship(); ok {
+		if err := emergencycontact.RelationshipValidator(v); err != nil {
+			return &ValidationError{Name: "relationship", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.relationship": %w`, err)}
+		}
+	}
+	if _, ok := ecc.mutation.Phone(); !ok {
+		return &ValidationError{Name: "phone", err: errors.New(`ent: missing required field "EmergencyContact.phone"`)}
+	}
+	if v, ok := ecc.mutation.Phone(); ok {
+		if err := emergencycontact.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.phone": %w`, err)}
+		}
+	}
+	if _, ok := ecc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "EmergencyContact.position"`)}
+	}
+	if v, ok := ecc.mutation.Position(); ok {
+		if err := emergencycontact.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.position": %w`, err)}
+		}
+	}
+	if len(ecc.mutation.ProfileIDs()) == 0 {
+		return &ValidationError{Name: "profile", err: errors.New(`ent: missing required edge "EmergencyContact.profile"`)}
+	}
+	return nil
+}
+
+func (ecc *EmergencyContactCreate) sqlSave(ctx context.Context) (*EmergencyContact, error) {
+	if err := ecc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ecc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ecc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ecc.mutation.id = &_node.ID
+	ecc.mutation.done = true
+	return _node, nil
+}
+
+func (ecc *EmergencyContactCreate) createSpec() (*EmergencyContact, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmergencyContact{config: ecc.config}
+		_spec = sqlgraph.NewCreateSpec(emergencycontact.Table, sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeInt))
+	)
+	if value, ok := ecc.mutation.Name(); ok {
+		_spec.SetField(emergencycontact.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ecc.mutation.Relationship(); ok {
+		_spec.SetField(emergencycontact.FieldRelationship, field.TypeString, value)
+		_node.Relationship = value
+	}
+	if value, ok := ecc.mutation.Phone(); ok {
+		_spec.SetField(emergencycontact.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
+	if value, ok := ecc.mutation.Address(); ok {
+		_spec.SetField(emergencycontact.FieldAddress, field.TypeString, value)
+		_node.Address = &value
+	}
+	if value, ok := ecc.mutation.Position(); ok {
+		_spec.SetField(emergencycontact.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if nodes := ecc.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emergencycontact.ProfileTable,
+			Columns: []string{emergencycontact.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.profile_emergency_contacts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EmergencyContactCreateBulk is the builder for creating many EmergencyContact entities in bulk.
+type EmergencyContactCreateBulk struct {
+	config
+	err      error
+	builders []*EmergencyContactCreate
+}
+
+// Save creates the EmergencyContact entities in the database.
+func (eccb *EmergencyContactCreateBulk) Save(ctx context.Context) ([]*EmergencyContact, error) {
+	if eccb.err != nil {
+		return nil, eccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(eccb.builders))
+	nodes := make([]*EmergencyContact, len(eccb.builders))
+	mutators := make([]Mutator, len(eccb.builders))
+	for i := range eccb.builders {
+		func(i int, root context.Context) {
+			builder := eccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmergencyContactMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, eccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, eccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, eccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eccb *EmergencyContactCreateBulk) SaveX(ctx context.Context) []*EmergencyContact {
+	v, err := eccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eccb *EmergencyContactCreateBulk) Exec(ctx context.Context) error {
+	_, err := eccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eccb *EmergencyContactCreateBulk) ExecX(ctx context.Context) {
+	if err := eccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
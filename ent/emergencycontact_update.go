@@ -0,0 +1,554 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/emergencycontact"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+	"github.com/huynhthanhthao/hrm_user_service/ent/profile"
+)
+
+// EmergencyContactUpdate is the builder for updating EmergencyContact entities.
+type EmergencyContactUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EmergencyContactMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EmergencyContactUpdate builder.
+func (ecu *EmergencyContactUpdate) Where(ps ...predicate.EmergencyContact) *EmergencyContactUpdate {
+	ecu.mutation.Where(ps...)
+	return ecu
+}
+
+// SetName sets the "name" field.
+func (ecu *EmergencyContactUpdate) SetName(s string) *EmergencyContactUpdate {
+	ecu.mutation.SetName(s)
+	return ecu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ecu *EmergencyContactUpdate) SetNillableName(s *string) *EmergencyContactUpdate {
+	if s != nil {
+		ecu.SetName(*s)
+	}
+	return ecu
+}
+
+// SetRelationship sets the "relationship" field.
+func (ecu *EmergencyContactUpdate) SetRelationship(s string) *EmergencyContactUpdate {
+	ecu.mutation.SetRelationship(s)
+	return ecu
+}
+
+// SetNillableRelationship sets the "relationship" field if the given value is not nil.
+func (ecu *EmergencyContactUpdate) SetNillableRelationship(s *string) *EmergencyContactUpdate {
+	if s != nil {
+		ecu.SetRelationship(*s)
+	}
+	return ecu
+}
+
+// SetPhone sets the "phone" field.
+func (ecu *EmergencyContactUpdate) SetPhone(s string) *EmergencyContactUpdate {
+	ecu.mutation.SetPhone(s)
+	return ecu
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (ecu *EmergencyContactUpdate) SetNillablePhone(s *string) *EmergencyContactUpdate {
+	if s != nil {
+		ecu.SetPhone(*s)
+	}
+	return ecu
+}
+
+// SetAddress sets the "address" field.
+func (ecu *EmergencyContactUpdate) SetAddress(s string) *EmergencyContactUpdate {
+	ecu.mutation.SetAddress(s)
+	return ecu
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (ecu *EmergencyContactUpdate) SetNillableAddress(s *string) *EmergencyContactUpdate {
+	if s != nil {
+		ecu.SetAddress(*s)
+	}
+	return ecu
+}
+
+// ClearAddress clears the value of the "address" field.
+func (ecu *EmergencyContactUpdate) ClearAddress() *EmergencyContactUpdate {
+	ecu.mutation.ClearAddress()
+	return ecu
+}
+
+// SetPosition sets the "position" field.
+func (ecu *EmergencyContactUpdate) SetPosition(i int) *EmergencyContactUpdate {
+	ecu.mutation.ResetPosition()
+	ecu.mutation.SetPosition(i)
+	return ecu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (ecu *EmergencyContactUpdate) SetNillablePosition(i *int) *EmergencyContactUpdate {
+	if i != nil {
+		ecu.SetPosition(*i)
+	}
+	return ecu
+}
+
+// AddPosition adds i to the "position" field.
+func (ecu *EmergencyContactUpdate) AddPosition(i int) *EmergencyContactUpdate {
+	ecu.mutation.AddPosition(i)
+	return ecu
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (ecu *EmergencyContactUpdate) SetProfileID(id int) *EmergencyContactUpdate {
+	ecu.mutation.SetProfileID(id)
+	return ecu
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (ecu *EmergencyContactUpdate) SetProfile(p *Profile) *EmergencyContactUpdate {
+	return ecu.SetProfileID(p.ID)
+}
+
+// Mutation returns the EmergencyContactMutation object of the builder.
+func (ecu *EmergencyContactUpdate) Mutation() *EmergencyContactMutation {
+	return ecu.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (ecu *EmergencyContactUpdate) ClearProfile() *EmergencyContactUpdate {
+	ecu.mutation.ClearProfile()
+	return ecu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ecu *EmergencyContactUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ecu.sqlSave, ecu.mutation, ecu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecu *EmergencyContactUpdate) SaveX(ctx context.Context) int {
+	affected, err := ecu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ecu *EmergencyContactUpdate) Exec(ctx context.Context) error {
+	_, err := ecu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecu *EmergencyContactUpdate) ExecX(ctx context.Context) {
+	if err := ecu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ecu *EmergencyContactUpdate) check() error {
+	if v, ok := ecu.mutation.Name(); ok {
+		if err := emergencycontact.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.name": %w`, err)}
+		}
+	}
+	if v, ok := ecu.mutation.Relationship(); ok {
+		if err := emergencycontact.RelationshipValidator(v); err != nil {
+			return &ValidationError{Name: "relationship", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.relationship": %w`, err)}
+		}
+	}
+	if v, ok := ecu.mutation.Phone(); ok {
+		if err := emergencycontact.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.phone": %w`, err)}
+		}
+	}
+	if v, ok := ecu.mutation.Position(); ok {
+		if err := emergencycontact.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.position": %w`, err)}
+		}
+	}
+	if ecu.mutation.ProfileCleared() && len(ecu.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmergencyContact.profile"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ecu *EmergencyContactUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmergencyContactUpdate {
+	ecu.modifiers = append(ecu.modifiers, modifiers...)
+	return ecu
+}
+
+func (ecu *EmergencyContactUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ecu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emergencycontact.Table, emergencycontact.Columns, sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeInt))
+	if ps := ecu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ecu.mutation.Name(); ok {
+		_spec.SetField(emergencycontact.FieldName, field.TypeString, value)
+	}
+	if value, ok := ecu.mutation.Relationship(); ok {
+		_spec.SetField(emergencycontact.FieldRelationship, field.TypeString, value)
+	}
+	if value, ok := ecu.mutation.Phone(); ok {
+		_spec.SetField(emergencycontact.FieldPhone, field.TypeString, value)
+	}
+	if value, ok := ecu.mutation.Address(); ok {
+		_spec.SetField(emergencycontact.FieldAddress, field.TypeString, value)
+	}
+	if ecu.mutation.AddressCleared() {
+		_spec.ClearField(emergencycontact.FieldAddress, field.TypeString)
+	}
+	if value, ok := ecu.mutation.Position(); ok {
+		_spec.SetField(emergencycontact.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := ecu.mutation.AddedPosition(); ok {
+		_spec.AddField(emergencycontact.FieldPosition, field.TypeInt, value)
+	}
+	if ecu.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emergencycontact.ProfileTable,
+			Columns: []string{emergencycontact.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ecu.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emergencycontact.ProfileTable,
+			Columns: []string{emergencycontact.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ecu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ecu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emergencycontact.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ecu.mutation.done = true
+	return n, nil
+}
+
+// EmergencyContactUpdateOne is the builder for updating a single EmergencyContact entity.
+type EmergencyContactUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EmergencyContactMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (ecuo *EmergencyContactUpdateOne) SetName(s string) *EmergencyContactUpdateOne {
+	ecuo.mutation.SetName(s)
+	return ecuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ecuo *EmergencyContactUpdateOne) SetNillableName(s *string) *EmergencyContactUpdateOne {
+	if s != nil {
+		ecuo.SetName(*s)
+	}
+	return ecuo
+}
+
+// SetRelationship sets the "relationship" field.
+func (ecuo *EmergencyContactUpdateOne) SetRelationship(s string) *EmergencyContactUpdateOne {
+	ecuo.mutation.SetRelationship(s)
+	return ecuo
+}
+
+// SetNillableRelationship sets the "relationship" field if the given value is not nil.
+func (ecuo *EmergencyContactUpdateOne) SetNillableRelationship(s *string) *EmergencyContactUpdateOne {
+	if s != nil {
+		ecuo.SetRelationship(*s)
+	}
+	return ecuo
+}
+
+// SetPhone sets the "phone" field.
+func (ecuo *EmergencyContactUpdateOne) SetPhone(s string) *EmergencyContactUpdateOne {
+	ecuo.mutation.SetPhone(s)
+	return ecuo
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (ecuo *EmergencyContactUpdateOne) SetNillablePhone(s *string) *EmergencyContactUpdateOne {
+	if s != nil {
+		ecuo.SetPhone(*s)
+	}
+	return ecuo
+}
+
+// SetAddress sets the "address" field.
+func (ecuo *EmergencyContactUpdateOne) SetAddress(s string) *EmergencyContactUpdateOne {
+	ecuo.mutation.SetAddress(s)
+	return ecuo
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (ecuo *EmergencyContactUpdateOne) SetNillableAddress(s *string) *EmergencyContactUpdateOne {
+	if s != nil {
+		ecuo.SetAddress(*s)
+	}
+	return ecuo
+}
+
+// ClearAddress clears the value of the "address" field.
+func (ecuo *EmergencyContactUpdateOne) ClearAddress() *EmergencyContactUpdateOne {
+	ecuo.mutation.ClearAddress()
+	return ecuo
+}
+
+// SetPosition sets the "position" field.
+func (ecuo *EmergencyContactUpdateOne) SetPosition(i int) *EmergencyContactUpdateOne {
+	ecuo.mutation.ResetPosition()
+	ecuo.mutation.SetPosition(i)
+	return ecuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (ecuo *EmergencyContactUpdateOne) SetNillablePosition(i *int) *EmergencyContactUpdateOne {
+	if i != nil {
+		ecuo.SetPosition(*i)
+	}
+	return ecuo
+}
+
+// AddPosition adds i to the "position" field.
+func (ecuo *EmergencyContactUpdateOne) AddPosition(i int) *EmergencyContactUpdateOne {
+	ecuo.mutation.AddPosition(i)
+	return ecuo
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (ecuo *EmergencyContactUpdateOne) SetProfileID(id int) *EmergencyContactUpdateOne {
+	ecuo.mutation.SetProfileID(id)
+	return ecuo
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (ecuo *EmergencyContactUpdateOne) SetProfile(p *Profile) *EmergencyContactUpdateOne {
+	return ecuo.SetProfileID(p.ID)
+}
+
+// Mutation returns the EmergencyContactMutation object of the builder.
+func (ecuo *EmergencyContactUpdateOne) Mutation() *EmergencyContactMutation {
+	return ecuo.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (ecuo *EmergencyContactUpdateOne) ClearProfile() *EmergencyContactUpdateOne {
+	ecuo.mutation.ClearProfile()
+	return ecuo
+}
+
+// Where appends a list predicates to the EmergencyContactUpdate builder.
+func (ecuo *EmergencyContactUpdateOne) Where(ps ...predicate.EmergencyContact) *EmergencyContactUpdateOne {
+	ecuo.mutation.Where(ps...)
+	return ecuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ecuo *EmergencyContactUpdateOne) Select(field string, fields ...string) *EmergencyContactUpdateOne {
+	ecuo.fields = append([]string{field}, fields...)
+	return ecuo
+}
+
+// Save executes the query and returns the updated EmergencyContact entity.
+func (ecuo *EmergencyContactUpdateOne) Save(ctx context.Context) (*EmergencyContact, error) {
+	return withHooks(ctx, ecuo.sqlSave, ecuo.mutation, ecuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecuo *EmergencyContactUpdateOne) SaveX(ctx context.Context) *EmergencyContact {
+	node, err := ecuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ecuo *EmergencyContactUpdateOne) Exec(ctx context.Context) error {
+	_, err := ecuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecuo *EmergencyContactUpdateOne) ExecX(ctx context.Context) {
+	if err := ecuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ecuo *EmergencyContactUpdateOne) check() error {
+	if v, ok := ecuo.mutation.Name(); ok {
+		if err := emergencycontact.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.name": %w`, err)}
+		}
+	}
+	if v, ok := ecuo.mutation.Relationship(); ok {
+		if err := emergencycontact.RelationshipValidator(v); err != nil {
+			return &ValidationError{Name: "relationship", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.relationship": %w`, err)}
+		}
+	}
+	if v, ok := ecuo.mutation.Phone(); ok {
+		if err := emergencycontact.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.phone": %w`, err)}
+		}
+	}
+	if v, ok := ecuo.mutation.Position(); ok {
+		if err := emergencycontact.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.position": %w`, err)}
+		}
+	}
+	if ecuo.mutation.ProfileCleared() && len(ecuo.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmergencyContact.profile"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ecuo *EmergencyContactUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmergencyContactUpdateOne {
+	ecuo.modifiers = append(ecuo.modifiers, modifiers...)
+	return ecuo
+}
+
+func (ecuo *EmergencyContactUpdateOne) sqlSave(ctx context.Context) (_node *EmergencyContact, err error) {
+	if err := ecuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emergencycontact.Table, emergencycontact.Columns, sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeInt))
+	id, ok := ecuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmergencyContact.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ecuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emergencycontact.FieldID)
+		for _, f := range fields {
+			if !emergencycontact.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emergencycontact.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ecuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ecuo.mutation.Name(); ok {
+		_spec.SetField(emergencycontact.FieldName, field.TypeString, value)
+	}
+	if value, ok := ecuo.mutation.Relationship(); ok {
+		_spec.SetField(emergencycontact.FieldRelationship, field.TypeString, value)
+	}
+	if value, ok := ecuo.mutation.Phone(); ok {
+		_spec.SetField(emergencycontact.FieldPhone, field.TypeString, value)
+	}
+	if value, ok := ecuo.mutation.Address(); ok {
+		_spec.SetField(emergencycontact.FieldAddress, field.TypeString, value)
+	}
+	if ecuo.mutation.AddressCleared() {
+		_spec.ClearField(emergencycontact.FieldAddress, field.TypeString)
+	}
+	if value, ok := ecuo.mutation.Position(); ok {
+		_spec.SetField(emergencycontact.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := ecuo.mutation.AddedPosition(); ok {
+		_spec.AddField(emergencycontact.FieldPosition, field.TypeInt, value)
+	}
+	if ecuo.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emergencycontact.ProfileTable,
+			Columns: []string{emergencycontact.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ecuo.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emergencycontact.ProfileTable,
+			Columns: []string{emergencycontact.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ecuo.modifiers...)
+	_node = &EmergencyContact{config: ecuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ecuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emergencycontact.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ecuo.mutation.done = true
+	return _node, nil
+}
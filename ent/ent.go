@@ -13,7 +13,17 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/huynhthanhthao/hrm_user_service/ent/account"
+	"github.com/huynhthanhthao/hrm_user_service/ent/attributedefinition"
+	"github.com/huynhthanhthao/hrm_user_service/ent/auditlog"
+	"github.com/huynhthanhthao/hrm_user_service/ent/emergencycontact"
+	"github.com/huynhthanhthao/hrm_user_service/ent/group"
+	"github.com/huynhthanhthao/hrm_user_service/ent/groupmember"
+	"github.com/huynhthanhthao/hrm_user_service/ent/loginevent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/membership"
+	"github.com/huynhthanhthao/hrm_user_service/ent/profile"
 	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/ent/usermerge"
+	"github.com/huynhthanhthao/hrm_user_service/ent/userversion"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -74,8 +84,18 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			account.Table: account.ValidColumn,
-			user.Table:    user.ValidColumn,
+			account.Table:             account.ValidColumn,
+			attributedefinition.Table: attributedefinition.ValidColumn,
+			auditlog.Table:            auditlog.ValidColumn,
+			emergencycontact.Table:    emergencycontact.ValidColumn,
+			group.Table:               group.ValidColumn,
+			groupmember.Table:         groupmember.ValidColumn,
+			loginevent.Table:          loginevent.ValidColumn,
+			membership.Table:          membership.ValidColumn,
+			profile.Table:             profile.ValidColumn,
+			user.Table:                user.ValidColumn,
+			usermerge.Table:           usermerge.ValidColumn,
+			userversion.Table:         userversion.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
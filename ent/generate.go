@@ -1,4 +1,4 @@
 package ent
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature intercept,sql/modifier ./schema
 //go:generate go run -mod=mod entgo.io/contrib/entproto/cmd/entproto -path ./schema
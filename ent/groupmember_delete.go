@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/groupmember"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+)
+
+// GroupMemberDelete is the builder for deleting a GroupMember entity.
+type GroupMemberDelete struct {
+	config
+	hooks    []Hook
+	mutation *GroupMemberMutation
+}
+
+// Where appends a list predicates to the GroupMemberDelete builder.
+func (gmd *GroupMemberDelete) Where(ps ...predicate.GroupMember) *GroupMemberDelete {
+	gmd.mutation.Where(ps...)
+	return gmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gmd *GroupMemberDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gmd.sqlExec, gmd.mutation, gmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmd *GroupMemberDelete) ExecX(ctx context.Context) int {
+	n, err := gmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gmd *GroupMemberDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(groupmember.Table, sqlgraph.NewFieldSpec(groupmember.FieldID, field.TypeInt))
+	if ps := gmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gmd.mutation.done = true
+	return affected, err
+}
+
+// GroupMemberDeleteOne is the builder for deleting a single GroupMember entity.
+type GroupMemberDeleteOne struct {
+	gmd *GroupMemberDelete
+}
+
+// Where appends a list predicates to the GroupMemberDelete builder.
+func (gmdo *GroupMemberDeleteOne) Where(ps ...predicate.GroupMember) *GroupMemberDeleteOne {
+	gmdo.gmd.mutation.Where(ps...)
+	return gmdo
+}
+
+// Exec executes the deletion query.
+func (gmdo *GroupMemberDeleteOne) Exec(ctx context.Context) error {
+	n, err := gmdo.gmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{groupmember.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmdo *GroupMemberDeleteOne) ExecX(ctx context.Context) {
+	if err := gmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/groupmember"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+)
+
+// GroupMemberUpdate is the builder for updating GroupMember entities.
+type GroupMemberUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *GroupMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the GroupMemberUpdate builder.
+func (gmu *GroupMemberUpdate) Where(ps ...predicate.GroupMember) *GroupMemberUpdate {
+	gmu.mutation.Where(ps...)
+	return gmu
+}
+
+// SetRole sets the "role" field.
+func (gmu *GroupMemberUpdate) SetRole(gr groupmember.Role) *GroupMemberUpdate {
+	gmu.mutation.SetRole(gr)
+	return gmu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (gmu *GroupMemberUpdate) SetNillableRole(gr *groupmember.Role) *GroupMemberUpdate {
+	if gr != nil {
+		gmu.SetRole(*gr)
+	}
+	return gmu
+}
+
+// Mutation returns the GroupMemberMutation object of the builder.
+func (gmu *GroupMemberUpdate) Mutation() *GroupMemberMutation {
+	return gmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gmu *GroupMemberUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, gmu.sqlSave, gmu.mutation, gmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gmu *GroupMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := gmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gmu *GroupMemberUpdate) Exec(ctx context.Context) error {
+	_, err := gmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmu *GroupMemberUpdate) ExecX(ctx context.Context) {
+	if err := gmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gmu *GroupMemberUpdate) check() error {
+	if v, ok := gmu.mutation.Role(); ok {
+		if err := groupmember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "GroupMember.role": %w`, err)}
+		}
+	}
+	if gmu.mutation.GroupCleared() && len(gmu.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupMember.group"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (gmu *GroupMemberUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GroupMemberUpdate {
+	gmu.modifiers = append(gmu.modifiers, modifiers...)
+	return gmu
+}
+
+func (gmu *GroupMemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupmember.Table, groupmember.Columns, sqlgraph.NewFieldSpec(groupmember.FieldID, field.TypeInt))
+	if ps := gmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gmu.mutation.Role(); ok {
+		_spec.SetField(groupmember.FieldRole, field.TypeEnum, value)
+	}
+	_spec.AddModifiers(gmu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, gmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gmu.mutation.done = true
+	return n, nil
+}
+
+// GroupMemberUpdateOne is the builder for updating a single GroupMember entity.
+type GroupMemberUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *GroupMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetRole sets the "role" field.
+func (gmuo *GroupMemberUpdateOne) SetRole(gr groupmember.Role) *GroupMemberUpdateOne {
+	gmuo.mutation.SetRole(gr)
+	return gmuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (gmuo *GroupMemberUpdateOne) SetNillableRole(gr *groupmember.Role) *GroupMemberUpdateOne {
+	if gr != nil {
+		gmuo.SetRole(*gr)
+	}
+	return gmuo
+}
+
+// Mutation returns the GroupMemberMutation object of the builder.
+func (gmuo *GroupMemberUpdateOne) Mutation() *GroupMemberMutation {
+	return gmuo.mutation
+}
+
+// Where appends a list predicates to the GroupMemberUpdate builder.
+func (gmuo *GroupMemberUpdateOne) Where(ps ...predicate.GroupMember) *GroupMemberUpdateOne {
+	gmuo.mutation.Where(ps...)
+	return gmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gmuo *GroupMemberUpdateOne) Select(field string, fields ...string) *GroupMemberUpdateOne {
+	gmuo.fields = append([]string{field}, fields...)
+	return gmuo
+}
+
+// Save executes the query and returns the updated GroupMember entity.
+func (gmuo *GroupMemberUpdateOne) Save(ctx context.Context) (*GroupMember, error) {
+	return withHooks(ctx, gmuo.sqlSave, gmuo.mutation, gmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gmuo *GroupMemberUpdateOne) SaveX(ctx context.Context) *GroupMember {
+	node, err := gmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gmuo *GroupMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := gmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmuo *GroupMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := gmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gmuo *GroupMemberUpdateOne) check() error {
+	if v, ok := gmuo.mutation.Role(); ok {
+		if err := groupmember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "GroupMember.role": %w`, err)}
+		}
+	}
+	if gmuo.mutation.GroupCleared() && len(gmuo.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupMember.group"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (gmuo *GroupMemberUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GroupMemberUpdateOne {
+	gmuo.modifiers = append(gmuo.modifiers, modifiers...)
+	return gmuo
+}
+
+func (gmuo *GroupMemberUpdateOne) sqlSave(ctx context.Context) (_node *GroupMember, err error) {
+	if err := gmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupmember.Table, groupmember.Columns, sqlgraph.NewFieldSpec(groupmember.FieldID, field.TypeInt))
+	id, ok := gmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GroupMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groupmember.FieldID)
+		for _, f := range fields {
+			if !groupmember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != groupmember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gmuo.mutation.Role(); ok {
+		_spec.SetField(groupmember.FieldRole, field.TypeEnum, value)
+	}
+	_spec.AddModifiers(gmuo.modifiers...)
+	_node = &GroupMember{config: gmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gmuo.mutation.done = true
+	return _node, nil
+}
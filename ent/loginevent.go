@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/huynhthanhthao/hrm_user_service/ent/loginevent"
+)
+
+// LoginEvent is the model entity for the LoginEvent schema.
+type LoginEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id"`
+	// Success holds the value of the "success" field.
+	Success bool `json:"success"`
+	// FailureReason holds the value of the "failure_reason" field.
+	FailureReason *string `json:"failure_reason"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID *int64 `json:"org_id"`
+	// IP holds the value of the "ip" field.
+	IP *string `json:"ip"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent *string `json:"user_agent"`
+	// Hạn của refresh token cấp ra, chỉ có khi đăng nhập thành công
+	ExpiresAt *time.Time `json:"expires_at"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoginEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loginevent.FieldSuccess:
+			values[i] = new(sql.NullBool)
+		case loginevent.FieldID, loginevent.FieldUserID, loginevent.FieldOrgID:
+			values[i] = new(sql.NullInt64)
+		case loginevent.FieldFailureReason, loginevent.FieldIP, loginevent.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case loginevent.FieldExpiresAt, loginevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoginEvent fields.
+func (le *LoginEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loginevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			le.ID = int(value.Int64)
+		case loginevent.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				le.UserID = int(value.Int64)
+			}
+		case loginevent.FieldSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field success", values[i])
+			} else if value.Valid {
+				le.Success = value.Bool
+			}
+		case loginevent.FieldFailureReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_reason", values[i])
+			} else if value.Valid {
+				le.FailureReason = new(string)
+				*le.FailureReason = value.String
+			}
+		case loginevent.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				le.OrgID = new(int64)
+				*le.OrgID = value.Int64
+			}
+		case loginevent.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				le.IP = new(string)
+				*le.IP = value.String
+			}
+		case loginevent.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				le.UserAgent = new(string)
+				*le.UserAgent = value.String
+			}
+		case loginevent.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				le.ExpiresAt = new(time.Time)
+				*le.ExpiresAt = value.Time
+			}
+		case loginevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				le.CreatedAt = value.Time
+			}
+		default:
+			le.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoginEvent.
+// This includes values selected through modifiers, order, etc.
+func (le *LoginEvent) Value(name string) (ent.Value, error) {
+	return le.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LoginEvent.
+// Note that you need to call LoginEvent.Unwrap() before calling this method if this LoginEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (le *LoginEvent) Update() *LoginEventUpdateOne {
+	return NewLoginEventClient(le.config).UpdateOne(le)
+}
+
+// Unwrap unwraps the LoginEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (le *LoginEvent) Unwrap() *LoginEvent {
+	_tx, ok := le.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoginEvent is not a transactional entity")
+	}
+	le.config.driver = _tx.drv
+	return le
+}
+
+// String implements the fmt.Stringer.
+func (le *LoginEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoginEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", le.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", le.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("success=")
+	builder.WriteString(fmt.Sprintf("%v", le.Success))
+	builder.WriteString(", ")
+	if v := le.FailureReason; v != nil {
+		builder.WriteString("failure_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := le.OrgID; v != nil {
+		builder.WriteString("org_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := le.IP; v != nil {
+		builder.WriteString("ip=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := le.UserAgent; v != nil {
+		builder.WriteString("user_agent=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := le.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(le.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoginEvents is a parsable slice of LoginEvent.
+type LoginEvents []*LoginEvent
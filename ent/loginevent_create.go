@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/loginevent"
+)
+
+// LoginEventCreate is the builder for creating a LoginEvent entity.
+type LoginEventCreate struct {
+	config
+	mutation *LoginEventMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (lec *LoginEventCreate) SetUserID(i int) *LoginEventCreate {
+	lec.mutation.SetUserID(i)
+	return lec
+}
+
+// SetSuccess sets the "success" field.
+func (lec *LoginEventCreate) SetSuccess(b bool) *LoginEventCreate {
+	lec.mutation.SetSuccess(b)
+	return lec
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (lec *LoginEventCreate) SetFailureReason(s string) *LoginEventCreate {
+	lec.mutation.SetFailureReason(s)
+	return lec
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (lec *LoginEventCreate) SetNillableFailureReason(s *string) *LoginEventCreate {
+	if s != nil {
+		lec.SetFailureReason(*s)
+	}
+	return lec
+}
+
+// SetOrgID sets the "org_id" field.
+func (lec *LoginEventCreate) SetOrgID(i int64) *LoginEventCreate {
+	lec.mutation.SetOrgID(i)
+	return lec
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (lec *LoginEventCreate) SetNillableOrgID(i *int64) *LoginEventCreate {
+	if i != nil {
+		lec.SetOrgID(*i)
+	}
+	return lec
+}
+
+// SetIP sets the "ip" field.
+func (lec *LoginEventCreate) SetIP(s string) *LoginEventCreate {
+	lec.mutation.SetIP(s)
+	return lec
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (lec *LoginEventCreate) SetNillableIP(s *string) *LoginEventCreate {
+	if s != nil {
+		lec.SetIP(*s)
+	}
+	return lec
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (lec *LoginEventCreate) SetUserAgent(s string) *LoginEventCreate {
+	lec.mutation.SetUserAgent(s)
+	return lec
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (lec *LoginEventCreate) SetNillableUserAgent(s *string) *LoginEventCreate {
+	if s != nil {
+		lec.SetUserAgent(*s)
+	}
+	return lec
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (lec *LoginEventCreate) SetExpiresAt(t time.Time) *LoginEventCreate {
+	lec.mutation.SetExpiresAt(t)
+	return lec
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (lec *LoginEventCreate) SetNillableExpiresAt(t *time.Time) *LoginEventCreate {
+	if t != nil {
+		lec.SetExpiresAt(*t)
+	}
+	return lec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lec *LoginEventCreate) SetCreatedAt(t time.Time) *LoginEventCreate {
+	lec.mutation.SetCreatedAt(t)
+	return lec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lec *LoginEventCreate) SetNillableCreatedAt(t *time.Time) *LoginEventCreate {
+	if t != nil {
+		lec.SetCreatedAt(*t)
+	}
+	return lec
+}
+
+// Mutation returns the LoginEventMutation object of the builder.
+func (lec *LoginEventCreate) Mutation() *LoginEventMutation {
+	return lec.mutation
+}
+
+// Save creates the LoginEvent in the database.
+func (lec *LoginEventCreate) Save(ctx context.Context) (*LoginEvent, error) {
+	lec.defaults()
+	return withHooks(ctx, lec.sqlSave, lec.mutation, lec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lec *LoginEventCreate) SaveX(ctx context.Context) *LoginEvent {
+	v, err := lec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lec *LoginEventCreate) Exec(ctx context.Context) error {
+	_, err := lec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lec *LoginEventCreate) ExecX(ctx context.Context) {
+	if err := lec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lec *LoginEventCreate) defaults() {
+	if _, ok := lec.mutation.CreatedAt(); !ok {
+		v := loginevent.DefaultCreatedAt()
+		lec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lec *LoginEventCreate) check() error {
+	if _, ok := lec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LoginEvent.user_id"`)}
+	}
+	if v, ok := lec.mutation.UserID(); ok {
+		if err := loginevent.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "LoginEvent.user_id": %w`, err)}
+		}
+	}
+	if _, ok := lec.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "LoginEvent.success"`)}
+	}
+	if _, ok := lec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoginEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (lec *LoginEventCreate) sqlSave(ctx context.Context) (*LoginEvent, error) {
+	if err := lec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	lec.mutation.id = &_node.ID
+	lec.mutation.done = true
+	return _node, nil
+}
+
+func (lec *LoginEventCreate) createSpec() (*LoginEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginEvent{config: lec.config}
+		_spec = sqlgraph.NewCreateSpec(loginevent.Table, sqlgraph.NewFieldSpec(loginevent.FieldID, field.TypeInt))
+	)
+	if value, ok := lec.mutation.UserID(); ok {
+		_spec.SetField(loginevent.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := lec.mutation.Success(); ok {
+		_spec.SetField(loginevent.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := lec.mutation.FailureReason(); ok {
+		_spec.SetField(loginevent.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = &value
+	}
+	if value, ok := lec.mutation.OrgID(); ok {
+		_spec.SetField(loginevent.FieldOrgID, field.TypeInt64, value)
+		_node.OrgID = &value
+	}
+	if value, ok := lec.mutation.IP(); ok {
+		_spec.SetField(loginevent.FieldIP, field.TypeString, value)
+		_node.IP = &value
+	}
+	if value, ok := lec.mutation.UserAgent(); ok {
+		_spec.SetField(loginevent.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = &value
+	}
+	if value, ok := lec.mutation.ExpiresAt(); ok {
+		_spec.SetField(loginevent.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := lec.mutation.CreatedAt(); ok {
+		_spec.SetField(loginevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// LoginEventCreateBulk is the builder for creating many LoginEvent entities in bulk.
+type LoginEventCreateBulk struct {
+	config
+	err      error
+	builders []*LoginEventCreate
+}
+
+// Save creates the LoginEvent entities in the database.
+func (lecb *LoginEventCreateBulk) Save(ctx context.Context) ([]*LoginEvent, error) {
+	if lecb.err != nil {
+		return nil, lecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lecb.builders))
+	nodes := make([]*LoginEvent, len(lecb.builders))
+	mutators := make([]Mutator, len(lecb.builders))
+	for i := range lecb.builders {
+		func(i int, root context.Context) {
+			builder := lecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lecb *LoginEventCreateBulk) SaveX(ctx context.Context) []*LoginEvent {
+	v, err := lecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lecb *LoginEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := lecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lecb *LoginEventCreateBulk) ExecX(ctx context.Context) {
+	if err := lecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/loginevent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+)
+
+// LoginEventQuery is the builder for querying LoginEvent entities.
+type LoginEventQuery struct {
+	config
+	ctx        *QueryContext
+	order      []loginevent.OrderOption
+	inters     []Interceptor
+	predicates []predicate.LoginEvent
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LoginEventQuery builder.
+func (leq *LoginEventQuery) Where(ps ...predicate.LoginEvent) *LoginEventQuery {
+	leq.predicates = append(leq.predicates, ps...)
+	return leq
+}
+
+// Limit the number of records to be returned by this query.
+func (leq *LoginEventQuery) Limit(limit int) *LoginEventQuery {
+	leq.ctx.Limit = &limit
+	return leq
+}
+
+// Offset to start from.
+func (leq *LoginEventQuery) Offset(offset int) *LoginEventQuery {
+	leq.ctx.Offset = &offset
+	return leq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (leq *LoginEventQuery) Unique(unique bool) *LoginEventQuery {
+	leq.ctx.Unique = &unique
+	return leq
+}
+
+// Order specifies how the records should be ordered.
+func (leq *LoginEventQuery) Order(o ...loginevent.OrderOption) *LoginEventQuery {
+	leq.order = append(leq.order, o...)
+	return leq
+}
+
+// First returns the first LoginEvent entity from the query.
+// Returns a *NotFoundError when no LoginEvent was found.
+func (leq *LoginEventQuery) First(ctx context.Context) (*LoginEvent, error) {
+	nodes, err := leq.Limit(1).All(setContextOp(ctx, leq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{loginevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (leq *LoginEventQuery) FirstX(ctx context.Context) *LoginEvent {
+	node, err := leq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LoginEvent ID from the query.
+// Returns a *NotFoundError when no LoginEvent ID was found.
+func (leq *LoginEventQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = leq.Limit(1).IDs(setContextOp(ctx, leq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{loginevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (leq *LoginEventQuery) FirstIDX(ctx context.Context) int {
+	id, err := leq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LoginEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LoginEvent entity is found.
+// Returns a *NotFoundError when no LoginEvent entities are found.
+func (leq *LoginEventQuery) Only(ctx context.Context) (*LoginEvent, error) {
+	nodes, err := leq.Limit(2).All(setContextOp(ctx, leq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{loginevent.Label}
+	default:
+		return nil, &NotSingularError{loginevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (leq *LoginEventQuery) OnlyX(ctx context.Context) *LoginEvent {
+	node, err := leq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LoginEvent ID in the query.
+// Returns a *NotSingularError when more than one LoginEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (leq *LoginEventQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = leq.Limit(2).IDs(setContextOp(ctx, leq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{loginevent.Label}
+	default:
+		err = &NotSingularError{loginevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (leq *LoginEventQuery) OnlyIDX(ctx context.Context) int {
+	id, err := leq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LoginEvents.
+func (leq *LoginEventQuery) All(ctx context.Context) ([]*LoginEvent, error) {
+	ctx = setContextOp(ctx, leq.ctx, ent.OpQueryAll)
+	if err := leq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LoginEvent, *LoginEventQuery]()
+	return withInterceptors[[]*LoginEvent](ctx, leq, qr, leq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (leq *LoginEventQuery) AllX(ctx context.Context) []*LoginEvent {
+	nodes, err := leq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LoginEvent IDs.
+func (leq *LoginEventQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if leq.ctx.Unique == nil && leq.path != nil {
+		leq.Unique(true)
+	}
+	ctx = setContextOp(ctx, leq.ctx, ent.OpQueryIDs)
+	if err = leq.Select(loginevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (leq *LoginEventQuery) IDsX(ctx context.Context) []int {
+	ids, err := leq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (leq *LoginEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, leq.ctx, ent.OpQueryCount)
+	if err := leq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, leq, querierCount[*LoginEventQuery](), leq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (leq *LoginEventQuery) CountX(ctx context.Context) int {
+	count, err := leq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (leq *LoginEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, leq.ctx, ent.OpQueryExist)
+	switch _, err := leq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (leq *LoginEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := leq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LoginEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (leq *LoginEventQuery) Clone() *LoginEventQuery {
+	if leq == nil {
+		return nil
+	}
+	return &LoginEventQuery{
+		config:     leq.config,
+		ctx:        leq.ctx.Clone(),
+		order:      append([]loginevent.OrderOption{}, leq.order...),
+		inters:     append([]Interceptor{}, leq.inters...),
+		predicates: append([]predicate.LoginEvent{}, leq.predicates...),
+		// clone intermediate query.
+		sql:       leq.sql.Clone(),
+		path:      leq.path,
+		modifiers: append([]func(*sql.Selector){}, leq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LoginEvent.Query().
+//		GroupBy(loginevent.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (leq *LoginEventQuery) GroupBy(field string, fields ...string) *LoginEventGroupBy {
+	leq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LoginEventGroupBy{build: leq}
+	grbuild.flds = &leq.ctx.Fields
+	grbuild.label = loginevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id"`
+//	}
+//
+//	client.LoginEvent.Query().
+//		Select(loginevent.FieldUserID).
+//		Scan(ctx, &v)
+func (leq *LoginEventQuery) Select(fields ...string) *LoginEventSelect {
+	leq.ctx.Fields = append(leq.ctx.Fields, fields...)
+	sbuild := &LoginEventSelect{LoginEventQuery: leq}
+	sbuild.label = loginevent.Label
+	sbuild.flds, sbuild.scan = &leq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LoginEventSelect configured with the given aggregations.
+func (leq *LoginEventQuery) Aggregate(fns ...AggregateFunc) *LoginEventSelect {
+	return leq.Select().Aggregate(fns...)
+}
+
+func (leq *LoginEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range leq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, leq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range leq.ctx.Fields {
+		if !loginevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if leq.path != nil {
+		prev, err := leq.path(ctx)
+		if err != nil {
+			return err
+		}
+		leq.sql = prev
+	}
+	return nil
+}
+
+func (leq *LoginEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LoginEvent, error) {
+	var (
+		nodes = []*LoginEvent{}
+		_spec = leq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LoginEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LoginEvent{config: leq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(leq.modifiers) > 0 {
+		_spec.Modifiers = leq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, leq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (leq *LoginEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := leq.querySpec()
+	if len(leq.modifiers) > 0 {
+		_spec.Modifiers = leq.modifiers
+	}
+	_spec.Node.Columns = leq.ctx.Fields
+	if len(leq.ctx.Fields) > 0 {
+		_spec.Unique = leq.ctx.Unique != nil && *leq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, leq.driver, _spec)
+}
+
+func (leq *LoginEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(loginevent.Table, loginevent.Columns, sqlgraph.NewFieldSpec(loginevent.FieldID, field.TypeInt))
+	_spec.From = leq.sql
+	if unique := leq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if leq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := leq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginevent.FieldID)
+		for i := range fields {
+			if fields[i] != loginevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := leq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := leq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := leq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := leq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (leq *LoginEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(leq.driver.Dialect())
+	t1 := builder.Table(loginevent.Table)
+	columns := leq.ctx.Fields
+	if len(columns) == 0 {
+		columns = loginevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if leq.sql != nil {
+		selector = leq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if leq.ctx.Unique != nil && *leq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range leq.modifiers {
+		m(selector)
+	}
+	for _, p := range leq.predicates {
+		p(selector)
+	}
+	for _, p := range leq.order {
+		p(selector)
+	}
+	if offset := leq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := leq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (leq *LoginEventQuery) Modify(modifiers ...func(s *sql.Selector)) *LoginEventSelect {
+	leq.modifiers = append(leq.modifiers, modifiers...)
+	return leq.Select()
+}
+
+// LoginEventGroupBy is the group-by builder for LoginEvent entities.
+type LoginEventGroupBy struct {
+	selector
+	build *LoginEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (legb *LoginEventGroupBy) Aggregate(fns ...AggregateFunc) *LoginEventGroupBy {
+	legb.fns = append(legb.fns, fns...)
+	return legb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (legb *LoginEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, legb.build.ctx, ent.OpQueryGroupBy)
+	if err := legb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoginEventQuery, *LoginEventGroupBy](ctx, legb.build, legb, legb.build.inters, v)
+}
+
+func (legb *LoginEventGroupBy) sqlScan(ctx context.Context, root *LoginEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(legb.fns))
+	for _, fn := range legb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*legb.flds)+len(legb.fns))
+		for _, f := range *legb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*legb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := legb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LoginEventSelect is the builder for selecting fields of LoginEvent entities.
+type LoginEventSelect struct {
+	*LoginEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (les *LoginEventSelect) Aggregate(fns ...AggregateFunc) *LoginEventSelect {
+	les.fns = append(les.fns, fns...)
+	return les
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (les *LoginEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, les.ctx, ent.OpQuerySelect)
+	if err := les.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoginEventQuery, *LoginEventSelect](ctx, les.LoginEventQuery, les, les.inters, v)
+}
+
+func (les *LoginEventSelect) sqlScan(ctx context.Context, root *LoginEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(les.fns))
+	for _, fn := range les.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*les.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := les.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (les *LoginEventSelect) Modify(modifiers ...func(s *sql.Selector)) *LoginEventSelect {
+	les.modifiers = append(les.modifiers, modifiers...)
+	return les
+}
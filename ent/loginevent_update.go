@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/loginevent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+)
+
+// LoginEventUpdate is the builder for updating LoginEvent entities.
+type LoginEventUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LoginEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LoginEventUpdate builder.
+func (leu *LoginEventUpdate) Where(ps ...predicate.LoginEvent) *LoginEventUpdate {
+	leu.mutation.Where(ps...)
+	return leu
+}
+
+// SetIP sets the "ip" field.
+func (leu *LoginEventUpdate) SetIP(s string) *LoginEventUpdate {
+	leu.mutation.SetIP(s)
+	return leu
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (leu *LoginEventUpdate) SetNillableIP(s *string) *LoginEventUpdate {
+	if s != nil {
+		leu.SetIP(*s)
+	}
+	return leu
+}
+
+// ClearIP clears the value of the "ip" field.
+func (leu *LoginEventUpdate) ClearIP() *LoginEventUpdate {
+	leu.mutation.ClearIP()
+	return leu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (leu *LoginEventUpdate) SetUserAgent(s string) *LoginEventUpdate {
+	leu.mutation.SetUserAgent(s)
+	return leu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (leu *LoginEventUpdate) SetNillableUserAgent(s *string) *LoginEventUpdate {
+	if s != nil {
+		leu.SetUserAgent(*s)
+	}
+	return leu
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (leu *LoginEventUpdate) ClearUserAgent() *LoginEventUpdate {
+	leu.mutation.ClearUserAgent()
+	return leu
+}
+
+// Mutation returns the LoginEventMutation object of the builder.
+func (leu *LoginEventUpdate) Mutation() *LoginEventMutation {
+	return leu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (leu *LoginEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, leu.sqlSave, leu.mutation, leu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (leu *LoginEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := leu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (leu *LoginEventUpdate) Exec(ctx context.Context) error {
+	_, err := leu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (leu *LoginEventUpdate) ExecX(ctx context.Context) {
+	if err := leu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (leu *LoginEventUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LoginEventUpdate {
+	leu.modifiers = append(leu.modifiers, modifiers...)
+	return leu
+}
+
+func (leu *LoginEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(loginevent.Table, loginevent.Columns, sqlgraph.NewFieldSpec(loginevent.FieldID, field.TypeInt))
+	if ps := leu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if leu.mutation.FailureReasonCleared() {
+		_spec.ClearField(loginevent.FieldFailureReason, field.TypeString)
+	}
+	if leu.mutation.OrgIDCleared() {
+		_spec.ClearField(loginevent.FieldOrgID, field.TypeInt64)
+	}
+	if value, ok := leu.mutation.IP(); ok {
+		_spec.SetField(loginevent.FieldIP, field.TypeString, value)
+	}
+	if leu.mutation.IPCleared() {
+		_spec.ClearField(loginevent.FieldIP, field.TypeString)
+	}
+	if value, ok := leu.mutation.UserAgent(); ok {
+		_spec.SetField(loginevent.FieldUserAgent, field.TypeString, value)
+	}
+	if leu.mutation.UserAgentCleared() {
+		_spec.ClearField(loginevent.FieldUserAgent, field.TypeString)
+	}
+	if leu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(loginevent.FieldExpiresAt, field.TypeTime)
+	}
+	_spec.AddModifiers(leu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, leu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	leu.mutation.done = true
+	return n, nil
+}
+
+// LoginEventUpdateOne is the builder for updating a single LoginEvent entity.
+type LoginEventUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LoginEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetIP sets the "ip" field.
+func (leuo *LoginEventUpdateOne) SetIP(s string) *LoginEventUpdateOne {
+	leuo.mutation.SetIP(s)
+	return leuo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (leuo *LoginEventUpdateOne) SetNillableIP(s *string) *LoginEventUpdateOne {
+	if s != nil {
+		leuo.SetIP(*s)
+	}
+	return leuo
+}
+
+// ClearIP clears the value of the "ip" field.
+func (leuo *LoginEventUpdateOne) ClearIP() *LoginEventUpdateOne {
+	leuo.mutation.ClearIP()
+	return leuo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (leuo *LoginEventUpdateOne) SetUserAgent(s string) *LoginEventUpdateOne {
+	leuo.mutation.SetUserAgent(s)
+	return leuo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (leuo *LoginEventUpdateOne) SetNillableUserAgent(s *string) *LoginEventUpdateOne {
+	if s != nil {
+		leuo.SetUserAgent(*s)
+	}
+	return leuo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (leuo *LoginEventUpdateOne) ClearUserAgent() *LoginEventUpdateOne {
+	leuo.mutation.ClearUserAgent()
+	return leuo
+}
+
+// Mutation returns the LoginEventMutation object of the builder.
+func (leuo *LoginEventUpdateOne) Mutation() *LoginEventMutation {
+	return leuo.mutation
+}
+
+// Where appends a list predicates to the LoginEventUpdate builder.
+func (leuo *LoginEventUpdateOne) Where(ps ...predicate.LoginEvent) *LoginEventUpdateOne {
+	leuo.mutation.Where(ps...)
+	return leuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (leuo *LoginEventUpdateOne) Select(field string, fields ...string) *LoginEventUpdateOne {
+	leuo.fields = append([]string{field}, fields...)
+	return leuo
+}
+
+// Save executes the query and returns the updated LoginEvent entity.
+func (leuo *LoginEventUpdateOne) Save(ctx context.Context) (*LoginEvent, error) {
+	return withHooks(ctx, leuo.sqlSave, leuo.mutation, leuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (leuo *LoginEventUpdateOne) SaveX(ctx context.Context) *LoginEvent {
+	node, err := leuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (leuo *LoginEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := leuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (leuo *LoginEventUpdateOne) ExecX(ctx context.Context) {
+	if err := leuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (leuo *LoginEventUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LoginEventUpdateOne {
+	leuo.modifiers = append(leuo.modifiers, modifiers...)
+	return leuo
+}
+
+func (leuo *LoginEventUpdateOne) sqlSave(ctx context.Context) (_node *LoginEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(loginevent.Table, loginevent.Columns, sqlgraph.NewFieldSpec(loginevent.FieldID, field.TypeInt))
+	id, ok := leuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoginEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := leuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginevent.FieldID)
+		for _, f := range fields {
+			if !loginevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loginevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := leuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if leuo.mutation.FailureReasonCleared() {
+		_spec.ClearField(loginevent.FieldFailureReason, field.TypeString)
+	}
+	if leuo.mutation.OrgIDCleared() {
+		_spec.ClearField(loginevent.FieldOrgID, field.TypeInt64)
+	}
+	if value, ok := leuo.mutation.IP(); ok {
+		_spec.SetField(loginevent.FieldIP, field.TypeString, value)
+	}
+	if leuo.mutation.IPCleared() {
+		_spec.ClearField(loginevent.FieldIP, field.TypeString)
+	}
+	if value, ok := leuo.mutation.UserAgent(); ok {
+		_spec.SetField(loginevent.FieldUserAgent, field.TypeString, value)
+	}
+	if leuo.mutation.UserAgentCleared() {
+		_spec.ClearField(loginevent.FieldUserAgent, field.TypeString)
+	}
+	if leuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(loginevent.FieldExpiresAt, field.TypeTime)
+	}
+	_spec.AddModifiers(leuo.modifiers...)
+	_node = &LoginEvent{config: leuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, leuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	leuo.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -11,9 +12,11 @@ var (
 	// AccountsColumns holds the columns for the "accounts" table.
 	AccountsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "username", Type: field.TypeString, Unique: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "username", Type: field.TypeString},
 		{Name: "password", Type: field.TypeString},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "inactive"}, Default: "active"},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_account", Type: field.TypeInt, Unique: true},
@@ -26,23 +29,289 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "accounts_users_account",
-				Columns:    []*schema.Column{AccountsColumns[6]},
+				Columns:    []*schema.Column{AccountsColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "account_username",
+				Unique:  true,
+				Columns: []*schema.Column{AccountsColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+		},
+	}
+	// AttributeDefinitionsColumns holds the columns for the "attribute_definitions" table.
+	AttributeDefinitionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeInt64},
+		{Name: "key", Type: field.TypeString},
+		{Name: "label", Type: field.TypeString, Default: ""},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"string", "number", "boolean", "date"}},
+		{Name: "required", Type: field.TypeBool, Default: false},
+		{Name: "enum_values", Type: field.TypeJSON, Nullable: true},
+		{Name: "pattern", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// AttributeDefinitionsTable holds the schema information for the "attribute_definitions" table.
+	AttributeDefinitionsTable = &schema.Table{
+		Name:       "attribute_definitions",
+		Columns:    AttributeDefinitionsColumns,
+		PrimaryKey: []*schema.Column{AttributeDefinitionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attributedefinition_org_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{AttributeDefinitionsColumns[1], AttributeDefinitionsColumns[2]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "action", Type: field.TypeString},
+		{Name: "actor_id", Type: field.TypeInt, Nullable: true},
+		{Name: "org_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "target_user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "entity_type", Type: field.TypeString, Nullable: true},
+		{Name: "entity_id", Type: field.TypeInt, Nullable: true},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "details", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_target_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[4], AuditLogsColumns[9]},
+			},
+			{
+				Name:    "auditlog_entity_type_entity_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[5], AuditLogsColumns[6], AuditLogsColumns[9]},
+			},
+			{
+				Name:    "auditlog_actor_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2], AuditLogsColumns[9]},
+			},
+			{
+				Name:    "auditlog_action",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[9]},
+			},
+		},
+	}
+	// EmergencyContactsColumns holds the columns for the "emergency_contacts" table.
+	EmergencyContactsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "relationship", Type: field.TypeString},
+		{Name: "phone", Type: field.TypeString},
+		{Name: "address", Type: field.TypeString, Nullable: true},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "profile_emergency_contacts", Type: field.TypeInt},
+	}
+	// EmergencyContactsTable holds the schema information for the "emergency_contacts" table.
+	EmergencyContactsTable = &schema.Table{
+		Name:       "emergency_contacts",
+		Columns:    EmergencyContactsColumns,
+		PrimaryKey: []*schema.Column{EmergencyContactsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "emergency_contacts_profiles_emergency_contacts",
+				Columns:    []*schema.Column{EmergencyContactsColumns[6]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// GroupsColumns holds the columns for the "groups" table.
+	GroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// GroupsTable holds the schema information for the "groups" table.
+	GroupsTable = &schema.Table{
+		Name:       "groups",
+		Columns:    GroupsColumns,
+		PrimaryKey: []*schema.Column{GroupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "group_org_id",
+				Unique:  false,
+				Columns: []*schema.Column{GroupsColumns[1]},
+			},
+		},
+	}
+	// GroupMembersColumns holds the columns for the "group_members" table.
+	GroupMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "admin", "member"}, Default: "member"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "group_id", Type: field.TypeInt},
+	}
+	// GroupMembersTable holds the schema information for the "group_members" table.
+	GroupMembersTable = &schema.Table{
+		Name:       "group_members",
+		Columns:    GroupMembersColumns,
+		PrimaryKey: []*schema.Column{GroupMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_members_groups_members",
+				Columns:    []*schema.Column{GroupMembersColumns[4]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "groupmember_group_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{GroupMembersColumns[4], GroupMembersColumns[1]},
+			},
+			{
+				Name:    "groupmember_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{GroupMembersColumns[1]},
+			},
+		},
+	}
+	// LoginEventsColumns holds the columns for the "login_events" table.
+	LoginEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "success", Type: field.TypeBool},
+		{Name: "failure_reason", Type: field.TypeString, Nullable: true},
+		{Name: "org_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// LoginEventsTable holds the schema information for the "login_events" table.
+	LoginEventsTable = &schema.Table{
+		Name:       "login_events",
+		Columns:    LoginEventsColumns,
+		PrimaryKey: []*schema.Column{LoginEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginevent_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginEventsColumns[1], LoginEventsColumns[8]},
+			},
+		},
+	}
+	// MembershipsColumns holds the columns for the "memberships" table.
+	MembershipsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeInt64},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_memberships", Type: field.TypeInt},
+	}
+	// MembershipsTable holds the schema information for the "memberships" table.
+	MembershipsTable = &schema.Table{
+		Name:       "memberships",
+		Columns:    MembershipsColumns,
+		PrimaryKey: []*schema.Column{MembershipsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "memberships_users_memberships",
+				Columns:    []*schema.Column{MembershipsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "membership_org_id_user_memberships",
+				Unique:  true,
+				Columns: []*schema.Column{MembershipsColumns[1], MembershipsColumns[4]},
+			},
+		},
+	}
+	// ProfilesColumns holds the columns for the "profiles" table.
+	ProfilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "date_of_birth", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "place_of_birth", Type: field.TypeString, Nullable: true},
+		{Name: "national_id", Type: field.TypeString, Nullable: true},
+		{Name: "national_id_hash", Type: field.TypeString, Nullable: true},
+		{Name: "national_id_issue_date", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "national_id_issue_place", Type: field.TypeString, Nullable: true},
+		{Name: "ethnicity", Type: field.TypeString, Nullable: true},
+		{Name: "religion", Type: field.TypeString, Nullable: true},
+		{Name: "marital_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"single", "married", "divorced", "widowed"}},
+		{Name: "tax_code", Type: field.TypeString, Nullable: true},
+		{Name: "social_insurance_number", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_profile", Type: field.TypeInt, Unique: true},
+	}
+	// ProfilesTable holds the schema information for the "profiles" table.
+	ProfilesTable = &schema.Table{
+		Name:       "profiles",
+		Columns:    ProfilesColumns,
+		PrimaryKey: []*schema.Column{ProfilesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "profiles_users_profile",
+				Columns:    []*schema.Column{ProfilesColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "profile_national_id_hash",
+				Unique:  true,
+				Columns: []*schema.Column{ProfilesColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "national_id_hash IS NOT NULL",
+				},
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "first_name", Type: field.TypeString},
 		{Name: "last_name", Type: field.TypeString},
 		{Name: "gender", Type: field.TypeEnum, Enums: []string{"other", "female", "male"}, Default: "other"},
-		{Name: "phone", Type: field.TypeString, Unique: true},
-		{Name: "email", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "phone", Type: field.TypeString},
+		{Name: "phone_hash", Type: field.TypeString, Nullable: true},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "email_hash", Type: field.TypeString, Nullable: true},
 		{Name: "avatar", Type: field.TypeString, Nullable: true},
 		{Name: "ward_code", Type: field.TypeString, Nullable: true},
+		{Name: "province_code", Type: field.TypeString, Nullable: true},
 		{Name: "address", Type: field.TypeString, Nullable: true},
+		{Name: "custom_attributes", Type: field.TypeJSON, Nullable: true},
+		{Name: "search_text", Type: field.TypeString, Default: ""},
+		{Name: "anonymized_at", Type: field.TypeTime, Nullable: true},
+		{Name: "perm_version", Type: field.TypeInt, Default: 0},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -51,14 +320,164 @@ var (
 		Name:       "users",
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_phone_hash",
+				Unique:  true,
+				Columns: []*schema.Column{UsersColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+			{
+				Name:    "user_email_hash",
+				Unique:  true,
+				Columns: []*schema.Column{UsersColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+			{
+				Name:    "user_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[1]},
+			},
+			{
+				Name:    "user_ward_code",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[10]},
+			},
+			{
+				Name:    "user_province_code",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[11]},
+			},
+			{
+				Name:    "user_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[18]},
+			},
+			{
+				Name:    "user_search_text",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[14]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+		},
+	}
+	// UserMergesColumns holds the columns for the "user_merges" table.
+	UserMergesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "merged_user_id", Type: field.TypeInt, Unique: true},
+		{Name: "survivor_user_id", Type: field.TypeInt},
+		{Name: "merged_by", Type: field.TypeInt, Nullable: true},
+		{Name: "merged_username", Type: field.TypeString, Nullable: true},
+		{Name: "moved_org_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// UserMergesTable holds the schema information for the "user_merges" table.
+	UserMergesTable = &schema.Table{
+		Name:       "user_merges",
+		Columns:    UserMergesColumns,
+		PrimaryKey: []*schema.Column{UserMergesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usermerge_survivor_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserMergesColumns[2]},
+			},
+		},
+	}
+	// UserVersionsColumns holds the columns for the "user_versions" table.
+	UserVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "first_name", Type: field.TypeString},
+		{Name: "last_name", Type: field.TypeString},
+		{Name: "gender", Type: field.TypeString},
+		{Name: "phone", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "address", Type: field.TypeString, Nullable: true},
+		{Name: "ward_code", Type: field.TypeString, Nullable: true},
+		{Name: "province_code", Type: field.TypeString, Nullable: true},
+		{Name: "avatar", Type: field.TypeString, Nullable: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "changed_by", Type: field.TypeInt, Nullable: true},
+	}
+	// UserVersionsTable holds the schema information for the "user_versions" table.
+	UserVersionsTable = &schema.Table{
+		Name:       "user_versions",
+		Columns:    UserVersionsColumns,
+		PrimaryKey: []*schema.Column{UserVersionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userversion_user_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{UserVersionsColumns[1], UserVersionsColumns[2]},
+			},
+			{
+				Name:    "userversion_user_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{UserVersionsColumns[1], UserVersionsColumns[12]},
+			},
+		},
+	}
+	// GroupSubgroupsColumns holds the columns for the "group_subgroups" table.
+	GroupSubgroupsColumns = []*schema.Column{
+		{Name: "group_id", Type: field.TypeInt},
+		{Name: "parent_group_id", Type: field.TypeInt},
+	}
+	// GroupSubgroupsTable holds the schema information for the "group_subgroups" table.
+	GroupSubgroupsTable = &schema.Table{
+		Name:       "group_subgroups",
+		Columns:    GroupSubgroupsColumns,
+		PrimaryKey: []*schema.Column{GroupSubgroupsColumns[0], GroupSubgroupsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_subgroups_group_id",
+				Columns:    []*schema.Column{GroupSubgroupsColumns[0]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "group_subgroups_parent_group_id",
+				Columns:    []*schema.Column{GroupSubgroupsColumns[1]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AccountsTable,
+		AttributeDefinitionsTable,
+		AuditLogsTable,
+		EmergencyContactsTable,
+		GroupsTable,
+		GroupMembersTable,
+		LoginEventsTable,
+		MembershipsTable,
+		ProfilesTable,
 		UsersTable,
+		UserMergesTable,
+		UserVersionsTable,
+		GroupSubgroupsTable,
 	}
 )
 
 func init() {
 	AccountsTable.ForeignKeys[0].RefTable = UsersTable
+	EmergencyContactsTable.ForeignKeys[0].RefTable = ProfilesTable
+	GroupMembersTable.ForeignKeys[0].RefTable = GroupsTable
+	MembershipsTable.ForeignKeys[0].RefTable = UsersTable
+	ProfilesTable.ForeignKeys[0].RefTable = UsersTable
+	GroupSubgroupsTable.ForeignKeys[0].RefTable = GroupsTable
+	GroupSubgroupsTable.ForeignKeys[1].RefTable = GroupsTable
 }
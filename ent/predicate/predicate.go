@@ -9,5 +9,35 @@ import (
 // Account is the predicate function for account builders.
 type Account func(*sql.Selector)
 
+// AttributeDefinition is the predicate function for attributedefinition builders.
+type AttributeDefinition func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// EmergencyContact is the predicate function for emergencycontact builders.
+type EmergencyContact func(*sql.Selector)
+
+// Group is the predicate function for group builders.
+type Group func(*sql.Selector)
+
+// GroupMember is the predicate function for groupmember builders.
+type GroupMember func(*sql.Selector)
+
+// LoginEvent is the predicate function for loginevent builders.
+type LoginEvent func(*sql.Selector)
+
+// Membership is the predicate function for membership builders.
+type Membership func(*sql.Selector)
+
+// Profile is the predicate function for profile builders.
+type Profile func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserMerge is the predicate function for usermerge builders.
+type UserMerge func(*sql.Selector)
+
+// UserVersion is the predicate function for userversion builders.
+type UserVersion func(*sql.Selector)
@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/huynhthanhthao/hrm_user_service/ent/profile"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+)
+
+// Profile is the model entity for the Profile schema.
+type Profile struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// DateOfBirth holds the value of the "date_of_birth" field.
+	DateOfBirth *time.Time `json:"date_of_birth"`
+	// PlaceOfBirth holds the value of the "place_of_birth" field.
+	PlaceOfBirth *string `json:"place_of_birth"`
+	// Số CCCD, mã hóa
+	NationalID *string `json:"national_id"`
+	// Blind index của national_id, do hook tính
+	NationalIDHash *string `json:"-"`
+	// NationalIDIssueDate holds the value of the "national_id_issue_date" field.
+	NationalIDIssueDate *time.Time `json:"national_id_issue_date"`
+	// NationalIDIssuePlace holds the value of the "national_id_issue_place" field.
+	NationalIDIssuePlace *string `json:"national_id_issue_place"`
+	// Ethnicity holds the value of the "ethnicity" field.
+	Ethnicity *string `json:"ethnicity"`
+	// Religion holds the value of the "religion" field.
+	Religion *string `json:"religion"`
+	// MaritalStatus holds the value of the "marital_status" field.
+	MaritalStatus *profile.MaritalStatus `json:"marital_status"`
+	// Mã số thuế cá nhân
+	TaxCode *string `json:"tax_code"`
+	// Mã số BHXH
+	SocialInsuranceNumber *string `json:"social_insurance_number"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProfileQuery when eager-loading is set.
+	Edges        ProfileEdges `json:"edges"`
+	user_profile *int
+	selectValues sql.SelectValues
+}
+
+// ProfileEdges holds the relations/edges for other nodes in the graph.
+type ProfileEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// EmergencyContacts holds the value of the emergency_contacts edge.
+	EmergencyContacts []*EmergencyContact `json:"emergency_contacts,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProfileEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// EmergencyContactsOrErr returns the EmergencyContacts value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) EmergencyContactsOrErr() ([]*EmergencyContact, error) {
+	if e.loadedTypes[1] {
+		return e.EmergencyContacts, nil
+	}
+	return nil, &NotLoadedError{edge: "emergency_contacts"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Profile) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case profile.FieldID:
+			values[i] = new(sql.NullInt64)
+		case profile.FieldPlaceOfBirth, profile.FieldNationalID, profile.FieldNationalIDHash, profile.FieldNationalIDIssuePlace, profile.FieldEthnicity, profile.FieldReligion, profile.FieldMaritalStatus, profile.FieldTaxCode, profile.FieldSocialInsuranceNumber:
+			values[i] = new(sql.NullString)
+		case profile.FieldDateOfBirth, profile.FieldNationalIDIssueDate, profile.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case profile.ForeignKeys[0]: // user_profile
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Profile fields.
+func (pr *Profile) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case profile.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case profile.FieldDateOfBirth:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date_of_birth", values[i])
+			} else if value.Valid {
+				pr.DateOfBirth = new(time.Time)
+				*pr.DateOfBirth = value.Time
+			}
+		case profile.FieldPlaceOfBirth:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field place_of_birth", values[i])
+			} else if value.Valid {
+				pr.PlaceOfBirth = new(string)
+				*pr.PlaceOfBirth = value.String
+			}
+		case profile.FieldNationalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field national_id", values[i])
+			} else if value.Valid {
+				pr.NationalID = new(string)
+				*pr.NationalID = value.String
+			}
+		case profile.FieldNationalIDHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field national_id_hash", values[i])
+			} else if value.Valid {
+				pr.NationalIDHash = new(string)
+				*pr.NationalIDHash = value.String
+			}
+		case profile.FieldNationalIDIssueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field national_id_issue_date", values[i])
+			} else if value.Valid {
+				pr.NationalIDIssueDate = new(time.Time)
+				*pr.NationalIDIssueDate = value.Time
+			}
+		case profile.FieldNationalIDIssuePlace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field national_id_issue_place", values[i])
+			} else if value.Valid {
+				pr.NationalIDIssuePlace = new(string)
+				*pr.NationalIDIssuePlace = value.String
+			}
+		case profile.FieldEthnicity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ethnicity", values[i])
+			} else if value.Valid {
+				pr.Ethnicity = new(string)
+				*pr.Ethnicity = value.String
+			}
+		case profile.FieldReligion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field religion", values[i])
+			} else if value.Valid {
+				pr.Religion = new(string)
+				*pr.Religion = value.String
+			}
+		case profile.FieldMaritalStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field marital_status", values[i])
+			} else if value.Valid {
+				pr.MaritalStatus = new(profile.MaritalStatus)
+				*pr.MaritalStatus = profile.MaritalStatus(value.String)
+			}
+		case profile.FieldTaxCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_code", values[i])
+			} else if value.Valid {
+				pr.TaxCode = new(string)
+				*pr.TaxCode = value.String
+			}
+		case profile.FieldSocialInsuranceNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field social_insurance_number", values[i])
+			} else if value.Valid {
+				pr.SocialInsuranceNumber = new(string)
+				*pr.SocialInsuranceNumber = value.String
+			}
+		case profile.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pr.UpdatedAt = value.Time
+			}
+		case profile.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_profile", value)
+			} else if value.Valid {
+				pr.user_profile = new(int)
+				*pr.user_profile = int(value.Int64)
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Profile.
+// This includes values selected through modifiers, order, etc.
+func (pr *Profile) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Profile entity.
+func (pr *Profile) QueryUser() *UserQuery {
+	return NewProfileClient(pr.config).QueryUser(pr)
+}
+
+// QueryEmergencyContacts queries the "emergency_contacts" edge of the Profile entity.
+func (pr *Profile) QueryEmergencyContacts() *EmergencyContactQuery {
+	return NewProfileClient(pr.config).QueryEmergencyContacts(pr)
+}
+
+// Update returns a builder for updating this Profile.
+// Note that you need to call Profile.Unwrap() before calling this method if this Profile
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *Profile) Update() *ProfileUpdateOne {
+	return NewProfileClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the Profile entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *Profile) Unwrap() *Profile {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Profile is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *Profile) String() string {
+	var builder strings.Builder
+	builder.WriteString("Profile(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	if v := pr.DateOfBirth; v != nil {
+		builder.WriteString("date_of_birth=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pr.PlaceOfBirth; v != nil {
+		builder.WriteString("place_of_birth=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pr.NationalID; v != nil {
+		builder.WriteString("national_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pr.NationalIDHash; v != nil {
+		builder.WriteString("national_id_hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pr.NationalIDIssueDate; v != nil {
+		builder.WriteString("national_id_issue_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pr.NationalIDIssuePlace; v != nil {
+		builder.WriteString("national_id_issue_place=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pr.Ethnicity; v != nil {
+		builder.WriteString("ethnicity=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pr.Religion; v != nil {
+		builder.WriteString("religion=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pr.MaritalStatus; v != nil {
+		builder.WriteString("marital_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pr.TaxCode; v != nil {
+		builder.WriteString("tax_code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pr.SocialInsuranceNumber; v != nil {
+		builder.WriteString("social_insurance_number=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Profiles is a parsable slice of Profile.
+type Profiles []*Profile
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package profile
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the profile type in the database.
+	Label = "profile"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDateOfBirth holds the string denoting the date_of_birth field in the database.
+	FieldDateOfBirth = "date_of_birth"
+	// FieldPlaceOfBirth holds the string denoting the place_of_birth field in the database.
+	FieldPlaceOfBirth = "place_of_birth"
+	// FieldNationalID holds the string denoting the national_id field in the database.
+	FieldNationalID = "national_id"
+	// FieldNationalIDHash holds the string denoting the national_id_hash field in the database.
+	FieldNationalIDHash = "national_id_hash"
+	// FieldNationalIDIssueDate holds the string denoting the national_id_issue_date field in the database.
+	FieldNationalIDIssueDate = "national_id_issue_date"
+	// FieldNationalIDIssuePlace holds the string denoting the national_id_issue_place field in the database.
+	FieldNationalIDIssuePlace = "national_id_issue_place"
+	// FieldEthnicity holds the string denoting the ethnicity field in the database.
+	FieldEthnicity = "ethnicity"
+	// FieldReligion holds the string denoting the religion field in the database.
+	FieldReligion = "religion"
+	// FieldMaritalStatus holds the string denoting the marital_status field in the database.
+	FieldMaritalStatus = "marital_status"
+	// FieldTaxCode holds the string denoting the tax_code field in the database.
+	FieldTaxCode = "tax_code"
+	// FieldSocialInsuranceNumber holds the string denoting the social_insurance_number field in the database.
+	FieldSocialInsuranceNumber = "social_insurance_number"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeEmergencyContacts holds the string denoting the emergency_contacts edge name in mutations.
+	EdgeEmergencyContacts = "emergency_contacts"
+	// Table holds the table name of the profile in the database.
+	Table = "profiles"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "profiles"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_profile"
+	// EmergencyContactsTable is the table that holds the emergency_contacts relation/edge.
+	EmergencyContactsTable = "emergency_contacts"
+	// EmergencyContactsInverseTable is the table name for the EmergencyContact entity.
+	// It exists in this package in order to avoid circular dependency with the "emergencycontact" package.
+	EmergencyContactsInverseTable = "emergency_contacts"
+	// EmergencyContactsColumn is the table column denoting the emergency_contacts relation/edge.
+	EmergencyContactsColumn = "profile_emergency_contacts"
+)
+
+// Columns holds all SQL columns for profile fields.
+var Columns = []string{
+	FieldID,
+	FieldDateOfBirth,
+	FieldPlaceOfBirth,
+	FieldNationalID,
+	FieldNationalIDHash,
+	FieldNationalIDIssueDate,
+	FieldNationalIDIssuePlace,
+	FieldEthnicity,
+	FieldReligion,
+	FieldMaritalStatus,
+	FieldTaxCode,
+	FieldSocialInsuranceNumber,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "profiles"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_profile",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/huynhthanhthao/hrm_user_service/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// MaritalStatus defines the type for the "marital_status" enum field.
+type MaritalStatus string
+
+// MaritalStatus values.
+const (
+	MaritalStatusSingle   MaritalStatus = "single"
+	MaritalStatusMarried  MaritalStatus = "married"
+	MaritalStatusDivorced MaritalStatus = "divorced"
+	MaritalStatusWidowed  MaritalStatus = "widowed"
+)
+
+func (ms MaritalStatus) String() string {
+	return string(ms)
+}
+
+// MaritalStatusValidator is a validator for the "marital_status" field enum values. It is called by the builders before save.
+func MaritalStatusValidator(ms MaritalStatus) error {
+	switch ms {
+	case MaritalStatusSingle, MaritalStatusMarried, MaritalStatusDivorced, MaritalStatusWidowed:
+		return nil
+	default:
+		return fmt.Errorf("profile: invalid enum value for marital_status field: %q", ms)
+	}
+}
+
+// OrderOption defines the ordering options for the Profile queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDateOfBirth orders the results by the date_of_birth field.
+func ByDateOfBirth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDateOfBirth, opts...).ToFunc()
+}
+
+// ByPlaceOfBirth orders the results by the place_of_birth field.
+func ByPlaceOfBirth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlaceOfBirth, opts...).ToFunc()
+}
+
+// ByNationalID orders the results by the national_id field.
+func ByNationalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNationalID, opts...).ToFunc()
+}
+
+// ByNationalIDHash orders the results by the national_id_hash field.
+func ByNationalIDHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNationalIDHash, opts...).ToFunc()
+}
+
+// ByNationalIDIssueDate orders the results by the national_id_issue_date field.
+func ByNationalIDIssueDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNationalIDIssueDate, opts...).ToFunc()
+}
+
+// ByNationalIDIssuePlace orders the results by the national_id_issue_place field.
+func ByNationalIDIssuePlace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNationalIDIssuePlace, opts...).ToFunc()
+}
+
+// ByEthnicity orders the results by the ethnicity field.
+func ByEthnicity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEthnicity, opts...).ToFunc()
+}
+
+// ByReligion orders the results by the religion field.
+func ByReligion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReligion, opts...).ToFunc()
+}
+
+// ByMaritalStatus orders the results by the marital_status field.
+func ByMaritalStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaritalStatus, opts...).ToFunc()
+}
+
+// ByTaxCode orders the results by the tax_code field.
+func ByTaxCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxCode, opts...).ToFunc()
+}
+
+// BySocialInsuranceNumber orders the results by the social_insurance_number field.
+func BySocialInsuranceNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSocialInsuranceNumber, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEmergencyContactsCount orders the results by emergency_contacts count.
+func ByEmergencyContactsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEmergencyContactsStep(), opts...)
+	}
+}
+
+// ByEmergencyContacts orders the results by emergency_contacts terms.
+func ByEmergencyContacts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmergencyContactsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+	)
+}
+func newEmergencyContactsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmergencyContactsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EmergencyContactsTable, EmergencyContactsColumn),
+	)
+}
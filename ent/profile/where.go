@@ -0,0 +1,942 @@
+// Code generated by ent, DO NOT EDIT.
+
+package profile
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldID, id))
+}
+
+// DateOfBirth applies equality check predicate on the "date_of_birth" field. It's identical to DateOfBirthEQ.
+func DateOfBirth(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldDateOfBirth, v))
+}
+
+// PlaceOfBirth applies equality check predicate on the "place_of_birth" field. It's identical to PlaceOfBirthEQ.
+func PlaceOfBirth(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldPlaceOfBirth, v))
+}
+
+// NationalID applies equality check predicate on the "national_id" field. It's identical to NationalIDEQ.
+func NationalID(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldNationalID, v))
+}
+
+// NationalIDHash applies equality check predicate on the "national_id_hash" field. It's identical to NationalIDHashEQ.
+func NationalIDHash(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldNationalIDHash, v))
+}
+
+// NationalIDIssueDate applies equality check predicate on the "national_id_issue_date" field. It's identical to NationalIDIssueDateEQ.
+func NationalIDIssueDate(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldNationalIDIssueDate, v))
+}
+
+// NationalIDIssuePlace applies equality check predicate on the "national_id_issue_place" field. It's identical to NationalIDIssuePlaceEQ.
+func NationalIDIssuePlace(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldNationalIDIssuePlace, v))
+}
+
+// Ethnicity applies equality check predicate on the "ethnicity" field. It's identical to EthnicityEQ.
+func Ethnicity(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldEthnicity, v))
+}
+
+// Religion applies equality check predicate on the "religion" field. It's identical to ReligionEQ.
+func Religion(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldReligion, v))
+}
+
+// TaxCode applies equality check predicate on the "tax_code" field. It's identical to TaxCodeEQ.
+func TaxCode(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldTaxCode, v))
+}
+
+// SocialInsuranceNumber applies equality check predicate on the "social_insurance_number" field. It's identical to SocialInsuranceNumberEQ.
+func SocialInsuranceNumber(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldSocialInsuranceNumber, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DateOfBirthEQ applies the EQ predicate on the "date_of_birth" field.
+func DateOfBirthEQ(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldDateOfBirth, v))
+}
+
+// DateOfBirthNEQ applies the NEQ predicate on the "date_of_birth" field.
+func DateOfBirthNEQ(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldDateOfBirth, v))
+}
+
+// DateOfBirthIn applies the In predicate on the "date_of_birth" field.
+func DateOfBirthIn(vs ...time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldDateOfBirth, vs...))
+}
+
+// DateOfBirthNotIn applies the NotIn predicate on the "date_of_birth" field.
+func DateOfBirthNotIn(vs ...time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldDateOfBirth, vs...))
+}
+
+// DateOfBirthGT applies the GT predicate on the "date_of_birth" field.
+func DateOfBirthGT(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldDateOfBirth, v))
+}
+
+// DateOfBirthGTE applies the GTE predicate on the "date_of_birth" field.
+func DateOfBirthGTE(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldDateOfBirth, v))
+}
+
+// DateOfBirthLT applies the LT predicate on the "date_of_birth" field.
+func DateOfBirthLT(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldDateOfBirth, v))
+}
+
+// DateOfBirthLTE applies the LTE predicate on the "date_of_birth" field.
+func DateOfBirthLTE(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldDateOfBirth, v))
+}
+
+// DateOfBirthIsNil applies the IsNil predicate on the "date_of_birth" field.
+func DateOfBirthIsNil() predicate.Profile {
+	return predicate.Profile(sql.FieldIsNull(FieldDateOfBirth))
+}
+
+// DateOfBirthNotNil applies the NotNil predicate on the "date_of_birth" field.
+func DateOfBirthNotNil() predicate.Profile {
+	return predicate.Profile(sql.FieldNotNull(FieldDateOfBirth))
+}
+
+// PlaceOfBirthEQ applies the EQ predicate on the "place_of_birth" field.
+func PlaceOfBirthEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldPlaceOfBirth, v))
+}
+
+// PlaceOfBirthNEQ applies the NEQ predicate on the "place_of_birth" field.
+func PlaceOfBirthNEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldPlaceOfBirth, v))
+}
+
+// PlaceOfBirthIn applies the In predicate on the "place_of_birth" field.
+func PlaceOfBirthIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldPlaceOfBirth, vs...))
+}
+
+// PlaceOfBirthNotIn applies the NotIn predicate on the "place_of_birth" field.
+func PlaceOfBirthNotIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldPlaceOfBirth, vs...))
+}
+
+// PlaceOfBirthGT applies the GT predicate on the "place_of_birth" field.
+func PlaceOfBirthGT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldPlaceOfBirth, v))
+}
+
+// PlaceOfBirthGTE applies the GTE predicate on the "place_of_birth" field.
+func PlaceOfBirthGTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldPlaceOfBirth, v))
+}
+
+// PlaceOfBirthLT applies the LT predicate on the "place_of_birth" field.
+func PlaceOfBirthLT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldPlaceOfBirth, v))
+}
+
+// PlaceOfBirthLTE applies the LTE predicate on the "place_of_birth" field.
+func PlaceOfBirthLTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldPlaceOfBirth, v))
+}
+
+// PlaceOfBirthContains applies the Contains predicate on the "place_of_birth" field.
+func PlaceOfBirthContains(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContains(FieldPlaceOfBirth, v))
+}
+
+// PlaceOfBirthHasPrefix applies the HasPrefix predicate on the "place_of_birth" field.
+func PlaceOfBirthHasPrefix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasPrefix(FieldPlaceOfBirth, v))
+}
+
+// PlaceOfBirthHasSuffix applies the HasSuffix predicate on the "place_of_birth" field.
+func PlaceOfBirthHasSuffix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasSuffix(FieldPlaceOfBirth, v))
+}
+
+// PlaceOfBirthIsNil applies the IsNil predicate on the "place_of_birth" field.
+func PlaceOfBirthIsNil() predicate.Profile {
+	return predicate.Profile(sql.FieldIsNull(FieldPlaceOfBirth))
+}
+
+// PlaceOfBirthNotNil applies the NotNil predicate on the "place_of_birth" field.
+func PlaceOfBirthNotNil() predicate.Profile {
+	return predicate.Profile(sql.FieldNotNull(FieldPlaceOfBirth))
+}
+
+// PlaceOfBirthEqualFold applies the EqualFold predicate on the "place_of_birth" field.
+func PlaceOfBirthEqualFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEqualFold(FieldPlaceOfBirth, v))
+}
+
+// PlaceOfBirthContainsFold applies the ContainsFold predicate on the "place_of_birth" field.
+func PlaceOfBirthContainsFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContainsFold(FieldPlaceOfBirth, v))
+}
+
+// NationalIDEQ applies the EQ predicate on the "national_id" field.
+func NationalIDEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldNationalID, v))
+}
+
+// NationalIDNEQ applies the NEQ predicate on the "national_id" field.
+func NationalIDNEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldNationalID, v))
+}
+
+// NationalIDIn applies the In predicate on the "national_id" field.
+func NationalIDIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldNationalID, vs...))
+}
+
+// NationalIDNotIn applies the NotIn predicate on the "national_id" field.
+func NationalIDNotIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldNationalID, vs...))
+}
+
+// NationalIDGT applies the GT predicate on the "national_id" field.
+func NationalIDGT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldNationalID, v))
+}
+
+// NationalIDGTE applies the GTE predicate on the "national_id" field.
+func NationalIDGTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldNationalID, v))
+}
+
+// NationalIDLT applies the LT predicate on the "national_id" field.
+func NationalIDLT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldNationalID, v))
+}
+
+// NationalIDLTE applies the LTE predicate on the "national_id" field.
+func NationalIDLTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldNationalID, v))
+}
+
+// NationalIDContains applies the Contains predicate on the "national_id" field.
+func NationalIDContains(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContains(FieldNationalID, v))
+}
+
+// NationalIDHasPrefix applies the HasPrefix predicate on the "national_id" field.
+func NationalIDHasPrefix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasPrefix(FieldNationalID, v))
+}
+
+// NationalIDHasSuffix applies the HasSuffix predicate on the "national_id" field.
+func NationalIDHasSuffix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasSuffix(FieldNationalID, v))
+}
+
+// NationalIDIsNil applies the IsNil predicate on the "national_id" field.
+func NationalIDIsNil() predicate.Profile {
+	return predicate.Profile(sql.FieldIsNull(FieldNationalID))
+}
+
+// NationalIDNotNil applies the NotNil predicate on the "national_id" field.
+func NationalIDNotNil() predicate.Profile {
+	return predicate.Profile(sql.FieldNotNull(FieldNationalID))
+}
+
+// NationalIDEqualFold applies the EqualFold predicate on the "national_id" field.
+func NationalIDEqualFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEqualFold(FieldNationalID, v))
+}
+
+// NationalIDContainsFold applies the ContainsFold predicate on the "national_id" field.
+func NationalIDContainsFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContainsFold(FieldNationalID, v))
+}
+
+// NationalIDHashEQ applies the EQ predicate on the "national_id_hash" field.
+func NationalIDHashEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldNationalIDHash, v))
+}
+
+// NationalIDHashNEQ applies the NEQ predicate on the "national_id_hash" field.
+func NationalIDHashNEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldNationalIDHash, v))
+}
+
+// NationalIDHashIn applies the In predicate on the "national_id_hash" field.
+func NationalIDHashIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldNationalIDHash, vs...))
+}
+
+// NationalIDHashNotIn applies the NotIn predicate on the "national_id_hash" field.
+func NationalIDHashNotIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldNationalIDHash, vs...))
+}
+
+// NationalIDHashGT applies the GT predicate on the "national_id_hash" field.
+func NationalIDHashGT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldNationalIDHash, v))
+}
+
+// NationalIDHashGTE applies the GTE predicate on the "national_id_hash" field.
+func NationalIDHashGTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldNationalIDHash, v))
+}
+
+// NationalIDHashLT applies the LT predicate on the "national_id_hash" field.
+func NationalIDHashLT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldNationalIDHash, v))
+}
+
+// NationalIDHashLTE applies the LTE predicate on the "national_id_hash" field.
+func NationalIDHashLTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldNationalIDHash, v))
+}
+
+// NationalIDHashContains applies the Contains predicate on the "national_id_hash" field.
+func NationalIDHashContains(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContains(FieldNationalIDHash, v))
+}
+
+// NationalIDHashHasPrefix applies the HasPrefix predicate on the "national_id_hash" field.
+func NationalIDHashHasPrefix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasPrefix(FieldNationalIDHash, v))
+}
+
+// NationalIDHashHasSuffix applies the HasSuffix predicate on the "national_id_hash" field.
+func NationalIDHashHasSuffix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasSuffix(FieldNationalIDHash, v))
+}
+
+// NationalIDHashIsNil applies the IsNil predicate on the "national_id_hash" field.
+func NationalIDHashIsNil() predicate.Profile {
+	return predicate.Profile(sql.FieldIsNull(FieldNationalIDHash))
+}
+
+// NationalIDHashNotNil applies the NotNil predicate on the "national_id_hash" field.
+func NationalIDHashNotNil() predicate.Profile {
+	return predicate.Profile(sql.FieldNotNull(FieldNationalIDHash))
+}
+
+// NationalIDHashEqualFold applies the EqualFold predicate on the "national_id_hash" field.
+func NationalIDHashEqualFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEqualFold(FieldNationalIDHash, v))
+}
+
+// NationalIDHashContainsFold applies the ContainsFold predicate on the "national_id_hash" field.
+func NationalIDHashContainsFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContainsFold(FieldNationalIDHash, v))
+}
+
+// NationalIDIssueDateEQ applies the EQ predicate on the "national_id_issue_date" field.
+func NationalIDIssueDateEQ(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldNationalIDIssueDate, v))
+}
+
+// NationalIDIssueDateNEQ applies the NEQ predicate on the "national_id_issue_date" field.
+func NationalIDIssueDateNEQ(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldNationalIDIssueDate, v))
+}
+
+// NationalIDIssueDateIn applies the In predicate on the "national_id_issue_date" field.
+func NationalIDIssueDateIn(vs ...time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldNationalIDIssueDate, vs...))
+}
+
+// NationalIDIssueDateNotIn applies the NotIn predicate on the "national_id_issue_date" field.
+func NationalIDIssueDateNotIn(vs ...time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldNationalIDIssueDate, vs...))
+}
+
+// NationalIDIssueDateGT applies the GT predicate on the "national_id_issue_date" field.
+func NationalIDIssueDateGT(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldNationalIDIssueDate, v))
+}
+
+// NationalIDIssueDateGTE applies the GTE predicate on the "national_id_issue_date" field.
+func NationalIDIssueDateGTE(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldNationalIDIssueDate, v))
+}
+
+// NationalIDIssueDateLT applies the LT predicate on the "national_id_issue_date" field.
+func NationalIDIssueDateLT(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldNationalIDIssueDate, v))
+}
+
+// NationalIDIssueDateLTE applies the LTE predicate on the "national_id_issue_date" field.
+func NationalIDIssueDateLTE(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldNationalIDIssueDate, v))
+}
+
+// NationalIDIssueDateIsNil applies the IsNil predicate on the "national_id_issue_date" field.
+func NationalIDIssueDateIsNil() predicate.Profile {
+	return predicate.Profile(sql.FieldIsNull(FieldNationalIDIssueDate))
+}
+
+// NationalIDIssueDateNotNil applies the NotNil predicate on the "national_id_issue_date" field.
+func NationalIDIssueDateNotNil() predicate.Profile {
+	return predicate.Profile(sql.FieldNotNull(FieldNationalIDIssueDate))
+}
+
+// NationalIDIssuePlaceEQ applies the EQ predicate on the "national_id_issue_place" field.
+func NationalIDIssuePlaceEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldNationalIDIssuePlace, v))
+}
+
+// NationalIDIssuePlaceNEQ applies the NEQ predicate on the "national_id_issue_place" field.
+func NationalIDIssuePlaceNEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldNationalIDIssuePlace, v))
+}
+
+// NationalIDIssuePlaceIn applies the In predicate on the "national_id_issue_place" field.
+func NationalIDIssuePlaceIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldNationalIDIssuePlace, vs...))
+}
+
+// NationalIDIssuePlaceNotIn applies the NotIn predicate on the "national_id_issue_place" field.
+func NationalIDIssuePlaceNotIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldNationalIDIssuePlace, vs...))
+}
+
+// NationalIDIssuePlaceGT applies the GT predicate on the "national_id_issue_place" field.
+func NationalIDIssuePlaceGT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldNationalIDIssuePlace, v))
+}
+
+// NationalIDIssuePlaceGTE applies the GTE predicate on the "national_id_issue_place" field.
+func NationalIDIssuePlaceGTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldNationalIDIssuePlace, v))
+}
+
+// NationalIDIssuePlaceLT applies the LT predicate on the "national_id_issue_place" field.
+func NationalIDIssuePlaceLT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldNationalIDIssuePlace, v))
+}
+
+// NationalIDIssuePlaceLTE applies the LTE predicate on the "national_id_issue_place" field.
+func NationalIDIssuePlaceLTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldNationalIDIssuePlace, v))
+}
+
+// NationalIDIssuePlaceContains applies the Contains predicate on the "national_id_issue_place" field.
+func NationalIDIssuePlaceContains(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContains(FieldNationalIDIssuePlace, v))
+}
+
+// NationalIDIssuePlaceHasPrefix applies the HasPrefix predicate on the "national_id_issue_place" field.
+func NationalIDIssuePlaceHasPrefix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasPrefix(FieldNationalIDIssuePlace, v))
+}
+
+// NationalIDIssuePlaceHasSuffix applies the HasSuffix predicate on the "national_id_issue_place" field.
+func NationalIDIssuePlaceHasSuffix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasSuffix(FieldNationalIDIssuePlace, v))
+}
+
+// NationalIDIssuePlaceIsNil applies the IsNil predicate on the "national_id_issue_place" field.
+func NationalIDIssuePlaceIsNil() predicate.Profile {
+	return predicate.Profile(sql.FieldIsNull(FieldNationalIDIssuePlace))
+}
+
+// NationalIDIssuePlaceNotNil applies the NotNil predicate on the "national_id_issue_place" field.
+func NationalIDIssuePlaceNotNil() predicate.Profile {
+	return predicate.Profile(sql.FieldNotNull(FieldNationalIDIssuePlace))
+}
+
+// NationalIDIssuePlaceEqualFold applies the EqualFold predicate on the "national_id_issue_place" field.
+func NationalIDIssuePlaceEqualFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEqualFold(FieldNationalIDIssuePlace, v))
+}
+
+// NationalIDIssuePlaceContainsFold applies the ContainsFold predicate on the "national_id_issue_place" field.
+func NationalIDIssuePlaceContainsFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContainsFold(FieldNationalIDIssuePlace, v))
+}
+
+// EthnicityEQ applies the EQ predicate on the "ethnicity" field.
+func EthnicityEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldEthnicity, v))
+}
+
+// EthnicityNEQ applies the NEQ predicate on the "ethnicity" field.
+func EthnicityNEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldEthnicity, v))
+}
+
+// EthnicityIn applies the In predicate on the "ethnicity" field.
+func EthnicityIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldEthnicity, vs...))
+}
+
+// EthnicityNotIn applies the NotIn predicate on the "ethnicity" field.
+func EthnicityNotIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldEthnicity, vs...))
+}
+
+// EthnicityGT applies the GT predicate on the "ethnicity" field.
+func EthnicityGT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldEthnicity, v))
+}
+
+// EthnicityGTE applies the GTE predicate on the "ethnicity" field.
+func EthnicityGTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldEthnicity, v))
+}
+
+// EthnicityLT applies the LT predicate on the "ethnicity" field.
+func EthnicityLT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldEthnicity, v))
+}
+
+// EthnicityLTE applies the LTE predicate on the "ethnicity" field.
+func EthnicityLTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldEthnicity, v))
+}
+
+// EthnicityContains applies the Contains predicate on the "ethnicity" field.
+func EthnicityContains(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContains(FieldEthnicity, v))
+}
+
+// EthnicityHasPrefix applies the HasPrefix predicate on the "ethnicity" field.
+func EthnicityHasPrefix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasPrefix(FieldEthnicity, v))
+}
+
+// EthnicityHasSuffix applies the HasSuffix predicate on the "ethnicity" field.
+func EthnicityHasSuffix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasSuffix(FieldEthnicity, v))
+}
+
+// EthnicityIsNil applies the IsNil predicate on the "ethnicity" field.
+func EthnicityIsNil() predicate.Profile {
+	return predicate.Profile(sql.FieldIsNull(FieldEthnicity))
+}
+
+// EthnicityNotNil applies the NotNil predicate on the "ethnicity" field.
+func EthnicityNotNil() predicate.Profile {
+	return predicate.Profile(sql.FieldNotNull(FieldEthnicity))
+}
+
+// EthnicityEqualFold applies the EqualFold predicate on the "ethnicity" field.
+func EthnicityEqualFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEqualFold(FieldEthnicity, v))
+}
+
+// EthnicityContainsFold applies the ContainsFold predicate on the "ethnicity" field.
+func EthnicityContainsFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContainsFold(FieldEthnicity, v))
+}
+
+// ReligionEQ applies the EQ predicate on the "religion" field.
+func ReligionEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldReligion, v))
+}
+
+// ReligionNEQ applies the NEQ predicate on the "religion" field.
+func ReligionNEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldReligion, v))
+}
+
+// ReligionIn applies the In predicate on the "religion" field.
+func ReligionIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldReligion, vs...))
+}
+
+// ReligionNotIn applies the NotIn predicate on the "religion" field.
+func ReligionNotIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldReligion, vs...))
+}
+
+// ReligionGT applies the GT predicate on the "religion" field.
+func ReligionGT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldReligion, v))
+}
+
+// ReligionGTE applies the GTE predicate on the "religion" field.
+func ReligionGTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldReligion, v))
+}
+
+// ReligionLT applies the LT predicate on the "religion" field.
+func ReligionLT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldReligion, v))
+}
+
+// ReligionLTE applies the LTE predicate on the "religion" field.
+func ReligionLTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldReligion, v))
+}
+
+// ReligionContains applies the Contains predicate on the "religion" field.
+func ReligionContains(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContains(FieldReligion, v))
+}
+
+// ReligionHasPrefix applies the HasPrefix predicate on the "religion" field.
+func ReligionHasPrefix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasPrefix(FieldReligion, v))
+}
+
+// ReligionHasSuffix applies the HasSuffix predicate on the "religion" field.
+func ReligionHasSuffix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasSuffix(FieldReligion, v))
+}
+
+// ReligionIsNil applies the IsNil predicate on the "religion" field.
+func ReligionIsNil() predicate.Profile {
+	return predicate.Profile(sql.FieldIsNull(FieldReligion))
+}
+
+// ReligionNotNil applies the NotNil predicate on the "religion" field.
+func ReligionNotNil() predicate.Profile {
+	return predicate.Profile(sql.FieldNotNull(FieldReligion))
+}
+
+// ReligionEqualFold applies the EqualFold predicate on the "religion" field.
+func ReligionEqualFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEqualFold(FieldReligion, v))
+}
+
+// ReligionContainsFold applies the ContainsFold predicate on the "religion" field.
+func ReligionContainsFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContainsFold(FieldReligion, v))
+}
+
+// MaritalStatusEQ applies the EQ predicate on the "marital_status" field.
+func MaritalStatusEQ(v MaritalStatus) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldMaritalStatus, v))
+}
+
+// MaritalStatusNEQ applies the NEQ predicate on the "marital_status" field.
+func MaritalStatusNEQ(v MaritalStatus) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldMaritalStatus, v))
+}
+
+// MaritalStatusIn applies the In predicate on the "marital_status" field.
+func MaritalStatusIn(vs ...MaritalStatus) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldMaritalStatus, vs...))
+}
+
+// MaritalStatusNotIn applies the NotIn predicate on the "marital_status" field.
+func MaritalStatusNotIn(vs ...MaritalStatus) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldMaritalStatus, vs...))
+}
+
+// MaritalStatusIsNil applies the IsNil predicate on the "marital_status" field.
+func MaritalStatusIsNil() predicate.Profile {
+	return predicate.Profile(sql.FieldIsNull(FieldMaritalStatus))
+}
+
+// MaritalStatusNotNil applies the NotNil predicate on the "marital_status" field.
+func MaritalStatusNotNil() predicate.Profile {
+	return predicate.Profile(sql.FieldNotNull(FieldMaritalStatus))
+}
+
+// TaxCodeEQ applies the EQ predicate on the "tax_code" field.
+func TaxCodeEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldTaxCode, v))
+}
+
+// TaxCodeNEQ applies the NEQ predicate on the "tax_code" field.
+func TaxCodeNEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldTaxCode, v))
+}
+
+// TaxCodeIn applies the In predicate on the "tax_code" field.
+func TaxCodeIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldTaxCode, vs...))
+}
+
+// TaxCodeNotIn applies the NotIn predicate on the "tax_code" field.
+func TaxCodeNotIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldTaxCode, vs...))
+}
+
+// TaxCodeGT applies the GT predicate on the "tax_code" field.
+func TaxCodeGT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldTaxCode, v))
+}
+
+// TaxCodeGTE applies the GTE predicate on the "tax_code" field.
+func TaxCodeGTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldTaxCode, v))
+}
+
+// TaxCodeLT applies the LT predicate on the "tax_code" field.
+func TaxCodeLT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldTaxCode, v))
+}
+
+// TaxCodeLTE applies the LTE predicate on the "tax_code" field.
+func TaxCodeLTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldTaxCode, v))
+}
+
+// TaxCodeContains applies the Contains predicate on the "tax_code" field.
+func TaxCodeContains(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContains(FieldTaxCode, v))
+}
+
+// TaxCodeHasPrefix applies the HasPrefix predicate on the "tax_code" field.
+func TaxCodeHasPrefix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasPrefix(FieldTaxCode, v))
+}
+
+// TaxCodeHasSuffix applies the HasSuffix predicate on the "tax_code" field.
+func TaxCodeHasSuffix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasSuffix(FieldTaxCode, v))
+}
+
+// TaxCodeIsNil applies the IsNil predicate on the "tax_code" field.
+func TaxCodeIsNil() predicate.Profile {
+	return predicate.Profile(sql.FieldIsNull(FieldTaxCode))
+}
+
+// TaxCodeNotNil applies the NotNil predicate on the "tax_code" field.
+func TaxCodeNotNil() predicate.Profile {
+	return predicate.Profile(sql.FieldNotNull(FieldTaxCode))
+}
+
+// TaxCodeEqualFold applies the EqualFold predicate on the "tax_code" field.
+func TaxCodeEqualFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEqualFold(FieldTaxCode, v))
+}
+
+// TaxCodeContainsFold applies the ContainsFold predicate on the "tax_code" field.
+func TaxCodeContainsFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContainsFold(FieldTaxCode, v))
+}
+
+// SocialInsuranceNumberEQ applies the EQ predicate on the "social_insurance_number" field.
+func SocialInsuranceNumberEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldSocialInsuranceNumber, v))
+}
+
+// SocialInsuranceNumberNEQ applies the NEQ predicate on the "social_insurance_number" field.
+func SocialInsuranceNumberNEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldSocialInsuranceNumber, v))
+}
+
+// SocialInsuranceNumberIn applies the In predicate on the "social_insurance_number" field.
+func SocialInsuranceNumberIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldSocialInsuranceNumber, vs...))
+}
+
+// SocialInsuranceNumberNotIn applies the NotIn predicate on the "social_insurance_number" field.
+func SocialInsuranceNumberNotIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldSocialInsuranceNumber, vs...))
+}
+
+// SocialInsuranceNumberGT applies the GT predicate on the "social_insurance_number" field.
+func SocialInsuranceNumberGT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldSocialInsuranceNumber, v))
+}
+
+// SocialInsuranceNumberGTE applies the GTE predicate on the "social_insurance_number" field.
+func SocialInsuranceNumberGTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldSocialInsuranceNumber, v))
+}
+
+// SocialInsuranceNumberLT applies the LT predicate on the "social_insurance_number" field.
+func SocialInsuranceNumberLT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldSocialInsuranceNumber, v))
+}
+
+// SocialInsuranceNumberLTE applies the LTE predicate on the "social_insurance_number" field.
+func SocialInsuranceNumberLTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldSocialInsuranceNumber, v))
+}
+
+// SocialInsuranceNumberContains applies the Contains predicate on the "social_insurance_number" field.
+func SocialInsuranceNumberContains(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContains(FieldSocialInsuranceNumber, v))
+}
+
+// SocialInsuranceNumberHasPrefix applies the HasPrefix predicate on the "social_insurance_number" field.
+func SocialInsuranceNumberHasPrefix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasPrefix(FieldSocialInsuranceNumber, v))
+}
+
+// SocialInsuranceNumberHasSuffix applies the HasSuffix predicate on the "social_insurance_number" field.
+func SocialInsuranceNumberHasSuffix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasSuffix(FieldSocialInsuranceNumber, v))
+}
+
+// SocialInsuranceNumberIsNil applies the IsNil predicate on the "social_insurance_number" field.
+func SocialInsuranceNumberIsNil() predicate.Profile {
+	return predicate.Profile(sql.FieldIsNull(FieldSocialInsuranceNumber))
+}
+
+// SocialInsuranceNumberNotNil applies the NotNil predicate on the "social_insurance_number" field.
+func SocialInsuranceNumberNotNil() predicate.Profile {
+	return predicate.Profile(sql.FieldNotNull(FieldSocialInsuranceNumber))
+}
+
+// SocialInsuranceNumberEqualFold applies the EqualFold predicate on the "social_insurance_number" field.
+func SocialInsuranceNumberEqualFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEqualFold(FieldSocialInsuranceNumber, v))
+}
+
+// SocialInsuranceNumberContainsFold applies the ContainsFold predicate on the "social_insurance_number" field.
+func SocialInsuranceNumberContainsFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContainsFold(FieldSocialInsuranceNumber, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEmergencyContacts applies the HasEdge predicate on the "emergency_contacts" edge.
+func HasEmergencyContacts() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EmergencyContactsTable, EmergencyContactsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmergencyContactsWith applies the HasEdge predicate on the "emergency_contacts" edge with a given conditions (other predicates).
+func HasEmergencyContactsWith(preds ...predicate.EmergencyContact) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newEmergencyContactsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Profile) predicate.Profile {
+	return predicate.Profile(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Profile) predicate.Profile {
+	return predicate.Profile(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Profile) predicate.Profile {
+	return predicate.Profile(sql.NotPredicates(p))
+}
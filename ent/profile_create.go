@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/emergencycontact"
+	"github.com/huynhthanhthao/hrm_user_service/ent/profile"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+)
+
+// ProfileCreate is the builder for creating a Profile entity.
+type ProfileCreate struct {
+	config
+	mutation *ProfileMutation
+	hooks    []Hook
+}
+
+// SetDateOfBirth sets the "date_of_birth" field.
+func (pc *ProfileCreate) SetDateOfBirth(t time.Time) *ProfileCreate {
+	pc.mutation.SetDateOfBirth(t)
+	return pc
+}
+
+// SetNillableDateOfBirth sets the "date_of_birth" field if the given value is not nil.
+func (pc *ProfileCreate) SetNillableDateOfBirth(t *time.Time) *ProfileCreate {
+	if t != nil {
+		pc.SetDateOfBirth(*t)
+	}
+	return pc
+}
+
+// SetPlaceOfBirth sets the "place_of_birth" field.
+func (pc *ProfileCreate) SetPlaceOfBirth(s string) *ProfileCreate {
+	pc.mutation.SetPlaceOfBirth(s)
+	return pc
+}
+
+// SetNillablePlaceOfBirth sets the "place_of_birth" field if the given value is not nil.
+func (pc *ProfileCreate) SetNillablePlaceOfBirth(s *string) *ProfileCreate {
+	if s != nil {
+		pc.SetPlaceOfBirth(*s)
+	}
+	return pc
+}
+
+// SetNationalID sets the "national_id" field.
+func (pc *ProfileCreate) SetNationalID(s string) *ProfileCreate {
+	pc.mutation.SetNationalID(s)
+	return pc
+}
+
+// SetNillableNationalID sets the "national_id" field if the given value is not nil.
+func (pc *ProfileCreate) SetNillableNationalID(s *string) *ProfileCreate {
+	if s != nil {
+		pc.SetNationalID(*s)
+	}
+	return pc
+}
+
+// SetNationalIDHash sets the "national_id_hash" field.
+func (pc *ProfileCreate) SetNationalIDHash(s string) *ProfileCreate {
+	pc.mutation.SetNationalIDHash(s)
+	return pc
+}
+
+// SetNillableNationalIDHash sets the "national_id_hash" field if the given value is not nil.
+func (pc *ProfileCreate) SetNillableNationalIDHash(s *string) *ProfileCreate {
+	if s != nil {
+		pc.SetNationalIDHash(*s)
+	}
+	return pc
+}
+
+// SetNationalIDIssueDate sets the "national_id_issue_date" field.
+func (pc *ProfileCreate) SetNationalIDIssueDate(t time.Time) *ProfileCreate {
+	pc.mutation.SetNationalIDIssueDate(t)
+	return pc
+}
+
+// SetNillableNationalIDIssueDate sets the "national_id_issue_date" field if the given value is not nil.
+func (pc *ProfileCreate) SetNillableNationalIDIssueDate(t *time.Time) *ProfileCreate {
+	if t != nil {
+		pc.SetNationalIDIssueDate(*t)
+	}
+	return pc
+}
+
+// SetNationalIDIssuePlace sets the "national_id_issue_place" field.
+func (pc *ProfileCreate) SetNationalIDIssuePlace(s string) *ProfileCreate {
+	pc.mutation.SetNationalIDIssuePlace(s)
+	return pc
+}
+
+// SetNillableNationalIDIssuePlace sets the "national_id_issue_place" field if the given value is not nil.
+func (pc *ProfileCreate) SetNillableNationalIDIssuePlace(s *string) *ProfileCreate {
+	if s != nil {
+		pc.SetNationalIDIssuePlace(*s)
+	}
+	return pc
+}
+
+// SetEthnicity sets the "ethnicity" field.
+func (pc *ProfileCreate) SetEthnicity(s string) *ProfileCreate {
+	pc.mutation.SetEthnicity(s)
+	return pc
+}
+
+// SetNillableEthnicity sets the "ethnicity" field if the given value is not nil.
+func (pc *ProfileCreate) SetNillableEthnicity(s *string) *ProfileCreate {
+	if s != nil {
+		pc.SetEthnicity(*s)
+	}
+	return pc
+}
+
+// SetReligion sets the "religion" field.
+func (pc *ProfileCreate) SetReligion(s string) *ProfileCreate {
+	pc.mutation.SetReligion(s)
+	return pc
+}
+
+// SetNillableReligion sets the "religion" field if the given value is not nil.
+func (pc *ProfileCreate) SetNillableReligion(s *string) *ProfileCreate {
+	if s != nil {
+		pc.SetReligion(*s)
+	}
+	return pc
+}
+
+// SetMaritalStatus sets the "marital_status" field.
+func (pc *ProfileCreate) SetMaritalStatus(ps profile.MaritalStatus) *ProfileCreate {
+	pc.mutation.SetMaritalStatus(ps)
+	return pc
+}
+
+// SetNillableMaritalStatus sets the "marital_status" field if the given value is not nil.
+func (pc *ProfileCreate) SetNillableMaritalStatus(ps *profile.MaritalStatus) *ProfileCreate {
+	if ps != nil {
+		pc.SetMaritalStatus(*ps)
+	}
+	return pc
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (pc *ProfileCreate) SetTaxCode(s string) *ProfileCreate {
+	pc.mutation.SetTaxCode(s)
+	return pc
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (pc *ProfileCreate) SetNillableTaxCode(s *string) *ProfileCreate {
+	if s != nil {
+		pc.SetTaxCode(*s)
+	}
+	return pc
+}
+
+// SetSocialInsuranceNumber sets the "social_insurance_number" field.
+func (pc *ProfileCreate) SetSocialInsuranceNumber(s string) *ProfileCreate {
+	pc.mutation.SetSocialInsuranceNumber(s)
+	return pc
+}
+
+// SetNillableSocialInsuranceNumber sets the "social_insurance_number" field if the given value is not nil.
+func (pc *ProfileCreate) SetNillableSocialInsuranceNumber(s *string) *ProfileCreate {
+	if s != nil {
+		pc.SetSocialInsuranceNumber(*s)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *ProfileCreate) SetUpdatedAt(t time.Time) *ProfileCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *ProfileCreate) SetNillableUpdatedAt(t *time.Time) *ProfileCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (pc *ProfileCreate) SetUserID(id int) *ProfileCreate {
+	pc.mutation.SetUserID(id)
+	return pc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pc *ProfileCreate) SetUser(u *User) *ProfileCreate {
+	return pc.SetUserID(u.ID)
+}
+
+// AddEmergencyContactIDs adds the "emergency_contacts" edge to the EmergencyContact entity by IDs.
+func (pc *ProfileCreate) AddEmergencyContactIDs(ids ...int) *ProfileCreate {
+	pc.mutation.AddEmergencyContactIDs(ids...)
+	return pc
+}
+
+// AddEmergencyContacts adds the "emergency_contacts" edges to the EmergencyContact entity.
+func (pc *ProfileCreate) AddEmergencyContacts(e ...*EmergencyContact) *ProfileCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return pc.AddEmergencyContactIDs(ids...)
+}
+
+// Mutation returns the ProfileMutation object of the builder.
+func (pc *ProfileCreate) Mutation() *ProfileMutation {
+	return pc.mutation
+}
+
+// Save creates the Profile in the database.
+func (pc *ProfileCreate) Save(ctx context.Context) (*Profile, error) {
+	if err := pc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *ProfileCreate) SaveX(ctx context.Context) *Profile {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *ProfileCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *ProfileCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *ProfileCreate) defaults() error {
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		if profile.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized profile.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := profile.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *ProfileCreate) check() error {
+	if v, ok := pc.mutation.MaritalStatus(); ok {
+		if err := profile.MaritalStatusValidator(v); err != nil {
+			return &ValidationError{Name: "marital_status", err: fmt.Errorf(`ent: validator failed for field "Profile.marital_status": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Profile.updated_at"`)}
+	}
+	if len(pc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Profile.user"`)}
+	}
+	return nil
+}
+
+func (pc *ProfileCreate) sqlSave(ctx context.Context) (*Profile, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *ProfileCreate) createSpec() (*Profile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Profile{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(profile.Table, sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt))
+	)
+	if value, ok := pc.mutation.DateOfBirth(); ok {
+		_spec.SetField(profile.FieldDateOfBirth, field.TypeTime, value)
+		_node.DateOfBirth = &value
+	}
+	if value, ok := pc.mutation.PlaceOfBirth(); ok {
+		_spec.SetField(profile.FieldPlaceOfBirth, field.TypeString, value)
+		_node.PlaceOfBirth = &value
+	}
+	if value, ok := pc.mutation.NationalID(); ok {
+		_spec.SetField(profile.FieldNationalID, field.TypeString, value)
+		_node.NationalID = &value
+	}
+	if value, ok := pc.mutation.NationalIDHash(); ok {
+		_spec.SetField(profile.FieldNationalIDHash, field.TypeString, value)
+		_node.NationalIDHash = &value
+	}
+	if value, ok := pc.mutation.NationalIDIssueDate(); ok {
+		_spec.SetField(profile.FieldNationalIDIssueDate, field.TypeTime, value)
+		_node.NationalIDIssueDate = &value
+	}
+	if value, ok := pc.mutation.NationalIDIssuePlace(); ok {
+		_spec.SetField(profile.FieldNationalIDIssuePlace, field.TypeString, value)
+		_node.NationalIDIssuePlace = &value
+	}
+	if value, ok := pc.mutation.Ethnicity(); ok {
+		_spec.SetField(profile.FieldEthnicity, field.TypeString, value)
+		_node.Ethnicity = &value
+	}
+	if value, ok := pc.mutation.Religion(); ok {
+		_spec.SetField(profile.FieldReligion, field.TypeString, value)
+		_node.Religion = &value
+	}
+	if value, ok := pc.mutation.MaritalStatus(); ok {
+		_spec.SetField(profile.FieldMaritalStatus, field.TypeEnum, value)
+		_node.MaritalStatus = &value
+	}
+	if value, ok := pc.mutation.TaxCode(); ok {
+		_spec.SetField(profile.FieldTaxCode, field.TypeString, value)
+		_node.TaxCode = &value
+	}
+	if value, ok := pc.mutation.SocialInsuranceNumber(); ok {
+		_spec.SetField(profile.FieldSocialInsuranceNumber, field.TypeString, value)
+		_node.SocialInsuranceNumber = &value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.SetField(profile.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := pc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   profile.UserTable,
+			Columns: []string{profile.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_profile = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.EmergencyContactsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.EmergencyContactsTable,
+			Columns: []string{profile.EmergencyContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProfileCreateBulk is the builder for creating many Profile entities in bulk.
+type ProfileCreateBulk struct {
+	config
+	err      error
+	builders []*ProfileCreate
+}
+
+// Save creates the Profile entities in the database.
+func (pcb *ProfileCreateBulk) Save(ctx context.Context) ([]*Profile, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Profile, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProfileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *ProfileCreateBulk) SaveX(ctx context.Context) []*Profile {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *ProfileCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *ProfileCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
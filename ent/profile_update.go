@@ -0,0 +1,1100 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/emergencycontact"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+	"github.com/huynhthanhthao/hrm_user_service/ent/profile"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+)
+
+// ProfileUpdate is the builder for updating Profile entities.
+type ProfileUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ProfileMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ProfileUpdate builder.
+func (pu *ProfileUpdate) Where(ps ...predicate.Profile) *ProfileUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetDateOfBirth sets the "date_of_birth" field.
+func (pu *ProfileUpdate) SetDateOfBirth(t time.Time) *ProfileUpdate {
+	pu.mutation.SetDateOfBirth(t)
+	return pu
+}
+
+// SetNillableDateOfBirth sets the "date_of_birth" field if the given value is not nil.
+func (pu *ProfileUpdate) SetNillableDateOfBirth(t *time.Time) *ProfileUpdate {
+	if t != nil {
+		pu.SetDateOfBirth(*t)
+	}
+	return pu
+}
+
+// ClearDateOfBirth clears the value of the "date_of_birth" field.
+func (pu *ProfileUpdate) ClearDateOfBirth() *ProfileUpdate {
+	pu.mutation.ClearDateOfBirth()
+	return pu
+}
+
+// SetPlaceOfBirth sets the "place_of_birth" field.
+func (pu *ProfileUpdate) SetPlaceOfBirth(s string) *ProfileUpdate {
+	pu.mutation.SetPlaceOfBirth(s)
+	return pu
+}
+
+// SetNillablePlaceOfBirth sets the "place_of_birth" field if the given value is not nil.
+func (pu *ProfileUpdate) SetNillablePlaceOfBirth(s *string) *ProfileUpdate {
+	if s != nil {
+		pu.SetPlaceOfBirth(*s)
+	}
+	return pu
+}
+
+// ClearPlaceOfBirth clears the value of the "place_of_birth" field.
+func (pu *ProfileUpdate) ClearPlaceOfBirth() *ProfileUpdate {
+	pu.mutation.ClearPlaceOfBirth()
+	return pu
+}
+
+// SetNationalID sets the "national_id" field.
+func (pu *ProfileUpdate) SetNationalID(s string) *ProfileUpdate {
+	pu.mutation.SetNationalID(s)
+	return pu
+}
+
+// SetNillableNationalID sets the "national_id" field if the given value is not nil.
+func (pu *ProfileUpdate) SetNillableNationalID(s *string) *ProfileUpdate {
+	if s != nil {
+		pu.SetNationalID(*s)
+	}
+	return pu
+}
+
+// ClearNationalID clears the value of the "national_id" field.
+func (pu *ProfileUpdate) ClearNationalID() *ProfileUpdate {
+	pu.mutation.ClearNationalID()
+	return pu
+}
+
+// SetNationalIDHash sets the "national_id_hash" field.
+func (pu *ProfileUpdate) SetNationalIDHash(s string) *ProfileUpdate {
+	pu.mutation.SetNationalIDHash(s)
+	return pu
+}
+
+// SetNillableNationalIDHash sets the "national_id_hash" field if the given value is not nil.
+func (pu *ProfileUpdate) SetNillableNationalIDHash(s *string) *ProfileUpdate {
+	if s != nil {
+		pu.SetNationalIDHash(*s)
+	}
+	return pu
+}
+
+// ClearNationalIDHash clears the value of the "national_id_hash" field.
+func (pu *ProfileUpdate) ClearNationalIDHash() *ProfileUpdate {
+	pu.mutation.ClearNationalIDHash()
+	return pu
+}
+
+// SetNationalIDIssueDate sets the "national_id_issue_date" field.
+func (pu *ProfileUpdate) SetNationalIDIssueDate(t time.Time) *ProfileUpdate {
+	pu.mutation.SetNationalIDIssueDate(t)
+	return pu
+}
+
+// SetNillableNationalIDIssueDate sets the "national_id_issue_date" field if the given value is not nil.
+func (pu *ProfileUpdate) SetNillableNationalIDIssueDate(t *time.Time) *ProfileUpdate {
+	if t != nil {
+		pu.SetNationalIDIssueDate(*t)
+	}
+	return pu
+}
+
+// ClearNationalIDIssueDate clears the value of the "national_id_issue_date" field.
+func (pu *ProfileUpdate) ClearNationalIDIssueDate() *ProfileUpdate {
+	pu.mutation.ClearNationalIDIssueDate()
+	return pu
+}
+
+// SetNationalIDIssuePlace sets the "national_id_issue_place" field.
+func (pu *ProfileUpdate) SetNationalIDIssuePlace(s string) *ProfileUpdate {
+	pu.mutation.SetNationalIDIssuePlace(s)
+	return pu
+}
+
+// SetNillableNationalIDIssuePlace sets the "national_id_issue_place" field if the given value is not nil.
+func (pu *ProfileUpdate) SetNillableNationalIDIssuePlace(s *string) *ProfileUpdate {
+	if s != nil {
+		pu.SetNationalIDIssuePlace(*s)
+	}
+	return pu
+}
+
+// ClearNationalIDIssuePlace clears the value of the "national_id_issue_place" field.
+func (pu *ProfileUpdate) ClearNationalIDIssuePlace() *ProfileUpdate {
+	pu.mutation.ClearNationalIDIssuePlace()
+	return pu
+}
+
+// SetEthnicity sets the "ethnicity" field.
+func (pu *ProfileUpdate) SetEthnicity(s string) *ProfileUpdate {
+	pu.mutation.SetEthnicity(s)
+	return pu
+}
+
+// SetNillableEthnicity sets the "ethnicity" field if the given value is not nil.
+func (pu *ProfileUpdate) SetNillableEthnicity(s *string) *ProfileUpdate {
+	if s != nil {
+		pu.SetEthnicity(*s)
+	}
+	return pu
+}
+
+// ClearEthnicity clears the value of the "ethnicity" field.
+func (pu *ProfileUpdate) ClearEthnicity() *ProfileUpdate {
+	pu.mutation.ClearEthnicity()
+	return pu
+}
+
+// SetReligion sets the "religion" field.
+func (pu *ProfileUpdate) SetReligion(s string) *ProfileUpdate {
+	pu.mutation.SetReligion(s)
+	return pu
+}
+
+// SetNillableReligion sets the "religion" field if the given value is not nil.
+func (pu *ProfileUpdate) SetNillableReligion(s *string) *ProfileUpdate {
+	if s != nil {
+		pu.SetReligion(*s)
+	}
+	return pu
+}
+
+// ClearReligion clears the value of the "religion" field.
+func (pu *ProfileUpdate) ClearReligion() *ProfileUpdate {
+	pu.mutation.ClearReligion()
+	return pu
+}
+
+// SetMaritalStatus sets the "marital_status" field.
+func (pu *ProfileUpdate) SetMaritalStatus(ps profile.MaritalStatus) *ProfileUpdate {
+	pu.mutation.SetMaritalStatus(ps)
+	return pu
+}
+
+// SetNillableMaritalStatus sets the "marital_status" field if the given value is not nil.
+func (pu *ProfileUpdate) SetNillableMaritalStatus(ps *profile.MaritalStatus) *ProfileUpdate {
+	if ps != nil {
+		pu.SetMaritalStatus(*ps)
+	}
+	return pu
+}
+
+// ClearMaritalStatus clears the value of the "marital_status" field.
+func (pu *ProfileUpdate) ClearMaritalStatus() *ProfileUpdate {
+	pu.mutation.ClearMaritalStatus()
+	return pu
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (pu *ProfileUpdate) SetTaxCode(s string) *ProfileUpdate {
+	pu.mutation.SetTaxCode(s)
+	return pu
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (pu *ProfileUpdate) SetNillableTaxCode(s *string) *ProfileUpdate {
+	if s != nil {
+		pu.SetTaxCode(*s)
+	}
+	return pu
+}
+
+// ClearTaxCode clears the value of the "tax_code" field.
+func (pu *ProfileUpdate) ClearTaxCode() *ProfileUpdate {
+	pu.mutation.ClearTaxCode()
+	return pu
+}
+
+// SetSocialInsuranceNumber sets the "social_insurance_number" field.
+func (pu *ProfileUpdate) SetSocialInsuranceNumber(s string) *ProfileUpdate {
+	pu.mutation.SetSocialInsuranceNumber(s)
+	return pu
+}
+
+// SetNillableSocialInsuranceNumber sets the "social_insurance_number" field if the given value is not nil.
+func (pu *ProfileUpdate) SetNillableSocialInsuranceNumber(s *string) *ProfileUpdate {
+	if s != nil {
+		pu.SetSocialInsuranceNumber(*s)
+	}
+	return pu
+}
+
+// ClearSocialInsuranceNumber clears the value of the "social_insurance_number" field.
+func (pu *ProfileUpdate) ClearSocialInsuranceNumber() *ProfileUpdate {
+	pu.mutation.ClearSocialInsuranceNumber()
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *ProfileUpdate) SetUpdatedAt(t time.Time) *ProfileUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (pu *ProfileUpdate) SetUserID(id int) *ProfileUpdate {
+	pu.mutation.SetUserID(id)
+	return pu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pu *ProfileUpdate) SetUser(u *User) *ProfileUpdate {
+	return pu.SetUserID(u.ID)
+}
+
+// AddEmergencyContactIDs adds the "emergency_contacts" edge to the EmergencyContact entity by IDs.
+func (pu *ProfileUpdate) AddEmergencyContactIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.AddEmergencyContactIDs(ids...)
+	return pu
+}
+
+// AddEmergencyContacts adds the "emergency_contacts" edges to the EmergencyContact entity.
+func (pu *ProfileUpdate) AddEmergencyContacts(e ...*EmergencyContact) *ProfileUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return pu.AddEmergencyContactIDs(ids...)
+}
+
+// Mutation returns the ProfileMutation object of the builder.
+func (pu *ProfileUpdate) Mutation() *ProfileMutation {
+	return pu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (pu *ProfileUpdate) ClearUser() *ProfileUpdate {
+	pu.mutation.ClearUser()
+	return pu
+}
+
+// ClearEmergencyContacts clears all "emergency_contacts" edges to the EmergencyContact entity.
+func (pu *ProfileUpdate) ClearEmergencyContacts() *ProfileUpdate {
+	pu.mutation.ClearEmergencyContacts()
+	return pu
+}
+
+// RemoveEmergencyContactIDs removes the "emergency_contacts" edge to EmergencyContact entities by IDs.
+func (pu *ProfileUpdate) RemoveEmergencyContactIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.RemoveEmergencyContactIDs(ids...)
+	return pu
+}
+
+// RemoveEmergencyContacts removes "emergency_contacts" edges to EmergencyContact entities.
+func (pu *ProfileUpdate) RemoveEmergencyContacts(e ...*EmergencyContact) *ProfileUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return pu.RemoveEmergencyContactIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *ProfileUpdate) Save(ctx context.Context) (int, error) {
+	if err := pu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *ProfileUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *ProfileUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *ProfileUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *ProfileUpdate) defaults() error {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		if profile.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized profile.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := profile.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *ProfileUpdate) check() error {
+	if v, ok := pu.mutation.MaritalStatus(); ok {
+		if err := profile.MaritalStatusValidator(v); err != nil {
+			return &ValidationError{Name: "marital_status", err: fmt.Errorf(`ent: validator failed for field "Profile.marital_status": %w`, err)}
+		}
+	}
+	if pu.mutation.UserCleared() && len(pu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Profile.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pu *ProfileUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ProfileUpdate {
+	pu.modifiers = append(pu.modifiers, modifiers...)
+	return pu
+}
+
+func (pu *ProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profile.Table, profile.Columns, sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.DateOfBirth(); ok {
+		_spec.SetField(profile.FieldDateOfBirth, field.TypeTime, value)
+	}
+	if pu.mutation.DateOfBirthCleared() {
+		_spec.ClearField(profile.FieldDateOfBirth, field.TypeTime)
+	}
+	if value, ok := pu.mutation.PlaceOfBirth(); ok {
+		_spec.SetField(profile.FieldPlaceOfBirth, field.TypeString, value)
+	}
+	if pu.mutation.PlaceOfBirthCleared() {
+		_spec.ClearField(profile.FieldPlaceOfBirth, field.TypeString)
+	}
+	if value, ok := pu.mutation.NationalID(); ok {
+		_spec.SetField(profile.FieldNationalID, field.TypeString, value)
+	}
+	if pu.mutation.NationalIDCleared() {
+		_spec.ClearField(profile.FieldNationalID, field.TypeString)
+	}
+	if value, ok := pu.mutation.NationalIDHash(); ok {
+		_spec.SetField(profile.FieldNationalIDHash, field.TypeString, value)
+	}
+	if pu.mutation.NationalIDHashCleared() {
+		_spec.ClearField(profile.FieldNationalIDHash, field.TypeString)
+	}
+	if value, ok := pu.mutation.NationalIDIssueDate(); ok {
+		_spec.SetField(profile.FieldNationalIDIssueDate, field.TypeTime, value)
+	}
+	if pu.mutation.NationalIDIssueDateCleared() {
+		_spec.ClearField(profile.FieldNationalIDIssueDate, field.TypeTime)
+	}
+	if value, ok := pu.mutation.NationalIDIssuePlace(); ok {
+		_spec.SetField(profile.FieldNationalIDIssuePlace, field.TypeString, value)
+	}
+	if pu.mutation.NationalIDIssuePlaceCleared() {
+		_spec.ClearField(profile.FieldNationalIDIssuePlace, field.TypeString)
+	}
+	if value, ok := pu.mutation.Ethnicity(); ok {
+		_spec.SetField(profile.FieldEthnicity, field.TypeString, value)
+	}
+	if pu.mutation.EthnicityCleared() {
+		_spec.ClearField(profile.FieldEthnicity, field.TypeString)
+	}
+	if value, ok := pu.mutation.Religion(); ok {
+		_spec.SetField(profile.FieldReligion, field.TypeString, value)
+	}
+	if pu.mutation.ReligionCleared() {
+		_spec.ClearField(profile.FieldReligion, field.TypeString)
+	}
+	if value, ok := pu.mutation.MaritalStatus(); ok {
+		_spec.SetField(profile.FieldMaritalStatus, field.TypeEnum, value)
+	}
+	if pu.mutation.MaritalStatusCleared() {
+		_spec.ClearField(profile.FieldMaritalStatus, field.TypeEnum)
+	}
+	if value, ok := pu.mutation.TaxCode(); ok {
+		_spec.SetField(profile.FieldTaxCode, field.TypeString, value)
+	}
+	if pu.mutation.TaxCodeCleared() {
+		_spec.ClearField(profile.FieldTaxCode, field.TypeString)
+	}
+	if value, ok := pu.mutation.SocialInsuranceNumber(); ok {
+		_spec.SetField(profile.FieldSocialInsuranceNumber, field.TypeString, value)
+	}
+	if pu.mutation.SocialInsuranceNumberCleared() {
+		_spec.ClearField(profile.FieldSocialInsuranceNumber, field.TypeString)
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(profile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   profile.UserTable,
+			Columns: []string{profile.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   profile.UserTable,
+			Columns: []string{profile.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.EmergencyContactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.EmergencyContactsTable,
+			Columns: []string{profile.EmergencyContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedEmergencyContactsIDs(); len(nodes) > 0 && !pu.mutation.EmergencyContactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.EmergencyContactsTable,
+			Columns: []string{profile.EmergencyContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.EmergencyContactsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.EmergencyContactsTable,
+			Columns: []string{profile.EmergencyContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// ProfileUpdateOne is the builder for updating a single Profile entity.
+type ProfileUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ProfileMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDateOfBirth sets the "date_of_birth" field.
+func (puo *ProfileUpdateOne) SetDateOfBirth(t time.Time) *ProfileUpdateOne {
+	puo.mutation.SetDateOfBirth(t)
+	return puo
+}
+
+// SetNillableDateOfBirth sets the "date_of_birth" field if the given value is not nil.
+func (puo *ProfileUpdateOne) SetNillableDateOfBirth(t *time.Time) *ProfileUpdateOne {
+	if t != nil {
+		puo.SetDateOfBirth(*t)
+	}
+	return puo
+}
+
+// ClearDateOfBirth clears the value of the "date_of_birth" field.
+func (puo *ProfileUpdateOne) ClearDateOfBirth() *ProfileUpdateOne {
+	puo.mutation.ClearDateOfBirth()
+	return puo
+}
+
+// SetPlaceOfBirth sets the "place_of_birth" field.
+func (puo *ProfileUpdateOne) SetPlaceOfBirth(s string) *ProfileUpdateOne {
+	puo.mutation.SetPlaceOfBirth(s)
+	return puo
+}
+
+// SetNillablePlaceOfBirth sets the "place_of_birth" field if the given value is not nil.
+func (puo *ProfileUpdateOne) SetNillablePlaceOfBirth(s *string) *ProfileUpdateOne {
+	if s != nil {
+		puo.SetPlaceOfBirth(*s)
+	}
+	return puo
+}
+
+// ClearPlaceOfBirth clears the value of the "place_of_birth" field.
+func (puo *ProfileUpdateOne) ClearPlaceOfBirth() *ProfileUpdateOne {
+	puo.mutation.ClearPlaceOfBirth()
+	return puo
+}
+
+// SetNationalID sets the "national_id" field.
+func (puo *ProfileUpdateOne) SetNationalID(s string) *ProfileUpdateOne {
+	puo.mutation.SetNationalID(s)
+	return puo
+}
+
+// SetNillableNationalID sets the "national_id" field if the given value is not nil.
+func (puo *ProfileUpdateOne) SetNillableNationalID(s *string) *ProfileUpdateOne {
+	if s != nil {
+		puo.SetNationalID(*s)
+	}
+	return puo
+}
+
+// ClearNationalID clears the value of the "national_id" field.
+func (puo *ProfileUpdateOne) ClearNationalID() *ProfileUpdateOne {
+	puo.mutation.ClearNationalID()
+	return puo
+}
+
+// SetNationalIDHash sets the "national_id_hash" field.
+func (puo *ProfileUpdateOne) SetNationalIDHash(s string) *ProfileUpdateOne {
+	puo.mutation.SetNationalIDHash(s)
+	return puo
+}
+
+// SetNillableNationalIDHash sets the "national_id_hash" field if the given value is not nil.
+func (puo *ProfileUpdateOne) SetNillableNationalIDHash(s *string) *ProfileUpdateOne {
+	if s != nil {
+		puo.SetNationalIDHash(*s)
+	}
+	return puo
+}
+
+// ClearNationalIDHash clears the value of the "national_id_hash" field.
+func (puo *ProfileUpdateOne) ClearNationalIDHash() *ProfileUpdateOne {
+	puo.mutation.ClearNationalIDHash()
+	return puo
+}
+
+// SetNationalIDIssueDate sets the "national_id_issue_date" field.
+func (puo *ProfileUpdateOne) SetNationalIDIssueDate(t time.Time) *ProfileUpdateOne {
+	puo.mutation.SetNationalIDIssueDate(t)
+	return puo
+}
+
+// SetNillableNationalIDIssueDate sets the "national_id_issue_date" field if the given value is not nil.
+func (puo *ProfileUpdateOne) SetNillableNationalIDIssueDate(t *time.Time) *ProfileUpdateOne {
+	if t != nil {
+		puo.SetNationalIDIssueDate(*t)
+	}
+	return puo
+}
+
+// ClearNationalIDIssueDate clears the value of the "national_id_issue_date" field.
+func (puo *ProfileUpdateOne) ClearNationalIDIssueDate() *ProfileUpdateOne {
+	puo.mutation.ClearNationalIDIssueDate()
+	return puo
+}
+
+// SetNationalIDIssuePlace sets the "national_id_issue_place" field.
+func (puo *ProfileUpdateOne) SetNationalIDIssuePlace(s string) *ProfileUpdateOne {
+	puo.mutation.SetNationalIDIssuePlace(s)
+	return puo
+}
+
+// SetNillableNationalIDIssuePlace sets the "national_id_issue_place" field if the given value is not nil.
+func (puo *ProfileUpdateOne) SetNillableNationalIDIssuePlace(s *string) *ProfileUpdateOne {
+	if s != nil {
+		puo.SetNationalIDIssuePlace(*s)
+	}
+	return puo
+}
+
+// ClearNationalIDIssuePlace clears the value of the "national_id_issue_place" field.
+func (puo *ProfileUpdateOne) ClearNationalIDIssuePlace() *ProfileUpdateOne {
+	puo.mutation.ClearNationalIDIssuePlace()
+	return puo
+}
+
+// SetEthnicity sets the "ethnicity" field.
+func (puo *ProfileUpdateOne) SetEthnicity(s string) *ProfileUpdateOne {
+	puo.mutation.SetEthnicity(s)
+	return puo
+}
+
+// SetNillableEthnicity sets the "ethnicity" field if the given value is not nil.
+func (puo *ProfileUpdateOne) SetNillableEthnicity(s *string) *ProfileUpdateOne {
+	if s != nil {
+		puo.SetEthnicity(*s)
+	}
+	return puo
+}
+
+// ClearEthnicity clears the value of the "ethnicity" field.
+func (puo *ProfileUpdateOne) ClearEthnicity() *ProfileUpdateOne {
+	puo.mutation.ClearEthnicity()
+	return puo
+}
+
+// SetReligion sets the "religion" field.
+func (puo *ProfileUpdateOne) SetReligion(s string) *ProfileUpdateOne {
+	puo.mutation.SetReligion(s)
+	return puo
+}
+
+// SetNillableReligion sets the "religion" field if the given value is not nil.
+func (puo *ProfileUpdateOne) SetNillableReligion(s *string) *ProfileUpdateOne {
+	if s != nil {
+		puo.SetReligion(*s)
+	}
+	return puo
+}
+
+// ClearReligion clears the value of the "religion" field.
+func (puo *ProfileUpdateOne) ClearReligion() *ProfileUpdateOne {
+	puo.mutation.ClearReligion()
+	return puo
+}
+
+// SetMaritalStatus sets the "marital_status" field.
+func (puo *ProfileUpdateOne) SetMaritalStatus(ps profile.MaritalStatus) *ProfileUpdateOne {
+	puo.mutation.SetMaritalStatus(ps)
+	return puo
+}
+
+// SetNillableMaritalStatus sets the "marital_status" field if the given value is not nil.
+func (puo *ProfileUpdateOne) SetNillableMaritalStatus(ps *profile.MaritalStatus) *ProfileUpdateOne {
+	if ps != nil {
+		puo.SetMaritalStatus(*ps)
+	}
+	return puo
+}
+
+// ClearMaritalStatus clears the value of the "marital_status" field.
+func (puo *ProfileUpdateOne) ClearMaritalStatus() *ProfileUpdateOne {
+	puo.mutation.ClearMaritalStatus()
+	return puo
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (puo *ProfileUpdateOne) SetTaxCode(s string) *ProfileUpdateOne {
+	puo.mutation.SetTaxCode(s)
+	return puo
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (puo *ProfileUpdateOne) SetNillableTaxCode(s *string) *ProfileUpdateOne {
+	if s != nil {
+		puo.SetTaxCode(*s)
+	}
+	return puo
+}
+
+// ClearTaxCode clears the value of the "tax_code" field.
+func (puo *ProfileUpdateOne) ClearTaxCode() *ProfileUpdateOne {
+	puo.mutation.ClearTaxCode()
+	return puo
+}
+
+// SetSocialInsuranceNumber sets the "social_insurance_number" field.
+func (puo *ProfileUpdateOne) SetSocialInsuranceNumber(s string) *ProfileUpdateOne {
+	puo.mutation.SetSocialInsuranceNumber(s)
+	return puo
+}
+
+// SetNillableSocialInsuranceNumber sets the "social_insurance_number" field if the given value is not nil.
+func (puo *ProfileUpdateOne) SetNillableSocialInsuranceNumber(s *string) *ProfileUpdateOne {
+	if s != nil {
+		puo.SetSocialInsuranceNumber(*s)
+	}
+	return puo
+}
+
+// ClearSocialInsuranceNumber clears the value of the "social_insurance_number" field.
+func (puo *ProfileUpdateOne) ClearSocialInsuranceNumber() *ProfileUpdateOne {
+	puo.mutation.ClearSocialInsuranceNumber()
+	return puo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *ProfileUpdateOne) SetUpdatedAt(t time.Time) *ProfileUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (puo *ProfileUpdateOne) SetUserID(id int) *ProfileUpdateOne {
+	puo.mutation.SetUserID(id)
+	return puo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (puo *ProfileUpdateOne) SetUser(u *User) *ProfileUpdateOne {
+	return puo.SetUserID(u.ID)
+}
+
+// AddEmergencyContactIDs adds the "emergency_contacts" edge to the EmergencyContact entity by IDs.
+func (puo *ProfileUpdateOne) AddEmergencyContactIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.AddEmergencyContactIDs(ids...)
+	return puo
+}
+
+// AddEmergencyContacts adds the "emergency_contacts" edges to the EmergencyContact entity.
+func (puo *ProfileUpdateOne) AddEmergencyContacts(e ...*EmergencyContact) *ProfileUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return puo.AddEmergencyContactIDs(ids...)
+}
+
+// Mutation returns the ProfileMutation object of the builder.
+func (puo *ProfileUpdateOne) Mutation() *ProfileMutation {
+	return puo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (puo *ProfileUpdateOne) ClearUser() *ProfileUpdateOne {
+	puo.mutation.ClearUser()
+	return puo
+}
+
+// ClearEmergencyContacts clears all "emergency_contacts" edges to the EmergencyContact entity.
+func (puo *ProfileUpdateOne) ClearEmergencyContacts() *ProfileUpdateOne {
+	puo.mutation.ClearEmergencyContacts()
+	return puo
+}
+
+// RemoveEmergencyContactIDs removes the "emergency_contacts" edge to EmergencyContact entities by IDs.
+func (puo *ProfileUpdateOne) RemoveEmergencyContactIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.RemoveEmergencyContactIDs(ids...)
+	return puo
+}
+
+// RemoveEmergencyContacts removes "emergency_contacts" edges to EmergencyContact entities.
+func (puo *ProfileUpdateOne) RemoveEmergencyContacts(e ...*EmergencyContact) *ProfileUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return puo.RemoveEmergencyContactIDs(ids...)
+}
+
+// Where appends a list predicates to the ProfileUpdate builder.
+func (puo *ProfileUpdateOne) Where(ps ...predicate.Profile) *ProfileUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *ProfileUpdateOne) Select(field string, fields ...string) *ProfileUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Profile entity.
+func (puo *ProfileUpdateOne) Save(ctx context.Context) (*Profile, error) {
+	if err := puo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *ProfileUpdateOne) SaveX(ctx context.Context) *Profile {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *ProfileUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *ProfileUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *ProfileUpdateOne) defaults() error {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		if profile.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized profile.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := profile.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *ProfileUpdateOne) check() error {
+	if v, ok := puo.mutation.MaritalStatus(); ok {
+		if err := profile.MaritalStatusValidator(v); err != nil {
+			return &ValidationError{Name: "marital_status", err: fmt.Errorf(`ent: validator failed for field "Profile.marital_status": %w`, err)}
+		}
+	}
+	if puo.mutation.UserCleared() && len(puo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Profile.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (puo *ProfileUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ProfileUpdateOne {
+	puo.modifiers = append(puo.modifiers, modifiers...)
+	return puo
+}
+
+func (puo *ProfileUpdateOne) sqlSave(ctx context.Context) (_node *Profile, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profile.Table, profile.Columns, sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Profile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profile.FieldID)
+		for _, f := range fields {
+			if !profile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != profile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.DateOfBirth(); ok {
+		_spec.SetField(profile.FieldDateOfBirth, field.TypeTime, value)
+	}
+	if puo.mutation.DateOfBirthCleared() {
+		_spec.ClearField(profile.FieldDateOfBirth, field.TypeTime)
+	}
+	if value, ok := puo.mutation.PlaceOfBirth(); ok {
+		_spec.SetField(profile.FieldPlaceOfBirth, field.TypeString, value)
+	}
+	if puo.mutation.PlaceOfBirthCleared() {
+		_spec.ClearField(profile.FieldPlaceOfBirth, field.TypeString)
+	}
+	if value, ok := puo.mutation.NationalID(); ok {
+		_spec.SetField(profile.FieldNationalID, field.TypeString, value)
+	}
+	if puo.mutation.NationalIDCleared() {
+		_spec.ClearField(profile.FieldNationalID, field.TypeString)
+	}
+	if value, ok := puo.mutation.NationalIDHash(); ok {
+		_spec.SetField(profile.FieldNationalIDHash, field.TypeString, value)
+	}
+	if puo.mutation.NationalIDHashCleared() {
+		_spec.ClearField(profile.FieldNationalIDHash, field.TypeString)
+	}
+	if value, ok := puo.mutation.NationalIDIssueDate(); ok {
+		_spec.SetField(profile.FieldNationalIDIssueDate, field.TypeTime, value)
+	}
+	if puo.mutation.NationalIDIssueDateCleared() {
+		_spec.ClearField(profile.FieldNationalIDIssueDate, field.TypeTime)
+	}
+	if value, ok := puo.mutation.NationalIDIssuePlace(); ok {
+		_spec.SetField(profile.FieldNationalIDIssuePlace, field.TypeString, value)
+	}
+	if puo.mutation.NationalIDIssuePlaceCleared() {
+		_spec.ClearField(profile.FieldNationalIDIssuePlace, field.TypeString)
+	}
+	if value, ok := puo.mutation.Ethnicity(); ok {
+		_spec.SetField(profile.FieldEthnicity, field.TypeString, value)
+	}
+	if puo.mutation.EthnicityCleared() {
+		_spec.ClearField(profile.FieldEthnicity, field.TypeString)
+	}
+	if value, ok := puo.mutation.Religion(); ok {
+		_spec.SetField(profile.FieldReligion, field.TypeString, value)
+	}
+	if puo.mutation.ReligionCleared() {
+		_spec.ClearField(profile.FieldReligion, field.TypeString)
+	}
+	if value, ok := puo.mutation.MaritalStatus(); ok {
+		_spec.SetField(profile.FieldMaritalStatus, field.TypeEnum, value)
+	}
+	if puo.mutation.MaritalStatusCleared() {
+		_spec.ClearField(profile.FieldMaritalStatus, field.TypeEnum)
+	}
+	if value, ok := puo.mutation.TaxCode(); ok {
+		_spec.SetField(profile.FieldTaxCode, field.TypeString, value)
+	}
+	if puo.mutation.TaxCodeCleared() {
+		_spec.ClearField(profile.FieldTaxCode, field.TypeString)
+	}
+	if value, ok := puo.mutation.SocialInsuranceNumber(); ok {
+		_spec.SetField(profile.FieldSocialInsuranceNumber, field.TypeString, value)
+	}
+	if puo.mutation.SocialInsuranceNumberCleared() {
+		_spec.ClearField(profile.FieldSocialInsuranceNumber, field.TypeString)
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(profile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if puo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   profile.UserTable,
+			Columns: []string{profile.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   profile.UserTable,
+			Columns: []string{profile.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.EmergencyContactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.EmergencyContactsTable,
+			Columns: []string{profile.EmergencyContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedEmergencyContactsIDs(); len(nodes) > 0 && !puo.mutation.EmergencyContactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.EmergencyContactsTable,
+			Columns: []string{profile.EmergencyContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.EmergencyContactsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.EmergencyContactsTable,
+			Columns: []string{profile.EmergencyContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(puo.modifiers...)
+	_node = &Profile{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
@@ -2,7 +2,284 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/huynhthanhthao/hrm_user_service/ent/runtime.go
+import (
+	"time"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent/account"
+	"github.com/huynhthanhthao/hrm_user_service/ent/attributedefinition"
+	"github.com/huynhthanhthao/hrm_user_service/ent/auditlog"
+	"github.com/huynhthanhthao/hrm_user_service/ent/emergencycontact"
+	"github.com/huynhthanhthao/hrm_user_service/ent/group"
+	"github.com/huynhthanhthao/hrm_user_service/ent/groupmember"
+	"github.com/huynhthanhthao/hrm_user_service/ent/loginevent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/membership"
+	"github.com/huynhthanhthao/hrm_user_service/ent/profile"
+	"github.com/huynhthanhthao/hrm_user_service/ent/schema"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/ent/usermerge"
+	"github.com/huynhthanhthao/hrm_user_service/ent/userversion"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	accountMixin := schema.Account{}.Mixin()
+	accountMixinHooks0 := accountMixin[0].Hooks()
+	account.Hooks[0] = accountMixinHooks0[0]
+	accountMixinInters0 := accountMixin[0].Interceptors()
+	account.Interceptors[0] = accountMixinInters0[0]
+	accountFields := schema.Account{}.Fields()
+	_ = accountFields
+	// accountDescUsername is the schema descriptor for username field.
+	accountDescUsername := accountFields[1].Descriptor()
+	// account.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	account.UsernameValidator = accountDescUsername.Validators[0].(func(string) error)
+	// accountDescPassword is the schema descriptor for password field.
+	accountDescPassword := accountFields[2].Descriptor()
+	// account.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
+	account.PasswordValidator = accountDescPassword.Validators[0].(func(string) error)
+	// accountDescCreatedAt is the schema descriptor for created_at field.
+	accountDescCreatedAt := accountFields[5].Descriptor()
+	// account.DefaultCreatedAt holds the default value on creation for the created_at field.
+	account.DefaultCreatedAt = accountDescCreatedAt.Default.(func() time.Time)
+	// accountDescUpdatedAt is the schema descriptor for updated_at field.
+	accountDescUpdatedAt := accountFields[6].Descriptor()
+	// account.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	account.DefaultUpdatedAt = accountDescUpdatedAt.Default.(func() time.Time)
+	// account.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	account.UpdateDefaultUpdatedAt = accountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// accountDescID is the schema descriptor for id field.
+	accountDescID := accountFields[0].Descriptor()
+	// account.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	account.IDValidator = accountDescID.Validators[0].(func(int) error)
+	attributedefinitionFields := schema.AttributeDefinition{}.Fields()
+	_ = attributedefinitionFields
+	// attributedefinitionDescOrgID is the schema descriptor for org_id field.
+	attributedefinitionDescOrgID := attributedefinitionFields[0].Descriptor()
+	// attributedefinition.OrgIDValidator is a validator for the "org_id" field. It is called by the builders before save.
+	attributedefinition.OrgIDValidator = attributedefinitionDescOrgID.Validators[0].(func(int64) error)
+	// attributedefinitionDescKey is the schema descriptor for key field.
+	attributedefinitionDescKey := attributedefinitionFields[1].Descriptor()
+	// attributedefinition.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	attributedefinition.KeyValidator = attributedefinitionDescKey.Validators[0].(func(string) error)
+	// attributedefinitionDescLabel is the schema descriptor for label field.
+	attributedefinitionDescLabel := attributedefinitionFields[2].Descriptor()
+	// attributedefinition.DefaultLabel holds the default value on creation for the label field.
+	attributedefinition.DefaultLabel = attributedefinitionDescLabel.Default.(string)
+	// attributedefinitionDescRequired is the schema descriptor for required field.
+	attributedefinitionDescRequired := attributedefinitionFields[4].Descriptor()
+	// attributedefinition.DefaultRequired holds the default value on creation for the required field.
+	attributedefinition.DefaultRequired = attributedefinitionDescRequired.Default.(bool)
+	// attributedefinitionDescCreatedAt is the schema descriptor for created_at field.
+	attributedefinitionDescCreatedAt := attributedefinitionFields[7].Descriptor()
+	// attributedefinition.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attributedefinition.DefaultCreatedAt = attributedefinitionDescCreatedAt.Default.(func() time.Time)
+	// attributedefinitionDescUpdatedAt is the schema descriptor for updated_at field.
+	attributedefinitionDescUpdatedAt := attributedefinitionFields[8].Descriptor()
+	// attributedefinition.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	attributedefinition.DefaultUpdatedAt = attributedefinitionDescUpdatedAt.Default.(func() time.Time)
+	// attributedefinition.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	attributedefinition.UpdateDefaultUpdatedAt = attributedefinitionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[0].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = auditlogDescAction.Validators[0].(func(string) error)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[8].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	emergencycontactFields := schema.EmergencyContact{}.Fields()
+	_ = emergencycontactFields
+	// emergencycontactDescName is the schema descriptor for name field.
+	emergencycontactDescName := emergencycontactFields[0].Descriptor()
+	// emergencycontact.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	emergencycontact.NameValidator = emergencycontactDescName.Validators[0].(func(string) error)
+	// emergencycontactDescRelationship is the schema descriptor for relationship field.
+	emergencycontactDescRelationship := emergencycontactFields[1].Descriptor()
+	// emergencycontact.RelationshipValidator is a validator for the "relationship" field. It is called by the builders before save.
+	emergencycontact.RelationshipValidator = emergencycontactDescRelationship.Validators[0].(func(string) error)
+	// emergencycontactDescPhone is the schema descriptor for phone field.
+	emergencycontactDescPhone := emergencycontactFields[2].Descriptor()
+	// emergencycontact.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	emergencycontact.PhoneValidator = emergencycontactDescPhone.Validators[0].(func(string) error)
+	// emergencycontactDescPosition is the schema descriptor for position field.
+	emergencycontactDescPosition := emergencycontactFields[4].Descriptor()
+	// emergencycontact.DefaultPosition holds the default value on creation for the position field.
+	emergencycontact.DefaultPosition = emergencycontactDescPosition.Default.(int)
+	// emergencycontact.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	emergencycontact.PositionValidator = emergencycontactDescPosition.Validators[0].(func(int) error)
+	groupInters := schema.Group{}.Interceptors()
+	group.Interceptors[0] = groupInters[0]
+	groupFields := schema.Group{}.Fields()
+	_ = groupFields
+	// groupDescName is the schema descriptor for name field.
+	groupDescName := groupFields[1].Descriptor()
+	// group.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	group.NameValidator = func() func(string) error {
+		validators := groupDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// groupDescDescription is the schema descriptor for description field.
+	groupDescDescription := groupFields[2].Descriptor()
+	// group.DefaultDescription holds the default value on creation for the description field.
+	group.DefaultDescription = groupDescDescription.Default.(string)
+	// groupDescCreatedAt is the schema descriptor for created_at field.
+	groupDescCreatedAt := groupFields[3].Descriptor()
+	// group.DefaultCreatedAt holds the default value on creation for the created_at field.
+	group.DefaultCreatedAt = groupDescCreatedAt.Default.(func() time.Time)
+	// groupDescUpdatedAt is the schema descriptor for updated_at field.
+	groupDescUpdatedAt := groupFields[4].Descriptor()
+	// group.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	group.DefaultUpdatedAt = groupDescUpdatedAt.Default.(func() time.Time)
+	// group.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	group.UpdateDefaultUpdatedAt = groupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	groupmemberFields := schema.GroupMember{}.Fields()
+	_ = groupmemberFields
+	// groupmemberDescUserID is the schema descriptor for user_id field.
+	groupmemberDescUserID := groupmemberFields[1].Descriptor()
+	// groupmember.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	groupmember.UserIDValidator = groupmemberDescUserID.Validators[0].(func(int) error)
+	// groupmemberDescCreatedAt is the schema descriptor for created_at field.
+	groupmemberDescCreatedAt := groupmemberFields[3].Descriptor()
+	// groupmember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	groupmember.DefaultCreatedAt = groupmemberDescCreatedAt.Default.(func() time.Time)
+	logineventFields := schema.LoginEvent{}.Fields()
+	_ = logineventFields
+	// logineventDescUserID is the schema descriptor for user_id field.
+	logineventDescUserID := logineventFields[0].Descriptor()
+	// loginevent.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	loginevent.UserIDValidator = logineventDescUserID.Validators[0].(func(int) error)
+	// logineventDescCreatedAt is the schema descriptor for created_at field.
+	logineventDescCreatedAt := logineventFields[7].Descriptor()
+	// loginevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginevent.DefaultCreatedAt = logineventDescCreatedAt.Default.(func() time.Time)
+	membershipFields := schema.Membership{}.Fields()
+	_ = membershipFields
+	// membershipDescOrgID is the schema descriptor for org_id field.
+	membershipDescOrgID := membershipFields[0].Descriptor()
+	// membership.OrgIDValidator is a validator for the "org_id" field. It is called by the builders before save.
+	membership.OrgIDValidator = membershipDescOrgID.Validators[0].(func(int64) error)
+	// membershipDescIsDefault is the schema descriptor for is_default field.
+	membershipDescIsDefault := membershipFields[1].Descriptor()
+	// membership.DefaultIsDefault holds the default value on creation for the is_default field.
+	membership.DefaultIsDefault = membershipDescIsDefault.Default.(bool)
+	// membershipDescCreatedAt is the schema descriptor for created_at field.
+	membershipDescCreatedAt := membershipFields[2].Descriptor()
+	// membership.DefaultCreatedAt holds the default value on creation for the created_at field.
+	membership.DefaultCreatedAt = membershipDescCreatedAt.Default.(func() time.Time)
+	profileHooks := schema.Profile{}.Hooks()
+	profile.Hooks[0] = profileHooks[0]
+	profileInters := schema.Profile{}.Interceptors()
+	profile.Interceptors[0] = profileInters[0]
+	profileFields := schema.Profile{}.Fields()
+	_ = profileFields
+	// profileDescUpdatedAt is the schema descriptor for updated_at field.
+	profileDescUpdatedAt := profileFields[11].Descriptor()
+	// profile.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	profile.DefaultUpdatedAt = profileDescUpdatedAt.Default.(func() time.Time)
+	// profile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	profile.UpdateDefaultUpdatedAt = profileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	userMixin := schema.User{}.Mixin()
+	userMixinHooks0 := userMixin[0].Hooks()
+	userHooks := schema.User{}.Hooks()
+	user.Hooks[0] = userMixinHooks0[0]
+	user.Hooks[1] = userHooks[0]
+	user.Hooks[2] = userHooks[1]
+	user.Hooks[3] = userHooks[2]
+	user.Hooks[4] = userHooks[3]
+	user.Hooks[5] = userHooks[4]
+	userMixinInters0 := userMixin[0].Interceptors()
+	userInters := schema.User{}.Interceptors()
+	user.Interceptors[0] = userMixinInters0[0]
+	user.Interceptors[1] = userInters[0]
+	user.Interceptors[2] = userInters[1]
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescFirstName is the schema descriptor for first_name field.
+	userDescFirstName := userFields[1].Descriptor()
+	// user.FirstNameValidator is a validator for the "first_name" field. It is called by the builders before save.
+	user.FirstNameValidator = userDescFirstName.Validators[0].(func(string) error)
+	// userDescLastName is the schema descriptor for last_name field.
+	userDescLastName := userFields[2].Descriptor()
+	// user.LastNameValidator is a validator for the "last_name" field. It is called by the builders before save.
+	user.LastNameValidator = userDescLastName.Validators[0].(func(string) error)
+	// userDescPhone is the schema descriptor for phone field.
+	userDescPhone := userFields[4].Descriptor()
+	// user.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	user.PhoneValidator = userDescPhone.Validators[0].(func(string) error)
+	// userDescSearchText is the schema descriptor for search_text field.
+	userDescSearchText := userFields[13].Descriptor()
+	// user.DefaultSearchText holds the default value on creation for the search_text field.
+	user.DefaultSearchText = userDescSearchText.Default.(string)
+	// userDescPermVersion is the schema descriptor for perm_version field.
+	userDescPermVersion := userFields[15].Descriptor()
+	// user.DefaultPermVersion holds the default value on creation for the perm_version field.
+	user.DefaultPermVersion = userDescPermVersion.Default.(int)
+	// user.PermVersionValidator is a validator for the "perm_version" field. It is called by the builders before save.
+	user.PermVersionValidator = userDescPermVersion.Validators[0].(func(int) error)
+	// userDescVersion is the schema descriptor for version field.
+	userDescVersion := userFields[16].Descriptor()
+	// user.DefaultVersion holds the default value on creation for the version field.
+	user.DefaultVersion = userDescVersion.Default.(int)
+	// user.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	user.VersionValidator = userDescVersion.Validators[0].(func(int) error)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[17].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[18].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	user.IDValidator = userDescID.Validators[0].(func(int) error)
+	usermergeFields := schema.UserMerge{}.Fields()
+	_ = usermergeFields
+	// usermergeDescMergedUserID is the schema descriptor for merged_user_id field.
+	usermergeDescMergedUserID := usermergeFields[0].Descriptor()
+	// usermerge.MergedUserIDValidator is a validator for the "merged_user_id" field. It is called by the builders before save.
+	usermerge.MergedUserIDValidator = usermergeDescMergedUserID.Validators[0].(func(int) error)
+	// usermergeDescSurvivorUserID is the schema descriptor for survivor_user_id field.
+	usermergeDescSurvivorUserID := usermergeFields[1].Descriptor()
+	// usermerge.SurvivorUserIDValidator is a validator for the "survivor_user_id" field. It is called by the builders before save.
+	usermerge.SurvivorUserIDValidator = usermergeDescSurvivorUserID.Validators[0].(func(int) error)
+	// usermergeDescCreatedAt is the schema descriptor for created_at field.
+	usermergeDescCreatedAt := usermergeFields[5].Descriptor()
+	// usermerge.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usermerge.DefaultCreatedAt = usermergeDescCreatedAt.Default.(func() time.Time)
+	userversionHooks := schema.UserVersion{}.Hooks()
+	userversion.Hooks[0] = userversionHooks[0]
+	userversionInters := schema.UserVersion{}.Interceptors()
+	userversion.Interceptors[0] = userversionInters[0]
+	userversionFields := schema.UserVersion{}.Fields()
+	_ = userversionFields
+	// userversionDescUserID is the schema descriptor for user_id field.
+	userversionDescUserID := userversionFields[0].Descriptor()
+	// userversion.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	userversion.UserIDValidator = userversionDescUserID.Validators[0].(func(int) error)
+	// userversionDescVersion is the schema descriptor for version field.
+	userversionDescVersion := userversionFields[1].Descriptor()
+	// userversion.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	userversion.VersionValidator = userversionDescVersion.Validators[0].(func(int) error)
+}
 
 const (
 	Version = "v0.14.4"                                         // Version of ent codegen.
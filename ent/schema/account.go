@@ -4,14 +4,22 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 type Account struct {
 	ent.Schema
 }
 
+func (Account) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 func (Account) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("id").
@@ -19,7 +27,6 @@ func (Account) Fields() []ent.Field {
 			Unique().
 			StructTag(`json:"id"`),
 		field.String("username").
-			Unique().
 			NotEmpty().
 			StructTag(`json:"username"`),
 		field.String("password").
@@ -29,6 +36,10 @@ func (Account) Fields() []ent.Field {
 			Values("active", "inactive").
 			Default("active").
 			StructTag(`json:"status"`),
+		field.Time("last_login_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"last_login_at"`),
 		field.Time("created_at").
 			Default(time.Now).
 			StructTag(`json:"created_at"`),
@@ -44,3 +55,12 @@ func (Account) Edges() []ent.Edge {
 		edge.From("user", User.Type).Ref("account").Unique().Required(),
 	}
 }
+
+func (Account) Indexes() []ent.Index {
+	return []ent.Index{
+		// Username chỉ duy nhất trong các account chưa bị xóa mềm
+		index.Fields("username").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"regexp"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AttributeDefinition mô tả một thuộc tính tùy chỉnh của user trong một tổ chức
+// (số thẻ nhân viên, cỡ giày đồng phục, tài khoản ngân hàng...).
+// Giá trị được lưu trong users.custom_attributes theo từng tổ chức.
+type AttributeDefinition struct {
+	ent.Schema
+}
+
+func (AttributeDefinition) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("org_id").
+			Positive().
+			Immutable().
+			StructTag(`json:"org_id"`),
+		field.String("key").
+			Match(regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)).
+			Immutable().
+			StructTag(`json:"key"`),
+		field.String("label").
+			Default("").
+			StructTag(`json:"label"`),
+		field.Enum("type").
+			Values("string", "number", "boolean", "date").
+			StructTag(`json:"type"`).
+			Comment("date lưu dạng chuỗi YYYY-MM-DD"),
+		field.Bool("required").
+			Default(false).
+			StructTag(`json:"required"`),
+		field.JSON("enum_values", []string{}).
+			Optional().
+			StructTag(`json:"enum_values"`).
+			Comment("Chỉ dùng cho type string: giá trị phải thuộc danh sách"),
+		field.String("pattern").
+			Optional().
+			Nillable().
+			StructTag(`json:"pattern"`).
+			Comment("Chỉ dùng cho type string: regex phải khớp toàn bộ giá trị"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			StructTag(`json:"created_at"`),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`),
+	}
+}
+
+func (AttributeDefinition) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("org_id", "key").Unique(),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog ghi lại các thao tác trên dữ liệu user. Không có edge tới User để bản ghi
+// còn lại sau khi user bị xóa vĩnh viễn; details và changes không chứa dữ liệu cá nhân
+// ở dạng đầy đủ (mật khẩu bị ẩn, phone/email/địa chỉ bị che).
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("action").
+			NotEmpty().
+			Immutable().
+			StructTag(`json:"action"`),
+		field.Int("actor_id").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"actor_id"`).
+			Comment("User thực hiện; nil nếu gọi nội bộ"),
+		field.Int64("org_id").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"org_id"`),
+		field.Int("target_user_id").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"target_user_id"`),
+		field.String("entity_type").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"entity_type"`).
+			Comment("user | account; nil với thao tác nghiệp vụ (export, ẩn danh hóa)"),
+		field.Int("entity_id").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"entity_id"`),
+		field.JSON("changes", map[string]any{}).
+			Optional().
+			Immutable().
+			StructTag(`json:"changes"`).
+			Comment(`Giá trị trước/sau của các field bị đổi: {"field": {"from": ..., "to": ...}}`),
+		field.JSON("details", map[string]any{}).
+			Optional().
+			Immutable().
+			StructTag(`json:"details"`),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			StructTag(`json:"created_at"`),
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_user_id", "created_at"),
+		index.Fields("entity_type", "entity_id", "created_at"),
+		index.Fields("actor_id", "created_at"),
+		index.Fields("action"),
+		index.Fields("created_at"),
+	}
+}
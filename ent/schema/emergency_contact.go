@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// EmergencyContact là người liên hệ khi khẩn cấp, thuộc hồ sơ nhân sự.
+// position giữ thứ tự do client gửi lên (0 là người liên hệ chính).
+type EmergencyContact struct {
+	ent.Schema
+}
+
+func (EmergencyContact) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			StructTag(`json:"name"`),
+		field.String("relationship").
+			NotEmpty().
+			StructTag(`json:"relationship"`),
+		field.String("phone").
+			NotEmpty().
+			StructTag(`json:"phone"`),
+		field.String("address").
+			Optional().
+			Nillable().
+			StructTag(`json:"address"`),
+		field.Int("position").
+			NonNegative().
+			Default(0).
+			StructTag(`json:"position"`),
+	}
+}
+
+func (EmergencyContact) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("profile", Profile.Type).Ref("emergency_contacts").Unique().Required(),
+	}
+}
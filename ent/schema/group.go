@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	gen "github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/group"
+	"github.com/huynhthanhthao/hrm_user_service/ent/groupmember"
+	"github.com/huynhthanhthao/hrm_user_service/ent/intercept"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+)
+
+// Group là nhóm user tự do (nhóm chat, nhóm nhận thông báo...), không phải phòng ban của HR.
+// Nhóm có thể chứa nhóm con; thành viên của nhóm con được xem là thành viên của nhóm cha.
+type Group struct {
+	ent.Schema
+}
+
+func (Group) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("org_id").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"org_id"`).
+			Comment("Tổ chức sở hữu nhóm; trống nếu nhóm được tạo bởi lời gọi nội bộ không gắn tổ chức"),
+		field.String("name").
+			NotEmpty().
+			MaxLen(100).
+			StructTag(`json:"name"`),
+		field.String("description").
+			Default("").
+			StructTag(`json:"description"`),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			StructTag(`json:"created_at"`),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`),
+	}
+}
+
+func (Group) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("members", GroupMember.Type),
+		edge.To("subgroups", Group.Type).
+			From("parent_groups"),
+	}
+}
+
+func (Group) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("org_id"),
+	}
+}
+
+// Viewer đang chọn tổ chức chỉ thấy nhóm của tổ chức đó;
+// viewer chưa chọn tổ chức chỉ thấy nhóm mình là thành viên trực tiếp
+func (Group) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseGroup(func(ctx context.Context, q *gen.GroupQuery) error {
+			if viewer.AllOrgs(ctx) {
+				return nil
+			}
+			if orgID, ok := viewer.OrgFromContext(ctx); ok {
+				q.Where(group.OrgID(orgID))
+				return nil
+			}
+			q.Where(group.HasMembersWith(groupmember.UserID(viewer.FromContext(ctx).UserID)))
+			return nil
+		}),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GroupMember là thành viên trực tiếp của một nhóm.
+// owner được sửa, xóa nhóm và đổi vai trò; admin được quản lý thành viên và nhóm con.
+type GroupMember struct {
+	ent.Schema
+}
+
+func (GroupMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("group_id").
+			Immutable().
+			StructTag(`json:"group_id"`),
+		field.Int("user_id").
+			Positive().
+			Immutable().
+			StructTag(`json:"user_id"`),
+		field.Enum("role").
+			Values("owner", "admin", "member").
+			Default("member").
+			StructTag(`json:"role"`),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			StructTag(`json:"created_at"`),
+	}
+}
+
+func (GroupMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("group", Group.Type).
+			Ref("members").
+			Field("group_id").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (GroupMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id", "user_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LoginEvent là lịch sử đăng nhập của user (thành công và thất bại).
+// Lần đăng nhập thành công là một phiên: refresh token cấp ra còn hiệu lực tới expires_at.
+type LoginEvent struct {
+	ent.Schema
+}
+
+func (LoginEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Positive().
+			Immutable().
+			StructTag(`json:"user_id"`),
+		field.Bool("success").
+			Immutable().
+			StructTag(`json:"success"`),
+		field.String("failure_reason").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"failure_reason"`),
+		field.Int64("org_id").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"org_id"`),
+		field.String("ip").
+			Optional().
+			Nillable().
+			StructTag(`json:"ip"`),
+		field.String("user_agent").
+			Optional().
+			Nillable().
+			StructTag(`json:"user_agent"`),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"expires_at"`).
+			Comment("Hạn của refresh token cấp ra, chỉ có khi đăng nhập thành công"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			StructTag(`json:"created_at"`),
+	}
+}
+
+func (LoginEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
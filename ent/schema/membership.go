@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Membership gắn user với một tổ chức (organization của HR service).
+// Một user có thể thuộc nhiều tổ chức, trong đó tối đa một tổ chức mặc định.
+type Membership struct {
+	ent.Schema
+}
+
+func (Membership) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("org_id").
+			Positive().
+			StructTag(`json:"org_id"`),
+		field.Bool("is_default").
+			Default(false).
+			StructTag(`json:"is_default"`),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			StructTag(`json:"created_at"`),
+	}
+}
+
+func (Membership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("memberships").Unique().Required(),
+	}
+}
+
+func (Membership) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("org_id").Edges("user").Unique(),
+	}
+}
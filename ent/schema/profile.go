@@ -0,0 +1,161 @@
+package schema
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	gen "github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/hook"
+	"github.com/huynhthanhthao/hrm_user_service/internal/fieldcrypt"
+)
+
+// Profile là hồ sơ nhân sự của user (thông tin cho giấy tờ HR).
+// Tách khỏi User vì là dữ liệu cá nhân nhạy cảm, chỉ đọc/ghi khi có quyền riêng.
+type Profile struct {
+	ent.Schema
+}
+
+// Cột ngày không có giờ
+var dateSchemaType = map[string]string{dialect.Postgres: "date"}
+
+func (Profile) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("date_of_birth").
+			Optional().
+			Nillable().
+			SchemaType(dateSchemaType).
+			StructTag(`json:"date_of_birth"`),
+		field.String("place_of_birth").
+			Optional().
+			Nillable().
+			StructTag(`json:"place_of_birth"`),
+		field.String("national_id").
+			Optional().
+			Nillable().
+			StructTag(`json:"national_id"`).
+			Comment("Số CCCD, mã hóa"),
+		field.String("national_id_hash").
+			Optional().
+			Nillable().
+			StructTag(`json:"-"`).
+			Comment("Blind index của national_id, do hook tính"),
+		field.Time("national_id_issue_date").
+			Optional().
+			Nillable().
+			SchemaType(dateSchemaType).
+			StructTag(`json:"national_id_issue_date"`),
+		field.String("national_id_issue_place").
+			Optional().
+			Nillable().
+			StructTag(`json:"national_id_issue_place"`),
+		field.String("ethnicity").
+			Optional().
+			Nillable().
+			StructTag(`json:"ethnicity"`),
+		field.String("religion").
+			Optional().
+			Nillable().
+			StructTag(`json:"religion"`),
+		field.Enum("marital_status").
+			Values("single", "married", "divorced", "widowed").
+			Optional().
+			Nillable().
+			StructTag(`json:"marital_status"`),
+		field.String("tax_code").
+			Optional().
+			Nillable().
+			StructTag(`json:"tax_code"`).
+			Comment("Mã số thuế cá nhân"),
+		field.String("social_insurance_number").
+			Optional().
+			Nillable().
+			StructTag(`json:"social_insurance_number"`).
+			Comment("Mã số BHXH"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`),
+	}
+}
+
+func (Profile) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("profile").Unique().Required(),
+		edge.To("emergency_contacts", EmergencyContact.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Mã hóa số CCCD; hồ sơ trả về sau khi lưu hoặc khi đọc ra được giải mã lại
+func (Profile) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(profilePIIHook, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+	}
+}
+
+func profilePIIHook(next ent.Mutator) ent.Mutator {
+	return hook.ProfileFunc(func(ctx context.Context, m *gen.ProfileMutation) (gen.Value, error) {
+		if id, ok := m.NationalID(); ok {
+			h, err := fieldcrypt.NationalIDIndex(id)
+			if err != nil {
+				return nil, err
+			}
+			enc, err := fieldcrypt.Encrypt(fieldcrypt.ProfileNationalID, id)
+			if err != nil {
+				return nil, err
+			}
+			m.SetNationalIDHash(h)
+			m.SetNationalID(enc)
+		}
+		if m.NationalIDCleared() {
+			m.ClearNationalIDHash()
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if p, ok := v.(*gen.Profile); ok {
+			if err := fieldcrypt.DecryptPtr(fieldcrypt.ProfileNationalID, p.NationalID); err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+	})
+}
+
+func (Profile) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+			return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+				v, err := next.Query(ctx, q)
+				if err != nil {
+					return nil, err
+				}
+				if profiles, ok := v.([]*gen.Profile); ok {
+					for _, p := range profiles {
+						if err := fieldcrypt.DecryptPtr(fieldcrypt.ProfileNationalID, p.NationalID); err != nil {
+							return nil, err
+						}
+					}
+				}
+				return v, nil
+			})
+		}),
+	}
+}
+
+func (Profile) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("national_id_hash").
+			Unique().
+			Annotations(entsql.IndexWhere("national_id_hash IS NOT NULL")),
+	}
+}
@@ -1,17 +1,38 @@
 package schema
 
 import (
+	"context"
+	"errors"
+	"strconv"
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	gen "github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/hook"
+	"github.com/huynhthanhthao/hrm_user_service/ent/intercept"
+	"github.com/huynhthanhthao/hrm_user_service/ent/membership"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/internal/fieldcrypt"
+	"github.com/huynhthanhthao/hrm_user_service/internal/unaccent"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
 )
 
 type User struct {
 	ent.Schema
 }
 
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("id").
@@ -29,14 +50,24 @@ func (User) Fields() []ent.Field {
 			Default("other").
 			StructTag(`json:"gender"`),
 		field.String("phone").
-			Unique().
 			NotEmpty().
-			StructTag(`json:"phone"`),
+			StructTag(`json:"phone"`).
+			Comment("Mã hóa; tìm kiếm và kiểm tra trùng theo phone_hash"),
+		field.String("phone_hash").
+			Optional().
+			Nillable().
+			StructTag(`json:"-"`).
+			Comment("Blind index của phone, do hook tính"),
 		field.String("email").
-			Unique().
 			Optional().
 			Nillable().
-			StructTag(`json:"email"`),
+			StructTag(`json:"email"`).
+			Comment("Mã hóa; tìm kiếm và kiểm tra trùng theo email_hash"),
+		field.String("email_hash").
+			Optional().
+			Nillable().
+			StructTag(`json:"-"`).
+			Comment("Blind index của email (chữ thường), do hook tính"),
 		field.String("avatar").
 			Optional().
 			Nillable().
@@ -45,10 +76,37 @@ func (User) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			StructTag(`json:"ward_code"`),
+		field.String("province_code").
+			Optional().
+			Nillable().
+			StructTag(`json:"province_code"`),
 		field.String("address").
 			Optional().
 			Nillable().
-			StructTag(`json:"address"`),
+			StructTag(`json:"address"`).
+			Comment("Mã hóa"),
+		field.JSON("custom_attributes", map[string]map[string]any{}).
+			Optional().
+			StructTag(`json:"custom_attributes"`).
+			Comment("Thuộc tính tùy chỉnh theo tổ chức: org_id -> key -> giá trị, kiểm tra theo AttributeDefinition"),
+		field.String("search_text").
+			Default("").
+			StructTag(`json:"-"`).
+			Comment("Họ tên đã bỏ dấu, dùng cho tìm kiếm trigram (không chứa số điện thoại, email vì các cột này được mã hóa)"),
+		field.Time("anonymized_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"anonymized_at"`).
+			Comment("Thời điểm dữ liệu cá nhân bị xóa theo yêu cầu; id được giữ để tham chiếu bên HR vẫn hợp lệ"),
+		field.Int("perm_version").
+			NonNegative().
+			Default(0).
+			StructTag(`json:"perm_version"`),
+		field.Int("version").
+			NonNegative().
+			Default(1).
+			StructTag(`json:"version"`).
+			Comment("Tăng sau mỗi lần cập nhật, dùng để phát hiện ghi đè đồng thời (ETag)"),
 		field.Time("created_at").
 			Default(time.Now).
 			StructTag(`json:"created_at"`),
@@ -62,5 +120,219 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("account", Account.Type).Unique(),
+		edge.To("memberships", Membership.Type),
+		edge.To("profile", Profile.Type).Unique(),
+	}
+}
+
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		// Chỉ duy nhất trong các user chưa bị xóa mềm
+		index.Fields("phone_hash").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+		index.Fields("email_hash").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+		index.Fields("deleted_at"),
+		index.Fields("ward_code"),
+		index.Fields("province_code"),
+		index.Fields("created_at"),
+		index.Fields("search_text").
+			Annotations(
+				entsql.IndexTypes(map[string]string{dialect.Postgres: "GIN"}),
+				entsql.OpClass("gin_trgm_ops"),
+			),
+	}
+}
+
+// Cập nhật search_text mỗi khi họ tên thay đổi; mã hóa số điện thoại, email, địa chỉ.
+// Mỗi lần cập nhật đều tăng version.
+// userPIIHook đứng cuối để các hook khác luôn thấy plaintext.
+func (User) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(userVersionHook, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+		hook.On(bumpVersionHook, ent.OpUpdate|ent.OpUpdateOne),
+		hook.On(searchTextHook, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+		hook.On(customAttributesScopeHook, ent.OpCreate|ent.OpUpdateOne),
+		hook.On(userPIIHook, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+	}
+}
+
+// ErrPartialNameBulkUpdate: cập nhật nhiều user không lấy được tên cũ của từng user để tính search_text
+var ErrPartialNameBulkUpdate = errors.New("bulk user updates must set both first_name and last_name")
+
+func searchTextHook(next ent.Mutator) ent.Mutator {
+	return hook.UserFunc(func(ctx context.Context, m *gen.UserMutation) (gen.Value, error) {
+		firstName, firstOK := m.FirstName()
+		lastName, lastOK := m.LastName()
+		if !firstOK && !lastOK && !m.Op().Is(ent.OpCreate) {
+			return next.Mutate(ctx, m)
+		}
+		if m.Op().Is(ent.OpUpdate) && !(firstOK && lastOK) {
+			return nil, ErrPartialNameBulkUpdate
+		}
+
+		if m.Op().Is(ent.OpUpdateOne) {
+			var err error
+			if !firstOK {
+				if firstName, err = m.OldFirstName(ctx); err != nil {
+					return nil, err
+				}
+			}
+			if !lastOK {
+				if lastName, err = m.OldLastName(ctx); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		m.SetSearchText(unaccent.SearchText(firstName, lastName))
+		return next.Mutate(ctx, m)
+	})
+}
+
+// bumpVersionHook tăng version trong cùng câu lệnh UPDATE, kể cả khi xóa mềm
+func bumpVersionHook(next ent.Mutator) ent.Mutator {
+	return hook.UserFunc(func(ctx context.Context, m *gen.UserMutation) (gen.Value, error) {
+		if _, ok := m.Version(); !ok {
+			m.AddVersion(1)
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// customAttributesScopeHook giới hạn custom_attributes của user trả về sau khi lưu như khi đọc
+func customAttributesScopeHook(next ent.Mutator) ent.Mutator {
+	return hook.UserFunc(func(ctx context.Context, m *gen.UserMutation) (gen.Value, error) {
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if u, ok := v.(*gen.User); ok {
+			scopeCustomAttributes(ctx, u)
+		}
+		return v, nil
+	})
+}
+
+// scopeCustomAttributes chỉ giữ thuộc tính của tổ chức viewer đang chọn
+func scopeCustomAttributes(ctx context.Context, u *gen.User) {
+	orgID, ok := viewer.OrgFromContext(ctx)
+	if !ok || u.CustomAttributes == nil {
+		return
+	}
+	key := strconv.FormatInt(orgID, 10)
+	attrs, found := u.CustomAttributes[key]
+	if !found {
+		u.CustomAttributes = nil
+		return
+	}
+	u.CustomAttributes = map[string]map[string]any{key: attrs}
+}
+
+// userPIIHook tính blind index từ plaintext rồi thay giá trị trong mutation bằng ciphertext.
+// User trả về sau khi lưu được giải mã lại để người gọi không thấy ciphertext.
+func userPIIHook(next ent.Mutator) ent.Mutator {
+	return hook.UserFunc(func(ctx context.Context, m *gen.UserMutation) (gen.Value, error) {
+		if phone, ok := m.Phone(); ok {
+			h, err := fieldcrypt.PhoneIndex(phone)
+			if err != nil {
+				return nil, err
+			}
+			enc, err := fieldcrypt.Encrypt(fieldcrypt.UserPhone, phone)
+			if err != nil {
+				return nil, err
+			}
+			m.SetPhoneHash(h)
+			m.SetPhone(enc)
+		}
+		if email, ok := m.Email(); ok {
+			h, err := fieldcrypt.EmailIndex(email)
+			if err != nil {
+				return nil, err
+			}
+			enc, err := fieldcrypt.Encrypt(fieldcrypt.UserEmail, email)
+			if err != nil {
+				return nil, err
+			}
+			m.SetEmailHash(h)
+			m.SetEmail(enc)
+		}
+		if m.EmailCleared() {
+			m.ClearEmailHash()
+		}
+		if address, ok := m.Address(); ok {
+			enc, err := fieldcrypt.Encrypt(fieldcrypt.UserAddress, address)
+			if err != nil {
+				return nil, err
+			}
+			m.SetAddress(enc)
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if u, ok := v.(*gen.User); ok {
+			if err := decryptUser(u); err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+	})
+}
+
+func decryptUser(u *gen.User) error {
+	phone, err := fieldcrypt.Decrypt(fieldcrypt.UserPhone, u.Phone)
+	if err != nil {
+		return err
+	}
+	u.Phone = phone
+	if err := fieldcrypt.DecryptPtr(fieldcrypt.UserEmail, u.Email); err != nil {
+		return err
+	}
+	return fieldcrypt.DecryptPtr(fieldcrypt.UserAddress, u.Address)
+}
+
+// Giới hạn mọi truy vấn User trong tổ chức của viewer
+func (User) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseUser(func(ctx context.Context, q *gen.UserQuery) error {
+			if viewer.AllOrgs(ctx) {
+				return nil
+			}
+			v := viewer.FromContext(ctx)
+			if v.OrgID == nil {
+				// Viewer chưa chọn tổ chức (token của user chưa có membership) chỉ thấy chính mình
+				q.Where(user.ID(v.UserID))
+				return nil
+			}
+			// User luôn xem được chính mình, kể cả khi chưa có membership
+			q.Where(user.Or(
+				user.HasMembershipsWith(membership.OrgID(*v.OrgID)),
+				user.ID(v.UserID),
+			))
+			return nil
+		}),
+		// Giải mã các cột PII của user đọc ra (kể cả khi được nạp qua edge);
+		// viewer đang chọn tổ chức chỉ thấy custom_attributes của tổ chức đó
+		ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+			return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+				v, err := next.Query(ctx, q)
+				if err != nil {
+					return nil, err
+				}
+				if users, ok := v.([]*gen.User); ok {
+					for _, u := range users {
+						if err := decryptUser(u); err != nil {
+							return nil, err
+						}
+						scopeCustomAttributes(ctx, u)
+					}
+				}
+				return v, nil
+			})
+		}),
 	}
 }
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserMerge ghi lại việc gộp user trùng: user merged_user_id đã được gộp vào survivor_user_id.
+// Dùng để chuyển hướng id cũ; không có edge tới User để bản ghi còn lại sau khi user cũ bị purge.
+type UserMerge struct {
+	ent.Schema
+}
+
+func (UserMerge) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("merged_user_id").
+			Positive().
+			Unique().
+			Immutable().
+			StructTag(`json:"merged_user_id"`),
+		field.Int("survivor_user_id").
+			Positive().
+			StructTag(`json:"survivor_user_id"`),
+		field.Int("merged_by").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"merged_by"`).
+			Comment("User thực hiện gộp; nil nếu gọi nội bộ"),
+		field.String("merged_username").
+			Optional().
+			Nillable().
+			StructTag(`json:"merged_username"`).
+			Comment("Username của account bị xóa do survivor đã có account"),
+		field.JSON("moved_org_ids", []int64{}).
+			Optional().
+			StructTag(`json:"moved_org_ids"`),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			StructTag(`json:"created_at"`),
+	}
+}
+
+func (UserMerge) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("survivor_user_id"),
+	}
+}
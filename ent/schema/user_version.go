@@ -0,0 +1,298 @@
+package schema
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	gen "github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/hook"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/ent/userversion"
+	"github.com/huynhthanhthao/hrm_user_service/internal/fieldcrypt"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+)
+
+// UserVersion là lịch sử thông tin cá nhân của user theo thời gian: mỗi lần họ tên, liên hệ hoặc
+// địa chỉ đổi thì phiên bản hiện tại được đóng (valid_to) và một phiên bản mới được thêm.
+// Dùng để tra thông tin của user tại thời điểm phát hành giấy tờ.
+type UserVersion struct {
+	ent.Schema
+}
+
+func (UserVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Positive().
+			Immutable().
+			StructTag(`json:"user_id"`),
+		field.Int("version").
+			Positive().
+			Immutable().
+			StructTag(`json:"version"`),
+		field.String("first_name").
+			Immutable().
+			StructTag(`json:"first_name"`),
+		field.String("last_name").
+			Immutable().
+			StructTag(`json:"last_name"`),
+		field.String("gender").
+			Immutable().
+			StructTag(`json:"gender"`),
+		// Các cột PII được mã hóa như bảng users; không Immutable để mã hóa lại được khi đổi key
+		field.String("phone").
+			StructTag(`json:"phone"`),
+		field.String("email").
+			Optional().
+			Nillable().
+			StructTag(`json:"email"`),
+		field.String("address").
+			Optional().
+			Nillable().
+			StructTag(`json:"address"`),
+		field.String("ward_code").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"ward_code"`),
+		field.String("province_code").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"province_code"`),
+		field.String("avatar").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"avatar"`),
+		field.Time("valid_from").
+			StructTag(`json:"valid_from"`),
+		field.Time("valid_to").
+			Optional().
+			Nillable().
+			StructTag(`json:"valid_to"`).
+			Comment("nil: phiên bản hiện tại"),
+		field.Int("changed_by").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"changed_by"`).
+			Comment("User thực hiện thay đổi; nil nếu gọi nội bộ"),
+	}
+}
+
+func (UserVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "version").Unique(),
+		index.Fields("user_id", "valid_from"),
+	}
+}
+
+func (UserVersion) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(userVersionPIIHook, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+	}
+}
+
+func userVersionPIIHook(next ent.Mutator) ent.Mutator {
+	return hook.UserVersionFunc(func(ctx context.Context, m *gen.UserVersionMutation) (gen.Value, error) {
+		if phone, ok := m.Phone(); ok {
+			enc, err := fieldcrypt.Encrypt(fieldcrypt.UserVersionPhone, phone)
+			if err != nil {
+				return nil, err
+			}
+			m.SetPhone(enc)
+		}
+		if email, ok := m.Email(); ok {
+			enc, err := fieldcrypt.Encrypt(fieldcrypt.UserVersionEmail, email)
+			if err != nil {
+				return nil, err
+			}
+			m.SetEmail(enc)
+		}
+		if address, ok := m.Address(); ok {
+			enc, err := fieldcrypt.Encrypt(fieldcrypt.UserVersionAddress, address)
+			if err != nil {
+				return nil, err
+			}
+			m.SetAddress(enc)
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if uv, ok := v.(*gen.UserVersion); ok {
+			if err := decryptUserVersion(uv); err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+	})
+}
+
+func decryptUserVersion(v *gen.UserVersion) error {
+	phone, err := fieldcrypt.Decrypt(fieldcrypt.UserVersionPhone, v.Phone)
+	if err != nil {
+		return err
+	}
+	v.Phone = phone
+	if err := fieldcrypt.DecryptPtr(fieldcrypt.UserVersionEmail, v.Email); err != nil {
+		return err
+	}
+	return fieldcrypt.DecryptPtr(fieldcrypt.UserVersionAddress, v.Address)
+}
+
+func (UserVersion) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+			return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+				v, err := next.Query(ctx, q)
+				if err != nil {
+					return nil, err
+				}
+				if versions, ok := v.([]*gen.UserVersion); ok {
+					for _, uv := range versions {
+						if err := decryptUserVersion(uv); err != nil {
+							return nil, err
+						}
+					}
+				}
+				return v, nil
+			})
+		}),
+	}
+}
+
+// Các field của User được lưu vào lịch sử
+var versionedFields = []string{
+	user.FieldFirstName, user.FieldLastName, user.FieldGender, user.FieldPhone, user.FieldEmail,
+	user.FieldAddress, user.FieldWardCode, user.FieldProvinceCode, user.FieldAvatar,
+}
+
+// NewUserVersion chép thông tin hiện tại của u vào một phiên bản mới;
+// bên gọi đặt version, valid_from và changed_by.
+func NewUserVersion(c *gen.UserVersionClient, u *gen.User) *gen.UserVersionCreate {
+	return c.Create().
+		SetUserID(u.ID).
+		SetFirstName(u.FirstName).
+		SetLastName(u.LastName).
+		SetGender(string(u.Gender)).
+		SetPhone(u.Phone).
+		SetNillableEmail(u.Email).
+		SetNillableAddress(u.Address).
+		SetNillableWardCode(u.WardCode).
+		SetNillableProvinceCode(u.ProvinceCode).
+		SetNillableAvatar(u.Avatar)
+}
+
+func equalPtr(a, b *string) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+// sameAsVersion: thông tin hiện tại của u trùng với phiên bản v
+func sameAsVersion(u *gen.User, v *gen.UserVersion) bool {
+	return u.FirstName == v.FirstName &&
+		u.LastName == v.LastName &&
+		string(u.Gender) == v.Gender &&
+		u.Phone == v.Phone &&
+		equalPtr(u.Email, v.Email) &&
+		equalPtr(u.Address, v.Address) &&
+		equalPtr(u.WardCode, v.WardCode) &&
+		equalPtr(u.ProvinceCode, v.ProvinceCode) &&
+		equalPtr(u.Avatar, v.Avatar)
+}
+
+// userVersionHook thêm phiên bản mới cho các user có thông tin trong lịch sử bị đổi.
+// User được đọc lại sau mutation để so với phiên bản hiện tại nên mutation không đổi
+// giá trị thực sự (ví dụ mã hóa lại) không tạo phiên bản mới.
+func userVersionHook(next ent.Mutator) ent.Mutator {
+	return hook.UserFunc(func(ctx context.Context, m *gen.UserMutation) (gen.Value, error) {
+		if !m.Op().Is(ent.OpCreate) && !touchesVersionedFields(m) {
+			return next.Mutate(ctx, m)
+		}
+
+		var ids []int
+		if id, ok := m.ID(); ok {
+			ids = []int{id}
+		} else if m.Op().Is(ent.OpUpdate) {
+			var err error
+			if ids, err = m.IDs(viewer.Unscoped(SkipSoftDelete(ctx))); err != nil {
+				return nil, err
+			}
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if u, ok := v.(*gen.User); ok && m.Op().Is(ent.OpCreate) {
+			ids = []int{u.ID}
+		}
+		if err := recordUserVersions(ctx, m.Client(), ids); err != nil {
+			return nil, err
+		}
+		return v, nil
+	})
+}
+
+func touchesVersionedFields(m *gen.UserMutation) bool {
+	for _, f := range versionedFields {
+		if _, ok := m.Field(f); ok || m.FieldCleared(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordUserVersions đóng phiên bản hiện tại và thêm phiên bản mới cho các user có thông tin khác phiên bản hiện tại
+func recordUserVersions(ctx context.Context, client *gen.Client, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	ctx = viewer.Unscoped(SkipSoftDelete(ctx))
+	users, err := client.User.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	current, err := client.UserVersion.Query().
+		Where(userversion.UserIDIn(ids...), userversion.ValidToIsNil()).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	currentByUser := make(map[int]*gen.UserVersion, len(current))
+	for _, v := range current {
+		currentByUser[v.UserID] = v
+	}
+
+	var changedBy *int
+	if v := viewer.FromContext(ctx); v != nil && v.UserID != 0 {
+		changedBy = &v.UserID
+	}
+	now := time.Now()
+	for _, u := range users {
+		version := 1
+		if prev := currentByUser[u.ID]; prev != nil {
+			if sameAsVersion(u, prev) {
+				continue
+			}
+			if err := client.UserVersion.UpdateOneID(prev.ID).SetValidTo(now).Exec(ctx); err != nil {
+				return err
+			}
+			version = prev.Version + 1
+		}
+		err := NewUserVersion(client.UserVersion, u).
+			SetVersion(version).
+			SetValidFrom(now).
+			SetNillableChangedBy(changedBy).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -14,8 +14,28 @@ type Tx struct {
 	config
 	// Account is the client for interacting with the Account builders.
 	Account *AccountClient
+	// AttributeDefinition is the client for interacting with the AttributeDefinition builders.
+	AttributeDefinition *AttributeDefinitionClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// EmergencyContact is the client for interacting with the EmergencyContact builders.
+	EmergencyContact *EmergencyContactClient
+	// Group is the client for interacting with the Group builders.
+	Group *GroupClient
+	// GroupMember is the client for interacting with the GroupMember builders.
+	GroupMember *GroupMemberClient
+	// LoginEvent is the client for interacting with the LoginEvent builders.
+	LoginEvent *LoginEventClient
+	// Membership is the client for interacting with the Membership builders.
+	Membership *MembershipClient
+	// Profile is the client for interacting with the Profile builders.
+	Profile *ProfileClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserMerge is the client for interacting with the UserMerge builders.
+	UserMerge *UserMergeClient
+	// UserVersion is the client for interacting with the UserVersion builders.
+	UserVersion *UserVersionClient
 
 	// lazily loaded.
 	client     *Client
@@ -148,7 +168,17 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Account = NewAccountClient(tx.config)
+	tx.AttributeDefinition = NewAttributeDefinitionClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.EmergencyContact = NewEmergencyContactClient(tx.config)
+	tx.Group = NewGroupClient(tx.config)
+	tx.GroupMember = NewGroupMemberClient(tx.config)
+	tx.LoginEvent = NewLoginEventClient(tx.config)
+	tx.Membership = NewMembershipClient(tx.config)
+	tx.Profile = NewProfileClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserMerge = NewUserMergeClient(tx.config)
+	tx.UserVersion = NewUserVersionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
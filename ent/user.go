@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/huynhthanhthao/hrm_user_service/ent/account"
+	"github.com/huynhthanhthao/hrm_user_service/ent/profile"
 	"github.com/huynhthanhthao/hrm_user_service/ent/user"
 )
 
@@ -18,22 +20,40 @@ type User struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// FirstName holds the value of the "first_name" field.
 	FirstName string `json:"first_name"`
 	// LastName holds the value of the "last_name" field.
 	LastName string `json:"last_name"`
 	// Gender holds the value of the "gender" field.
 	Gender user.Gender `json:"gender"`
-	// Phone holds the value of the "phone" field.
+	// Mã hóa; tìm kiếm và kiểm tra trùng theo phone_hash
 	Phone string `json:"phone"`
-	// Email holds the value of the "email" field.
+	// Blind index của phone, do hook tính
+	PhoneHash *string `json:"-"`
+	// Mã hóa; tìm kiếm và kiểm tra trùng theo email_hash
 	Email *string `json:"email"`
+	// Blind index của email (chữ thường), do hook tính
+	EmailHash *string `json:"-"`
 	// Avatar holds the value of the "avatar" field.
 	Avatar *string `json:"avatar"`
 	// WardCode holds the value of the "ward_code" field.
 	WardCode *string `json:"ward_code"`
-	// Address holds the value of the "address" field.
+	// ProvinceCode holds the value of the "province_code" field.
+	ProvinceCode *string `json:"province_code"`
+	// Mã hóa
 	Address *string `json:"address"`
+	// Thuộc tính tùy chỉnh theo tổ chức: org_id -> key -> giá trị, kiểm tra theo AttributeDefinition
+	CustomAttributes map[string]map[string]interface{} `json:"custom_attributes"`
+	// Họ tên đã bỏ dấu, dùng cho tìm kiếm trigram (không chứa số điện thoại, email vì các cột này được mã hóa)
+	SearchText string `json:"-"`
+	// Thời điểm dữ liệu cá nhân bị xóa theo yêu cầu; id được giữ để tham chiếu bên HR vẫn hợp lệ
+	AnonymizedAt *time.Time `json:"anonymized_at"`
+	// PermVersion holds the value of the "perm_version" field.
+	PermVersion int `json:"perm_version"`
+	// Tăng sau mỗi lần cập nhật, dùng để phát hiện ghi đè đồng thời (ETag)
+	Version int `json:"version"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -48,9 +68,13 @@ type User struct {
 type UserEdges struct {
 	// Account holds the value of the account edge.
 	Account *Account `json:"account,omitempty"`
+	// Memberships holds the value of the memberships edge.
+	Memberships []*Membership `json:"memberships,omitempty"`
+	// Profile holds the value of the profile edge.
+	Profile *Profile `json:"profile,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // AccountOrErr returns the Account value or an error if the edge
@@ -64,16 +88,38 @@ func (e UserEdges) AccountOrErr() (*Account, error) {
 	return nil, &NotLoadedError{edge: "account"}
 }
 
+// MembershipsOrErr returns the Memberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MembershipsOrErr() ([]*Membership, error) {
+	if e.loadedTypes[1] {
+		return e.Memberships, nil
+	}
+	return nil, &NotLoadedError{edge: "memberships"}
+}
+
+// ProfileOrErr returns the Profile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) ProfileOrErr() (*Profile, error) {
+	if e.Profile != nil {
+		return e.Profile, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: profile.Label}
+	}
+	return nil, &NotLoadedError{edge: "profile"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID:
+		case user.FieldCustomAttributes:
+			values[i] = new([]byte)
+		case user.FieldID, user.FieldPermVersion, user.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case user.FieldFirstName, user.FieldLastName, user.FieldGender, user.FieldPhone, user.FieldEmail, user.FieldAvatar, user.FieldWardCode, user.FieldAddress:
+		case user.FieldFirstName, user.FieldLastName, user.FieldGender, user.FieldPhone, user.FieldPhoneHash, user.FieldEmail, user.FieldEmailHash, user.FieldAvatar, user.FieldWardCode, user.FieldProvinceCode, user.FieldAddress, user.FieldSearchText:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldDeletedAt, user.FieldAnonymizedAt, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -96,6 +142,13 @@ func (u *User) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			u.ID = int(value.Int64)
+		case user.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				u.DeletedAt = new(time.Time)
+				*u.DeletedAt = value.Time
+			}
 		case user.FieldFirstName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field first_name", values[i])
@@ -120,6 +173,13 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Phone = value.String
 			}
+		case user.FieldPhoneHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone_hash", values[i])
+			} else if value.Valid {
+				u.PhoneHash = new(string)
+				*u.PhoneHash = value.String
+			}
 		case user.FieldEmail:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field email", values[i])
@@ -127,6 +187,13 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.Email = new(string)
 				*u.Email = value.String
 			}
+		case user.FieldEmailHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email_hash", values[i])
+			} else if value.Valid {
+				u.EmailHash = new(string)
+				*u.EmailHash = value.String
+			}
 		case user.FieldAvatar:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field avatar", values[i])
@@ -141,6 +208,13 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.WardCode = new(string)
 				*u.WardCode = value.String
 			}
+		case user.FieldProvinceCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field province_code", values[i])
+			} else if value.Valid {
+				u.ProvinceCode = new(string)
+				*u.ProvinceCode = value.String
+			}
 		case user.FieldAddress:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field address", values[i])
@@ -148,6 +222,39 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.Address = new(string)
 				*u.Address = value.String
 			}
+		case user.FieldCustomAttributes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field custom_attributes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.CustomAttributes); err != nil {
+					return fmt.Errorf("unmarshal field custom_attributes: %w", err)
+				}
+			}
+		case user.FieldSearchText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field search_text", values[i])
+			} else if value.Valid {
+				u.SearchText = value.String
+			}
+		case user.FieldAnonymizedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field anonymized_at", values[i])
+			} else if value.Valid {
+				u.AnonymizedAt = new(time.Time)
+				*u.AnonymizedAt = value.Time
+			}
+		case user.FieldPermVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field perm_version", values[i])
+			} else if value.Valid {
+				u.PermVersion = int(value.Int64)
+			}
+		case user.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				u.Version = int(value.Int64)
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -178,6 +285,16 @@ func (u *User) QueryAccount() *AccountQuery {
 	return NewUserClient(u.config).QueryAccount(u)
 }
 
+// QueryMemberships queries the "memberships" edge of the User entity.
+func (u *User) QueryMemberships() *MembershipQuery {
+	return NewUserClient(u.config).QueryMemberships(u)
+}
+
+// QueryProfile queries the "profile" edge of the User entity.
+func (u *User) QueryProfile() *ProfileQuery {
+	return NewUserClient(u.config).QueryProfile(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -201,6 +318,11 @@ func (u *User) String() string {
 	var builder strings.Builder
 	builder.WriteString("User(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", u.ID))
+	if v := u.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("first_name=")
 	builder.WriteString(u.FirstName)
 	builder.WriteString(", ")
@@ -213,11 +335,21 @@ func (u *User) String() string {
 	builder.WriteString("phone=")
 	builder.WriteString(u.Phone)
 	builder.WriteString(", ")
+	if v := u.PhoneHash; v != nil {
+		builder.WriteString("phone_hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := u.Email; v != nil {
 		builder.WriteString("email=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := u.EmailHash; v != nil {
+		builder.WriteString("email_hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := u.Avatar; v != nil {
 		builder.WriteString("avatar=")
 		builder.WriteString(*v)
@@ -228,11 +360,33 @@ func (u *User) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := u.ProvinceCode; v != nil {
+		builder.WriteString("province_code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := u.Address; v != nil {
 		builder.WriteString("address=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("custom_attributes=")
+	builder.WriteString(fmt.Sprintf("%v", u.CustomAttributes))
+	builder.WriteString(", ")
+	builder.WriteString("search_text=")
+	builder.WriteString(u.SearchText)
+	builder.WriteString(", ")
+	if v := u.AnonymizedAt; v != nil {
+		builder.WriteString("anonymized_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("perm_version=")
+	builder.WriteString(fmt.Sprintf("%v", u.PermVersion))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", u.Version))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -55,6 +55,11 @@ func IDLTE(id int) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // FirstName applies equality check predicate on the "first_name" field. It's identical to FirstNameEQ.
 func FirstName(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldFirstName, v))
@@ -70,11 +75,21 @@ func Phone(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPhone, v))
 }
 
+// PhoneHash applies equality check predicate on the "phone_hash" field. It's identical to PhoneHashEQ.
+func PhoneHash(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPhoneHash, v))
+}
+
 // Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
 func Email(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldEmail, v))
 }
 
+// EmailHash applies equality check predicate on the "email_hash" field. It's identical to EmailHashEQ.
+func EmailHash(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailHash, v))
+}
+
 // Avatar applies equality check predicate on the "avatar" field. It's identical to AvatarEQ.
 func Avatar(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAvatar, v))
@@ -85,11 +100,36 @@ func WardCode(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldWardCode, v))
 }
 
+// ProvinceCode applies equality check predicate on the "province_code" field. It's identical to ProvinceCodeEQ.
+func ProvinceCode(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldProvinceCode, v))
+}
+
 // Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
 func Address(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAddress, v))
 }
 
+// SearchText applies equality check predicate on the "search_text" field. It's identical to SearchTextEQ.
+func SearchText(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSearchText, v))
+}
+
+// AnonymizedAt applies equality check predicate on the "anonymized_at" field. It's identical to AnonymizedAtEQ.
+func AnonymizedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldAnonymizedAt, v))
+}
+
+// PermVersion applies equality check predicate on the "perm_version" field. It's identical to PermVersionEQ.
+func PermVersion(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPermVersion, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldVersion, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -100,6 +140,56 @@ func UpdatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // FirstNameEQ applies the EQ predicate on the "first_name" field.
 func FirstNameEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldFirstName, v))
@@ -315,6 +405,81 @@ func PhoneContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldPhone, v))
 }
 
+// PhoneHashEQ applies the EQ predicate on the "phone_hash" field.
+func PhoneHashEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPhoneHash, v))
+}
+
+// PhoneHashNEQ applies the NEQ predicate on the "phone_hash" field.
+func PhoneHashNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPhoneHash, v))
+}
+
+// PhoneHashIn applies the In predicate on the "phone_hash" field.
+func PhoneHashIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPhoneHash, vs...))
+}
+
+// PhoneHashNotIn applies the NotIn predicate on the "phone_hash" field.
+func PhoneHashNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPhoneHash, vs...))
+}
+
+// PhoneHashGT applies the GT predicate on the "phone_hash" field.
+func PhoneHashGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPhoneHash, v))
+}
+
+// PhoneHashGTE applies the GTE predicate on the "phone_hash" field.
+func PhoneHashGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPhoneHash, v))
+}
+
+// PhoneHashLT applies the LT predicate on the "phone_hash" field.
+func PhoneHashLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPhoneHash, v))
+}
+
+// PhoneHashLTE applies the LTE predicate on the "phone_hash" field.
+func PhoneHashLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPhoneHash, v))
+}
+
+// PhoneHashContains applies the Contains predicate on the "phone_hash" field.
+func PhoneHashContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldPhoneHash, v))
+}
+
+// PhoneHashHasPrefix applies the HasPrefix predicate on the "phone_hash" field.
+func PhoneHashHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldPhoneHash, v))
+}
+
+// PhoneHashHasSuffix applies the HasSuffix predicate on the "phone_hash" field.
+func PhoneHashHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldPhoneHash, v))
+}
+
+// PhoneHashIsNil applies the IsNil predicate on the "phone_hash" field.
+func PhoneHashIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPhoneHash))
+}
+
+// PhoneHashNotNil applies the NotNil predicate on the "phone_hash" field.
+func PhoneHashNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPhoneHash))
+}
+
+// PhoneHashEqualFold applies the EqualFold predicate on the "phone_hash" field.
+func PhoneHashEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldPhoneHash, v))
+}
+
+// PhoneHashContainsFold applies the ContainsFold predicate on the "phone_hash" field.
+func PhoneHashContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldPhoneHash, v))
+}
+
 // EmailEQ applies the EQ predicate on the "email" field.
 func EmailEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldEmail, v))
@@ -390,6 +555,81 @@ func EmailContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldEmail, v))
 }
 
+// EmailHashEQ applies the EQ predicate on the "email_hash" field.
+func EmailHashEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailHash, v))
+}
+
+// EmailHashNEQ applies the NEQ predicate on the "email_hash" field.
+func EmailHashNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldEmailHash, v))
+}
+
+// EmailHashIn applies the In predicate on the "email_hash" field.
+func EmailHashIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldEmailHash, vs...))
+}
+
+// EmailHashNotIn applies the NotIn predicate on the "email_hash" field.
+func EmailHashNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldEmailHash, vs...))
+}
+
+// EmailHashGT applies the GT predicate on the "email_hash" field.
+func EmailHashGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldEmailHash, v))
+}
+
+// EmailHashGTE applies the GTE predicate on the "email_hash" field.
+func EmailHashGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldEmailHash, v))
+}
+
+// EmailHashLT applies the LT predicate on the "email_hash" field.
+func EmailHashLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldEmailHash, v))
+}
+
+// EmailHashLTE applies the LTE predicate on the "email_hash" field.
+func EmailHashLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldEmailHash, v))
+}
+
+// EmailHashContains applies the Contains predicate on the "email_hash" field.
+func EmailHashContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldEmailHash, v))
+}
+
+// EmailHashHasPrefix applies the HasPrefix predicate on the "email_hash" field.
+func EmailHashHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldEmailHash, v))
+}
+
+// EmailHashHasSuffix applies the HasSuffix predicate on the "email_hash" field.
+func EmailHashHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldEmailHash, v))
+}
+
+// EmailHashIsNil applies the IsNil predicate on the "email_hash" field.
+func EmailHashIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldEmailHash))
+}
+
+// EmailHashNotNil applies the NotNil predicate on the "email_hash" field.
+func EmailHashNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldEmailHash))
+}
+
+// EmailHashEqualFold applies the EqualFold predicate on the "email_hash" field.
+func EmailHashEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldEmailHash, v))
+}
+
+// EmailHashContainsFold applies the ContainsFold predicate on the "email_hash" field.
+func EmailHashContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldEmailHash, v))
+}
+
 // AvatarEQ applies the EQ predicate on the "avatar" field.
 func AvatarEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAvatar, v))
@@ -540,6 +780,81 @@ func WardCodeContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldWardCode, v))
 }
 
+// ProvinceCodeEQ applies the EQ predicate on the "province_code" field.
+func ProvinceCodeEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldProvinceCode, v))
+}
+
+// ProvinceCodeNEQ applies the NEQ predicate on the "province_code" field.
+func ProvinceCodeNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldProvinceCode, v))
+}
+
+// ProvinceCodeIn applies the In predicate on the "province_code" field.
+func ProvinceCodeIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldProvinceCode, vs...))
+}
+
+// ProvinceCodeNotIn applies the NotIn predicate on the "province_code" field.
+func ProvinceCodeNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldProvinceCode, vs...))
+}
+
+// ProvinceCodeGT applies the GT predicate on the "province_code" field.
+func ProvinceCodeGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldProvinceCode, v))
+}
+
+// ProvinceCodeGTE applies the GTE predicate on the "province_code" field.
+func ProvinceCodeGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldProvinceCode, v))
+}
+
+// ProvinceCodeLT applies the LT predicate on the "province_code" field.
+func ProvinceCodeLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldProvinceCode, v))
+}
+
+// ProvinceCodeLTE applies the LTE predicate on the "province_code" field.
+func ProvinceCodeLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldProvinceCode, v))
+}
+
+// ProvinceCodeContains applies the Contains predicate on the "province_code" field.
+func ProvinceCodeContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldProvinceCode, v))
+}
+
+// ProvinceCodeHasPrefix applies the HasPrefix predicate on the "province_code" field.
+func ProvinceCodeHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldProvinceCode, v))
+}
+
+// ProvinceCodeHasSuffix applies the HasSuffix predicate on the "province_code" field.
+func ProvinceCodeHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldProvinceCode, v))
+}
+
+// ProvinceCodeIsNil applies the IsNil predicate on the "province_code" field.
+func ProvinceCodeIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldProvinceCode))
+}
+
+// ProvinceCodeNotNil applies the NotNil predicate on the "province_code" field.
+func ProvinceCodeNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldProvinceCode))
+}
+
+// ProvinceCodeEqualFold applies the EqualFold predicate on the "province_code" field.
+func ProvinceCodeEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldProvinceCode, v))
+}
+
+// ProvinceCodeContainsFold applies the ContainsFold predicate on the "province_code" field.
+func ProvinceCodeContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldProvinceCode, v))
+}
+
 // AddressEQ applies the EQ predicate on the "address" field.
 func AddressEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAddress, v))
@@ -615,6 +930,211 @@ func AddressContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldAddress, v))
 }
 
+// CustomAttributesIsNil applies the IsNil predicate on the "custom_attributes" field.
+func CustomAttributesIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldCustomAttributes))
+}
+
+// CustomAttributesNotNil applies the NotNil predicate on the "custom_attributes" field.
+func CustomAttributesNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldCustomAttributes))
+}
+
+// SearchTextEQ applies the EQ predicate on the "search_text" field.
+func SearchTextEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSearchText, v))
+}
+
+// SearchTextNEQ applies the NEQ predicate on the "search_text" field.
+func SearchTextNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldSearchText, v))
+}
+
+// SearchTextIn applies the In predicate on the "search_text" field.
+func SearchTextIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldSearchText, vs...))
+}
+
+// SearchTextNotIn applies the NotIn predicate on the "search_text" field.
+func SearchTextNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldSearchText, vs...))
+}
+
+// SearchTextGT applies the GT predicate on the "search_text" field.
+func SearchTextGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldSearchText, v))
+}
+
+// SearchTextGTE applies the GTE predicate on the "search_text" field.
+func SearchTextGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldSearchText, v))
+}
+
+// SearchTextLT applies the LT predicate on the "search_text" field.
+func SearchTextLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldSearchText, v))
+}
+
+// SearchTextLTE applies the LTE predicate on the "search_text" field.
+func SearchTextLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldSearchText, v))
+}
+
+// SearchTextContains applies the Contains predicate on the "search_text" field.
+func SearchTextContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldSearchText, v))
+}
+
+// SearchTextHasPrefix applies the HasPrefix predicate on the "search_text" field.
+func SearchTextHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldSearchText, v))
+}
+
+// SearchTextHasSuffix applies the HasSuffix predicate on the "search_text" field.
+func SearchTextHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldSearchText, v))
+}
+
+// SearchTextEqualFold applies the EqualFold predicate on the "search_text" field.
+func SearchTextEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldSearchText, v))
+}
+
+// SearchTextContainsFold applies the ContainsFold predicate on the "search_text" field.
+func SearchTextContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldSearchText, v))
+}
+
+// AnonymizedAtEQ applies the EQ predicate on the "anonymized_at" field.
+func AnonymizedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldAnonymizedAt, v))
+}
+
+// AnonymizedAtNEQ applies the NEQ predicate on the "anonymized_at" field.
+func AnonymizedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldAnonymizedAt, v))
+}
+
+// AnonymizedAtIn applies the In predicate on the "anonymized_at" field.
+func AnonymizedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldAnonymizedAt, vs...))
+}
+
+// AnonymizedAtNotIn applies the NotIn predicate on the "anonymized_at" field.
+func AnonymizedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldAnonymizedAt, vs...))
+}
+
+// AnonymizedAtGT applies the GT predicate on the "anonymized_at" field.
+func AnonymizedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldAnonymizedAt, v))
+}
+
+// AnonymizedAtGTE applies the GTE predicate on the "anonymized_at" field.
+func AnonymizedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldAnonymizedAt, v))
+}
+
+// AnonymizedAtLT applies the LT predicate on the "anonymized_at" field.
+func AnonymizedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldAnonymizedAt, v))
+}
+
+// AnonymizedAtLTE applies the LTE predicate on the "anonymized_at" field.
+func AnonymizedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldAnonymizedAt, v))
+}
+
+// AnonymizedAtIsNil applies the IsNil predicate on the "anonymized_at" field.
+func AnonymizedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldAnonymizedAt))
+}
+
+// AnonymizedAtNotNil applies the NotNil predicate on the "anonymized_at" field.
+func AnonymizedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldAnonymizedAt))
+}
+
+// PermVersionEQ applies the EQ predicate on the "perm_version" field.
+func PermVersionEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPermVersion, v))
+}
+
+// PermVersionNEQ applies the NEQ predicate on the "perm_version" field.
+func PermVersionNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPermVersion, v))
+}
+
+// PermVersionIn applies the In predicate on the "perm_version" field.
+func PermVersionIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPermVersion, vs...))
+}
+
+// PermVersionNotIn applies the NotIn predicate on the "perm_version" field.
+func PermVersionNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPermVersion, vs...))
+}
+
+// PermVersionGT applies the GT predicate on the "perm_version" field.
+func PermVersionGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPermVersion, v))
+}
+
+// PermVersionGTE applies the GTE predicate on the "perm_version" field.
+func PermVersionGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPermVersion, v))
+}
+
+// PermVersionLT applies the LT predicate on the "perm_version" field.
+func PermVersionLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPermVersion, v))
+}
+
+// PermVersionLTE applies the LTE predicate on the "perm_version" field.
+func PermVersionLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPermVersion, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldVersion, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -718,6 +1238,52 @@ func HasAccountWith(preds ...predicate.Account) predicate.User {
 	})
 }
 
+// HasMemberships applies the HasEdge predicate on the "memberships" edge.
+func HasMemberships() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MembershipsTable, MembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMembershipsWith applies the HasEdge predicate on the "memberships" edge with a given conditions (other predicates).
+func HasMembershipsWith(preds ...predicate.Membership) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMembershipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProfile applies the HasEdge predicate on the "profile" edge.
+func HasProfile() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ProfileTable, ProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileWith applies the HasEdge predicate on the "profile" edge with a given conditions (other predicates).
+func HasProfileWith(preds ...predicate.Profile) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
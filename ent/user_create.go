@@ -11,6 +11,8 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/huynhthanhthao/hrm_user_service/ent/account"
+	"github.com/huynhthanhthao/hrm_user_service/ent/membership"
+	"github.com/huynhthanhthao/hrm_user_service/ent/profile"
 	"github.com/huynhthanhthao/hrm_user_service/ent/user"
 )
 
@@ -21,6 +23,20 @@ type UserCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (uc *UserCreate) SetDeletedAt(t time.Time) *UserCreate {
+	uc.mutation.SetDeletedAt(t)
+	return uc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDeletedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetDeletedAt(*t)
+	}
+	return uc
+}
+
 // SetFirstName sets the "first_name" field.
 func (uc *UserCreate) SetFirstName(s string) *UserCreate {
 	uc.mutation.SetFirstName(s)
@@ -53,6 +69,20 @@ func (uc *UserCreate) SetPhone(s string) *UserCreate {
 	return uc
 }
 
+// SetPhoneHash sets the "phone_hash" field.
+func (uc *UserCreate) SetPhoneHash(s string) *UserCreate {
+	uc.mutation.SetPhoneHash(s)
+	return uc
+}
+
+// SetNillablePhoneHash sets the "phone_hash" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePhoneHash(s *string) *UserCreate {
+	if s != nil {
+		uc.SetPhoneHash(*s)
+	}
+	return uc
+}
+
 // SetEmail sets the "email" field.
 func (uc *UserCreate) SetEmail(s string) *UserCreate {
 	uc.mutation.SetEmail(s)
@@ -67,6 +97,20 @@ func (uc *UserCreate) SetNillableEmail(s *string) *UserCreate {
 	return uc
 }
 
+// SetEmailHash sets the "email_hash" field.
+func (uc *UserCreate) SetEmailHash(s string) *UserCreate {
+	uc.mutation.SetEmailHash(s)
+	return uc
+}
+
+// SetNillableEmailHash sets the "email_hash" field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmailHash(s *string) *UserCreate {
+	if s != nil {
+		uc.SetEmailHash(*s)
+	}
+	return uc
+}
+
 // SetAvatar sets the "avatar" field.
 func (uc *UserCreate) SetAvatar(s string) *UserCreate {
 	uc.mutation.SetAvatar(s)
@@ -95,6 +139,20 @@ func (uc *UserCreate) SetNillableWardCode(s *string) *UserCreate {
 	return uc
 }
 
+// SetProvinceCode sets the "province_code" field.
+func (uc *UserCreate) SetProvinceCode(s string) *UserCreate {
+	uc.mutation.SetProvinceCode(s)
+	return uc
+}
+
+// SetNillableProvinceCode sets the "province_code" field if the given value is not nil.
+func (uc *UserCreate) SetNillableProvinceCode(s *string) *UserCreate {
+	if s != nil {
+		uc.SetProvinceCode(*s)
+	}
+	return uc
+}
+
 // SetAddress sets the "address" field.
 func (uc *UserCreate) SetAddress(s string) *UserCreate {
 	uc.mutation.SetAddress(s)
@@ -109,6 +167,68 @@ func (uc *UserCreate) SetNillableAddress(s *string) *UserCreate {
 	return uc
 }
 
+// SetCustomAttributes sets the "custom_attributes" field.
+func (uc *UserCreate) SetCustomAttributes(m map[string]map[string]interface{}) *UserCreate {
+	uc.mutation.SetCustomAttributes(m)
+	return uc
+}
+
+// SetSearchText sets the "search_text" field.
+func (uc *UserCreate) SetSearchText(s string) *UserCreate {
+	uc.mutation.SetSearchText(s)
+	return uc
+}
+
+// SetNillableSearchText sets the "search_text" field if the given value is not nil.
+func (uc *UserCreate) SetNillableSearchText(s *string) *UserCreate {
+	if s != nil {
+		uc.SetSearchText(*s)
+	}
+	return uc
+}
+
+// SetAnonymizedAt sets the "anonymized_at" field.
+func (uc *UserCreate) SetAnonymizedAt(t time.Time) *UserCreate {
+	uc.mutation.SetAnonymizedAt(t)
+	return uc
+}
+
+// SetNillableAnonymizedAt sets the "anonymized_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableAnonymizedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetAnonymizedAt(*t)
+	}
+	return uc
+}
+
+// SetPermVersion sets the "perm_version" field.
+func (uc *UserCreate) SetPermVersion(i int) *UserCreate {
+	uc.mutation.SetPermVersion(i)
+	return uc
+}
+
+// SetNillablePermVersion sets the "perm_version" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePermVersion(i *int) *UserCreate {
+	if i != nil {
+		uc.SetPermVersion(*i)
+	}
+	return uc
+}
+
+// SetVersion sets the "version" field.
+func (uc *UserCreate) SetVersion(i int) *UserCreate {
+	uc.mutation.SetVersion(i)
+	return uc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (uc *UserCreate) SetNillableVersion(i *int) *UserCreate {
+	if i != nil {
+		uc.SetVersion(*i)
+	}
+	return uc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uc *UserCreate) SetCreatedAt(t time.Time) *UserCreate {
 	uc.mutation.SetCreatedAt(t)
@@ -162,6 +282,40 @@ func (uc *UserCreate) SetAccount(a *Account) *UserCreate {
 	return uc.SetAccountID(a.ID)
 }
 
+// AddMembershipIDs adds the "memberships" edge to the Membership entity by IDs.
+func (uc *UserCreate) AddMembershipIDs(ids ...int) *UserCreate {
+	uc.mutation.AddMembershipIDs(ids...)
+	return uc
+}
+
+// AddMemberships adds the "memberships" edges to the Membership entity.
+func (uc *UserCreate) AddMemberships(m ...*Membership) *UserCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMembershipIDs(ids...)
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (uc *UserCreate) SetProfileID(id int) *UserCreate {
+	uc.mutation.SetProfileID(id)
+	return uc
+}
+
+// SetNillableProfileID sets the "profile" edge to the Profile entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillableProfileID(id *int) *UserCreate {
+	if id != nil {
+		uc = uc.SetProfileID(*id)
+	}
+	return uc
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (uc *UserCreate) SetProfile(p *Profile) *UserCreate {
+	return uc.SetProfileID(p.ID)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -169,7 +323,9 @@ func (uc *UserCreate) Mutation() *UserMutation {
 
 // Save creates the User in the database.
 func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
-	uc.defaults()
+	if err := uc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, uc.sqlSave, uc.mutation, uc.hooks)
 }
 
@@ -196,19 +352,38 @@ func (uc *UserCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (uc *UserCreate) defaults() {
+func (uc *UserCreate) defaults() error {
 	if _, ok := uc.mutation.Gender(); !ok {
 		v := user.DefaultGender
 		uc.mutation.SetGender(v)
 	}
+	if _, ok := uc.mutation.SearchText(); !ok {
+		v := user.DefaultSearchText
+		uc.mutation.SetSearchText(v)
+	}
+	if _, ok := uc.mutation.PermVersion(); !ok {
+		v := user.DefaultPermVersion
+		uc.mutation.SetPermVersion(v)
+	}
+	if _, ok := uc.mutation.Version(); !ok {
+		v := user.DefaultVersion
+		uc.mutation.SetVersion(v)
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
+		if user.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized user.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := user.DefaultCreatedAt()
 		uc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := uc.mutation.UpdatedAt(); !ok {
+		if user.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized user.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := user.DefaultUpdatedAt()
 		uc.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -245,6 +420,25 @@ func (uc *UserCreate) check() error {
 			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "User.phone": %w`, err)}
 		}
 	}
+	if _, ok := uc.mutation.SearchText(); !ok {
+		return &ValidationError{Name: "search_text", err: errors.New(`ent: missing required field "User.search_text"`)}
+	}
+	if _, ok := uc.mutation.PermVersion(); !ok {
+		return &ValidationError{Name: "perm_version", err: errors.New(`ent: missing required field "User.perm_version"`)}
+	}
+	if v, ok := uc.mutation.PermVersion(); ok {
+		if err := user.PermVersionValidator(v); err != nil {
+			return &ValidationError{Name: "perm_version", err: fmt.Errorf(`ent: validator failed for field "User.perm_version": %w`, err)}
+		}
+	}
+	if _, ok := uc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "User.version"`)}
+	}
+	if v, ok := uc.mutation.Version(); ok {
+		if err := user.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "User.version": %w`, err)}
+		}
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -288,6 +482,10 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := uc.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := uc.mutation.FirstName(); ok {
 		_spec.SetField(user.FieldFirstName, field.TypeString, value)
 		_node.FirstName = value
@@ -304,10 +502,18 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldPhone, field.TypeString, value)
 		_node.Phone = value
 	}
+	if value, ok := uc.mutation.PhoneHash(); ok {
+		_spec.SetField(user.FieldPhoneHash, field.TypeString, value)
+		_node.PhoneHash = &value
+	}
 	if value, ok := uc.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 		_node.Email = &value
 	}
+	if value, ok := uc.mutation.EmailHash(); ok {
+		_spec.SetField(user.FieldEmailHash, field.TypeString, value)
+		_node.EmailHash = &value
+	}
 	if value, ok := uc.mutation.Avatar(); ok {
 		_spec.SetField(user.FieldAvatar, field.TypeString, value)
 		_node.Avatar = &value
@@ -316,10 +522,34 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldWardCode, field.TypeString, value)
 		_node.WardCode = &value
 	}
+	if value, ok := uc.mutation.ProvinceCode(); ok {
+		_spec.SetField(user.FieldProvinceCode, field.TypeString, value)
+		_node.ProvinceCode = &value
+	}
 	if value, ok := uc.mutation.Address(); ok {
 		_spec.SetField(user.FieldAddress, field.TypeString, value)
 		_node.Address = &value
 	}
+	if value, ok := uc.mutation.CustomAttributes(); ok {
+		_spec.SetField(user.FieldCustomAttributes, field.TypeJSON, value)
+		_node.CustomAttributes = value
+	}
+	if value, ok := uc.mutation.SearchText(); ok {
+		_spec.SetField(user.FieldSearchText, field.TypeString, value)
+		_node.SearchText = value
+	}
+	if value, ok := uc.mutation.AnonymizedAt(); ok {
+		_spec.SetField(user.FieldAnonymizedAt, field.TypeTime, value)
+		_node.AnonymizedAt = &value
+	}
+	if value, ok := uc.mutation.PermVersion(); ok {
+		_spec.SetField(user.FieldPermVersion, field.TypeInt, value)
+		_node.PermVersion = value
+	}
+	if value, ok := uc.mutation.Version(); ok {
+		_spec.SetField(user.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := uc.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -344,6 +574,38 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.ProfileTable,
+			Columns: []string{user.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -12,15 +12,18 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/huynhthanhthao/hrm_user_service/ent/account"
+	"github.com/huynhthanhthao/hrm_user_service/ent/membership"
 	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+	"github.com/huynhthanhthao/hrm_user_service/ent/profile"
 	"github.com/huynhthanhthao/hrm_user_service/ent/user"
 )
 
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -29,6 +32,26 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (uu *UserUpdate) SetDeletedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetDeletedAt(t)
+	return uu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDeletedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDeletedAt(*t)
+	}
+	return uu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uu *UserUpdate) ClearDeletedAt() *UserUpdate {
+	uu.mutation.ClearDeletedAt()
+	return uu
+}
+
 // SetFirstName sets the "first_name" field.
 func (uu *UserUpdate) SetFirstName(s string) *UserUpdate {
 	uu.mutation.SetFirstName(s)
@@ -85,6 +108,26 @@ func (uu *UserUpdate) SetNillablePhone(s *string) *UserUpdate {
 	return uu
 }
 
+// SetPhoneHash sets the "phone_hash" field.
+func (uu *UserUpdate) SetPhoneHash(s string) *UserUpdate {
+	uu.mutation.SetPhoneHash(s)
+	return uu
+}
+
+// SetNillablePhoneHash sets the "phone_hash" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePhoneHash(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetPhoneHash(*s)
+	}
+	return uu
+}
+
+// ClearPhoneHash clears the value of the "phone_hash" field.
+func (uu *UserUpdate) ClearPhoneHash() *UserUpdate {
+	uu.mutation.ClearPhoneHash()
+	return uu
+}
+
 // SetEmail sets the "email" field.
 func (uu *UserUpdate) SetEmail(s string) *UserUpdate {
 	uu.mutation.SetEmail(s)
@@ -105,6 +148,26 @@ func (uu *UserUpdate) ClearEmail() *UserUpdate {
 	return uu
 }
 
+// SetEmailHash sets the "email_hash" field.
+func (uu *UserUpdate) SetEmailHash(s string) *UserUpdate {
+	uu.mutation.SetEmailHash(s)
+	return uu
+}
+
+// SetNillableEmailHash sets the "email_hash" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableEmailHash(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetEmailHash(*s)
+	}
+	return uu
+}
+
+// ClearEmailHash clears the value of the "email_hash" field.
+func (uu *UserUpdate) ClearEmailHash() *UserUpdate {
+	uu.mutation.ClearEmailHash()
+	return uu
+}
+
 // SetAvatar sets the "avatar" field.
 func (uu *UserUpdate) SetAvatar(s string) *UserUpdate {
 	uu.mutation.SetAvatar(s)
@@ -145,6 +208,26 @@ func (uu *UserUpdate) ClearWardCode() *UserUpdate {
 	return uu
 }
 
+// SetProvinceCode sets the "province_code" field.
+func (uu *UserUpdate) SetProvinceCode(s string) *UserUpdate {
+	uu.mutation.SetProvinceCode(s)
+	return uu
+}
+
+// SetNillableProvinceCode sets the "province_code" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableProvinceCode(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetProvinceCode(*s)
+	}
+	return uu
+}
+
+// ClearProvinceCode clears the value of the "province_code" field.
+func (uu *UserUpdate) ClearProvinceCode() *UserUpdate {
+	uu.mutation.ClearProvinceCode()
+	return uu
+}
+
 // SetAddress sets the "address" field.
 func (uu *UserUpdate) SetAddress(s string) *UserUpdate {
 	uu.mutation.SetAddress(s)
@@ -165,6 +248,94 @@ func (uu *UserUpdate) ClearAddress() *UserUpdate {
 	return uu
 }
 
+// SetCustomAttributes sets the "custom_attributes" field.
+func (uu *UserUpdate) SetCustomAttributes(m map[string]map[string]interface{}) *UserUpdate {
+	uu.mutation.SetCustomAttributes(m)
+	return uu
+}
+
+// ClearCustomAttributes clears the value of the "custom_attributes" field.
+func (uu *UserUpdate) ClearCustomAttributes() *UserUpdate {
+	uu.mutation.ClearCustomAttributes()
+	return uu
+}
+
+// SetSearchText sets the "search_text" field.
+func (uu *UserUpdate) SetSearchText(s string) *UserUpdate {
+	uu.mutation.SetSearchText(s)
+	return uu
+}
+
+// SetNillableSearchText sets the "search_text" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableSearchText(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetSearchText(*s)
+	}
+	return uu
+}
+
+// SetAnonymizedAt sets the "anonymized_at" field.
+func (uu *UserUpdate) SetAnonymizedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetAnonymizedAt(t)
+	return uu
+}
+
+// SetNillableAnonymizedAt sets the "anonymized_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableAnonymizedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetAnonymizedAt(*t)
+	}
+	return uu
+}
+
+// ClearAnonymizedAt clears the value of the "anonymized_at" field.
+func (uu *UserUpdate) ClearAnonymizedAt() *UserUpdate {
+	uu.mutation.ClearAnonymizedAt()
+	return uu
+}
+
+// SetPermVersion sets the "perm_version" field.
+func (uu *UserUpdate) SetPermVersion(i int) *UserUpdate {
+	uu.mutation.ResetPermVersion()
+	uu.mutation.SetPermVersion(i)
+	return uu
+}
+
+// SetNillablePermVersion sets the "perm_version" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePermVersion(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetPermVersion(*i)
+	}
+	return uu
+}
+
+// AddPermVersion adds i to the "perm_version" field.
+func (uu *UserUpdate) AddPermVersion(i int) *UserUpdate {
+	uu.mutation.AddPermVersion(i)
+	return uu
+}
+
+// SetVersion sets the "version" field.
+func (uu *UserUpdate) SetVersion(i int) *UserUpdate {
+	uu.mutation.ResetVersion()
+	uu.mutation.SetVersion(i)
+	return uu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableVersion(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetVersion(*i)
+	}
+	return uu
+}
+
+// AddVersion adds i to the "version" field.
+func (uu *UserUpdate) AddVersion(i int) *UserUpdate {
+	uu.mutation.AddVersion(i)
+	return uu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uu *UserUpdate) SetCreatedAt(t time.Time) *UserUpdate {
 	uu.mutation.SetCreatedAt(t)
@@ -204,6 +375,40 @@ func (uu *UserUpdate) SetAccount(a *Account) *UserUpdate {
 	return uu.SetAccountID(a.ID)
 }
 
+// AddMembershipIDs adds the "memberships" edge to the Membership entity by IDs.
+func (uu *UserUpdate) AddMembershipIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddMembershipIDs(ids...)
+	return uu
+}
+
+// AddMemberships adds the "memberships" edges to the Membership entity.
+func (uu *UserUpdate) AddMemberships(m ...*Membership) *UserUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMembershipIDs(ids...)
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (uu *UserUpdate) SetProfileID(id int) *UserUpdate {
+	uu.mutation.SetProfileID(id)
+	return uu
+}
+
+// SetNillableProfileID sets the "profile" edge to the Profile entity by ID if the given value is not nil.
+func (uu *UserUpdate) SetNillableProfileID(id *int) *UserUpdate {
+	if id != nil {
+		uu = uu.SetProfileID(*id)
+	}
+	return uu
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (uu *UserUpdate) SetProfile(p *Profile) *UserUpdate {
+	return uu.SetProfileID(p.ID)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -215,9 +420,38 @@ func (uu *UserUpdate) ClearAccount() *UserUpdate {
 	return uu
 }
 
+// ClearMemberships clears all "memberships" edges to the Membership entity.
+func (uu *UserUpdate) ClearMemberships() *UserUpdate {
+	uu.mutation.ClearMemberships()
+	return uu
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to Membership entities by IDs.
+func (uu *UserUpdate) RemoveMembershipIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveMembershipIDs(ids...)
+	return uu
+}
+
+// RemoveMemberships removes "memberships" edges to Membership entities.
+func (uu *UserUpdate) RemoveMemberships(m ...*Membership) *UserUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.RemoveMembershipIDs(ids...)
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (uu *UserUpdate) ClearProfile() *UserUpdate {
+	uu.mutation.ClearProfile()
+	return uu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
-	uu.defaults()
+	if err := uu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
 }
 
@@ -244,11 +478,15 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (uu *UserUpdate) defaults() {
+func (uu *UserUpdate) defaults() error {
 	if _, ok := uu.mutation.UpdatedAt(); !ok {
+		if user.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized user.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := user.UpdateDefaultUpdatedAt()
 		uu.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -273,9 +511,25 @@ func (uu *UserUpdate) check() error {
 			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "User.phone": %w`, err)}
 		}
 	}
+	if v, ok := uu.mutation.PermVersion(); ok {
+		if err := user.PermVersionValidator(v); err != nil {
+			return &ValidationError{Name: "perm_version", err: fmt.Errorf(`ent: validator failed for field "User.perm_version": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.Version(); ok {
+		if err := user.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "User.version": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uu *UserUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdate {
+	uu.modifiers = append(uu.modifiers, modifiers...)
+	return uu
+}
+
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := uu.check(); err != nil {
 		return n, err
@@ -288,6 +542,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := uu.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uu.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := uu.mutation.FirstName(); ok {
 		_spec.SetField(user.FieldFirstName, field.TypeString, value)
 	}
@@ -300,12 +560,24 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.Phone(); ok {
 		_spec.SetField(user.FieldPhone, field.TypeString, value)
 	}
+	if value, ok := uu.mutation.PhoneHash(); ok {
+		_spec.SetField(user.FieldPhoneHash, field.TypeString, value)
+	}
+	if uu.mutation.PhoneHashCleared() {
+		_spec.ClearField(user.FieldPhoneHash, field.TypeString)
+	}
 	if value, ok := uu.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
 	if uu.mutation.EmailCleared() {
 		_spec.ClearField(user.FieldEmail, field.TypeString)
 	}
+	if value, ok := uu.mutation.EmailHash(); ok {
+		_spec.SetField(user.FieldEmailHash, field.TypeString, value)
+	}
+	if uu.mutation.EmailHashCleared() {
+		_spec.ClearField(user.FieldEmailHash, field.TypeString)
+	}
 	if value, ok := uu.mutation.Avatar(); ok {
 		_spec.SetField(user.FieldAvatar, field.TypeString, value)
 	}
@@ -318,12 +590,45 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.WardCodeCleared() {
 		_spec.ClearField(user.FieldWardCode, field.TypeString)
 	}
+	if value, ok := uu.mutation.ProvinceCode(); ok {
+		_spec.SetField(user.FieldProvinceCode, field.TypeString, value)
+	}
+	if uu.mutation.ProvinceCodeCleared() {
+		_spec.ClearField(user.FieldProvinceCode, field.TypeString)
+	}
 	if value, ok := uu.mutation.Address(); ok {
 		_spec.SetField(user.FieldAddress, field.TypeString, value)
 	}
 	if uu.mutation.AddressCleared() {
 		_spec.ClearField(user.FieldAddress, field.TypeString)
 	}
+	if value, ok := uu.mutation.CustomAttributes(); ok {
+		_spec.SetField(user.FieldCustomAttributes, field.TypeJSON, value)
+	}
+	if uu.mutation.CustomAttributesCleared() {
+		_spec.ClearField(user.FieldCustomAttributes, field.TypeJSON)
+	}
+	if value, ok := uu.mutation.SearchText(); ok {
+		_spec.SetField(user.FieldSearchText, field.TypeString, value)
+	}
+	if value, ok := uu.mutation.AnonymizedAt(); ok {
+		_spec.SetField(user.FieldAnonymizedAt, field.TypeTime, value)
+	}
+	if uu.mutation.AnonymizedAtCleared() {
+		_spec.ClearField(user.FieldAnonymizedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.PermVersion(); ok {
+		_spec.SetField(user.FieldPermVersion, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedPermVersion(); ok {
+		_spec.AddField(user.FieldPermVersion, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.Version(); ok {
+		_spec.SetField(user.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedVersion(); ok {
+		_spec.AddField(user.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := uu.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -359,6 +664,81 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !uu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.ProfileTable,
+			Columns: []string{user.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.ProfileTable,
+			Columns: []string{user.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(uu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -374,9 +754,30 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uuo *UserUpdateOne) SetDeletedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeletedAt(t)
+	return uuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeletedAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uuo *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
+	uuo.mutation.ClearDeletedAt()
+	return uuo
 }
 
 // SetFirstName sets the "first_name" field.
@@ -435,6 +836,26 @@ func (uuo *UserUpdateOne) SetNillablePhone(s *string) *UserUpdateOne {
 	return uuo
 }
 
+// SetPhoneHash sets the "phone_hash" field.
+func (uuo *UserUpdateOne) SetPhoneHash(s string) *UserUpdateOne {
+	uuo.mutation.SetPhoneHash(s)
+	return uuo
+}
+
+// SetNillablePhoneHash sets the "phone_hash" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePhoneHash(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPhoneHash(*s)
+	}
+	return uuo
+}
+
+// ClearPhoneHash clears the value of the "phone_hash" field.
+func (uuo *UserUpdateOne) ClearPhoneHash() *UserUpdateOne {
+	uuo.mutation.ClearPhoneHash()
+	return uuo
+}
+
 // SetEmail sets the "email" field.
 func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
 	uuo.mutation.SetEmail(s)
@@ -455,6 +876,26 @@ func (uuo *UserUpdateOne) ClearEmail() *UserUpdateOne {
 	return uuo
 }
 
+// SetEmailHash sets the "email_hash" field.
+func (uuo *UserUpdateOne) SetEmailHash(s string) *UserUpdateOne {
+	uuo.mutation.SetEmailHash(s)
+	return uuo
+}
+
+// SetNillableEmailHash sets the "email_hash" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmailHash(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmailHash(*s)
+	}
+	return uuo
+}
+
+// ClearEmailHash clears the value of the "email_hash" field.
+func (uuo *UserUpdateOne) ClearEmailHash() *UserUpdateOne {
+	uuo.mutation.ClearEmailHash()
+	return uuo
+}
+
 // SetAvatar sets the "avatar" field.
 func (uuo *UserUpdateOne) SetAvatar(s string) *UserUpdateOne {
 	uuo.mutation.SetAvatar(s)
@@ -495,6 +936,26 @@ func (uuo *UserUpdateOne) ClearWardCode() *UserUpdateOne {
 	return uuo
 }
 
+// SetProvinceCode sets the "province_code" field.
+func (uuo *UserUpdateOne) SetProvinceCode(s string) *UserUpdateOne {
+	uuo.mutation.SetProvinceCode(s)
+	return uuo
+}
+
+// SetNillableProvinceCode sets the "province_code" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableProvinceCode(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetProvinceCode(*s)
+	}
+	return uuo
+}
+
+// ClearProvinceCode clears the value of the "province_code" field.
+func (uuo *UserUpdateOne) ClearProvinceCode() *UserUpdateOne {
+	uuo.mutation.ClearProvinceCode()
+	return uuo
+}
+
 // SetAddress sets the "address" field.
 func (uuo *UserUpdateOne) SetAddress(s string) *UserUpdateOne {
 	uuo.mutation.SetAddress(s)
@@ -515,6 +976,94 @@ func (uuo *UserUpdateOne) ClearAddress() *UserUpdateOne {
 	return uuo
 }
 
+// SetCustomAttributes sets the "custom_attributes" field.
+func (uuo *UserUpdateOne) SetCustomAttributes(m map[string]map[string]interface{}) *UserUpdateOne {
+	uuo.mutation.SetCustomAttributes(m)
+	return uuo
+}
+
+// ClearCustomAttributes clears the value of the "custom_attributes" field.
+func (uuo *UserUpdateOne) ClearCustomAttributes() *UserUpdateOne {
+	uuo.mutation.ClearCustomAttributes()
+	return uuo
+}
+
+// SetSearchText sets the "search_text" field.
+func (uuo *UserUpdateOne) SetSearchText(s string) *UserUpdateOne {
+	uuo.mutation.SetSearchText(s)
+	return uuo
+}
+
+// SetNillableSearchText sets the "search_text" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSearchText(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetSearchText(*s)
+	}
+	return uuo
+}
+
+// SetAnonymizedAt sets the "anonymized_at" field.
+func (uuo *UserUpdateOne) SetAnonymizedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetAnonymizedAt(t)
+	return uuo
+}
+
+// SetNillableAnonymizedAt sets the "anonymized_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAnonymizedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetAnonymizedAt(*t)
+	}
+	return uuo
+}
+
+// ClearAnonymizedAt clears the value of the "anonymized_at" field.
+func (uuo *UserUpdateOne) ClearAnonymizedAt() *UserUpdateOne {
+	uuo.mutation.ClearAnonymizedAt()
+	return uuo
+}
+
+// SetPermVersion sets the "perm_version" field.
+func (uuo *UserUpdateOne) SetPermVersion(i int) *UserUpdateOne {
+	uuo.mutation.ResetPermVersion()
+	uuo.mutation.SetPermVersion(i)
+	return uuo
+}
+
+// SetNillablePermVersion sets the "perm_version" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePermVersion(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetPermVersion(*i)
+	}
+	return uuo
+}
+
+// AddPermVersion adds i to the "perm_version" field.
+func (uuo *UserUpdateOne) AddPermVersion(i int) *UserUpdateOne {
+	uuo.mutation.AddPermVersion(i)
+	return uuo
+}
+
+// SetVersion sets the "version" field.
+func (uuo *UserUpdateOne) SetVersion(i int) *UserUpdateOne {
+	uuo.mutation.ResetVersion()
+	uuo.mutation.SetVersion(i)
+	return uuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableVersion(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetVersion(*i)
+	}
+	return uuo
+}
+
+// AddVersion adds i to the "version" field.
+func (uuo *UserUpdateOne) AddVersion(i int) *UserUpdateOne {
+	uuo.mutation.AddVersion(i)
+	return uuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uuo *UserUpdateOne) SetCreatedAt(t time.Time) *UserUpdateOne {
 	uuo.mutation.SetCreatedAt(t)
@@ -554,6 +1103,40 @@ func (uuo *UserUpdateOne) SetAccount(a *Account) *UserUpdateOne {
 	return uuo.SetAccountID(a.ID)
 }
 
+// AddMembershipIDs adds the "memberships" edge to the Membership entity by IDs.
+func (uuo *UserUpdateOne) AddMembershipIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddMembershipIDs(ids...)
+	return uuo
+}
+
+// AddMemberships adds the "memberships" edges to the Membership entity.
+func (uuo *UserUpdateOne) AddMemberships(m ...*Membership) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMembershipIDs(ids...)
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (uuo *UserUpdateOne) SetProfileID(id int) *UserUpdateOne {
+	uuo.mutation.SetProfileID(id)
+	return uuo
+}
+
+// SetNillableProfileID sets the "profile" edge to the Profile entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableProfileID(id *int) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetProfileID(*id)
+	}
+	return uuo
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (uuo *UserUpdateOne) SetProfile(p *Profile) *UserUpdateOne {
+	return uuo.SetProfileID(p.ID)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -565,6 +1148,33 @@ func (uuo *UserUpdateOne) ClearAccount() *UserUpdateOne {
 	return uuo
 }
 
+// ClearMemberships clears all "memberships" edges to the Membership entity.
+func (uuo *UserUpdateOne) ClearMemberships() *UserUpdateOne {
+	uuo.mutation.ClearMemberships()
+	return uuo
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to Membership entities by IDs.
+func (uuo *UserUpdateOne) RemoveMembershipIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveMembershipIDs(ids...)
+	return uuo
+}
+
+// RemoveMemberships removes "memberships" edges to Membership entities.
+func (uuo *UserUpdateOne) RemoveMemberships(m ...*Membership) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMembershipIDs(ids...)
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (uuo *UserUpdateOne) ClearProfile() *UserUpdateOne {
+	uuo.mutation.ClearProfile()
+	return uuo
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	uuo.mutation.Where(ps...)
@@ -580,7 +1190,9 @@ func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne
 
 // Save executes the query and returns the updated User entity.
 func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	uuo.defaults()
+	if err := uuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
 }
 
@@ -607,11 +1219,15 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (uuo *UserUpdateOne) defaults() {
+func (uuo *UserUpdateOne) defaults() error {
 	if _, ok := uuo.mutation.UpdatedAt(); !ok {
+		if user.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized user.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := user.UpdateDefaultUpdatedAt()
 		uuo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -636,9 +1252,25 @@ func (uuo *UserUpdateOne) check() error {
 			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "User.phone": %w`, err)}
 		}
 	}
+	if v, ok := uuo.mutation.PermVersion(); ok {
+		if err := user.PermVersionValidator(v); err != nil {
+			return &ValidationError{Name: "perm_version", err: fmt.Errorf(`ent: validator failed for field "User.perm_version": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Version(); ok {
+		if err := user.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "User.version": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uuo *UserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdateOne {
+	uuo.modifiers = append(uuo.modifiers, modifiers...)
+	return uuo
+}
+
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if err := uuo.check(); err != nil {
 		return _node, err
@@ -668,6 +1300,12 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			}
 		}
 	}
+	if value, ok := uuo.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := uuo.mutation.FirstName(); ok {
 		_spec.SetField(user.FieldFirstName, field.TypeString, value)
 	}
@@ -680,12 +1318,24 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.Phone(); ok {
 		_spec.SetField(user.FieldPhone, field.TypeString, value)
 	}
+	if value, ok := uuo.mutation.PhoneHash(); ok {
+		_spec.SetField(user.FieldPhoneHash, field.TypeString, value)
+	}
+	if uuo.mutation.PhoneHashCleared() {
+		_spec.ClearField(user.FieldPhoneHash, field.TypeString)
+	}
 	if value, ok := uuo.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
 	if uuo.mutation.EmailCleared() {
 		_spec.ClearField(user.FieldEmail, field.TypeString)
 	}
+	if value, ok := uuo.mutation.EmailHash(); ok {
+		_spec.SetField(user.FieldEmailHash, field.TypeString, value)
+	}
+	if uuo.mutation.EmailHashCleared() {
+		_spec.ClearField(user.FieldEmailHash, field.TypeString)
+	}
 	if value, ok := uuo.mutation.Avatar(); ok {
 		_spec.SetField(user.FieldAvatar, field.TypeString, value)
 	}
@@ -698,12 +1348,45 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if uuo.mutation.WardCodeCleared() {
 		_spec.ClearField(user.FieldWardCode, field.TypeString)
 	}
+	if value, ok := uuo.mutation.ProvinceCode(); ok {
+		_spec.SetField(user.FieldProvinceCode, field.TypeString, value)
+	}
+	if uuo.mutation.ProvinceCodeCleared() {
+		_spec.ClearField(user.FieldProvinceCode, field.TypeString)
+	}
 	if value, ok := uuo.mutation.Address(); ok {
 		_spec.SetField(user.FieldAddress, field.TypeString, value)
 	}
 	if uuo.mutation.AddressCleared() {
 		_spec.ClearField(user.FieldAddress, field.TypeString)
 	}
+	if value, ok := uuo.mutation.CustomAttributes(); ok {
+		_spec.SetField(user.FieldCustomAttributes, field.TypeJSON, value)
+	}
+	if uuo.mutation.CustomAttributesCleared() {
+		_spec.ClearField(user.FieldCustomAttributes, field.TypeJSON)
+	}
+	if value, ok := uuo.mutation.SearchText(); ok {
+		_spec.SetField(user.FieldSearchText, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.AnonymizedAt(); ok {
+		_spec.SetField(user.FieldAnonymizedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.AnonymizedAtCleared() {
+		_spec.ClearField(user.FieldAnonymizedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.PermVersion(); ok {
+		_spec.SetField(user.FieldPermVersion, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedPermVersion(); ok {
+		_spec.AddField(user.FieldPermVersion, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.Version(); ok {
+		_spec.SetField(user.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedVersion(); ok {
+		_spec.AddField(user.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := uuo.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -739,6 +1422,81 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !uuo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.ProfileTable,
+			Columns: []string{user.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.ProfileTable,
+			Columns: []string{user.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(uuo.modifiers...)
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/huynhthanhthao/hrm_user_service/ent/usermerge"
+)
+
+// UserMerge is the model entity for the UserMerge schema.
+type UserMerge struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// MergedUserID holds the value of the "merged_user_id" field.
+	MergedUserID int `json:"merged_user_id"`
+	// SurvivorUserID holds the value of the "survivor_user_id" field.
+	SurvivorUserID int `json:"survivor_user_id"`
+	// User thực hiện gộp; nil nếu gọi nội bộ
+	MergedBy *int `json:"merged_by"`
+	// Username của account bị xóa do survivor đã có account
+	MergedUsername *string `json:"merged_username"`
+	// MovedOrgIds holds the value of the "moved_org_ids" field.
+	MovedOrgIds []int64 `json:"moved_org_ids"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserMerge) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usermerge.FieldMovedOrgIds:
+			values[i] = new([]byte)
+		case usermerge.FieldID, usermerge.FieldMergedUserID, usermerge.FieldSurvivorUserID, usermerge.FieldMergedBy:
+			values[i] = new(sql.NullInt64)
+		case usermerge.FieldMergedUsername:
+			values[i] = new(sql.NullString)
+		case usermerge.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserMerge fields.
+func (um *UserMerge) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usermerge.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			um.ID = int(value.Int64)
+		case usermerge.FieldMergedUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field merged_user_id", values[i])
+			} else if value.Valid {
+				um.MergedUserID = int(value.Int64)
+			}
+		case usermerge.FieldSurvivorUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field survivor_user_id", values[i])
+			} else if value.Valid {
+				um.SurvivorUserID = int(value.Int64)
+			}
+		case usermerge.FieldMergedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field merged_by", values[i])
+			} else if value.Valid {
+				um.MergedBy = new(int)
+				*um.MergedBy = int(value.Int64)
+			}
+		case usermerge.FieldMergedUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field merged_username", values[i])
+			} else if value.Valid {
+				um.MergedUsername = new(string)
+				*um.MergedUsername = value.String
+			}
+		case usermerge.FieldMovedOrgIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field moved_org_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &um.MovedOrgIds); err != nil {
+					return fmt.Errorf("unmarshal field moved_org_ids: %w", err)
+				}
+			}
+		case usermerge.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				um.CreatedAt = value.Time
+			}
+		default:
+			um.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserMerge.
+// This includes values selected through modifiers, order, etc.
+func (um *UserMerge) Value(name string) (ent.Value, error) {
+	return um.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserMerge.
+// Note that you need to call UserMerge.Unwrap() before calling this method if this UserMerge
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (um *UserMerge) Update() *UserMergeUpdateOne {
+	return NewUserMergeClient(um.config).UpdateOne(um)
+}
+
+// Unwrap unwraps the UserMerge entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (um *UserMerge) Unwrap() *UserMerge {
+	_tx, ok := um.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserMerge is not a transactional entity")
+	}
+	um.config.driver = _tx.drv
+	return um
+}
+
+// String implements the fmt.Stringer.
+func (um *UserMerge) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserMerge(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", um.ID))
+	builder.WriteString("merged_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", um.MergedUserID))
+	builder.WriteString(", ")
+	builder.WriteString("survivor_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", um.SurvivorUserID))
+	builder.WriteString(", ")
+	if v := um.MergedBy; v != nil {
+		builder.WriteString("merged_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := um.MergedUsername; v != nil {
+		builder.WriteString("merged_username=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("moved_org_ids=")
+	builder.WriteString(fmt.Sprintf("%v", um.MovedOrgIds))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(um.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserMerges is a parsable slice of UserMerge.
+type UserMerges []*UserMerge
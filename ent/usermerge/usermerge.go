@@ -0,0 +1,93 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usermerge
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the usermerge type in the database.
+	Label = "user_merge"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMergedUserID holds the string denoting the merged_user_id field in the database.
+	FieldMergedUserID = "merged_user_id"
+	// FieldSurvivorUserID holds the string denoting the survivor_user_id field in the database.
+	FieldSurvivorUserID = "survivor_user_id"
+	// FieldMergedBy holds the string denoting the merged_by field in the database.
+	FieldMergedBy = "merged_by"
+	// FieldMergedUsername holds the string denoting the merged_username field in the database.
+	FieldMergedUsername = "merged_username"
+	// FieldMovedOrgIds holds the string denoting the moved_org_ids field in the database.
+	FieldMovedOrgIds = "moved_org_ids"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the usermerge in the database.
+	Table = "user_merges"
+)
+
+// Columns holds all SQL columns for usermerge fields.
+var Columns = []string{
+	FieldID,
+	FieldMergedUserID,
+	FieldSurvivorUserID,
+	FieldMergedBy,
+	FieldMergedUsername,
+	FieldMovedOrgIds,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MergedUserIDValidator is a validator for the "merged_user_id" field. It is called by the builders before save.
+	MergedUserIDValidator func(int) error
+	// SurvivorUserIDValidator is a validator for the "survivor_user_id" field. It is called by the builders before save.
+	SurvivorUserIDValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the UserMerge queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMergedUserID orders the results by the merged_user_id field.
+func ByMergedUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMergedUserID, opts...).ToFunc()
+}
+
+// BySurvivorUserID orders the results by the survivor_user_id field.
+func BySurvivorUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSurvivorUserID, opts...).ToFunc()
+}
+
+// ByMergedBy orders the results by the merged_by field.
+func ByMergedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMergedBy, opts...).ToFunc()
+}
+
+// ByMergedUsername orders the results by the merged_username field.
+func ByMergedUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMergedUsername, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
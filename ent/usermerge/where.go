@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usermerge
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldLTE(FieldID, id))
+}
+
+// MergedUserID applies equality check predicate on the "merged_user_id" field. It's identical to MergedUserIDEQ.
+func MergedUserID(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldEQ(FieldMergedUserID, v))
+}
+
+// SurvivorUserID applies equality check predicate on the "survivor_user_id" field. It's identical to SurvivorUserIDEQ.
+func SurvivorUserID(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldEQ(FieldSurvivorUserID, v))
+}
+
+// MergedBy applies equality check predicate on the "merged_by" field. It's identical to MergedByEQ.
+func MergedBy(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldEQ(FieldMergedBy, v))
+}
+
+// MergedUsername applies equality check predicate on the "merged_username" field. It's identical to MergedUsernameEQ.
+func MergedUsername(v string) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldEQ(FieldMergedUsername, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// MergedUserIDEQ applies the EQ predicate on the "merged_user_id" field.
+func MergedUserIDEQ(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldEQ(FieldMergedUserID, v))
+}
+
+// MergedUserIDNEQ applies the NEQ predicate on the "merged_user_id" field.
+func MergedUserIDNEQ(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldNEQ(FieldMergedUserID, v))
+}
+
+// MergedUserIDIn applies the In predicate on the "merged_user_id" field.
+func MergedUserIDIn(vs ...int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldIn(FieldMergedUserID, vs...))
+}
+
+// MergedUserIDNotIn applies the NotIn predicate on the "merged_user_id" field.
+func MergedUserIDNotIn(vs ...int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldNotIn(FieldMergedUserID, vs...))
+}
+
+// MergedUserIDGT applies the GT predicate on the "merged_user_id" field.
+func MergedUserIDGT(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldGT(FieldMergedUserID, v))
+}
+
+// MergedUserIDGTE applies the GTE predicate on the "merged_user_id" field.
+func MergedUserIDGTE(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldGTE(FieldMergedUserID, v))
+}
+
+// MergedUserIDLT applies the LT predicate on the "merged_user_id" field.
+func MergedUserIDLT(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldLT(FieldMergedUserID, v))
+}
+
+// MergedUserIDLTE applies the LTE predicate on the "merged_user_id" field.
+func MergedUserIDLTE(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldLTE(FieldMergedUserID, v))
+}
+
+// SurvivorUserIDEQ applies the EQ predicate on the "survivor_user_id" field.
+func SurvivorUserIDEQ(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldEQ(FieldSurvivorUserID, v))
+}
+
+// SurvivorUserIDNEQ applies the NEQ predicate on the "survivor_user_id" field.
+func SurvivorUserIDNEQ(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldNEQ(FieldSurvivorUserID, v))
+}
+
+// SurvivorUserIDIn applies the In predicate on the "survivor_user_id" field.
+func SurvivorUserIDIn(vs ...int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldIn(FieldSurvivorUserID, vs...))
+}
+
+// SurvivorUserIDNotIn applies the NotIn predicate on the "survivor_user_id" field.
+func SurvivorUserIDNotIn(vs ...int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldNotIn(FieldSurvivorUserID, vs...))
+}
+
+// SurvivorUserIDGT applies the GT predicate on the "survivor_user_id" field.
+func SurvivorUserIDGT(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldGT(FieldSurvivorUserID, v))
+}
+
+// SurvivorUserIDGTE applies the GTE predicate on the "survivor_user_id" field.
+func SurvivorUserIDGTE(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldGTE(FieldSurvivorUserID, v))
+}
+
+// SurvivorUserIDLT applies the LT predicate on the "survivor_user_id" field.
+func SurvivorUserIDLT(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldLT(FieldSurvivorUserID, v))
+}
+
+// SurvivorUserIDLTE applies the LTE predicate on the "survivor_user_id" field.
+func SurvivorUserIDLTE(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldLTE(FieldSurvivorUserID, v))
+}
+
+// MergedByEQ applies the EQ predicate on the "merged_by" field.
+func MergedByEQ(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldEQ(FieldMergedBy, v))
+}
+
+// MergedByNEQ applies the NEQ predicate on the "merged_by" field.
+func MergedByNEQ(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldNEQ(FieldMergedBy, v))
+}
+
+// MergedByIn applies the In predicate on the "merged_by" field.
+func MergedByIn(vs ...int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldIn(FieldMergedBy, vs...))
+}
+
+// MergedByNotIn applies the NotIn predicate on the "merged_by" field.
+func MergedByNotIn(vs ...int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldNotIn(FieldMergedBy, vs...))
+}
+
+// MergedByGT applies the GT predicate on the "merged_by" field.
+func MergedByGT(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldGT(FieldMergedBy, v))
+}
+
+// MergedByGTE applies the GTE predicate on the "merged_by" field.
+func MergedByGTE(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldGTE(FieldMergedBy, v))
+}
+
+// MergedByLT applies the LT predicate on the "merged_by" field.
+func MergedByLT(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldLT(FieldMergedBy, v))
+}
+
+// MergedByLTE applies the LTE predicate on the "merged_by" field.
+func MergedByLTE(v int) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldLTE(FieldMergedBy, v))
+}
+
+// MergedByIsNil applies the IsNil predicate on the "merged_by" field.
+func MergedByIsNil() predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldIsNull(FieldMergedBy))
+}
+
+// MergedByNotNil applies the NotNil predicate on the "merged_by" field.
+func MergedByNotNil() predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldNotNull(FieldMergedBy))
+}
+
+// MergedUsernameEQ applies the EQ predicate on the "merged_username" field.
+func MergedUsernameEQ(v string) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldEQ(FieldMergedUsername, v))
+}
+
+// MergedUsernameNEQ applies the NEQ predicate on the "merged_username" field.
+func MergedUsernameNEQ(v string) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldNEQ(FieldMergedUsername, v))
+}
+
+// MergedUsernameIn applies the In predicate on the "merged_username" field.
+func MergedUsernameIn(vs ...string) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldIn(FieldMergedUsername, vs...))
+}
+
+// MergedUsernameNotIn applies the NotIn predicate on the "merged_username" field.
+func MergedUsernameNotIn(vs ...string) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldNotIn(FieldMergedUsername, vs...))
+}
+
+// MergedUsernameGT applies the GT predicate on the "merged_username" field.
+func MergedUsernameGT(v string) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldGT(FieldMergedUsername, v))
+}
+
+// MergedUsernameGTE applies the GTE predicate on the "merged_username" field.
+func MergedUsernameGTE(v string) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldGTE(FieldMergedUsername, v))
+}
+
+// MergedUsernameLT applies the LT predicate on the "merged_username" field.
+func MergedUsernameLT(v string) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldLT(FieldMergedUsername, v))
+}
+
+// MergedUsernameLTE applies the LTE predicate on the "merged_username" field.
+func MergedUsernameLTE(v string) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldLTE(FieldMergedUsername, v))
+}
+
+// MergedUsernameContains applies the Contains predicate on the "merged_username" field.
+func MergedUsernameContains(v string) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldContains(FieldMergedUsername, v))
+}
+
+// MergedUsernameHasPrefix applies the HasPrefix predicate on the "merged_username" field.
+func MergedUsernameHasPrefix(v string) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldHasPrefix(FieldMergedUsername, v))
+}
+
+// MergedUsernameHasSuffix applies the HasSuffix predicate on the "merged_username" field.
+func MergedUsernameHasSuffix(v string) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldHasSuffix(FieldMergedUsername, v))
+}
+
+// MergedUsernameIsNil applies the IsNil predicate on the "merged_username" field.
+func MergedUsernameIsNil() predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldIsNull(FieldMergedUsername))
+}
+
+// MergedUsernameNotNil applies the NotNil predicate on the "merged_username" field.
+func MergedUsernameNotNil() predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldNotNull(FieldMergedUsername))
+}
+
+// MergedUsernameEqualFold applies the EqualFold predicate on the "merged_username" field.
+func MergedUsernameEqualFold(v string) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldEqualFold(FieldMergedUsername, v))
+}
+
+// MergedUsernameContainsFold applies the ContainsFold predicate on the "merged_username" field.
+func MergedUsernameContainsFold(v string) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldContainsFold(FieldMergedUsername, v))
+}
+
+// MovedOrgIdsIsNil applies the IsNil predicate on the "moved_org_ids" field.
+func MovedOrgIdsIsNil() predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldIsNull(FieldMovedOrgIds))
+}
+
+// MovedOrgIdsNotNil applies the NotNil predicate on the "moved_org_ids" field.
+func MovedOrgIdsNotNil() predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldNotNull(FieldMovedOrgIds))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserMerge {
+	return predicate.UserMerge(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserMerge) predicate.UserMerge {
+	return predicate.UserMerge(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserMerge) predicate.UserMerge {
+	return predicate.UserMerge(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserMerge) predicate.UserMerge {
+	return predicate.UserMerge(sql.NotPredicates(p))
+}
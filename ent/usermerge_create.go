@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/usermerge"
+)
+
+// UserMergeCreate is the builder for creating a UserMerge entity.
+type UserMergeCreate struct {
+	config
+	mutation *UserMergeMutation
+	hooks    []Hook
+}
+
+// SetMergedUserID sets the "merged_user_id" field.
+func (umc *UserMergeCreate) SetMergedUserID(i int) *UserMergeCreate {
+	umc.mutation.SetMergedUserID(i)
+	return umc
+}
+
+// SetSurvivorUserID sets the "survivor_user_id" field.
+func (umc *UserMergeCreate) SetSurvivorUserID(i int) *UserMergeCreate {
+	umc.mutation.SetSurvivorUserID(i)
+	return umc
+}
+
+// SetMergedBy sets the "merged_by" field.
+func (umc *UserMergeCreate) SetMergedBy(i int) *UserMergeCreate {
+	umc.mutation.SetMergedBy(i)
+	return umc
+}
+
+// SetNillableMergedBy sets the "merged_by" field if the given value is not nil.
+func (umc *UserMergeCreate) SetNillableMergedBy(i *int) *UserMergeCreate {
+	if i != nil {
+		umc.SetMergedBy(*i)
+	}
+	return umc
+}
+
+// SetMergedUsername sets the "merged_username" field.
+func (umc *UserMergeCreate) SetMergedUsername(s string) *UserMergeCreate {
+	umc.mutation.SetMergedUsername(s)
+	return umc
+}
+
+// SetNillableMergedUsername sets the "merged_username" field if the given value is not nil.
+func (umc *UserMergeCreate) SetNillableMergedUsername(s *string) *UserMergeCreate {
+	if s != nil {
+		umc.SetMergedUsername(*s)
+	}
+	return umc
+}
+
+// SetMovedOrgIds sets the "moved_org_ids" field.
+func (umc *UserMergeCreate) SetMovedOrgIds(i []int64) *UserMergeCreate {
+	umc.mutation.SetMovedOrgIds(i)
+	return umc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (umc *UserMergeCreate) SetCreatedAt(t time.Time) *UserMergeCreate {
+	umc.mutation.SetCreatedAt(t)
+	return umc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (umc *UserMergeCreate) SetNillableCreatedAt(t *time.Time) *UserMergeCreate {
+	if t != nil {
+		umc.SetCreatedAt(*t)
+	}
+	return umc
+}
+
+// Mutation returns the UserMergeMutation object of the builder.
+func (umc *UserMergeCreate) Mutation() *UserMergeMutation {
+	return umc.mutation
+}
+
+// Save creates the UserMerge in the database.
+func (umc *UserMergeCreate) Save(ctx context.Context) (*UserMerge, error) {
+	umc.defaults()
+	return withHooks(ctx, umc.sqlSave, umc.mutation, umc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (umc *UserMergeCreate) SaveX(ctx context.Context) *UserMerge {
+	v, err := umc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (umc *UserMergeCreate) Exec(ctx context.Context) error {
+	_, err := umc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (umc *UserMergeCreate) ExecX(ctx context.Context) {
+	if err := umc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (umc *UserMergeCreate) defaults() {
+	if _, ok := umc.mutation.CreatedAt(); !ok {
+		v := usermerge.DefaultCreatedAt()
+		umc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (umc *UserMergeCreate) check() error {
+	if _, ok := umc.mutation.MergedUserID(); !ok {
+		return &ValidationError{Name: "merged_user_id", err: errors.New(`ent: missing required field "UserMerge.merged_user_id"`)}
+	}
+	if v, ok := umc.mutation.MergedUserID(); ok {
+		if err := usermerge.MergedUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "merged_user_id", err: fmt.Errorf(`ent: validator failed for field "UserMerge.merged_user_id": %w`, err)}
+		}
+	}
+	if _, ok := umc.mutation.SurvivorUserID(); !ok {
+		return &ValidationError{Name: "survivor_user_id", err: errors.New(`ent: missing required field "UserMerge.survivor_user_id"`)}
+	}
+	if v, ok := umc.mutation.SurvivorUserID(); ok {
+		if err := usermerge.SurvivorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "survivor_user_id", err: fmt.Errorf(`ent: validator failed for field "UserMerge.survivor_user_id": %w`, err)}
+		}
+	}
+	if _, ok := umc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserMerge.created_at"`)}
+	}
+	return nil
+}
+
+func (umc *UserMergeCreate) sqlSave(ctx context.Context) (*UserMerge, error) {
+	if err := umc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := umc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, umc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	umc.mutation.id = &_node.ID
+	umc.mutation.done = true
+	return _node, nil
+}
+
+func (umc *UserMergeCreate) createSpec() (*UserMerge, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserMerge{config: umc.config}
+		_spec = sqlgraph.NewCreateSpec(usermerge.Table, sqlgraph.NewFieldSpec(usermerge.FieldID, field.TypeInt))
+	)
+	if value, ok := umc.mutation.MergedUserID(); ok {
+		_spec.SetField(usermerge.FieldMergedUserID, field.TypeInt, value)
+		_node.MergedUserID = value
+	}
+	if value, ok := umc.mutation.SurvivorUserID(); ok {
+		_spec.SetField(usermerge.FieldSurvivorUserID, field.TypeInt, value)
+		_node.SurvivorUserID = value
+	}
+	if value, ok := umc.mutation.MergedBy(); ok {
+		_spec.SetField(usermerge.FieldMergedBy, field.TypeInt, value)
+		_node.MergedBy = &value
+	}
+	if value, ok := umc.mutation.MergedUsername(); ok {
+		_spec.SetField(usermerge.FieldMergedUsername, field.TypeString, value)
+		_node.MergedUsername = &value
+	}
+	if value, ok := umc.mutation.MovedOrgIds(); ok {
+		_spec.SetField(usermerge.FieldMovedOrgIds, field.TypeJSON, value)
+		_node.MovedOrgIds = value
+	}
+	if value, ok := umc.mutation.CreatedAt(); ok {
+		_spec.SetField(usermerge.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// UserMergeCreateBulk is the builder for creating many UserMerge entities in bulk.
+type UserMergeCreateBulk struct {
+	config
+	err      error
+	builders []*UserMergeCreate
+}
+
+// Save creates the UserMerge entities in the database.
+func (umcb *UserMergeCreateBulk) Save(ctx context.Context) ([]*UserMerge, error) {
+	if umcb.err != nil {
+		return nil, umcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(umcb.builders))
+	nodes := make([]*UserMerge, len(umcb.builders))
+	mutators := make([]Mutator, len(umcb.builders))
+	for i := range umcb.builders {
+		func(i int, root context.Context) {
+			builder := umcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserMergeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, umcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, umcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, umcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (umcb *UserMergeCreateBulk) SaveX(ctx context.Context) []*UserMerge {
+	v, err := umcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (umcb *UserMergeCreateBulk) Exec(ctx context.Context) error {
+	_, err := umcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (umcb *UserMergeCreateBulk) ExecX(ctx context.Context) {
+	if err := umcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
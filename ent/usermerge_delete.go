@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+	"github.com/huynhthanhthao/hrm_user_service/ent/usermerge"
+)
+
+// UserMergeDelete is the builder for deleting a UserMerge entity.
+type UserMergeDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserMergeMutation
+}
+
+// Where appends a list predicates to the UserMergeDelete builder.
+func (umd *UserMergeDelete) Where(ps ...predicate.UserMerge) *UserMergeDelete {
+	umd.mutation.Where(ps...)
+	return umd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (umd *UserMergeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, umd.sqlExec, umd.mutation, umd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (umd *UserMergeDelete) ExecX(ctx context.Context) int {
+	n, err := umd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (umd *UserMergeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(usermerge.Table, sqlgraph.NewFieldSpec(usermerge.FieldID, field.TypeInt))
+	if ps := umd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, umd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	umd.mutation.done = true
+	return affected, err
+}
+
+// UserMergeDeleteOne is the builder for deleting a single UserMerge entity.
+type UserMergeDeleteOne struct {
+	umd *UserMergeDelete
+}
+
+// Where appends a list predicates to the UserMergeDelete builder.
+func (umdo *UserMergeDeleteOne) Where(ps ...predicate.UserMerge) *UserMergeDeleteOne {
+	umdo.umd.mutation.Where(ps...)
+	return umdo
+}
+
+// Exec executes the deletion query.
+func (umdo *UserMergeDeleteOne) Exec(ctx context.Context) error {
+	n, err := umdo.umd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{usermerge.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (umdo *UserMergeDeleteOne) ExecX(ctx context.Context) {
+	if err := umdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
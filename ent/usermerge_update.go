@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+	"github.com/huynhthanhthao/hrm_user_service/ent/usermerge"
+)
+
+// UserMergeUpdate is the builder for updating UserMerge entities.
+type UserMergeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *UserMergeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the UserMergeUpdate builder.
+func (umu *UserMergeUpdate) Where(ps ...predicate.UserMerge) *UserMergeUpdate {
+	umu.mutation.Where(ps...)
+	return umu
+}
+
+// SetSurvivorUserID sets the "survivor_user_id" field.
+func (umu *UserMergeUpdate) SetSurvivorUserID(i int) *UserMergeUpdate {
+	umu.mutation.ResetSurvivorUserID()
+	umu.mutation.SetSurvivorUserID(i)
+	return umu
+}
+
+// SetNillableSurvivorUserID sets the "survivor_user_id" field if the given value is not nil.
+func (umu *UserMergeUpdate) SetNillableSurvivorUserID(i *int) *UserMergeUpdate {
+	if i != nil {
+		umu.SetSurvivorUserID(*i)
+	}
+	return umu
+}
+
+// AddSurvivorUserID adds i to the "survivor_user_id" field.
+func (umu *UserMergeUpdate) AddSurvivorUserID(i int) *UserMergeUpdate {
+	umu.mutation.AddSurvivorUserID(i)
+	return umu
+}
+
+// SetMergedUsername sets the "merged_username" field.
+func (umu *UserMergeUpdate) SetMergedUsername(s string) *UserMergeUpdate {
+	umu.mutation.SetMergedUsername(s)
+	return umu
+}
+
+// SetNillableMergedUsername sets the "merged_username" field if the given value is not nil.
+func (umu *UserMergeUpdate) SetNillableMergedUsername(s *string) *UserMergeUpdate {
+	if s != nil {
+		umu.SetMergedUsername(*s)
+	}
+	return umu
+}
+
+// ClearMergedUsername clears the value of the "merged_username" field.
+func (umu *UserMergeUpdate) ClearMergedUsername() *UserMergeUpdate {
+	umu.mutation.ClearMergedUsername()
+	return umu
+}
+
+// SetMovedOrgIds sets the "moved_org_ids" field.
+func (umu *UserMergeUpdate) SetMovedOrgIds(i []int64) *UserMergeUpdate {
+	umu.mutation.SetMovedOrgIds(i)
+	return umu
+}
+
+// AppendMovedOrgIds appends i to the "moved_org_ids" field.
+func (umu *UserMergeUpdate) AppendMovedOrgIds(i []int64) *UserMergeUpdate {
+	umu.mutation.AppendMovedOrgIds(i)
+	return umu
+}
+
+// ClearMovedOrgIds clears the value of the "moved_org_ids" field.
+func (umu *UserMergeUpdate) ClearMovedOrgIds() *UserMergeUpdate {
+	umu.mutation.ClearMovedOrgIds()
+	return umu
+}
+
+// Mutation returns the UserMergeMutation object of the builder.
+func (umu *UserMergeUpdate) Mutation() *UserMergeMutation {
+	return umu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (umu *UserMergeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, umu.sqlSave, umu.mutation, umu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (umu *UserMergeUpdate) SaveX(ctx context.Context) int {
+	affected, err := umu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (umu *UserMergeUpdate) Exec(ctx context.Context) error {
+	_, err := umu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (umu *UserMergeUpdate) ExecX(ctx context.Context) {
+	if err := umu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (umu *UserMergeUpdate) check() error {
+	if v, ok := umu.mutation.SurvivorUserID(); ok {
+		if err := usermerge.SurvivorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "survivor_user_id", err: fmt.Errorf(`ent: validator failed for field "UserMerge.survivor_user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (umu *UserMergeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserMergeUpdate {
+	umu.modifiers = append(umu.modifiers, modifiers...)
+	return umu
+}
+
+func (umu *UserMergeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := umu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usermerge.Table, usermerge.Columns, sqlgraph.NewFieldSpec(usermerge.FieldID, field.TypeInt))
+	if ps := umu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := umu.mutation.SurvivorUserID(); ok {
+		_spec.SetField(usermerge.FieldSurvivorUserID, field.TypeInt, value)
+	}
+	if value, ok := umu.mutation.AddedSurvivorUserID(); ok {
+		_spec.AddField(usermerge.FieldSurvivorUserID, field.TypeInt, value)
+	}
+	if umu.mutation.MergedByCleared() {
+		_spec.ClearField(usermerge.FieldMergedBy, field.TypeInt)
+	}
+	if value, ok := umu.mutation.MergedUsername(); ok {
+		_spec.SetField(usermerge.FieldMergedUsername, field.TypeString, value)
+	}
+	if umu.mutation.MergedUsernameCleared() {
+		_spec.ClearField(usermerge.FieldMergedUsername, field.TypeString)
+	}
+	if value, ok := umu.mutation.MovedOrgIds(); ok {
+		_spec.SetField(usermerge.FieldMovedOrgIds, field.TypeJSON, value)
+	}
+	if value, ok := umu.mutation.AppendedMovedOrgIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usermerge.FieldMovedOrgIds, value)
+		})
+	}
+	if umu.mutation.MovedOrgIdsCleared() {
+		_spec.ClearField(usermerge.FieldMovedOrgIds, field.TypeJSON)
+	}
+	_spec.AddModifiers(umu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, umu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usermerge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	umu.mutation.done = true
+	return n, nil
+}
+
+// UserMergeUpdateOne is the builder for updating a single UserMerge entity.
+type UserMergeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserMergeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetSurvivorUserID sets the "survivor_user_id" field.
+func (umuo *UserMergeUpdateOne) SetSurvivorUserID(i int) *UserMergeUpdateOne {
+	umuo.mutation.ResetSurvivorUserID()
+	umuo.mutation.SetSurvivorUserID(i)
+	return umuo
+}
+
+// SetNillableSurvivorUserID sets the "survivor_user_id" field if the given value is not nil.
+func (umuo *UserMergeUpdateOne) SetNillableSurvivorUserID(i *int) *UserMergeUpdateOne {
+	if i != nil {
+		umuo.SetSurvivorUserID(*i)
+	}
+	return umuo
+}
+
+// AddSurvivorUserID adds i to the "survivor_user_id" field.
+func (umuo *UserMergeUpdateOne) AddSurvivorUserID(i int) *UserMergeUpdateOne {
+	umuo.mutation.AddSurvivorUserID(i)
+	return umuo
+}
+
+// SetMergedUsername sets the "merged_username" field.
+func (umuo *UserMergeUpdateOne) SetMergedUsername(s string) *UserMergeUpdateOne {
+	umuo.mutation.SetMergedUsername(s)
+	return umuo
+}
+
+// SetNillableMergedUsername sets the "merged_username" field if the given value is not nil.
+func (umuo *UserMergeUpdateOne) SetNillableMergedUsername(s *string) *UserMergeUpdateOne {
+	if s != nil {
+		umuo.SetMergedUsername(*s)
+	}
+	return umuo
+}
+
+// ClearMergedUsername clears the value of the "merged_username" field.
+func (umuo *UserMergeUpdateOne) ClearMergedUsername() *UserMergeUpdateOne {
+	umuo.mutation.ClearMergedUsername()
+	return umuo
+}
+
+// SetMovedOrgIds sets the "moved_org_ids" field.
+func (umuo *UserMergeUpdateOne) SetMovedOrgIds(i []int64) *UserMergeUpdateOne {
+	umuo.mutation.SetMovedOrgIds(i)
+	return umuo
+}
+
+// AppendMovedOrgIds appends i to the "moved_org_ids" field.
+func (umuo *UserMergeUpdateOne) AppendMovedOrgIds(i []int64) *UserMergeUpdateOne {
+	umuo.mutation.AppendMovedOrgIds(i)
+	return umuo
+}
+
+// ClearMovedOrgIds clears the value of the "moved_org_ids" field.
+func (umuo *UserMergeUpdateOne) ClearMovedOrgIds() *UserMergeUpdateOne {
+	umuo.mutation.ClearMovedOrgIds()
+	return umuo
+}
+
+// Mutation returns the UserMergeMutation object of the builder.
+func (umuo *UserMergeUpdateOne) Mutation() *UserMergeMutation {
+	return umuo.mutation
+}
+
+// Where appends a list predicates to the UserMergeUpdate builder.
+func (umuo *UserMergeUpdateOne) Where(ps ...predicate.UserMerge) *UserMergeUpdateOne {
+	umuo.mutation.Where(ps...)
+	return umuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (umuo *UserMergeUpdateOne) Select(field string, fields ...string) *UserMergeUpdateOne {
+	umuo.fields = append([]string{field}, fields...)
+	return umuo
+}
+
+// Save executes the query and returns the updated UserMerge entity.
+func (umuo *UserMergeUpdateOne) Save(ctx context.Context) (*UserMerge, error) {
+	return withHooks(ctx, umuo.sqlSave, umuo.mutation, umuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (umuo *UserMergeUpdateOne) SaveX(ctx context.Context) *UserMerge {
+	node, err := umuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (umuo *UserMergeUpdateOne) Exec(ctx context.Context) error {
+	_, err := umuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (umuo *UserMergeUpdateOne) ExecX(ctx context.Context) {
+	if err := umuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (umuo *UserMergeUpdateOne) check() error {
+	if v, ok := umuo.mutation.SurvivorUserID(); ok {
+		if err := usermerge.SurvivorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "survivor_user_id", err: fmt.Errorf(`ent: validator failed for field "UserMerge.survivor_user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (umuo *UserMergeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserMergeUpdateOne {
+	umuo.modifiers = append(umuo.modifiers, modifiers...)
+	return umuo
+}
+
+func (umuo *UserMergeUpdateOne) sqlSave(ctx context.Context) (_node *UserMerge, err error) {
+	if err := umuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usermerge.Table, usermerge.Columns, sqlgraph.NewFieldSpec(usermerge.FieldID, field.TypeInt))
+	id, ok := umuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserMerge.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := umuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usermerge.FieldID)
+		for _, f := range fields {
+			if !usermerge.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usermerge.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := umuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := umuo.mutation.SurvivorUserID(); ok {
+		_spec.SetField(usermerge.FieldSurvivorUserID, field.TypeInt, value)
+	}
+	if value, ok := umuo.mutation.AddedSurvivorUserID(); ok {
+		_spec.AddField(usermerge.FieldSurvivorUserID, field.TypeInt, value)
+	}
+	if umuo.mutation.MergedByCleared() {
+		_spec.ClearField(usermerge.FieldMergedBy, field.TypeInt)
+	}
+	if value, ok := umuo.mutation.MergedUsername(); ok {
+		_spec.SetField(usermerge.FieldMergedUsername, field.TypeString, value)
+	}
+	if umuo.mutation.MergedUsernameCleared() {
+		_spec.ClearField(usermerge.FieldMergedUsername, field.TypeString)
+	}
+	if value, ok := umuo.mutation.MovedOrgIds(); ok {
+		_spec.SetField(usermerge.FieldMovedOrgIds, field.TypeJSON, value)
+	}
+	if value, ok := umuo.mutation.AppendedMovedOrgIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usermerge.FieldMovedOrgIds, value)
+		})
+	}
+	if umuo.mutation.MovedOrgIdsCleared() {
+		_spec.ClearField(usermerge.FieldMovedOrgIds, field.TypeJSON)
+	}
+	_spec.AddModifiers(umuo.modifiers...)
+	_node = &UserMerge{config: umuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, umuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usermerge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	umuo.mutation.done = true
+	return _node, nil
+}
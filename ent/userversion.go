@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/huynhthanhthao/hrm_user_service/ent/userversion"
+)
+
+// UserVersion is the model entity for the UserVersion schema.
+type UserVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version"`
+	// FirstName holds the value of the "first_name" field.
+	FirstName string `json:"first_name"`
+	// LastName holds the value of the "last_name" field.
+	LastName string `json:"last_name"`
+	// Gender holds the value of the "gender" field.
+	Gender string `json:"gender"`
+	// Phone holds the value of the "phone" field.
+	Phone string `json:"phone"`
+	// Email holds the value of the "email" field.
+	Email *string `json:"email"`
+	// Address holds the value of the "address" field.
+	Address *string `json:"address"`
+	// WardCode holds the value of the "ward_code" field.
+	WardCode *string `json:"ward_code"`
+	// ProvinceCode holds the value of the "province_code" field.
+	ProvinceCode *string `json:"province_code"`
+	// Avatar holds the value of the "avatar" field.
+	Avatar *string `json:"avatar"`
+	// ValidFrom holds the value of the "valid_from" field.
+	ValidFrom time.Time `json:"valid_from"`
+	// nil: phiên bản hiện tại
+	ValidTo *time.Time `json:"valid_to"`
+	// User thực hiện thay đổi; nil nếu gọi nội bộ
+	ChangedBy    *int `json:"changed_by"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userversion.FieldID, userversion.FieldUserID, userversion.FieldVersion, userversion.FieldChangedBy:
+			values[i] = new(sql.NullInt64)
+		case userversion.FieldFirstName, userversion.FieldLastName, userversion.FieldGender, userversion.FieldPhone, userversion.FieldEmail, userversion.FieldAddress, userversion.FieldWardCode, userversion.FieldProvinceCode, userversion.FieldAvatar:
+			values[i] = new(sql.NullString)
+		case userversion.FieldValidFrom, userversion.FieldValidTo:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserVersion fields.
+func (uv *UserVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userversion.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			uv.ID = int(value.Int64)
+		case userversion.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				uv.UserID = int(value.Int64)
+			}
+		case userversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				uv.Version = int(value.Int64)
+			}
+		case userversion.FieldFirstName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field first_name", values[i])
+			} else if value.Valid {
+				uv.FirstName = value.String
+			}
+		case userversion.FieldLastName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_name", values[i])
+			} else if value.Valid {
+				uv.LastName = value.String
+			}
+		case userversion.FieldGender:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gender", values[i])
+			} else if value.Valid {
+				uv.Gender = value.String
+			}
+		case userversion.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				uv.Phone = value.String
+			}
+		case userversion.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				uv.Email = new(string)
+				*uv.Email = value.String
+			}
+		case userversion.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				uv.Address = new(string)
+				*uv.Address = value.String
+			}
+		case userversion.FieldWardCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ward_code", values[i])
+			} else if value.Valid {
+				uv.WardCode = new(string)
+				*uv.WardCode = value.String
+			}
+		case userversion.FieldProvinceCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field province_code", values[i])
+			} else if value.Valid {
+				uv.ProvinceCode = new(string)
+				*uv.ProvinceCode = value.String
+			}
+		case userversion.FieldAvatar:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar", values[i])
+			} else if value.Valid {
+				uv.Avatar = new(string)
+				*uv.Avatar = value.String
+			}
+		case userversion.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				uv.ValidFrom = value.Time
+			}
+		case userversion.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				uv.ValidTo = new(time.Time)
+				*uv.ValidTo = value.Time
+			}
+		case userversion.FieldChangedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_by", values[i])
+			} else if value.Valid {
+				uv.ChangedBy = new(int)
+				*uv.ChangedBy = int(value.Int64)
+			}
+		default:
+			uv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserVersion.
+// This includes values selected through modifiers, order, etc.
+func (uv *UserVersion) Value(name string) (ent.Value, error) {
+	return uv.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserVersion.
+// Note that you need to call UserVersion.Unwrap() before calling this method if this UserVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (uv *UserVersion) Update() *UserVersionUpdateOne {
+	return NewUserVersionClient(uv.config).UpdateOne(uv)
+}
+
+// Unwrap unwraps the UserVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (uv *UserVersion) Unwrap() *UserVersion {
+	_tx, ok := uv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserVersion is not a transactional entity")
+	}
+	uv.config.driver = _tx.drv
+	return uv
+}
+
+// String implements the fmt.Stringer.
+func (uv *UserVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", uv.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", uv.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", uv.Version))
+	builder.WriteString(", ")
+	builder.WriteString("first_name=")
+	builder.WriteString(uv.FirstName)
+	builder.WriteString(", ")
+	builder.WriteString("last_name=")
+	builder.WriteString(uv.LastName)
+	builder.WriteString(", ")
+	builder.WriteString("gender=")
+	builder.WriteString(uv.Gender)
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(uv.Phone)
+	builder.WriteString(", ")
+	if v := uv.Email; v != nil {
+		builder.WriteString("email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := uv.Address; v != nil {
+		builder.WriteString("address=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := uv.WardCode; v != nil {
+		builder.WriteString("ward_code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := uv.ProvinceCode; v != nil {
+		builder.WriteString("province_code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := uv.Avatar; v != nil {
+		builder.WriteString("avatar=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("valid_from=")
+	builder.WriteString(uv.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := uv.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := uv.ChangedBy; v != nil {
+		builder.WriteString("changed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserVersions is a parsable slice of UserVersion.
+type UserVersions []*UserVersion
@@ -0,0 +1,995 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldUserID, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// FirstName applies equality check predicate on the "first_name" field. It's identical to FirstNameEQ.
+func FirstName(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldFirstName, v))
+}
+
+// LastName applies equality check predicate on the "last_name" field. It's identical to LastNameEQ.
+func LastName(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldLastName, v))
+}
+
+// Gender applies equality check predicate on the "gender" field. It's identical to GenderEQ.
+func Gender(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldGender, v))
+}
+
+// Phone applies equality check predicate on the "phone" field. It's identical to PhoneEQ.
+func Phone(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldPhone, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldEmail, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldAddress, v))
+}
+
+// WardCode applies equality check predicate on the "ward_code" field. It's identical to WardCodeEQ.
+func WardCode(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldWardCode, v))
+}
+
+// ProvinceCode applies equality check predicate on the "province_code" field. It's identical to ProvinceCodeEQ.
+func ProvinceCode(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldProvinceCode, v))
+}
+
+// Avatar applies equality check predicate on the "avatar" field. It's identical to AvatarEQ.
+func Avatar(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldAvatar, v))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ChangedBy applies equality check predicate on the "changed_by" field. It's identical to ChangedByEQ.
+func ChangedBy(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldChangedBy, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLTE(FieldUserID, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLTE(FieldVersion, v))
+}
+
+// FirstNameEQ applies the EQ predicate on the "first_name" field.
+func FirstNameEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldFirstName, v))
+}
+
+// FirstNameNEQ applies the NEQ predicate on the "first_name" field.
+func FirstNameNEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNEQ(FieldFirstName, v))
+}
+
+// FirstNameIn applies the In predicate on the "first_name" field.
+func FirstNameIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIn(FieldFirstName, vs...))
+}
+
+// FirstNameNotIn applies the NotIn predicate on the "first_name" field.
+func FirstNameNotIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotIn(FieldFirstName, vs...))
+}
+
+// FirstNameGT applies the GT predicate on the "first_name" field.
+func FirstNameGT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGT(FieldFirstName, v))
+}
+
+// FirstNameGTE applies the GTE predicate on the "first_name" field.
+func FirstNameGTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGTE(FieldFirstName, v))
+}
+
+// FirstNameLT applies the LT predicate on the "first_name" field.
+func FirstNameLT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLT(FieldFirstName, v))
+}
+
+// FirstNameLTE applies the LTE predicate on the "first_name" field.
+func FirstNameLTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLTE(FieldFirstName, v))
+}
+
+// FirstNameContains applies the Contains predicate on the "first_name" field.
+func FirstNameContains(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContains(FieldFirstName, v))
+}
+
+// FirstNameHasPrefix applies the HasPrefix predicate on the "first_name" field.
+func FirstNameHasPrefix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasPrefix(FieldFirstName, v))
+}
+
+// FirstNameHasSuffix applies the HasSuffix predicate on the "first_name" field.
+func FirstNameHasSuffix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasSuffix(FieldFirstName, v))
+}
+
+// FirstNameEqualFold applies the EqualFold predicate on the "first_name" field.
+func FirstNameEqualFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEqualFold(FieldFirstName, v))
+}
+
+// FirstNameContainsFold applies the ContainsFold predicate on the "first_name" field.
+func FirstNameContainsFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContainsFold(FieldFirstName, v))
+}
+
+// LastNameEQ applies the EQ predicate on the "last_name" field.
+func LastNameEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldLastName, v))
+}
+
+// LastNameNEQ applies the NEQ predicate on the "last_name" field.
+func LastNameNEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNEQ(FieldLastName, v))
+}
+
+// LastNameIn applies the In predicate on the "last_name" field.
+func LastNameIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIn(FieldLastName, vs...))
+}
+
+// LastNameNotIn applies the NotIn predicate on the "last_name" field.
+func LastNameNotIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotIn(FieldLastName, vs...))
+}
+
+// LastNameGT applies the GT predicate on the "last_name" field.
+func LastNameGT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGT(FieldLastName, v))
+}
+
+// LastNameGTE applies the GTE predicate on the "last_name" field.
+func LastNameGTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGTE(FieldLastName, v))
+}
+
+// LastNameLT applies the LT predicate on the "last_name" field.
+func LastNameLT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLT(FieldLastName, v))
+}
+
+// LastNameLTE applies the LTE predicate on the "last_name" field.
+func LastNameLTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLTE(FieldLastName, v))
+}
+
+// LastNameContains applies the Contains predicate on the "last_name" field.
+func LastNameContains(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContains(FieldLastName, v))
+}
+
+// LastNameHasPrefix applies the HasPrefix predicate on the "last_name" field.
+func LastNameHasPrefix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasPrefix(FieldLastName, v))
+}
+
+// LastNameHasSuffix applies the HasSuffix predicate on the "last_name" field.
+func LastNameHasSuffix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasSuffix(FieldLastName, v))
+}
+
+// LastNameEqualFold applies the EqualFold predicate on the "last_name" field.
+func LastNameEqualFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEqualFold(FieldLastName, v))
+}
+
+// LastNameContainsFold applies the ContainsFold predicate on the "last_name" field.
+func LastNameContainsFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContainsFold(FieldLastName, v))
+}
+
+// GenderEQ applies the EQ predicate on the "gender" field.
+func GenderEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldGender, v))
+}
+
+// GenderNEQ applies the NEQ predicate on the "gender" field.
+func GenderNEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNEQ(FieldGender, v))
+}
+
+// GenderIn applies the In predicate on the "gender" field.
+func GenderIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIn(FieldGender, vs...))
+}
+
+// GenderNotIn applies the NotIn predicate on the "gender" field.
+func GenderNotIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotIn(FieldGender, vs...))
+}
+
+// GenderGT applies the GT predicate on the "gender" field.
+func GenderGT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGT(FieldGender, v))
+}
+
+// GenderGTE applies the GTE predicate on the "gender" field.
+func GenderGTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGTE(FieldGender, v))
+}
+
+// GenderLT applies the LT predicate on the "gender" field.
+func GenderLT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLT(FieldGender, v))
+}
+
+// GenderLTE applies the LTE predicate on the "gender" field.
+func GenderLTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLTE(FieldGender, v))
+}
+
+// GenderContains applies the Contains predicate on the "gender" field.
+func GenderContains(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContains(FieldGender, v))
+}
+
+// GenderHasPrefix applies the HasPrefix predicate on the "gender" field.
+func GenderHasPrefix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasPrefix(FieldGender, v))
+}
+
+// GenderHasSuffix applies the HasSuffix predicate on the "gender" field.
+func GenderHasSuffix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasSuffix(FieldGender, v))
+}
+
+// GenderEqualFold applies the EqualFold predicate on the "gender" field.
+func GenderEqualFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEqualFold(FieldGender, v))
+}
+
+// GenderContainsFold applies the ContainsFold predicate on the "gender" field.
+func GenderContainsFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContainsFold(FieldGender, v))
+}
+
+// PhoneEQ applies the EQ predicate on the "phone" field.
+func PhoneEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldPhone, v))
+}
+
+// PhoneNEQ applies the NEQ predicate on the "phone" field.
+func PhoneNEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNEQ(FieldPhone, v))
+}
+
+// PhoneIn applies the In predicate on the "phone" field.
+func PhoneIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIn(FieldPhone, vs...))
+}
+
+// PhoneNotIn applies the NotIn predicate on the "phone" field.
+func PhoneNotIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotIn(FieldPhone, vs...))
+}
+
+// PhoneGT applies the GT predicate on the "phone" field.
+func PhoneGT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGT(FieldPhone, v))
+}
+
+// PhoneGTE applies the GTE predicate on the "phone" field.
+func PhoneGTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGTE(FieldPhone, v))
+}
+
+// PhoneLT applies the LT predicate on the "phone" field.
+func PhoneLT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLT(FieldPhone, v))
+}
+
+// PhoneLTE applies the LTE predicate on the "phone" field.
+func PhoneLTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLTE(FieldPhone, v))
+}
+
+// PhoneContains applies the Contains predicate on the "phone" field.
+func PhoneContains(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContains(FieldPhone, v))
+}
+
+// PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
+func PhoneHasPrefix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasPrefix(FieldPhone, v))
+}
+
+// PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
+func PhoneHasSuffix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasSuffix(FieldPhone, v))
+}
+
+// PhoneEqualFold applies the EqualFold predicate on the "phone" field.
+func PhoneEqualFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEqualFold(FieldPhone, v))
+}
+
+// PhoneContainsFold applies the ContainsFold predicate on the "phone" field.
+func PhoneContainsFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContainsFold(FieldPhone, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIsNull(FieldEmail))
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotNull(FieldEmail))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressIsNil applies the IsNil predicate on the "address" field.
+func AddressIsNil() predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIsNull(FieldAddress))
+}
+
+// AddressNotNil applies the NotNil predicate on the "address" field.
+func AddressNotNil() predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotNull(FieldAddress))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// WardCodeEQ applies the EQ predicate on the "ward_code" field.
+func WardCodeEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldWardCode, v))
+}
+
+// WardCodeNEQ applies the NEQ predicate on the "ward_code" field.
+func WardCodeNEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNEQ(FieldWardCode, v))
+}
+
+// WardCodeIn applies the In predicate on the "ward_code" field.
+func WardCodeIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIn(FieldWardCode, vs...))
+}
+
+// WardCodeNotIn applies the NotIn predicate on the "ward_code" field.
+func WardCodeNotIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotIn(FieldWardCode, vs...))
+}
+
+// WardCodeGT applies the GT predicate on the "ward_code" field.
+func WardCodeGT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGT(FieldWardCode, v))
+}
+
+// WardCodeGTE applies the GTE predicate on the "ward_code" field.
+func WardCodeGTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGTE(FieldWardCode, v))
+}
+
+// WardCodeLT applies the LT predicate on the "ward_code" field.
+func WardCodeLT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLT(FieldWardCode, v))
+}
+
+// WardCodeLTE applies the LTE predicate on the "ward_code" field.
+func WardCodeLTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLTE(FieldWardCode, v))
+}
+
+// WardCodeContains applies the Contains predicate on the "ward_code" field.
+func WardCodeContains(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContains(FieldWardCode, v))
+}
+
+// WardCodeHasPrefix applies the HasPrefix predicate on the "ward_code" field.
+func WardCodeHasPrefix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasPrefix(FieldWardCode, v))
+}
+
+// WardCodeHasSuffix applies the HasSuffix predicate on the "ward_code" field.
+func WardCodeHasSuffix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasSuffix(FieldWardCode, v))
+}
+
+// WardCodeIsNil applies the IsNil predicate on the "ward_code" field.
+func WardCodeIsNil() predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIsNull(FieldWardCode))
+}
+
+// WardCodeNotNil applies the NotNil predicate on the "ward_code" field.
+func WardCodeNotNil() predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotNull(FieldWardCode))
+}
+
+// WardCodeEqualFold applies the EqualFold predicate on the "ward_code" field.
+func WardCodeEqualFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEqualFold(FieldWardCode, v))
+}
+
+// WardCodeContainsFold applies the ContainsFold predicate on the "ward_code" field.
+func WardCodeContainsFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContainsFold(FieldWardCode, v))
+}
+
+// ProvinceCodeEQ applies the EQ predicate on the "province_code" field.
+func ProvinceCodeEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldProvinceCode, v))
+}
+
+// ProvinceCodeNEQ applies the NEQ predicate on the "province_code" field.
+func ProvinceCodeNEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNEQ(FieldProvinceCode, v))
+}
+
+// ProvinceCodeIn applies the In predicate on the "province_code" field.
+func ProvinceCodeIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIn(FieldProvinceCode, vs...))
+}
+
+// ProvinceCodeNotIn applies the NotIn predicate on the "province_code" field.
+func ProvinceCodeNotIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotIn(FieldProvinceCode, vs...))
+}
+
+// ProvinceCodeGT applies the GT predicate on the "province_code" field.
+func ProvinceCodeGT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGT(FieldProvinceCode, v))
+}
+
+// ProvinceCodeGTE applies the GTE predicate on the "province_code" field.
+func ProvinceCodeGTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGTE(FieldProvinceCode, v))
+}
+
+// ProvinceCodeLT applies the LT predicate on the "province_code" field.
+func ProvinceCodeLT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLT(FieldProvinceCode, v))
+}
+
+// ProvinceCodeLTE applies the LTE predicate on the "province_code" field.
+func ProvinceCodeLTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLTE(FieldProvinceCode, v))
+}
+
+// ProvinceCodeContains applies the Contains predicate on the "province_code" field.
+func ProvinceCodeContains(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContains(FieldProvinceCode, v))
+}
+
+// ProvinceCodeHasPrefix applies the HasPrefix predicate on the "province_code" field.
+func ProvinceCodeHasPrefix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasPrefix(FieldProvinceCode, v))
+}
+
+// ProvinceCodeHasSuffix applies the HasSuffix predicate on the "province_code" field.
+func ProvinceCodeHasSuffix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasSuffix(FieldProvinceCode, v))
+}
+
+// ProvinceCodeIsNil applies the IsNil predicate on the "province_code" field.
+func ProvinceCodeIsNil() predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIsNull(FieldProvinceCode))
+}
+
+// ProvinceCodeNotNil applies the NotNil predicate on the "province_code" field.
+func ProvinceCodeNotNil() predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotNull(FieldProvinceCode))
+}
+
+// ProvinceCodeEqualFold applies the EqualFold predicate on the "province_code" field.
+func ProvinceCodeEqualFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEqualFold(FieldProvinceCode, v))
+}
+
+// ProvinceCodeContainsFold applies the ContainsFold predicate on the "province_code" field.
+func ProvinceCodeContainsFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContainsFold(FieldProvinceCode, v))
+}
+
+// AvatarEQ applies the EQ predicate on the "avatar" field.
+func AvatarEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldAvatar, v))
+}
+
+// AvatarNEQ applies the NEQ predicate on the "avatar" field.
+func AvatarNEQ(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNEQ(FieldAvatar, v))
+}
+
+// AvatarIn applies the In predicate on the "avatar" field.
+func AvatarIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIn(FieldAvatar, vs...))
+}
+
+// AvatarNotIn applies the NotIn predicate on the "avatar" field.
+func AvatarNotIn(vs ...string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotIn(FieldAvatar, vs...))
+}
+
+// AvatarGT applies the GT predicate on the "avatar" field.
+func AvatarGT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGT(FieldAvatar, v))
+}
+
+// AvatarGTE applies the GTE predicate on the "avatar" field.
+func AvatarGTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGTE(FieldAvatar, v))
+}
+
+// AvatarLT applies the LT predicate on the "avatar" field.
+func AvatarLT(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLT(FieldAvatar, v))
+}
+
+// AvatarLTE applies the LTE predicate on the "avatar" field.
+func AvatarLTE(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLTE(FieldAvatar, v))
+}
+
+// AvatarContains applies the Contains predicate on the "avatar" field.
+func AvatarContains(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContains(FieldAvatar, v))
+}
+
+// AvatarHasPrefix applies the HasPrefix predicate on the "avatar" field.
+func AvatarHasPrefix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasPrefix(FieldAvatar, v))
+}
+
+// AvatarHasSuffix applies the HasSuffix predicate on the "avatar" field.
+func AvatarHasSuffix(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldHasSuffix(FieldAvatar, v))
+}
+
+// AvatarIsNil applies the IsNil predicate on the "avatar" field.
+func AvatarIsNil() predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIsNull(FieldAvatar))
+}
+
+// AvatarNotNil applies the NotNil predicate on the "avatar" field.
+func AvatarNotNil() predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotNull(FieldAvatar))
+}
+
+// AvatarEqualFold applies the EqualFold predicate on the "avatar" field.
+func AvatarEqualFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEqualFold(FieldAvatar, v))
+}
+
+// AvatarContainsFold applies the ContainsFold predicate on the "avatar" field.
+func AvatarContainsFold(v string) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldContainsFold(FieldAvatar, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotNull(FieldValidTo))
+}
+
+// ChangedByEQ applies the EQ predicate on the "changed_by" field.
+func ChangedByEQ(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldEQ(FieldChangedBy, v))
+}
+
+// ChangedByNEQ applies the NEQ predicate on the "changed_by" field.
+func ChangedByNEQ(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNEQ(FieldChangedBy, v))
+}
+
+// ChangedByIn applies the In predicate on the "changed_by" field.
+func ChangedByIn(vs ...int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIn(FieldChangedBy, vs...))
+}
+
+// ChangedByNotIn applies the NotIn predicate on the "changed_by" field.
+func ChangedByNotIn(vs ...int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotIn(FieldChangedBy, vs...))
+}
+
+// ChangedByGT applies the GT predicate on the "changed_by" field.
+func ChangedByGT(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGT(FieldChangedBy, v))
+}
+
+// ChangedByGTE applies the GTE predicate on the "changed_by" field.
+func ChangedByGTE(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldGTE(FieldChangedBy, v))
+}
+
+// ChangedByLT applies the LT predicate on the "changed_by" field.
+func ChangedByLT(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLT(FieldChangedBy, v))
+}
+
+// ChangedByLTE applies the LTE predicate on the "changed_by" field.
+func ChangedByLTE(v int) predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldLTE(FieldChangedBy, v))
+}
+
+// ChangedByIsNil applies the IsNil predicate on the "changed_by" field.
+func ChangedByIsNil() predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldIsNull(FieldChangedBy))
+}
+
+// ChangedByNotNil applies the NotNil predicate on the "changed_by" field.
+func ChangedByNotNil() predicate.UserVersion {
+	return predicate.UserVersion(sql.FieldNotNull(FieldChangedBy))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserVersion) predicate.UserVersion {
+	return predicate.UserVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserVersion) predicate.UserVersion {
+	return predicate.UserVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserVersion) predicate.UserVersion {
+	return predicate.UserVersion(sql.NotPredicates(p))
+}
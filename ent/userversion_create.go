@@ -0,0 +1,398 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/userversion"
+)
+
+// UserVersionCreate is the builder for creating a UserVersion entity.
+type UserVersionCreate struct {
+	config
+	mutation *UserVersionMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (uvc *UserVersionCreate) SetUserID(i int) *UserVersionCreate {
+	uvc.mutation.SetUserID(i)
+	return uvc
+}
+
+// SetVersion sets the "version" field.
+func (uvc *UserVersionCreate) SetVersion(i int) *UserVersionCreate {
+	uvc.mutation.SetVersion(i)
+	return uvc
+}
+
+// SetFirstName sets the "first_name" field.
+func (uvc *UserVersionCreate) SetFirstName(s string) *UserVersionCreate {
+	uvc.mutation.SetFirstName(s)
+	return uvc
+}
+
+// SetLastName sets the "last_name" field.
+func (uvc *UserVersionCreate) SetLastName(s string) *UserVersionCreate {
+	uvc.mutation.SetLastName(s)
+	return uvc
+}
+
+// SetGender sets the "gender" field.
+func (uvc *UserVersionCreate) SetGender(s string) *UserVersionCreate {
+	uvc.mutation.SetGender(s)
+	return uvc
+}
+
+// SetPhone sets the "phone" field.
+func (uvc *UserVersionCreate) SetPhone(s string) *UserVersionCreate {
+	uvc.mutation.SetPhone(s)
+	return uvc
+}
+
+// SetEmail sets the "email" field.
+func (uvc *UserVersionCreate) SetEmail(s string) *UserVersionCreate {
+	uvc.mutation.SetEmail(s)
+	return uvc
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uvc *UserVersionCreate) SetNillableEmail(s *string) *UserVersionCreate {
+	if s != nil {
+		uvc.SetEmail(*s)
+	}
+	return uvc
+}
+
+// SetAddress sets the "address" field.
+func (uvc *UserVersionCreate) SetAddress(s string) *UserVersionCreate {
+	uvc.mutation.SetAddress(s)
+	return uvc
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (uvc *UserVersionCreate) SetNillableAddress(s *string) *UserVersionCreate {
+	if s != nil {
+		uvc.SetAddress(*s)
+	}
+	return uvc
+}
+
+// SetWardCode sets the "ward_code" field.
+func (uvc *UserVersionCreate) SetWardCode(s string) *UserVersionCreate {
+	uvc.mutation.SetWardCode(s)
+	return uvc
+}
+
+// SetNillableWardCode sets the "ward_code" field if the given value is not nil.
+func (uvc *UserVersionCreate) SetNillableWardCode(s *string) *UserVersionCreate {
+	if s != nil {
+		uvc.SetWardCode(*s)
+	}
+	return uvc
+}
+
+// SetProvinceCode sets the "province_code" field.
+func (uvc *UserVersionCreate) SetProvinceCode(s string) *UserVersionCreate {
+	uvc.mutation.SetProvinceCode(s)
+	return uvc
+}
+
+// SetNillableProvinceCode sets the "province_code" field if the given value is not nil.
+func (uvc *UserVersionCreate) SetNillableProvinceCode(s *string) *UserVersionCreate {
+	if s != nil {
+		uvc.SetProvinceCode(*s)
+	}
+	return uvc
+}
+
+// SetAvatar sets the "avatar" field.
+func (uvc *UserVersionCreate) SetAvatar(s string) *UserVersionCreate {
+	uvc.mutation.SetAvatar(s)
+	return uvc
+}
+
+// SetNillableAvatar sets the "avatar" field if the given value is not nil.
+func (uvc *UserVersionCreate) SetNillableAvatar(s *string) *UserVersionCreate {
+	if s != nil {
+		uvc.SetAvatar(*s)
+	}
+	return uvc
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (uvc *UserVersionCreate) SetValidFrom(t time.Time) *UserVersionCreate {
+	uvc.mutation.SetValidFrom(t)
+	return uvc
+}
+
+// SetValidTo sets the "valid_to" field.
+func (uvc *UserVersionCreate) SetValidTo(t time.Time) *UserVersionCreate {
+	uvc.mutation.SetValidTo(t)
+	return uvc
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (uvc *UserVersionCreate) SetNillableValidTo(t *time.Time) *UserVersionCreate {
+	if t != nil {
+		uvc.SetValidTo(*t)
+	}
+	return uvc
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (uvc *UserVersionCreate) SetChangedBy(i int) *UserVersionCreate {
+	uvc.mutation.SetChangedBy(i)
+	return uvc
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (uvc *UserVersionCreate) SetNillableChangedBy(i *int) *UserVersionCreate {
+	if i != nil {
+		uvc.SetChangedBy(*i)
+	}
+	return uvc
+}
+
+// Mutation returns the UserVersionMutation object of the builder.
+func (uvc *UserVersionCreate) Mutation() *UserVersionMutation {
+	return uvc.mutation
+}
+
+// Save creates the UserVersion in the database.
+func (uvc *UserVersionCreate) Save(ctx context.Context) (*UserVersion, error) {
+	return withHooks(ctx, uvc.sqlSave, uvc.mutation, uvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uvc *UserVersionCreate) SaveX(ctx context.Context) *UserVersion {
+	v, err := uvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uvc *UserVersionCreate) Exec(ctx context.Context) error {
+	_, err := uvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uvc *UserVersionCreate) ExecX(ctx context.Context) {
+	if err := uvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uvc *UserVersionCreate) check() error {
+	if _, ok := uvc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserVersion.user_id"`)}
+	}
+	if v, ok := uvc.mutation.UserID(); ok {
+		if err := userversion.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UserVersion.user_id": %w`, err)}
+		}
+	}
+	if _, ok := uvc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "UserVersion.version"`)}
+	}
+	if v, ok := uvc.mutation.Version(); ok {
+		if err := userversion.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "UserVersion.version": %w`, err)}
+		}
+	}
+	if _, ok := uvc.mutation.FirstName(); !ok {
+		return &ValidationError{Name: "first_name", err: errors.New(`ent: missing required field "UserVersion.first_name"`)}
+	}
+	if _, ok := uvc.mutation.LastName(); !ok {
+		return &ValidationError{Name: "last_name", err: errors.New(`ent: missing required field "UserVersion.last_name"`)}
+	}
+	if _, ok := uvc.mutation.Gender(); !ok {
+		return &ValidationError{Name: "gender", err: errors.New(`ent: missing required field "UserVersion.gender"`)}
+	}
+	if _, ok := uvc.mutation.Phone(); !ok {
+		return &ValidationError{Name: "phone", err: errors.New(`ent: missing required field "UserVersion.phone"`)}
+	}
+	if _, ok := uvc.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`ent: missing required field "UserVersion.valid_from"`)}
+	}
+	return nil
+}
+
+func (uvc *UserVersionCreate) sqlSave(ctx context.Context) (*UserVersion, error) {
+	if err := uvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := uvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	uvc.mutation.id = &_node.ID
+	uvc.mutation.done = true
+	return _node, nil
+}
+
+func (uvc *UserVersionCreate) createSpec() (*UserVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserVersion{config: uvc.config}
+		_spec = sqlgraph.NewCreateSpec(userversion.Table, sqlgraph.NewFieldSpec(userversion.FieldID, field.TypeInt))
+	)
+	if value, ok := uvc.mutation.UserID(); ok {
+		_spec.SetField(userversion.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := uvc.mutation.Version(); ok {
+		_spec.SetField(userversion.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := uvc.mutation.FirstName(); ok {
+		_spec.SetField(userversion.FieldFirstName, field.TypeString, value)
+		_node.FirstName = value
+	}
+	if value, ok := uvc.mutation.LastName(); ok {
+		_spec.SetField(userversion.FieldLastName, field.TypeString, value)
+		_node.LastName = value
+	}
+	if value, ok := uvc.mutation.Gender(); ok {
+		_spec.SetField(userversion.FieldGender, field.TypeString, value)
+		_node.Gender = value
+	}
+	if value, ok := uvc.mutation.Phone(); ok {
+		_spec.SetField(userversion.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
+	if value, ok := uvc.mutation.Email(); ok {
+		_spec.SetField(userversion.FieldEmail, field.TypeString, value)
+		_node.Email = &value
+	}
+	if value, ok := uvc.mutation.Address(); ok {
+		_spec.SetField(userversion.FieldAddress, field.TypeString, value)
+		_node.Address = &value
+	}
+	if value, ok := uvc.mutation.WardCode(); ok {
+		_spec.SetField(userversion.FieldWardCode, field.TypeString, value)
+		_node.WardCode = &value
+	}
+	if value, ok := uvc.mutation.ProvinceCode(); ok {
+		_spec.SetField(userversion.FieldProvinceCode, field.TypeString, value)
+		_node.ProvinceCode = &value
+	}
+	if value, ok := uvc.mutation.Avatar(); ok {
+		_spec.SetField(userversion.FieldAvatar, field.TypeString, value)
+		_node.Avatar = &value
+	}
+	if value, ok := uvc.mutation.ValidFrom(); ok {
+		_spec.SetField(userversion.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := uvc.mutation.ValidTo(); ok {
+		_spec.SetField(userversion.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := uvc.mutation.ChangedBy(); ok {
+		_spec.SetField(userversion.FieldChangedBy, field.TypeInt, value)
+		_node.ChangedBy = &value
+	}
+	return _node, _spec
+}
+
+// UserVersionCreateBulk is the builder for creating many UserVersion entities in bulk.
+type UserVersionCreateBulk struct {
+	config
+	err      error
+	builders []*UserVersionCreate
+}
+
+// Save creates the UserVersion entities in the database.
+func (uvcb *UserVersionCreateBulk) Save(ctx context.Context) ([]*UserVersion, error) {
+	if uvcb.err != nil {
+		return nil, uvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uvcb.builders))
+	nodes := make([]*UserVersion, len(uvcb.builders))
+	mutators := make([]Mutator, len(uvcb.builders))
+	for i := range uvcb.builders {
+		func(i int, root context.Context) {
+			builder := uvcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uvcb *UserVersionCreateBulk) SaveX(ctx context.Context) []*UserVersion {
+	v, err := uvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uvcb *UserVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := uvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uvcb *UserVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := uvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+	"github.com/huynhthanhthao/hrm_user_service/ent/userversion"
+)
+
+// UserVersionUpdate is the builder for updating UserVersion entities.
+type UserVersionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *UserVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the UserVersionUpdate builder.
+func (uvu *UserVersionUpdate) Where(ps ...predicate.UserVersion) *UserVersionUpdate {
+	uvu.mutation.Where(ps...)
+	return uvu
+}
+
+// SetPhone sets the "phone" field.
+func (uvu *UserVersionUpdate) SetPhone(s string) *UserVersionUpdate {
+	uvu.mutation.SetPhone(s)
+	return uvu
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (uvu *UserVersionUpdate) SetNillablePhone(s *string) *UserVersionUpdate {
+	if s != nil {
+		uvu.SetPhone(*s)
+	}
+	return uvu
+}
+
+// SetEmail sets the "email" field.
+func (uvu *UserVersionUpdate) SetEmail(s string) *UserVersionUpdate {
+	uvu.mutation.SetEmail(s)
+	return uvu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uvu *UserVersionUpdate) SetNillableEmail(s *string) *UserVersionUpdate {
+	if s != nil {
+		uvu.SetEmail(*s)
+	}
+	return uvu
+}
+
+// ClearEmail clears the value of the "email" field.
+func (uvu *UserVersionUpdate) ClearEmail() *UserVersionUpdate {
+	uvu.mutation.ClearEmail()
+	return uvu
+}
+
+// SetAddress sets the "address" field.
+func (uvu *UserVersionUpdate) SetAddress(s string) *UserVersionUpdate {
+	uvu.mutation.SetAddress(s)
+	return uvu
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (uvu *UserVersionUpdate) SetNillableAddress(s *string) *UserVersionUpdate {
+	if s != nil {
+		uvu.SetAddress(*s)
+	}
+	return uvu
+}
+
+// ClearAddress clears the value of the "address" field.
+func (uvu *UserVersionUpdate) ClearAddress() *UserVersionUpdate {
+	uvu.mutation.ClearAddress()
+	return uvu
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (uvu *UserVersionUpdate) SetValidFrom(t time.Time) *UserVersionUpdate {
+	uvu.mutation.SetValidFrom(t)
+	return uvu
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (uvu *UserVersionUpdate) SetNillableValidFrom(t *time.Time) *UserVersionUpdate {
+	if t != nil {
+		uvu.SetValidFrom(*t)
+	}
+	return uvu
+}
+
+// SetValidTo sets the "valid_to" field.
+func (uvu *UserVersionUpdate) SetValidTo(t time.Time) *UserVersionUpdate {
+	uvu.mutation.SetValidTo(t)
+	return uvu
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (uvu *UserVersionUpdate) SetNillableValidTo(t *time.Time) *UserVersionUpdate {
+	if t != nil {
+		uvu.SetValidTo(*t)
+	}
+	return uvu
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (uvu *UserVersionUpdate) ClearValidTo() *UserVersionUpdate {
+	uvu.mutation.ClearValidTo()
+	return uvu
+}
+
+// Mutation returns the UserVersionMutation object of the builder.
+func (uvu *UserVersionUpdate) Mutation() *UserVersionMutation {
+	return uvu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uvu *UserVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, uvu.sqlSave, uvu.mutation, uvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uvu *UserVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := uvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uvu *UserVersionUpdate) Exec(ctx context.Context) error {
+	_, err := uvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uvu *UserVersionUpdate) ExecX(ctx context.Context) {
+	if err := uvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uvu *UserVersionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserVersionUpdate {
+	uvu.modifiers = append(uvu.modifiers, modifiers...)
+	return uvu
+}
+
+func (uvu *UserVersionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(userversion.Table, userversion.Columns, sqlgraph.NewFieldSpec(userversion.FieldID, field.TypeInt))
+	if ps := uvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uvu.mutation.Phone(); ok {
+		_spec.SetField(userversion.FieldPhone, field.TypeString, value)
+	}
+	if value, ok := uvu.mutation.Email(); ok {
+		_spec.SetField(userversion.FieldEmail, field.TypeString, value)
+	}
+	if uvu.mutation.EmailCleared() {
+		_spec.ClearField(userversion.FieldEmail, field.TypeString)
+	}
+	if value, ok := uvu.mutation.Address(); ok {
+		_spec.SetField(userversion.FieldAddress, field.TypeString, value)
+	}
+	if uvu.mutation.AddressCleared() {
+		_spec.ClearField(userversion.FieldAddress, field.TypeString)
+	}
+	if uvu.mutation.WardCodeCleared() {
+		_spec.ClearField(userversion.FieldWardCode, field.TypeString)
+	}
+	if uvu.mutation.ProvinceCodeCleared() {
+		_spec.ClearField(userversion.FieldProvinceCode, field.TypeString)
+	}
+	if uvu.mutation.AvatarCleared() {
+		_spec.ClearField(userversion.FieldAvatar, field.TypeString)
+	}
+	if value, ok := uvu.mutation.ValidFrom(); ok {
+		_spec.SetField(userversion.FieldValidFrom, field.TypeTime, value)
+	}
+	if value, ok := uvu.mutation.ValidTo(); ok {
+		_spec.SetField(userversion.FieldValidTo, field.TypeTime, value)
+	}
+	if uvu.mutation.ValidToCleared() {
+		_spec.ClearField(userversion.FieldValidTo, field.TypeTime)
+	}
+	if uvu.mutation.ChangedByCleared() {
+		_spec.ClearField(userversion.FieldChangedBy, field.TypeInt)
+	}
+	_spec.AddModifiers(uvu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, uvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uvu.mutation.done = true
+	return n, nil
+}
+
+// UserVersionUpdateOne is the builder for updating a single UserVersion entity.
+type UserVersionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetPhone sets the "phone" field.
+func (uvuo *UserVersionUpdateOne) SetPhone(s string) *UserVersionUpdateOne {
+	uvuo.mutation.SetPhone(s)
+	return uvuo
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (uvuo *UserVersionUpdateOne) SetNillablePhone(s *string) *UserVersionUpdateOne {
+	if s != nil {
+		uvuo.SetPhone(*s)
+	}
+	return uvuo
+}
+
+// SetEmail sets the "email" field.
+func (uvuo *UserVersionUpdateOne) SetEmail(s string) *UserVersionUpdateOne {
+	uvuo.mutation.SetEmail(s)
+	return uvuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uvuo *UserVersionUpdateOne) SetNillableEmail(s *string) *UserVersionUpdateOne {
+	if s != nil {
+		uvuo.SetEmail(*s)
+	}
+	return uvuo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (uvuo *UserVersionUpdateOne) ClearEmail() *UserVersionUpdateOne {
+	uvuo.mutation.ClearEmail()
+	return uvuo
+}
+
+// SetAddress sets the "address" field.
+func (uvuo *UserVersionUpdateOne) SetAddress(s string) *UserVersionUpdateOne {
+	uvuo.mutation.SetAddress(s)
+	return uvuo
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (uvuo *UserVersionUpdateOne) SetNillableAddress(s *string) *UserVersionUpdateOne {
+	if s != nil {
+		uvuo.SetAddress(*s)
+	}
+	return uvuo
+}
+
+// ClearAddress clears the value of the "address" field.
+func (uvuo *UserVersionUpdateOne) ClearAddress() *UserVersionUpdateOne {
+	uvuo.mutation.ClearAddress()
+	return uvuo
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (uvuo *UserVersionUpdateOne) SetValidFrom(t time.Time) *UserVersionUpdateOne {
+	uvuo.mutation.SetValidFrom(t)
+	return uvuo
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (uvuo *UserVersionUpdateOne) SetNillableValidFrom(t *time.Time) *UserVersionUpdateOne {
+	if t != nil {
+		uvuo.SetValidFrom(*t)
+	}
+	return uvuo
+}
+
+// SetValidTo sets the "valid_to" field.
+func (uvuo *UserVersionUpdateOne) SetValidTo(t time.Time) *UserVersionUpdateOne {
+	uvuo.mutation.SetValidTo(t)
+	return uvuo
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (uvuo *UserVersionUpdateOne) SetNillableValidTo(t *time.Time) *UserVersionUpdateOne {
+	if t != nil {
+		uvuo.SetValidTo(*t)
+	}
+	return uvuo
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (uvuo *UserVersionUpdateOne) ClearValidTo() *UserVersionUpdateOne {
+	uvuo.mutation.ClearValidTo()
+	return uvuo
+}
+
+// Mutation returns the UserVersionMutation object of the builder.
+func (uvuo *UserVersionUpdateOne) Mutation() *UserVersionMutation {
+	return uvuo.mutation
+}
+
+// Where appends a list predicates to the UserVersionUpdate builder.
+func (uvuo *UserVersionUpdateOne) Where(ps ...predicate.UserVersion) *UserVersionUpdateOne {
+	uvuo.mutation.Where(ps...)
+	return uvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uvuo *UserVersionUpdateOne) Select(field string, fields ...string) *UserVersionUpdateOne {
+	uvuo.fields = append([]string{field}, fields...)
+	return uvuo
+}
+
+// Save executes the query and returns the updated UserVersion entity.
+func (uvuo *UserVersionUpdateOne) Save(ctx context.Context) (*UserVersion, error) {
+	return withHooks(ctx, uvuo.sqlSave, uvuo.mutation, uvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uvuo *UserVersionUpdateOne) SaveX(ctx context.Context) *UserVersion {
+	node, err := uvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uvuo *UserVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := uvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uvuo *UserVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := uvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uvuo *UserVersionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserVersionUpdateOne {
+	uvuo.modifiers = append(uvuo.modifiers, modifiers...)
+	return uvuo
+}
+
+func (uvuo *UserVersionUpdateOne) sqlSave(ctx context.Context) (_node *UserVersion, err error) {
+	_spec := sqlgraph.NewUpdateSpec(userversion.Table, userversion.Columns, sqlgraph.NewFieldSpec(userversion.FieldID, field.TypeInt))
+	id, ok := uvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userversion.FieldID)
+		for _, f := range fields {
+			if !userversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uvuo.mutation.Phone(); ok {
+		_spec.SetField(userversion.FieldPhone, field.TypeString, value)
+	}
+	if value, ok := uvuo.mutation.Email(); ok {
+		_spec.SetField(userversion.FieldEmail, field.TypeString, value)
+	}
+	if uvuo.mutation.EmailCleared() {
+		_spec.ClearField(userversion.FieldEmail, field.TypeString)
+	}
+	if value, ok := uvuo.mutation.Address(); ok {
+		_spec.SetField(userversion.FieldAddress, field.TypeString, value)
+	}
+	if uvuo.mutation.AddressCleared() {
+		_spec.ClearField(userversion.FieldAddress, field.TypeString)
+	}
+	if uvuo.mutation.WardCodeCleared() {
+		_spec.ClearField(userversion.FieldWardCode, field.TypeString)
+	}
+	if uvuo.mutation.ProvinceCodeCleared() {
+		_spec.ClearField(userversion.FieldProvinceCode, field.TypeString)
+	}
+	if uvuo.mutation.AvatarCleared() {
+		_spec.ClearField(userversion.FieldAvatar, field.TypeString)
+	}
+	if value, ok := uvuo.mutation.ValidFrom(); ok {
+		_spec.SetField(userversion.FieldValidFrom, field.TypeTime, value)
+	}
+	if value, ok := uvuo.mutation.ValidTo(); ok {
+		_spec.SetField(userversion.FieldValidTo, field.TypeTime, value)
+	}
+	if uvuo.mutation.ValidToCleared() {
+		_spec.ClearField(userversion.FieldValidTo, field.TypeTime)
+	}
+	if uvuo.mutation.ChangedByCleared() {
+		_spec.ClearField(userversion.FieldChangedBy, field.TypeInt)
+	}
+	_spec.AddModifiers(uvuo.modifiers...)
+	_node = &UserVersion{config: uvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uvuo.mutation.done = true
+	return _node, nil
+}
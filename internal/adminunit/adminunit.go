@@ -0,0 +1,183 @@
+// Package adminunit chứa danh mục đơn vị hành chính Việt Nam (tỉnh/thành, quận/huyện, phường/xã)
+// theo mã của Tổng cục Thống kê, dùng để kiểm tra ward_code/province_code của user.
+package adminunit
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+)
+
+// Danh mục nhúng sẵn gồm đủ 63 tỉnh/thành nhưng chỉ một phần quận/huyện, phường/xã nên được đánh dấu
+// là chưa đầy đủ: ward_code không có trong danh mục không bị từ chối. Môi trường thật cần nạp bộ dữ liệu
+// đầy đủ bằng LoadFile (biến môi trường ADMIN_UNITS_FILE) để kiểm tra chặt.
+//
+//go:embed data/units.json
+var embedded []byte
+
+var (
+	ErrUnknownProvince  = errors.New("unknown province_code")
+	ErrUnknownDistrict  = errors.New("unknown district_code")
+	ErrUnknownWard      = errors.New("unknown ward_code")
+	ErrProvinceMismatch = errors.New("ward_code does not belong to province_code")
+)
+
+type Unit struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+type Province struct {
+	Unit
+	Districts []*District `json:"districts"`
+}
+
+type District struct {
+	Unit
+	Wards    []*Ward   `json:"wards"`
+	Province *Province `json:"-"`
+}
+
+type Ward struct {
+	Unit
+	District *District `json:"-"`
+}
+
+// Province trả về tỉnh/thành chứa phường/xã
+func (w *Ward) Province() *Province {
+	return w.District.Province
+}
+
+// Dataset là một phiên bản của danh mục, không thay đổi sau khi Parse nên đọc đồng thời an toàn
+type Dataset struct {
+	Provinces []*Province
+
+	// Danh mục thiếu phường/xã: Resolve bỏ qua ward_code không có trong danh mục
+	partial bool
+
+	provinces map[string]*Province
+	districts map[string]*District
+	wards     map[string]*Ward
+}
+
+// Parse đọc danh mục dạng JSON: [{code, name, districts: [{code, name, wards: [{code, name}]}]}]
+func Parse(r io.Reader) (*Dataset, error) {
+	var provinces []*Province
+	if err := json.NewDecoder(r).Decode(&provinces); err != nil {
+		return nil, fmt.Errorf("#1 Parse: invalid dataset: %w", err)
+	}
+	if len(provinces) == 0 {
+		return nil, errors.New("#2 Parse: dataset has no province")
+	}
+
+	ds := &Dataset{
+		Provinces: provinces,
+		provinces: make(map[string]*Province, len(provinces)),
+		districts: make(map[string]*District),
+		wards:     make(map[string]*Ward),
+	}
+	for _, p := range provinces {
+		if p.Code == "" || p.Name == "" {
+			return nil, fmt.Errorf("#3 Parse: province %q has empty code or name", p.Code)
+		}
+		if _, ok := ds.provinces[p.Code]; ok {
+			return nil, fmt.Errorf("#4 Parse: duplicate province code %q", p.Code)
+		}
+		ds.provinces[p.Code] = p
+		for _, d := range p.Districts {
+			if d.Code == "" || d.Name == "" {
+				return nil, fmt.Errorf("#5 Parse: district %q has empty code or name", d.Code)
+			}
+			if _, ok := ds.districts[d.Code]; ok {
+				return nil, fmt.Errorf("#6 Parse: duplicate district code %q", d.Code)
+			}
+			d.Province = p
+			ds.districts[d.Code] = d
+			for _, w := range d.Wards {
+				if w.Code == "" || w.Name == "" {
+					return nil, fmt.Errorf("#7 Parse: ward %q has empty code or name", w.Code)
+				}
+				if _, ok := ds.wards[w.Code]; ok {
+					return nil, fmt.Errorf("#8 Parse: duplicate ward code %q", w.Code)
+				}
+				w.District = d
+				ds.wards[w.Code] = w
+			}
+		}
+	}
+	return ds, nil
+}
+
+func (ds *Dataset) Province(code string) (*Province, bool) {
+	p, ok := ds.provinces[code]
+	return p, ok
+}
+
+func (ds *Dataset) District(code string) (*District, bool) {
+	d, ok := ds.districts[code]
+	return d, ok
+}
+
+func (ds *Dataset) Ward(code string) (*Ward, bool) {
+	w, ok := ds.wards[code]
+	return w, ok
+}
+
+// Partial cho biết danh mục thiếu phường/xã (danh mục nhúng sẵn)
+func (ds *Dataset) Partial() bool {
+	return ds.partial
+}
+
+// Resolve kiểm tra ward_code/province_code và trả về tỉnh/thành (suy ra từ phường/xã nếu có).
+// Cả hai rỗng thì trả về nil, nil.
+func (ds *Dataset) Resolve(wardCode, provinceCode string) (*Province, error) {
+	if wardCode == "" {
+		if provinceCode == "" {
+			return nil, nil
+		}
+		p, ok := ds.Province(provinceCode)
+		if !ok {
+			return nil, fmt.Errorf("%w %q", ErrUnknownProvince, provinceCode)
+		}
+		return p, nil
+	}
+
+	w, ok := ds.Ward(wardCode)
+	if !ok && ds.partial {
+		// Không xác định được tỉnh/thành của phường/xã: chỉ kiểm tra province_code nếu có
+		return ds.Resolve("", provinceCode)
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownWard, wardCode)
+	}
+	p := w.Province()
+	if provinceCode != "" && provinceCode != p.Code {
+		return nil, fmt.Errorf("%w: ward %q is in province %q", ErrProvinceMismatch, wardCode, p.Code)
+	}
+	return p, nil
+}
+
+var current atomic.Pointer[Dataset]
+
+func init() {
+	ds, err := Parse(bytes.NewReader(embedded))
+	if err != nil {
+		panic("adminunit: invalid embedded dataset: " + err.Error())
+	}
+	ds.partial = true
+	current.Store(ds)
+}
+
+// Default trả về danh mục đang dùng (nhúng sẵn hoặc nạp gần nhất bằng LoadFile)
+func Default() *Dataset {
+	return current.Load()
+}
+
+// Replace thay danh mục đang dùng; các request đang chạy vẫn dùng phiên bản cũ tới khi xong
+func Replace(ds *Dataset) {
+	current.Store(ds)
+}
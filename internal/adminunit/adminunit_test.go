@@ -0,0 +1,64 @@
+package adminunit
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	full, err := Parse(strings.NewReader(`[
+		{"code": "01", "name": "Thành phố Hà Nội", "districts": [
+			{"code": "001", "name": "Quận Ba Đình", "wards": [{"code": "00001", "name": "Phường Phúc Xá"}]}
+		]},
+		{"code": "79", "name": "Thành phố Hồ Chí Minh"}
+	]`))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	embedded := Default()
+	if !embedded.Partial() || full.Partial() {
+		t.Fatalf("Partial() = %v, %v; want true for the embedded dataset only", embedded.Partial(), full.Partial())
+	}
+
+	tests := []struct {
+		name         string
+		ds           *Dataset
+		wardCode     string
+		provinceCode string
+		want         string
+		wantErr      error
+	}{
+		{name: "rỗng", ds: full},
+		{name: "suy ra tỉnh từ phường", ds: full, wardCode: "00001", want: "01"},
+		{name: "chỉ có tỉnh", ds: full, provinceCode: "79", want: "79"},
+		{name: "tỉnh không tồn tại", ds: full, provinceCode: "99", wantErr: ErrUnknownProvince},
+		{name: "phường không tồn tại", ds: full, wardCode: "09619", wantErr: ErrUnknownWard},
+		{name: "phường không thuộc tỉnh", ds: full, wardCode: "00001", provinceCode: "79", wantErr: ErrProvinceMismatch},
+		{name: "nhúng sẵn: phường có trong danh mục", ds: embedded, wardCode: "00001", want: "01"},
+		{name: "nhúng sẵn: phường chưa có được chấp nhận", ds: embedded, wardCode: "09619"},
+		{name: "nhúng sẵn: phường chưa có kèm tỉnh", ds: embedded, wardCode: "09619", provinceCode: "79", want: "79"},
+		{name: "nhúng sẵn: tỉnh vẫn được kiểm tra", ds: embedded, wardCode: "09619", provinceCode: "99", wantErr: ErrUnknownProvince},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := tt.ds.Resolve(tt.wardCode, tt.provinceCode)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Resolve(%q, %q) error = %v, want %v", tt.wardCode, tt.provinceCode, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Resolve(%q, %q): %v", tt.wardCode, tt.provinceCode, err)
+			}
+			got := ""
+			if p != nil {
+				got = p.Code
+			}
+			if got != tt.want {
+				t.Errorf("Resolve(%q, %q) = %q, want %q", tt.wardCode, tt.provinceCode, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package adminunit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+)
+
+// LoadFile đọc danh mục từ file JSON và thay danh mục đang dùng.
+// File lỗi thì giữ nguyên danh mục cũ.
+func LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("#1 LoadFile: %w", err)
+	}
+	defer f.Close()
+
+	ds, err := Parse(f)
+	if err != nil {
+		return fmt.Errorf("#2 LoadFile: %s: %w", path, err)
+	}
+	Replace(ds)
+	return nil
+}
+
+// Watch kiểm tra file định kỳ và nạp lại khi thời điểm sửa đổi thay đổi (ví dụ khi địa giới hành chính
+// được điều chỉnh và file danh mục được cập nhật). onReload nhận kết quả mỗi lần nạp lại.
+// Chạy tới khi ctx bị hủy.
+func Watch(ctx context.Context, path string, interval time.Duration, onReload func(error)) {
+	var lastMod time.Time
+	if info, err := os.Stat(path); err == nil {
+		lastMod = info.ModTime()
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			onReload(fmt.Errorf("#1 Watch: %w", err))
+			continue
+		}
+		if info.ModTime().Equal(lastMod) {
+			continue
+		}
+		lastMod = info.ModTime()
+		onReload(LoadFile(path))
+	}
+}
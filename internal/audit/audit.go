@@ -0,0 +1,76 @@
+// Package audit ghi lại ai đã thay đổi dữ liệu user/account và thay đổi những gì.
+// Mutation trên User và Account được ghi tự động qua Hook; các thao tác nghiệp vụ
+// (export dữ liệu cá nhân, ẩn danh hóa) được service ghi bằng Write.
+package audit
+
+import (
+	"context"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+)
+
+// Loại entity được ghi tự động
+const (
+	EntityUser    = "user"
+	EntityAccount = "account"
+)
+
+// Entry là một dòng audit log
+type Entry struct {
+	Action       string
+	EntityType   string
+	EntityID     int
+	TargetUserID int
+	Changes      map[string]any
+	Details      map[string]any
+}
+
+// Write ghi e; người thực hiện và tổ chức lấy từ viewer trong ctx.
+// Truyền client của transaction để bản ghi chỉ tồn tại khi thao tác được commit.
+func Write(ctx context.Context, c *ent.AuditLogClient, e Entry) error {
+	create := c.Create().SetAction(e.Action)
+	if e.EntityType != "" {
+		create = create.SetEntityType(e.EntityType).SetEntityID(e.EntityID)
+	}
+	if e.TargetUserID > 0 {
+		create = create.SetTargetUserID(e.TargetUserID)
+	}
+	if v := viewer.FromContext(ctx); v != nil {
+		// Service gọi bằng x-org-id không có user
+		if v.UserID != 0 {
+			create = create.SetActorID(v.UserID)
+		}
+		create = create.SetNillableOrgID(v.OrgID)
+	}
+	if e.Changes != nil {
+		create = create.SetChanges(e.Changes)
+	}
+	if e.Details != nil {
+		create = create.SetDetails(e.Details)
+	}
+	return create.Exec(ctx)
+}
+
+type skipKey struct{}
+
+// Skip trả về context không ghi audit log tự động, dùng cho job bảo trì
+// không đổi nội dung dữ liệu (chuẩn hóa số điện thoại, mã hóa lại).
+func Skip(parent context.Context) context.Context {
+	return context.WithValue(parent, skipKey{}, true)
+}
+
+func skipped(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipKey{}).(bool)
+	return skip
+}
+
+// RedactChanges ẩn giá trị trước/sau của mọi field trong changes, chỉ giữ tên field bị đổi.
+// Dùng khi ẩn danh hóa user: audit log cũ không được giữ lại dữ liệu cá nhân đã bị xóa.
+func RedactChanges(changes map[string]any) map[string]any {
+	res := make(map[string]any, len(changes))
+	for f := range changes {
+		res[f] = map[string]any{"from": redacted, "to": redacted}
+	}
+	return res
+}
@@ -0,0 +1,270 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"entgo.io/ent"
+	gen "github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/account"
+	"github.com/huynhthanhthao/hrm_user_service/ent/schema"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/internal/pii"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+)
+
+// Kiểu mutation được ghi audit log
+var entityTypes = map[string]string{
+	gen.TypeUser:    EntityUser,
+	gen.TypeAccount: EntityAccount,
+}
+
+// Field do hệ thống tự cập nhật hoặc dẫn xuất từ field khác, không đưa vào diff.
+// Mutation chỉ đổi các field này (ví dụ cập nhật last_login_at khi đăng nhập) không được ghi.
+var ignoredFields = map[string]bool{
+	"created_at":    true,
+	"updated_at":    true,
+	"search_text":   true,
+	"phone_hash":    true,
+	"email_hash":    true,
+	"perm_version":  true,
+	"version":       true,
+	"last_login_at": true,
+}
+
+const redacted = "[REDACTED]"
+
+type mutation interface {
+	ent.Mutation
+	IDs(ctx context.Context) ([]int, error)
+	Client() *gen.Client
+}
+
+type mutationKey struct{}
+
+// Hook ghi audit log cho mọi mutation trên User và Account, trong cùng transaction nếu có.
+// Đăng ký bằng client.Use để chạy trước hook của schema: giá trị mới còn là plaintext
+// (chưa mã hóa) và xóa mềm được ghi là delete thay vì update deleted_at.
+// Lỗi ghi audit log được trả về cho bên gọi như lỗi của chính mutation.
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			entity, ok := entityTypes[m.Type()]
+			mx, isMutation := m.(mutation)
+			// Hook xóa mềm chạy lại chính mutation này dưới dạng update, không ghi lần hai
+			if !ok || !isMutation || skipped(ctx) || ctx.Value(mutationKey{}) == m {
+				return next.Mutate(ctx, m)
+			}
+
+			op := m.Op()
+			after := changedValues(entity, m)
+			if op.Is(ent.OpUpdate|ent.OpUpdateOne) && len(after) == 0 {
+				return next.Mutate(ctx, m)
+			}
+
+			var ids []int
+			var before map[int]snapshot
+			if !op.Is(ent.OpCreate) {
+				var err error
+				if ids, err = mx.IDs(viewer.Unscoped(ctx)); err != nil {
+					return nil, fmt.Errorf("#1 audit.Hook: failed to query %s ids: %w", entity, err)
+				}
+				if before, err = loadSnapshots(ctx, mx.Client(), entity, ids); err != nil {
+					return nil, fmt.Errorf("#2 audit.Hook: %w", err)
+				}
+			}
+
+			v, err := next.Mutate(context.WithValue(ctx, mutationKey{}, m), m)
+			if err != nil {
+				return nil, err
+			}
+
+			var entries []Entry
+			switch {
+			case op.Is(ent.OpCreate):
+				entries = append(entries, createEntry(entity, m, v, after))
+			case op.Is(ent.OpUpdate | ent.OpUpdateOne):
+				for _, id := range ids {
+					if e, ok := updateEntry(entity, id, before[id], after); ok {
+						entries = append(entries, e)
+					}
+				}
+			default:
+				for _, id := range ids {
+					entries = append(entries, Entry{
+						Action:       entity + ".delete",
+						EntityType:   entity,
+						EntityID:     id,
+						TargetUserID: before[id].targetUserID,
+					})
+				}
+			}
+			for _, e := range entries {
+				if err := Write(ctx, mx.Client().AuditLog, e); err != nil {
+					return nil, fmt.Errorf("#3 audit.Hook: failed to write audit log: %w", err)
+				}
+			}
+			return v, nil
+		})
+	}
+}
+
+// changedValues trả về giá trị mới (đã ẩn/che) của các field bị set hoặc clear
+func changedValues(entity string, m ent.Mutation) map[string]any {
+	values := make(map[string]any)
+	for _, f := range m.Fields() {
+		if ignoredFields[f] {
+			continue
+		}
+		v, _ := m.Field(f)
+		values[f] = redact(entity, f, v)
+	}
+	for _, f := range m.ClearedFields() {
+		if !ignoredFields[f] {
+			values[f] = nil
+		}
+	}
+	return values
+}
+
+func createEntry(entity string, m ent.Mutation, v ent.Value, after map[string]any) Entry {
+	e := Entry{Action: entity + ".create", EntityType: entity, Changes: make(map[string]any, len(after))}
+	switch created := v.(type) {
+	case *gen.User:
+		e.EntityID, e.TargetUserID = created.ID, created.ID
+	case *gen.Account:
+		e.EntityID = created.ID
+		if userID, ok := m.(*gen.AccountMutation).UserID(); ok {
+			e.TargetUserID = userID
+		}
+	}
+	for f, to := range after {
+		e.Changes[f] = map[string]any{"from": nil, "to": to}
+	}
+	return e
+}
+
+// updateEntry so giá trị mới với snapshot; không có field nào thực sự đổi thì bỏ qua
+func updateEntry(entity string, id int, before snapshot, after map[string]any) (Entry, bool) {
+	changes := make(map[string]any)
+	for f, to := range after {
+		// Giá trị cũ của field bị ẩn không đọc được (json:"-"), chỉ ghi nhận là đã đổi
+		if to == redacted {
+			changes[f] = map[string]any{"from": redacted, "to": redacted}
+			continue
+		}
+		from := redact(entity, f, before.values[f])
+		if jsonEqual(from, to) {
+			continue
+		}
+		changes[f] = map[string]any{"from": from, "to": to}
+	}
+	if len(changes) == 0 {
+		return Entry{}, false
+	}
+	return Entry{
+		Action:       entity + ".update",
+		EntityType:   entity,
+		EntityID:     id,
+		TargetUserID: before.targetUserID,
+		Changes:      changes,
+	}, true
+}
+
+// redact ẩn mật khẩu và che phone/email/địa chỉ để audit log không làm lộ dữ liệu đã mã hóa
+func redact(entity, field string, v any) any {
+	if v == nil {
+		return nil
+	}
+	switch entity + "." + field {
+	case EntityAccount + "." + account.FieldPassword:
+		return redacted
+	case EntityUser + "." + user.FieldPhone:
+		return maskString(v, pii.MaskPhone)
+	case EntityUser + "." + user.FieldEmail:
+		return maskString(v, pii.MaskEmail)
+	case EntityUser + "." + user.FieldAddress:
+		return maskString(v, pii.MaskAddress)
+	}
+	return v
+}
+
+func maskString(v any, mask func(string) string) any {
+	s, ok := v.(string)
+	if !ok {
+		return redacted
+	}
+	return mask(s)
+}
+
+func jsonEqual(a, b any) bool {
+	x, errA := json.Marshal(a)
+	y, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(x, y)
+}
+
+// snapshot là giá trị các field của một entity trước khi thay đổi
+type snapshot struct {
+	values       map[string]any
+	targetUserID int
+}
+
+// loadSnapshots đọc các entity sắp bị thay đổi, kể cả dòng đã xóa mềm và ngoài tổ chức của viewer
+func loadSnapshots(ctx context.Context, client *gen.Client, entity string, ids []int) (map[int]snapshot, error) {
+	res := make(map[int]snapshot, len(ids))
+	if len(ids) == 0 {
+		return res, nil
+	}
+	ctx = viewer.Unscoped(schema.SkipSoftDelete(ctx))
+
+	switch entity {
+	case EntityUser:
+		users, err := client.User.Query().Where(user.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query users: %w", err)
+		}
+		for _, u := range users {
+			values, err := toValues(u)
+			if err != nil {
+				return nil, err
+			}
+			res[u.ID] = snapshot{values: values, targetUserID: u.ID}
+		}
+	case EntityAccount:
+		accounts, err := client.Account.Query().Where(account.IDIn(ids...)).WithUser().All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query accounts: %w", err)
+		}
+		for _, a := range accounts {
+			values, err := toValues(a)
+			if err != nil {
+				return nil, err
+			}
+			s := snapshot{values: values}
+			if a.Edges.User != nil {
+				s.targetUserID = a.Edges.User.ID
+			}
+			res[a.ID] = s
+		}
+	}
+	return res, nil
+}
+
+// toValues chuyển entity thành map theo tên field (json tag trùng tên field).
+// Field có json:"-" như password không có trong map nên luôn được xem là thay đổi.
+func toValues(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var values map[string]any
+	if err := dec.Decode(&values); err != nil {
+		return nil, err
+	}
+	delete(values, "edges")
+	return values, nil
+}
@@ -0,0 +1,125 @@
+package avatar
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"github.com/disintegration/imaging"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// Kích thước file upload tối đa
+	MaxFileSize = 5 << 20
+	// Giới hạn số pixel để tránh ảnh "decompression bomb"
+	maxPixels = 40_000_000
+	// Cạnh dài nhất của ảnh gốc sau khi xử lý
+	maxOriginalSide = 1024
+)
+
+var (
+	ErrFileTooLarge    = fmt.Errorf("avatar must be at most %d bytes", MaxFileSize)
+	ErrUnsupportedType = errors.New("avatar must be a JPEG, PNG, GIF or WebP image")
+	ErrImageTooLarge   = errors.New("avatar dimensions are too large")
+	ErrEmptyFile       = errors.New("avatar file is empty")
+)
+
+// Các MIME type được chấp nhận, xác định từ nội dung file (không tin Content-Type của client)
+var allowedTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// Variant là một kích thước ảnh được lưu. Size = 0 là ảnh gốc (đã thu nhỏ tối đa maxOriginalSide).
+type Variant struct {
+	Name string
+	Size int
+}
+
+// Variants: ảnh gốc và các thumbnail vuông
+var Variants = []Variant{
+	{Name: "original", Size: 0},
+	{Name: "256", Size: 256},
+	{Name: "64", Size: 64},
+}
+
+// Image là một variant đã được mã hóa lại
+type Image struct {
+	Variant     Variant
+	Data        []byte
+	ContentType string
+	Ext         string
+}
+
+// Process kiểm tra MIME type và kích thước, xoay ảnh theo EXIF rồi mã hóa lại.
+// Mã hóa lại bằng encoder chuẩn nên toàn bộ metadata EXIF (GPS, thiết bị, ...) bị loại bỏ.
+// PNG/GIF giữ định dạng PNG để không mất nền trong suốt, các loại còn lại lưu JPEG.
+func Process(data []byte) ([]Image, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptyFile
+	}
+	if len(data) > MaxFileSize {
+		return nil, ErrFileTooLarge
+	}
+	mime := DetectType(data)
+	if !allowedTypes[mime] {
+		return nil, ErrUnsupportedType
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("#1 Process: %w: %v", ErrUnsupportedType, err)
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrImageTooLarge
+	}
+
+	src, err := imaging.Decode(bytes.NewReader(data), imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, fmt.Errorf("#2 Process: failed to decode image: %w", err)
+	}
+
+	usePNG := mime == "image/png" || mime == "image/gif"
+	images := make([]Image, 0, len(Variants))
+	for _, v := range Variants {
+		var img image.Image
+		if v.Size == 0 {
+			img = src
+			b := src.Bounds()
+			if b.Dx() > maxOriginalSide || b.Dy() > maxOriginalSide {
+				img = imaging.Fit(src, maxOriginalSide, maxOriginalSide, imaging.Lanczos)
+			}
+		} else {
+			img = imaging.Fill(src, v.Size, v.Size, imaging.Center, imaging.Lanczos)
+		}
+
+		var buf bytes.Buffer
+		out := Image{Variant: v}
+		if usePNG {
+			err = png.Encode(&buf, img)
+			out.ContentType, out.Ext = "image/png", ".png"
+		} else {
+			err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85})
+			out.ContentType, out.Ext = "image/jpeg", ".jpg"
+		}
+		if err != nil {
+			return nil, fmt.Errorf("#3 Process: failed to encode %s: %w", v.Name, err)
+		}
+		out.Data = buf.Bytes()
+		images = append(images, out)
+	}
+	return images, nil
+}
+
+// DetectType xác định MIME type từ nội dung file
+func DetectType(data []byte) string {
+	return http.DetectContentType(data)
+}
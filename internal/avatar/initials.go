@@ -0,0 +1,208 @@
+package avatar
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"image"
+	"image/color"
+	"image/png"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Kích thước avatar mặc định (pixel)
+const (
+	DefaultSize = 128
+	MinSize     = 16
+	MaxSize     = 512
+)
+
+// DefaultPath là đường dẫn HTTP của avatar mặc định, tham số là chữ cái đầu của tên
+const DefaultPath = "/avatars/default/"
+
+var ErrInvalidInitials = errors.New("initials must be one or two letters")
+
+// DefaultURLPrefix là URL gốc của HTTP server (ví dụ "https://api.example.com"), được gán lúc khởi động.
+// Để trống thì DefaultURL trả về đường dẫn tương đối.
+var DefaultURLPrefix string
+
+// Bảng màu nền, đủ tương phản với chữ trắng
+var palette = []color.RGBA{
+	{0xE5, 0x39, 0x35, 0xFF}, {0xD8, 0x1B, 0x60, 0xFF}, {0x8E, 0x24, 0xAA, 0xFF},
+	{0x5E, 0x35, 0xB1, 0xFF}, {0x39, 0x49, 0xAB, 0xFF}, {0x1E, 0x88, 0xE5, 0xFF},
+	{0x03, 0x9B, 0xE5, 0xFF}, {0x00, 0x89, 0x7B, 0xFF}, {0x43, 0xA0, 0x47, 0xFF},
+	{0x7C, 0xB3, 0x42, 0xFF}, {0xF4, 0x51, 0x1E, 0xFF}, {0x6D, 0x4C, 0x41, 0xFF},
+	{0x54, 0x6E, 0x7A, 0xFF}, {0xC0, 0xCA, 0x33, 0xFF}, {0xFB, 0x8C, 0x00, 0xFF},
+	{0x00, 0xAC, 0xC1, 0xFF},
+}
+
+// Color chọn màu nền cố định theo id (hash FNV-1a để id liên tiếp có màu khác nhau)
+func Color(id int) color.RGBA {
+	h := uint32(2166136261)
+	for _, b := range []byte(strconv.Itoa(id)) {
+		h ^= uint32(b)
+		h *= 16777619
+	}
+	return palette[h%uint32(len(palette))]
+}
+
+// firstLetter lấy chữ cái đầu tiên (dạng NFC, giữ dấu) của chuỗi
+func firstLetter(s string) string {
+	for _, r := range norm.NFC.String(s) {
+		if unicode.IsLetter(r) {
+			return string(unicode.ToUpper(r))
+		}
+	}
+	return ""
+}
+
+// Initials lấy chữ cái đầu của họ và của tên theo thứ tự tên người Việt:
+// last_name "Nguyễn Văn", first_name "Đức" -> "NĐ". Dấu được giữ nguyên ("Ánh" -> "Á").
+func Initials(firstName, lastName string) string {
+	family := strings.Fields(lastName)
+	given := strings.Fields(firstName)
+	switch {
+	case len(family) > 0 && len(given) > 0:
+		return firstLetter(family[0]) + firstLetter(given[len(given)-1])
+	case len(given) > 1:
+		return firstLetter(given[0]) + firstLetter(given[len(given)-1])
+	case len(given) == 1:
+		return firstLetter(given[0])
+	case len(family) > 0:
+		return firstLetter(family[0])
+	}
+	return ""
+}
+
+// Ký tự dùng khi user không có tên
+const unknownInitials = "?"
+
+// ValidInitials chuẩn hóa NFC và kiểm tra chuỗi gồm 1-2 chữ cái (hoặc unknownInitials)
+func ValidInitials(s string) (string, error) {
+	s = strings.ToUpper(norm.NFC.String(s))
+	if s == unknownInitials {
+		return s, nil
+	}
+	n := utf8.RuneCountInString(s)
+	if n == 0 || n > 2 {
+		return "", ErrInvalidInitials
+	}
+	for _, r := range s {
+		if !unicode.IsLetter(r) {
+			return "", ErrInvalidInitials
+		}
+	}
+	return s, nil
+}
+
+// DefaultURL trả về URL avatar mặc định của user, dùng khi user chưa có avatar
+func DefaultURL(id int, firstName, lastName string) string {
+	initials := Initials(firstName, lastName)
+	if initials == "" {
+		initials = unknownInitials
+	}
+	return fmt.Sprintf("%s%s%s?seed=%d", DefaultURLPrefix, DefaultPath, url.PathEscape(initials), id)
+}
+
+func hexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02X%02X%02X", c.R, c.G, c.B)
+}
+
+// RenderSVG vẽ avatar tròn với chữ cái đầu; font của trình duyệt hiển thị đủ dấu tiếng Việt
+func RenderSVG(initials string, seed, size int) []byte {
+	fontSize := size * 42 / 100
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, size, size, size, size)
+	fmt.Fprintf(&b, `<circle cx="%d" cy="%d" r="%d" fill="%s"/>`, size/2, size/2, size/2, hexColor(Color(seed)))
+	fmt.Fprintf(&b, `<text x="50%%" y="50%%" dy=".35em" text-anchor="middle" fill="#FFFFFF" `+
+		`font-family="Roboto, 'Segoe UI', Arial, sans-serif" font-size="%d" font-weight="600">%s</text>`,
+		fontSize, html.EscapeString(initials))
+	b.WriteString(`</svg>`)
+	return b.Bytes()
+}
+
+var boldFont *opentype.Font
+
+func init() {
+	f, err := opentype.Parse(gobold.TTF)
+	if err != nil {
+		panic("avatar: failed to parse embedded font: " + err.Error())
+	}
+	boldFont = f
+}
+
+// pngText thay các chữ font nhúng không có glyph (Ấ, Ư, Ơ, ...) bằng chữ gốc không dấu.
+// Đ có glyph riêng nên được giữ nguyên.
+func pngText(initials string) string {
+	var buf sfnt.Buffer
+	strip := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	var out strings.Builder
+	for _, r := range initials {
+		if idx, err := boldFont.GlyphIndex(&buf, r); err == nil && idx != 0 {
+			out.WriteRune(r)
+			continue
+		}
+		base, _, err := transform.String(strip, string(r))
+		if err != nil {
+			base = string(r)
+		}
+		out.WriteString(base)
+	}
+	return out.String()
+}
+
+// RenderPNG vẽ avatar tròn bằng font nhúng, dùng cho client không hiển thị được SVG
+func RenderPNG(initials string, seed, size int) ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	bg := Color(seed)
+	r := float64(size) / 2
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			dx, dy := float64(x)+0.5-r, float64(y)+0.5-r
+			if dx*dx+dy*dy <= r*r {
+				img.SetRGBA(x, y, bg)
+			}
+		}
+	}
+
+	face, err := opentype.NewFace(boldFont, &opentype.FaceOptions{
+		Size:    float64(size) * 0.42,
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("#1 RenderPNG: %w", err)
+	}
+	defer face.Close()
+
+	text := pngText(initials)
+	d := &font.Drawer{Dst: img, Src: image.NewUniform(color.White), Face: face}
+	bounds, _ := d.BoundString(text)
+	w := bounds.Max.X - bounds.Min.X
+	h := bounds.Max.Y - bounds.Min.Y
+	d.Dot = fixed.Point26_6{
+		X: fixed.I(size)/2 - w/2 - bounds.Min.X,
+		Y: fixed.I(size)/2 - h/2 - bounds.Min.Y,
+	}
+	d.DrawString(text)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("#2 RenderPNG: %w", err)
+	}
+	return buf.Bytes(), nil
+}
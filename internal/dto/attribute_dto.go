@@ -0,0 +1,17 @@
+package dto
+
+// Định nghĩa thuộc tính tùy chỉnh; enum_values và pattern chỉ dùng cho type string
+type UpdateAttributeDefinitionDTO struct {
+	Label      string   `json:"label" binding:"omitempty,max=100"`
+	Type       string   `json:"type" binding:"required,oneof=string number boolean date"`
+	Required   bool     `json:"required"`
+	EnumValues []string `json:"enum_values" binding:"omitempty,dive,required"`
+	Pattern    *string  `json:"pattern"`
+}
+
+type CreateAttributeDefinitionDTO struct {
+	// Viewer đang chọn tổ chức có thể bỏ trống
+	OrgID int64  `json:"org_id" binding:"omitempty,gt=0"`
+	Key   string `json:"key" binding:"required,max=64"`
+	UpdateAttributeDefinitionDTO
+}
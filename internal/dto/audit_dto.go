@@ -0,0 +1,15 @@
+package dto
+
+import "time"
+
+// ListAuditLogsParams: các điều kiện lọc để trống thì bỏ qua; kết quả mới nhất trước
+type ListAuditLogsParams struct {
+	PaginationParams
+	Action       string     `json:"action" form:"action"`
+	EntityType   string     `json:"entity_type" form:"entity_type" binding:"omitempty,oneof=user account"`
+	EntityID     int        `json:"entity_id" form:"entity_id" binding:"omitempty,gt=0"`
+	ActorID      int        `json:"actor_id" form:"actor_id" binding:"omitempty,gt=0"`
+	TargetUserID int        `json:"target_user_id" form:"target_user_id" binding:"omitempty,gt=0"`
+	From         *time.Time `json:"from" form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To           *time.Time `json:"to" form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+}
@@ -1,10 +1,29 @@
 package dto
 
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
 type PaginationParams struct {
-	Page     int `json:"page"`
-	PageSize int `json:"page_size"`
+	Page     int `json:"page" form:"page"`
+	PageSize int `json:"page_size" form:"page_size"`
+}
+
+// Normalize đưa page và page_size về khoảng hợp lệ
+func (p *PaginationParams) Normalize() {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.PageSize < 1 {
+		p.PageSize = DefaultPageSize
+	}
+	if p.PageSize > MaxPageSize {
+		p.PageSize = MaxPageSize
+	}
 }
 
 type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token" binding:"required"`
+	OrgID        *int64 `json:"org_id" binding:"omitempty,gt=0"`
 }
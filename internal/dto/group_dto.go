@@ -0,0 +1,39 @@
+package dto
+
+type ListGroupsParams struct {
+	PaginationParams
+	Search string `json:"search" form:"search"`
+}
+
+type CreateGroupDTO struct {
+	// Viewer đang chọn tổ chức có thể bỏ trống
+	OrgID       int64  `json:"org_id" binding:"omitempty,gt=0"`
+	Name        string `json:"name" binding:"required,max=100"`
+	Description string `json:"description" binding:"omitempty,max=500"`
+	// Để trống thì viewer là owner duy nhất
+	OwnerIDs []int `json:"owner_ids" binding:"omitempty,dive,gt=0"`
+}
+
+// Field nil thì giữ nguyên
+type UpdateGroupDTO struct {
+	Name        *string `json:"name" binding:"omitempty,min=1,max=100"`
+	Description *string `json:"description" binding:"omitempty,max=500"`
+}
+
+type GroupMemberDTO struct {
+	UserID int `json:"user_id" binding:"required,gt=0"`
+	// owner | admin | member (mặc định)
+	Role string `json:"role" binding:"omitempty,oneof=owner admin member"`
+}
+
+type AddGroupMembersDTO struct {
+	Members []GroupMemberDTO `json:"members" binding:"required,min=1,dive"`
+}
+
+type UpdateGroupMemberDTO struct {
+	Role string `json:"role" binding:"required,oneof=owner admin member"`
+}
+
+type AddSubgroupDTO struct {
+	SubgroupID int `json:"subgroup_id" binding:"required,gt=0"`
+}
@@ -1,11 +1,14 @@
 package dto
 
 type LoginDto struct {
-	Username string `json:"username" binding:"required,alphanum,min=3,max=20"`
+	// Username hoặc số điện thoại
+	Username string `json:"username" binding:"required,min=3,max=30"`
 	Password string `json:"password" binding:"required"`
+	OrgID    *int64 `json:"org_id" binding:"omitempty,gt=0"`
 }
 
 type LoginInput struct {
 	Username string
 	Password string
+	OrgID    *int64
 }
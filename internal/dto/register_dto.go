@@ -7,7 +7,7 @@ type RegisterDto struct {
 	LastName  string `json:"last_name" binding:"required,max=50"`
 	Email     string `json:"email" binding:"required,email"`
 	Avatar    string `json:"avatar"`
-	Phone     string `json:"phone" binding:"required,numeric,min=10,max=15"`
+	Phone     string `json:"phone" binding:"required,phone"`
 	WardCode  string `json:"ward_code" binding:"required,numeric,min=3,max=10"`
 	Address   string `json:"address" binding:"required,max=200"`
 	Gender    string `json:"gender" binding:"required,oneof=other female male"`
@@ -1,14 +1,70 @@
 package dto
 
+import (
+	"strings"
+	"time"
+)
+
 type UserParams struct {
 	IDs []int `json:"ids"`
 	PaginationParams
 }
+
+type ListUsersParams struct {
+	PaginationParams
+	Search string     `json:"search" form:"search"`
+	Cursor string     `json:"cursor" form:"cursor"`
+	Filter UserFilter `json:"filter"`
+	// REST: danh sách field cách nhau bởi dấu phẩy, tiền tố "-" để sắp xếp giảm dần
+	Sort    string    `json:"-" form:"sort"`
+	OrderBy []OrderBy `json:"order_by" form:"-"`
+}
+
+type UserFilter struct {
+	Genders       []string   `json:"genders" form:"gender" binding:"omitempty,dive,oneof=male female other"`
+	AccountStatus string     `json:"account_status" form:"account_status" binding:"omitempty,oneof=active inactive"`
+	CreatedFrom   *time.Time `json:"created_from" form:"created_from" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedTo     *time.Time `json:"created_to" form:"created_to" time_format:"2006-01-02T15:04:05Z07:00"`
+	UpdatedFrom   *time.Time `json:"updated_from" form:"updated_from" time_format:"2006-01-02T15:04:05Z07:00"`
+	UpdatedTo     *time.Time `json:"updated_to" form:"updated_to" time_format:"2006-01-02T15:04:05Z07:00"`
+	WardCodes     []string   `json:"ward_codes" form:"ward_code"`
+	ProvinceCodes []string   `json:"province_codes" form:"province_code"`
+	HasAvatar     *bool      `json:"has_avatar" form:"has_avatar"`
+	HasEmail      *bool      `json:"has_email" form:"has_email"`
+	// Thuộc tính tùy chỉnh: key -> giá trị (REST: attr[key]=value), của tổ chức viewer đang chọn hoặc AttributeOrgID
+	Attributes     map[string]string `json:"attributes" form:"-"`
+	AttributeOrgID int64             `json:"attribute_org_id" form:"attribute_org_id" binding:"omitempty,gt=0"`
+}
+
+type OrderBy struct {
+	Field string `json:"field"`
+	Desc  bool   `json:"desc"`
+}
+
+// ParseSort chuyển "-created_at,first_name" thành danh sách OrderBy
+func ParseSort(sort string) []OrderBy {
+	var orders []OrderBy
+	for _, part := range strings.Split(sort, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		desc := strings.HasPrefix(part, "-")
+		orders = append(orders, OrderBy{Field: strings.TrimPrefix(part, "-"), Desc: desc})
+	}
+	return orders
+}
+
 type Account struct {
 	Username string `json:"username" binding:"required,alphanum"`
 	Password string `json:"password" binding:"required,min=6,max=50"`
 }
 
+type UpdateAccountDTO struct {
+	Password string `json:"password" binding:"omitempty,min=6,max=50"`
+	Status   string `json:"status" binding:"omitempty,oneof=active inactive"`
+}
+
 type CreateUserInput struct {
 	FirstName string `json:"first_name"`
 	LastName  string `json:"last_name"`
@@ -29,14 +85,20 @@ type CreateUserDTO struct {
 	LastName  string `json:"last_name" binding:"required,max=50"`
 	Gender    string `json:"gender" binding:"required,oneof=male female other"`
 	Email     string `json:"email" binding:"required,email"`
-	Phone     string `json:"phone" binding:"required,numeric,min=10,max=15"`
+	Phone     string `json:"phone" binding:"required,phone"`
 	WardCode  string `json:"ward_code" binding:"required,numeric,min=3,max=10"`
 	Address   string `json:"address" binding:"required,max=200"`
 	Avatar    string `json:"avatar"`
 
+	ProvinceCode string `json:"province_code" binding:"omitempty,numeric,len=2"`
+
 	Account Account  `json:"account" binding:"required"`
 	PermIDs []string `json:"perm_ids" binding:"omitempty,dive,required"`
 	RoleIDs []string `json:"role_ids" binding:"omitempty,dive,required"`
+	OrgIDs  []int64  `json:"org_ids" binding:"omitempty,dive,gt=0"`
+
+	// org_id -> key -> giá trị
+	CustomAttributes map[string]map[string]any `json:"custom_attributes"`
 }
 
 type UpdateUserDTO struct {
@@ -44,12 +106,87 @@ type UpdateUserDTO struct {
 	LastName  string `json:"last_name" binding:"omitempty,max=50"`
 	Gender    string `json:"gender" binding:"omitempty,oneof=male female other"`
 	Email     string `json:"email" binding:"omitempty,email"`
-	Phone     string `json:"phone" binding:"omitempty,numeric,min=10,max=15"`
+	Phone     string `json:"phone" binding:"omitempty,phone"`
 	WardCode  string `json:"ward_code" binding:"omitempty,numeric,min=3,max=10"`
 	Address   string `json:"address" binding:"omitempty,max=200"`
 	Avatar    string `json:"avatar"`
 
-	Account Account  `json:"account" binding:"omitempty"`
-	PermIDs []string `json:"perm_ids" binding:"omitempty,dive"`
-	RoleIDs []string `json:"role_ids" binding:"omitempty,dive"`
+	ProvinceCode string `json:"province_code" binding:"omitempty,numeric,len=2"`
+
+	Account *UpdateAccountDTO `json:"account" binding:"omitempty"`
+	PermIDs []string          `json:"perm_ids" binding:"omitempty,dive"`
+	RoleIDs []string          `json:"role_ids" binding:"omitempty,dive"`
+	OrgIDs  []int64           `json:"org_ids" binding:"omitempty,dive,gt=0"`
+
+	// org_id -> key -> giá trị
+	CustomAttributes map[string]map[string]any `json:"custom_attributes"`
+}
+
+type EmergencyContactDTO struct {
+	Name         string `json:"name" binding:"required,max=100"`
+	Relationship string `json:"relationship" binding:"required,max=50"`
+	Phone        string `json:"phone" binding:"required,phone"`
+	Address      string `json:"address" binding:"omitempty,max=200"`
+}
+
+// Định dạng ngày của hồ sơ nhân sự
+const ProfileDateLayout = "2006-01-02"
+
+// UserProfileDTO là hồ sơ nhân sự; các ngày có dạng YYYY-MM-DD
+type UserProfileDTO struct {
+	DateOfBirth          string `json:"date_of_birth" binding:"omitempty,datetime=2006-01-02"`
+	PlaceOfBirth         string `json:"place_of_birth" binding:"omitempty,max=200"`
+	NationalID           string `json:"national_id" binding:"omitempty,numeric,len=12"`
+	NationalIDIssueDate  string `json:"national_id_issue_date" binding:"omitempty,datetime=2006-01-02"`
+	NationalIDIssuePlace string `json:"national_id_issue_place" binding:"omitempty,max=200"`
+	Ethnicity            string `json:"ethnicity" binding:"omitempty,max=50"`
+	Religion             string `json:"religion" binding:"omitempty,max=50"`
+	MaritalStatus        string `json:"marital_status" binding:"omitempty,oneof=single married divorced widowed"`
+	// Mã số thuế cá nhân: 10 số (mã cũ) hoặc 12 số (trùng số CCCD)
+	TaxCode               string `json:"tax_code" binding:"omitempty,numeric,min=10,max=12"`
+	SocialInsuranceNumber string `json:"social_insurance_number" binding:"omitempty,numeric,len=10"`
+
+	EmergencyContacts []EmergencyContactDTO `json:"emergency_contacts" binding:"omitempty,max=5,dive"`
+}
+
+// ImportUsersForm là các field multipart đi kèm file khi import user
+type ImportUsersForm struct {
+	// "csv" hoặc "xlsx"; để trống thì đoán theo tên file
+	Format    string `form:"format" binding:"omitempty,oneof=csv xlsx"`
+	DryRun    bool   `form:"dry_run"`
+	BatchSize int    `form:"batch_size" binding:"omitempty,min=1,max=500"`
+	// JSON object: tiêu đề cột -> field import, ví dụ {"Mã NV": "username"}
+	ColumnMapping string  `form:"column_mapping"`
+	OrgIDs        []int64 `form:"org_ids" binding:"omitempty,dive,gt=0"`
+}
+
+// ExportUsersParams dùng chung bộ lọc với ListUsersParams
+type ExportUsersParams struct {
+	Search string     `json:"search" form:"search"`
+	Filter UserFilter `json:"filter"`
+	Format string     `json:"format" form:"format" binding:"omitempty,oneof=csv xlsx jsonl"`
+	// REST: danh sách cột cách nhau bởi dấu phẩy; để trống thì export các cột mặc định
+	Columns string `json:"columns" form:"columns"`
+	// Ngôn ngữ tiêu đề cột: en (mặc định) hoặc vi
+	Lang string `json:"lang" form:"lang" binding:"omitempty,oneof=en vi"`
+}
+
+// FindDuplicateUsersParams: để trống thì dùng điểm tối thiểu và giới hạn mặc định
+type FindDuplicateUsersParams struct {
+	MinScore float64 `form:"min_score" binding:"omitempty,gt=0,lte=1"`
+	Limit    int     `form:"limit" binding:"omitempty,min=1,max=500"`
+}
+
+type MergeUsersDTO struct {
+	SurvivorID int `json:"survivor_id" binding:"required,gt=0"`
+	MergedID   int `json:"merged_id" binding:"required,gt=0,nefield=SurvivorID"`
+}
+
+type UserAsOfParams struct {
+	At time.Time `form:"at" binding:"required" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+type DiffUserVersionsParams struct {
+	From int `form:"from" binding:"required,gt=0"`
+	To   int `form:"to" binding:"required,gt=0"`
 }
@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/phone"
+)
+
+// Đăng ký các rule validate riêng với validator của gin binding
+func init() {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return
+	}
+	// phone: số di động Việt Nam hoặc số quốc tế có "+", chuẩn hóa được sang E.164
+	_ = v.RegisterValidation("phone", func(fl validator.FieldLevel) bool {
+		return phone.Valid(fl.Field().String())
+	})
+}
@@ -0,0 +1,68 @@
+package fieldcrypt
+
+import "strings"
+
+// Tên cột dùng làm AAD khi mã hóa và domain của blind index
+const (
+	UserPhone          = "users.phone"
+	UserEmail          = "users.email"
+	UserAddress        = "users.address"
+	ProfileNationalID  = "profiles.national_id"
+	UserVersionPhone   = "user_versions.phone"
+	UserVersionEmail   = "user_versions.email"
+	UserVersionAddress = "user_versions.address"
+)
+
+// Encrypt mã hóa giá trị của cột column bằng keyring đang dùng
+func Encrypt(column, plaintext string) (string, error) {
+	kr := Default()
+	if kr == nil {
+		return "", ErrNoKeyring
+	}
+	return kr.Encrypt(plaintext, column)
+}
+
+// Decrypt giải mã giá trị của cột column bằng keyring đang dùng
+func Decrypt(column, value string) (string, error) {
+	kr := Default()
+	if kr == nil {
+		return "", ErrNoKeyring
+	}
+	return kr.Decrypt(value, column)
+}
+
+// DecryptPtr giải mã giá trị nullable tại chỗ
+func DecryptPtr(column string, value *string) error {
+	if value == nil {
+		return nil
+	}
+	plaintext, err := Decrypt(column, *value)
+	if err != nil {
+		return err
+	}
+	*value = plaintext
+	return nil
+}
+
+func index(domain, value string) (string, error) {
+	kr := Default()
+	if kr == nil {
+		return "", ErrNoKeyring
+	}
+	return kr.BlindIndex(domain, value), nil
+}
+
+// PhoneIndex: blind index của số điện thoại đã chuẩn hóa E.164
+func PhoneIndex(e164 string) (string, error) {
+	return index(UserPhone, e164)
+}
+
+// EmailIndex: blind index của email, không phân biệt hoa thường
+func EmailIndex(email string) (string, error) {
+	return index(UserEmail, strings.ToLower(strings.TrimSpace(email)))
+}
+
+// NationalIDIndex: blind index của số CCCD
+func NationalIDIndex(id string) (string, error) {
+	return index(ProfileNationalID, strings.TrimSpace(id))
+}
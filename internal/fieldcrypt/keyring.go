@@ -0,0 +1,233 @@
+// Package fieldcrypt mã hóa các cột chứa dữ liệu cá nhân (số điện thoại, email, địa chỉ, CCCD)
+// theo kiểu envelope: mỗi giá trị được mã hóa AES-256-GCM bằng một data key ngẫu nhiên,
+// data key được bọc bằng master key (KEK) trong keyring. Keyring có nhiều phiên bản key
+// để xoay vòng: giá trị cũ vẫn giải mã được bằng key cũ cho tới khi được mã hóa lại.
+//
+// Blind index (HMAC-SHA256 của giá trị đã chuẩn hóa) thay cho cột plaintext trong
+// ràng buộc unique và tìm kiếm chính xác theo số điện thoại/email.
+package fieldcrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	keySize = 32
+	// Tiền tố của giá trị đã mã hóa; giá trị không có tiền tố là plaintext cũ (trước khi mã hóa)
+	prefix = "enc:"
+)
+
+var (
+	ErrNoKeyring    = errors.New("field encryption keyring is not loaded")
+	ErrUnknownKey   = errors.New("unknown encryption key")
+	ErrInvalidValue = errors.New("invalid encrypted value")
+)
+
+// Keyring là danh sách master key theo phiên bản và key dùng cho blind index
+type Keyring struct {
+	current  string
+	keys     map[string]cipher.AEAD
+	indexKey []byte
+}
+
+// keyringFile là định dạng file keyring, các key mã hóa base64 (32 byte):
+//
+//	{"current": "2", "keys": {"1": "...", "2": "..."}, "index_key": "..."}
+//
+// index_key không được đổi sau khi đã có dữ liệu vì blind index phải tính lại toàn bộ.
+type keyringFile struct {
+	Current  string            `json:"current"`
+	Keys     map[string]string `json:"keys"`
+	IndexKey string            `json:"index_key"`
+}
+
+func decodeKey(s string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != keySize {
+		return nil, fmt.Errorf("key must be %d bytes, got %d", keySize, len(key))
+	}
+	return key, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// ParseKeyring đọc keyring dạng JSON
+func ParseKeyring(r io.Reader) (*Keyring, error) {
+	var f keyringFile
+	if err := json.NewDecoder(r).Decode(&f); err != nil {
+		return nil, fmt.Errorf("#1 ParseKeyring: invalid keyring: %w", err)
+	}
+	if _, ok := f.Keys[f.Current]; !ok {
+		return nil, fmt.Errorf("#2 ParseKeyring: current key %q not found", f.Current)
+	}
+
+	kr := &Keyring{current: f.Current, keys: make(map[string]cipher.AEAD, len(f.Keys))}
+	for id, encoded := range f.Keys {
+		if id == "" || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("#3 ParseKeyring: invalid key id %q", id)
+		}
+		key, err := decodeKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("#4 ParseKeyring: key %q: %w", id, err)
+		}
+		if kr.keys[id], err = newAEAD(key); err != nil {
+			return nil, fmt.Errorf("#5 ParseKeyring: key %q: %w", id, err)
+		}
+	}
+
+	var err error
+	if kr.indexKey, err = decodeKey(f.IndexKey); err != nil {
+		return nil, fmt.Errorf("#6 ParseKeyring: index_key: %w", err)
+	}
+	return kr, nil
+}
+
+// LoadKeyring đọc keyring từ file
+func LoadKeyring(path string) (*Keyring, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("#1 LoadKeyring: %w", err)
+	}
+	defer f.Close()
+
+	kr, err := ParseKeyring(f)
+	if err != nil {
+		return nil, fmt.Errorf("#2 LoadKeyring: %s: %w", path, err)
+	}
+	return kr, nil
+}
+
+// CurrentKeyID là phiên bản key dùng để mã hóa giá trị mới
+func (kr *Keyring) CurrentKeyID() string {
+	return kr.current
+}
+
+// Encrypt mã hóa plaintext thành "enc:<key id>:<data key đã bọc>:<dữ liệu>".
+// aad (tên cột) gắn ciphertext với cột để không chép được sang cột khác.
+func (kr *Keyring) Encrypt(plaintext, aad string) (string, error) {
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	wrapped, err := seal(kr.keys[kr.current], dataKey, []byte(aad))
+	if err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	data, err := seal(dataAEAD, []byte(plaintext), []byte(aad))
+	if err != nil {
+		return "", err
+	}
+
+	enc := base64.RawStdEncoding
+	return prefix + kr.current + ":" + enc.EncodeToString(wrapped) + ":" + enc.EncodeToString(data), nil
+}
+
+// Decrypt giải mã giá trị do Encrypt tạo; plaintext cũ (không có tiền tố) được trả về nguyên vẹn
+func (kr *Keyring) Decrypt(value, aad string) (string, error) {
+	if !strings.HasPrefix(value, prefix) {
+		return value, nil
+	}
+	parts := strings.Split(strings.TrimPrefix(value, prefix), ":")
+	if len(parts) != 3 {
+		return "", ErrInvalidValue
+	}
+	kek, ok := kr.keys[parts[0]]
+	if !ok {
+		return "", fmt.Errorf("%w %q", ErrUnknownKey, parts[0])
+	}
+
+	enc := base64.RawStdEncoding
+	wrapped, err := enc.DecodeString(parts[1])
+	if err != nil {
+		return "", ErrInvalidValue
+	}
+	data, err := enc.DecodeString(parts[2])
+	if err != nil {
+		return "", ErrInvalidValue
+	}
+	dataKey, err := open(kek, wrapped, []byte(aad))
+	if err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", ErrInvalidValue
+	}
+	plaintext, err := open(dataAEAD, data, []byte(aad))
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// NeedsReencrypt cho biết giá trị là plaintext cũ hoặc được mã hóa bằng key không còn là key hiện tại
+func (kr *Keyring) NeedsReencrypt(value string) bool {
+	return !strings.HasPrefix(value, prefix+kr.current+":")
+}
+
+// BlindIndex trả về HMAC-SHA256 (hex) của value; domain tách biệt index của các cột khác nhau
+func (kr *Keyring) BlindIndex(domain, value string) string {
+	mac := hmac.New(sha256.New, kr.indexKey)
+	mac.Write([]byte(domain))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// seal trả về nonce || ciphertext
+func seal(aead cipher.AEAD, plaintext, aad []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(aead cipher.AEAD, data, aad []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, ErrInvalidValue
+	}
+	plaintext, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], aad)
+	if err != nil {
+		return nil, ErrInvalidValue
+	}
+	return plaintext, nil
+}
+
+var current atomic.Pointer[Keyring]
+
+// Default trả về keyring đang dùng; nil nếu chưa nạp
+func Default() *Keyring {
+	return current.Load()
+}
+
+// Replace thay keyring đang dùng
+func Replace(kr *Keyring) {
+	current.Store(kr)
+}
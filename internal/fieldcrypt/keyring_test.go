@@ -0,0 +1,228 @@
+package fieldcrypt
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func testKey(b byte) string {
+	return base64.StdEncoding.EncodeToString([]byte(strings.Repeat(string(b), keySize)))
+}
+
+func testKeyring(t *testing.T, current string, keys map[string]string) *Keyring {
+	t.Helper()
+	var entries []string
+	for id, key := range keys {
+		entries = append(entries, `"`+id+`": "`+key+`"`)
+	}
+	kr, err := ParseKeyring(strings.NewReader(`{"current": "` + current + `", "keys": {` +
+		strings.Join(entries, ", ") + `}, "index_key": "` + testKey('i') + `"}`))
+	if err != nil {
+		t.Fatalf("ParseKeyring: %v", err)
+	}
+	return kr
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	kr := testKeyring(t, "1", map[string]string{"1": testKey('a')})
+	tests := []struct {
+		name      string
+		plaintext string
+		column    string
+	}{
+		{name: "số điện thoại", plaintext: "+84912345678", column: UserPhone},
+		{name: "email", plaintext: "a@company.vn", column: UserEmail},
+		{name: "tiếng Việt", plaintext: "12 Lê Lợi, Hà Nội", column: UserAddress},
+		{name: "rỗng", plaintext: "", column: ProfileNationalID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enc, err := kr.Encrypt(tt.plaintext, tt.column)
+			if err != nil {
+				t.Fatalf("Encrypt: %v", err)
+			}
+			if !strings.HasPrefix(enc, prefix+"1:") {
+				t.Fatalf("Encrypt = %q, want prefix %q", enc, prefix+"1:")
+			}
+			if tt.plaintext != "" && strings.Contains(enc, tt.plaintext) {
+				t.Fatalf("ciphertext %q contains plaintext", enc)
+			}
+			got, err := kr.Decrypt(enc, tt.column)
+			if err != nil {
+				t.Fatalf("Decrypt: %v", err)
+			}
+			if got != tt.plaintext {
+				t.Errorf("Decrypt = %q, want %q", got, tt.plaintext)
+			}
+
+			again, err := kr.Encrypt(tt.plaintext, tt.column)
+			if err != nil {
+				t.Fatalf("Encrypt: %v", err)
+			}
+			if again == enc {
+				t.Errorf("Encrypt is deterministic: %q", enc)
+			}
+		})
+	}
+}
+
+func TestDecryptErrors(t *testing.T) {
+	kr := testKeyring(t, "1", map[string]string{"1": testKey('a')})
+	enc, err := kr.Encrypt("+84912345678", UserPhone)
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	parts := strings.Split(enc, ":")
+	tampered := strings.Join(append(parts[:3:3], "A"+parts[3][1:]), ":")
+	if tampered == enc {
+		tampered = strings.Join(append(parts[:3:3], "B"+parts[3][1:]), ":")
+	}
+
+	tests := []struct {
+		name    string
+		value   string
+		column  string
+		want    string
+		wantErr error
+	}{
+		{name: "plaintext cũ", value: "0912345678", column: UserPhone, want: "0912345678"},
+		{name: "sai cột (AAD)", value: enc, column: UserEmail, wantErr: ErrInvalidValue},
+		{name: "key không tồn tại", value: prefix + "9:" + parts[2] + ":" + parts[3], column: UserPhone, wantErr: ErrUnknownKey},
+		{name: "thiếu phần", value: prefix + "1:abc", column: UserPhone, wantErr: ErrInvalidValue},
+		{name: "base64 hỏng", value: prefix + "1:!!:!!", column: UserPhone, wantErr: ErrInvalidValue},
+		{name: "dữ liệu bị sửa", value: tampered, column: UserPhone, wantErr: ErrInvalidValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := kr.Decrypt(tt.value, tt.column)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Decrypt error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Decrypt: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Decrypt = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	oldKr := testKeyring(t, "1", map[string]string{"1": testKey('a')})
+	rotated := testKeyring(t, "2", map[string]string{"1": testKey('a'), "2": testKey('b')})
+	withoutOld := testKeyring(t, "2", map[string]string{"2": testKey('b')})
+
+	oldValue, err := oldKr.Encrypt("a@company.vn", UserEmail)
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	newValue, err := rotated.Encrypt("a@company.vn", UserEmail)
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		kr          *Keyring
+		value       string
+		wantReenc   bool
+		wantErr     error
+		wantDecrypt string
+	}{
+		{name: "key cũ vẫn giải mã được", kr: rotated, value: oldValue, wantReenc: true, wantDecrypt: "a@company.vn"},
+		{name: "key hiện tại", kr: rotated, value: newValue, wantReenc: false, wantDecrypt: "a@company.vn"},
+		{name: "plaintext cũ cần mã hóa", kr: rotated, value: "a@company.vn", wantReenc: true, wantDecrypt: "a@company.vn"},
+		{name: "đã bỏ key cũ", kr: withoutOld, value: oldValue, wantReenc: true, wantErr: ErrUnknownKey},
+		{name: "keyring cũ chưa có key mới", kr: oldKr, value: newValue, wantReenc: true, wantErr: ErrUnknownKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.kr.NeedsReencrypt(tt.value); got != tt.wantReenc {
+				t.Errorf("NeedsReencrypt = %v, want %v", got, tt.wantReenc)
+			}
+			got, err := tt.kr.Decrypt(tt.value, UserEmail)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Decrypt error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Decrypt: %v", err)
+			}
+			if got != tt.wantDecrypt {
+				t.Errorf("Decrypt = %q, want %q", got, tt.wantDecrypt)
+			}
+		})
+	}
+}
+
+func TestBlindIndex(t *testing.T) {
+	kr := testKeyring(t, "1", map[string]string{"1": testKey('a')})
+	rotated := testKeyring(t, "2", map[string]string{"1": testKey('a'), "2": testKey('b')})
+
+	if kr.BlindIndex(UserPhone, "+84912345678") != rotated.BlindIndex(UserPhone, "+84912345678") {
+		t.Error("blind index changed after rotating the encryption key")
+	}
+	if kr.BlindIndex(UserPhone, "x") == kr.BlindIndex(UserEmail, "x") {
+		t.Error("blind index of different domains must differ")
+	}
+	if kr.BlindIndex(UserPhone, "+84912345678") == kr.BlindIndex(UserPhone, "+84912345679") {
+		t.Error("blind index of different values must differ")
+	}
+}
+
+func TestIndexNormalization(t *testing.T) {
+	Replace(testKeyring(t, "1", map[string]string{"1": testKey('a')}))
+	t.Cleanup(func() { Replace(nil) })
+
+	tests := []struct {
+		name string
+		fn   func(string) (string, error)
+		a, b string
+	}{
+		{name: "email không phân biệt hoa thường", fn: EmailIndex, a: "A@Company.VN", b: "a@company.vn"},
+		{name: "email bỏ khoảng trắng", fn: EmailIndex, a: " a@company.vn ", b: "a@company.vn"},
+		{name: "CCCD bỏ khoảng trắng", fn: NationalIDIndex, a: " 001099012345 ", b: "001099012345"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := tt.fn(tt.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := tt.fn(tt.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if a != b {
+				t.Errorf("index(%q) != index(%q)", tt.a, tt.b)
+			}
+		})
+	}
+}
+
+func TestParseKeyringErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+	}{
+		{name: "JSON hỏng", json: `{`},
+		{name: "thiếu key hiện tại", json: `{"current": "2", "keys": {"1": "` + testKey('a') + `"}, "index_key": "` + testKey('i') + `"}`},
+		{name: "key sai độ dài", json: `{"current": "1", "keys": {"1": "YWJj"}, "index_key": "` + testKey('i') + `"}`},
+		{name: "thiếu index_key", json: `{"current": "1", "keys": {"1": "` + testKey('a') + `"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseKeyring(strings.NewReader(tt.json)); err == nil {
+				t.Error("ParseKeyring succeeded, want error")
+			}
+		})
+	}
+}
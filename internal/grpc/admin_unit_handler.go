@@ -0,0 +1,40 @@
+package userGrpc
+
+import (
+	"context"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/adminunit"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	userpb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *UserGRPCServer) ListProvinces(ctx context.Context, req *userpb.ListProvincesRequest) (*userpb.ListAdminUnitsResponse, error) {
+	return &userpb.ListAdminUnitsResponse{
+		Units: helper.ToProtoProvinces(adminunit.Default().Provinces),
+	}, nil
+}
+
+func (s *UserGRPCServer) ListDistricts(ctx context.Context, req *userpb.ListDistrictsRequest) (*userpb.ListAdminUnitsResponse, error) {
+	p, ok := adminunit.Default().Province(req.ProvinceCode)
+	if !ok {
+		return nil, status.Error(codes.NotFound, adminunit.ErrUnknownProvince.Error())
+	}
+
+	return &userpb.ListAdminUnitsResponse{
+		Units: helper.ToProtoDistricts(p.Districts),
+	}, nil
+}
+
+func (s *UserGRPCServer) ListWards(ctx context.Context, req *userpb.ListWardsRequest) (*userpb.ListAdminUnitsResponse, error) {
+	d, ok := adminunit.Default().District(req.DistrictCode)
+	if !ok {
+		return nil, status.Error(codes.NotFound, adminunit.ErrUnknownDistrict.Error())
+	}
+
+	return &userpb.ListAdminUnitsResponse{
+		Units: helper.ToProtoWards(d.Wards),
+	}, nil
+}
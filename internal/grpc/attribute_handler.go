@@ -0,0 +1,93 @@
+package userGrpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	userpb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func attributeError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrAttributeForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrInvalidAttributeDefinition), ent.IsValidationError(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case ent.IsConstraintError(err):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case ent.IsNotFound(err):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+func toUpdateAttributeDefinitionDTO(typ, label string, required bool, enumValues []string, pattern *string) dto.UpdateAttributeDefinitionDTO {
+	return dto.UpdateAttributeDefinitionDTO{
+		Label:      label,
+		Type:       typ,
+		Required:   required,
+		EnumValues: enumValues,
+		Pattern:    pattern,
+	}
+}
+
+func (s *UserGRPCServer) ListAttributeDefinitions(ctx context.Context, req *userpb.ListAttributeDefinitionsRequest) (*userpb.ListAttributeDefinitionsResponse, error) {
+	defs, err := s.userService.ListAttributeDefinitions(ctx, req.OrgId)
+	if err != nil {
+		return nil, attributeError(err)
+	}
+	return &userpb.ListAttributeDefinitionsResponse{
+		Definitions: helper.ToProtoAttributeDefinitions(defs),
+	}, nil
+}
+
+func (s *UserGRPCServer) CreateAttributeDefinition(ctx context.Context, req *userpb.CreateAttributeDefinitionRequest) (*userpb.AttributeDefinitionResponse, error) {
+	var pattern *string
+	if req.Pattern != nil {
+		pattern = &req.Pattern.Value
+	}
+	def, err := s.userService.CreateAttributeDefinition(ctx, dto.CreateAttributeDefinitionDTO{
+		OrgID:                        req.OrgId,
+		Key:                          req.Key,
+		UpdateAttributeDefinitionDTO: toUpdateAttributeDefinitionDTO(req.Type, req.Label, req.Required, req.EnumValues, pattern),
+	})
+	if err != nil {
+		return nil, attributeError(err)
+	}
+	return &userpb.AttributeDefinitionResponse{
+		Definition: helper.ToProtoAttributeDefinition(def),
+	}, nil
+}
+
+func (s *UserGRPCServer) UpdateAttributeDefinition(ctx context.Context, req *userpb.UpdateAttributeDefinitionRequest) (*userpb.AttributeDefinitionResponse, error) {
+	var pattern *string
+	if req.Pattern != nil {
+		pattern = &req.Pattern.Value
+	}
+	def, err := s.userService.UpdateAttributeDefinition(ctx, int(req.Id),
+		toUpdateAttributeDefinitionDTO(req.Type, req.Label, req.Required, req.EnumValues, pattern))
+	if err != nil {
+		return nil, attributeError(err)
+	}
+	return &userpb.AttributeDefinitionResponse{
+		Definition: helper.ToProtoAttributeDefinition(def),
+	}, nil
+}
+
+func (s *UserGRPCServer) DeleteAttributeDefinition(ctx context.Context, req *userpb.DeleteAttributeDefinitionRequest) (*userpb.DeleteAttributeDefinitionResponse, error) {
+	affected, err := s.userService.DeleteAttributeDefinition(ctx, int(req.Id))
+	if err != nil {
+		return nil, attributeError(err)
+	}
+	return &userpb.DeleteAttributeDefinitionResponse{
+		Success:       true,
+		AffectedUsers: int32(affected),
+	}, nil
+}
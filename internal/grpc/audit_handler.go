@@ -0,0 +1,47 @@
+package userGrpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	userpb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *UserGRPCServer) ListAuditLogs(ctx context.Context, req *userpb.ListAuditLogsRequest) (*userpb.ListAuditLogsResponse, error) {
+	params := dto.ListAuditLogsParams{
+		PaginationParams: dto.PaginationParams{Page: int(req.Page), PageSize: int(req.PageSize)},
+		Action:           req.Action,
+		EntityType:       req.EntityType,
+		EntityID:         int(req.EntityId),
+		ActorID:          int(req.ActorId),
+		TargetUserID:     int(req.TargetUserId),
+	}
+	if req.From != nil {
+		t := req.From.AsTime()
+		params.From = &t
+	}
+	if req.To != nil {
+		t := req.To.AsTime()
+		params.To = &t
+	}
+
+	page, err := s.userService.ListAuditLogs(ctx, params)
+	if err != nil {
+		if errors.Is(err, service.ErrAuditForbidden) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, err
+	}
+	return &userpb.ListAuditLogsResponse{
+		Logs:        helper.ToProtoAuditLogs(page.Logs),
+		Total:       int32(page.Total),
+		TotalPages:  int32(page.TotalPages),
+		CurrentPage: int32(page.CurrentPage),
+	}, nil
+}
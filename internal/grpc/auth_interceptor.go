@@ -0,0 +1,111 @@
+package userGrpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"strconv"
+	"strings"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Các service chuẩn của gRPC (reflection, health check) không cần xác thực
+func isPublicMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/grpc.reflection.") || strings.HasPrefix(fullMethod, "/grpc.health.")
+}
+
+// viewerFromMetadata dựng viewer từ metadata của request.
+//   - "authorization: Bearer <token>": access token của người dùng (gateway chuyển tiếp)
+//   - "x-service-token": service nội bộ, so khớp với serviceToken; kèm "x-org-id" để làm việc thay mặt một tổ chức
+//
+// Request không có thông tin xác thực hợp lệ bị từ chối. serviceToken rỗng thì không nhận lời gọi nội bộ.
+func viewerFromMetadata(ctx context.Context, authService *service.AuthService, serviceToken string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if values := md.Get("authorization"); len(values) > 0 {
+		parts := strings.SplitN(values[0], " ", 2)
+		if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+			return nil, status.Error(codes.Unauthenticated, "invalid authorization metadata format")
+		}
+		claims, err := authService.ValidateAccessToken(ctx, parts[1])
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return viewer.NewContext(ctx, &viewer.Viewer{
+			UserID:    claims.UserID,
+			OrgID:     claims.OrgID,
+			PermCodes: claims.PermCodes,
+		}), nil
+	}
+
+	values := md.Get("x-service-token")
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	if serviceToken == "" || subtle.ConstantTimeCompare([]byte(values[0]), []byte(serviceToken)) != 1 {
+		return nil, status.Error(codes.Unauthenticated, "invalid service token")
+	}
+
+	if values := md.Get("x-org-id"); len(values) > 0 {
+		orgID, err := strconv.ParseInt(values[0], 10, 64)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid x-org-id metadata")
+		}
+		return viewer.NewContext(ctx, &viewer.Viewer{OrgID: &orgID, Internal: true}), nil
+	}
+	// Service nội bộ không chọn tổ chức: không giới hạn, như lời gọi trong tiến trình
+	return ctx, nil
+}
+
+// authorize xác thực request và kiểm tra perm code của method như RequirePerms bên REST
+func authorize(ctx context.Context, fullMethod string, authService *service.AuthService, serviceToken string) (context.Context, error) {
+	if isPublicMethod(fullMethod) {
+		return ctx, nil
+	}
+	ctx, err := viewerFromMetadata(ctx, authService, serviceToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireMethodPerms(ctx, fullMethod); err != nil {
+		return nil, err
+	}
+	return ctx, nil
+}
+
+// AuthInterceptor gắn viewer vào context của các unary RPC
+func AuthInterceptor(authService *service.AuthService, serviceToken string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authorize(ctx, info.FullMethod, authService, serviceToken)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// viewerStream thay context của stream bằng context đã có viewer
+type viewerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *viewerStream) Context() context.Context {
+	return s.ctx
+}
+
+// AuthStreamInterceptor gắn viewer vào context của các streaming RPC
+func AuthStreamInterceptor(authService *service.AuthService, serviceToken string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), info.FullMethod, authService, serviceToken)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &viewerStream{ServerStream: ss, ctx: ctx})
+	}
+}
@@ -0,0 +1,176 @@
+package userGrpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+	userpb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func groupError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrGroupForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrGroupCycle), errors.Is(err, service.ErrLastGroupOwner):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrGroupOrgMismatch), errors.Is(err, service.ErrInvalidGroupMember), ent.IsValidationError(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case ent.IsConstraintError(err):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case ent.IsNotFound(err):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+func (s *UserGRPCServer) ListGroups(ctx context.Context, req *userpb.ListGroupsRequest) (*userpb.ListGroupsResponse, error) {
+	page, err := s.userService.ListGroups(ctx, dto.ListGroupsParams{
+		PaginationParams: dto.PaginationParams{Page: int(req.Page), PageSize: int(req.PageSize)},
+		Search:           req.Search,
+	})
+	if err != nil {
+		return nil, groupError(err)
+	}
+	return &userpb.ListGroupsResponse{
+		Groups:      helper.ToProtoGroups(page.Groups),
+		Total:       int32(page.Total),
+		TotalPages:  int32(page.TotalPages),
+		CurrentPage: int32(page.CurrentPage),
+	}, nil
+}
+
+func (s *UserGRPCServer) GetGroup(ctx context.Context, req *userpb.GetGroupRequest) (*userpb.GroupResponse, error) {
+	g, err := s.userService.GetGroup(ctx, int(req.Id))
+	if err != nil {
+		return nil, groupError(err)
+	}
+	return &userpb.GroupResponse{Group: helper.ToProtoGroup(g)}, nil
+}
+
+func (s *UserGRPCServer) CreateGroup(ctx context.Context, req *userpb.CreateGroupRequest) (*userpb.GroupResponse, error) {
+	ownerIDs := make([]int, 0, len(req.OwnerIds))
+	for _, id := range req.OwnerIds {
+		ownerIDs = append(ownerIDs, int(id))
+	}
+	g, err := s.userService.CreateGroup(ctx, dto.CreateGroupDTO{
+		OrgID:       req.OrgId,
+		Name:        req.Name,
+		Description: req.Description,
+		OwnerIDs:    ownerIDs,
+	})
+	if err != nil {
+		return nil, groupError(err)
+	}
+	return &userpb.GroupResponse{Group: helper.ToProtoGroup(g)}, nil
+}
+
+func (s *UserGRPCServer) UpdateGroup(ctx context.Context, req *userpb.UpdateGroupRequest) (*userpb.GroupResponse, error) {
+	var input dto.UpdateGroupDTO
+	if req.Name != nil {
+		input.Name = &req.Name.Value
+	}
+	if req.Description != nil {
+		input.Description = &req.Description.Value
+	}
+	g, err := s.userService.UpdateGroup(ctx, int(req.Id), input)
+	if err != nil {
+		return nil, groupError(err)
+	}
+	return &userpb.GroupResponse{Group: helper.ToProtoGroup(g)}, nil
+}
+
+func (s *UserGRPCServer) DeleteGroup(ctx context.Context, req *userpb.DeleteGroupRequest) (*userpb.DeleteGroupResponse, error) {
+	if err := s.userService.DeleteGroup(ctx, int(req.Id)); err != nil {
+		return nil, groupError(err)
+	}
+	return &userpb.DeleteGroupResponse{Success: true}, nil
+}
+
+func (s *UserGRPCServer) ListGroupMembers(ctx context.Context, req *userpb.ListGroupMembersRequest) (*userpb.ListGroupMembersResponse, error) {
+	members, err := s.userService.ListGroupMembers(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, groupError(err)
+	}
+	return &userpb.ListGroupMembersResponse{Members: helper.ToProtoGroupMembers(members)}, nil
+}
+
+func (s *UserGRPCServer) AddGroupMembers(ctx context.Context, req *userpb.AddGroupMembersRequest) (*userpb.ListGroupMembersResponse, error) {
+	if len(req.Members) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "members is required")
+	}
+	input := make([]dto.GroupMemberDTO, 0, len(req.Members))
+	for _, m := range req.Members {
+		input = append(input, dto.GroupMemberDTO{UserID: int(m.UserId), Role: m.Role})
+	}
+	members, err := s.userService.AddGroupMembers(ctx, int(req.GroupId), input)
+	if err != nil {
+		return nil, groupError(err)
+	}
+	return &userpb.ListGroupMembersResponse{Members: helper.ToProtoGroupMembers(members)}, nil
+}
+
+func (s *UserGRPCServer) UpdateGroupMember(ctx context.Context, req *userpb.UpdateGroupMemberRequest) (*userpb.GroupMemberResponse, error) {
+	m, err := s.userService.UpdateGroupMember(ctx, int(req.GroupId), int(req.UserId), req.Role)
+	if err != nil {
+		return nil, groupError(err)
+	}
+	return &userpb.GroupMemberResponse{Member: helper.ToProtoGroupMember(m)}, nil
+}
+
+func (s *UserGRPCServer) RemoveGroupMember(ctx context.Context, req *userpb.RemoveGroupMemberRequest) (*userpb.RemoveGroupMemberResponse, error) {
+	if err := s.userService.RemoveGroupMember(ctx, int(req.GroupId), int(req.UserId)); err != nil {
+		return nil, groupError(err)
+	}
+	return &userpb.RemoveGroupMemberResponse{Success: true}, nil
+}
+
+func (s *UserGRPCServer) AddSubgroup(ctx context.Context, req *userpb.AddSubgroupRequest) (*userpb.GroupResponse, error) {
+	g, err := s.userService.AddSubgroup(ctx, int(req.GroupId), int(req.SubgroupId))
+	if err != nil {
+		return nil, groupError(err)
+	}
+	return &userpb.GroupResponse{Group: helper.ToProtoGroup(g)}, nil
+}
+
+func (s *UserGRPCServer) RemoveSubgroup(ctx context.Context, req *userpb.RemoveSubgroupRequest) (*userpb.GroupResponse, error) {
+	g, err := s.userService.RemoveSubgroup(ctx, int(req.GroupId), int(req.SubgroupId))
+	if err != nil {
+		return nil, groupError(err)
+	}
+	return &userpb.GroupResponse{Group: helper.ToProtoGroup(g)}, nil
+}
+
+func (s *UserGRPCServer) ListUserGroups(ctx context.Context, req *userpb.ListUserGroupsRequest) (*userpb.ListUserGroupsResponse, error) {
+	if err := requireSelfOrPerm(ctx, int(req.UserId), viewer.PermUserRead); err != nil {
+		return nil, err
+	}
+	groups, err := s.userService.ListUserGroups(ctx, int(req.UserId))
+	if err != nil {
+		return nil, groupError(err)
+	}
+	res := make([]*userpb.UserGroup, 0, len(groups))
+	for _, g := range groups {
+		res = append(res, helper.ToProtoUserGroup(g.Group, g.Direct, g.Role))
+	}
+	return &userpb.ListUserGroupsResponse{Groups: res}, nil
+}
+
+func (s *UserGRPCServer) ExpandGroupMembers(ctx context.Context, req *userpb.ExpandGroupMembersRequest) (*userpb.ExpandGroupMembersResponse, error) {
+	members, err := s.userService.ExpandGroupMembers(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, groupError(err)
+	}
+	res := make([]*userpb.ExpandedGroupMember, 0, len(members))
+	for _, m := range members {
+		res = append(res, helper.ToProtoExpandedGroupMember(m.UserID, m.Direct, m.Role, m.ViaGroupIDs))
+	}
+	return &userpb.ExpandGroupMembersResponse{Members: res}, nil
+}
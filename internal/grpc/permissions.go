@@ -0,0 +1,77 @@
+package userGrpc
+
+import (
+	"context"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+	userpb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Perm code bắt buộc của từng RPC, giống RequirePerms của route REST tương ứng.
+// RPC không có trong map tự kiểm tra quyền (chính user đó hoặc có quyền) trong handler/service.
+var methodPerms = map[string][]string{
+	userpb.UserService_ListUsers_FullMethodName:     {viewer.PermUserRead},
+	userpb.UserService_GetUserById_FullMethodName:   {viewer.PermUserRead},
+	userpb.UserService_GetUsersByIDs_FullMethodName: {viewer.PermUserRead},
+	userpb.UserService_SearchUsers_FullMethodName:   {viewer.PermUserRead},
+
+	userpb.UserService_CreateUser_FullMethodName:       {viewer.PermUserCreate},
+	userpb.UserService_BatchCreateUsers_FullMethodName: {viewer.PermUserCreate},
+	userpb.UserService_ImportUsers_FullMethodName:      {viewer.PermUserCreate},
+	userpb.UserService_UpdateUserByID_FullMethodName:   {viewer.PermUserUpdate},
+	userpb.UserService_BatchUpdateUsers_FullMethodName: {viewer.PermUserUpdate},
+	userpb.UserService_DeleteUserByID_FullMethodName:   {viewer.PermUserDelete},
+	userpb.UserService_BatchDeleteUsers_FullMethodName: {viewer.PermUserDelete},
+	userpb.UserService_RestoreUser_FullMethodName:      {viewer.PermUserDelete},
+	userpb.UserService_PurgeUser_FullMethodName:        {viewer.PermUserDelete},
+	userpb.UserService_ExportUsers_FullMethodName:      {viewer.PermUserExport},
+
+	userpb.UserService_FindDuplicateUsers_FullMethodName: {viewer.PermUserMerge},
+	userpb.UserService_MergeUsers_FullMethodName:         {viewer.PermUserMerge},
+	userpb.UserService_AnonymizeUser_FullMethodName:      {viewer.PermUserPersonalData},
+	userpb.UserService_ListAuditLogs_FullMethodName:      {viewer.PermUserAuditRead},
+
+	userpb.UserService_GetUserAsOf_FullMethodName:      {viewer.PermUserRead},
+	userpb.UserService_ListUserVersions_FullMethodName: {viewer.PermUserRead},
+	userpb.UserService_DiffUserVersions_FullMethodName: {viewer.PermUserRead},
+
+	userpb.UserService_ListAttributeDefinitions_FullMethodName:  {viewer.PermUserRead},
+	userpb.UserService_CreateAttributeDefinition_FullMethodName: {viewer.PermUserAttributeManage},
+	userpb.UserService_UpdateAttributeDefinition_FullMethodName: {viewer.PermUserAttributeManage},
+	userpb.UserService_DeleteAttributeDefinition_FullMethodName: {viewer.PermUserAttributeManage},
+
+	userpb.UserService_ListGroups_FullMethodName:         {viewer.PermUserRead},
+	userpb.UserService_GetGroup_FullMethodName:           {viewer.PermUserRead},
+	userpb.UserService_ListGroupMembers_FullMethodName:   {viewer.PermUserRead},
+	userpb.UserService_ExpandGroupMembers_FullMethodName: {viewer.PermUserRead},
+}
+
+// skipPermCheck: lời gọi nội bộ (không có viewer hoặc service token) không bị kiểm tra perm code
+func skipPermCheck(v *viewer.Viewer) bool {
+	return v == nil || v.Internal
+}
+
+func requireMethodPerms(ctx context.Context, fullMethod string) error {
+	v := viewer.FromContext(ctx)
+	if skipPermCheck(v) {
+		return nil
+	}
+	for _, code := range methodPerms[fullMethod] {
+		if !v.HasPerm(code) {
+			return status.Error(codes.PermissionDenied, "missing permission: "+code)
+		}
+	}
+	return nil
+}
+
+// requireSelfOrPerm dùng cho RPC mà user được thao tác trên chính mình (REST tách thành route /me)
+func requireSelfOrPerm(ctx context.Context, userID int, code string) error {
+	v := viewer.FromContext(ctx)
+	if skipPermCheck(v) || (v.UserID != 0 && v.UserID == userID) || v.HasPerm(code) {
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "missing permission: "+code)
+}
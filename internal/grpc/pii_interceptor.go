@@ -0,0 +1,41 @@
+package userGrpc
+
+import (
+	"context"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// PIIMaskInterceptor che phone, email, address của các User trong response theo quyền của viewer.
+// Phải chạy sau AuthInterceptor để context đã có viewer.
+func PIIMaskInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if msg, ok := resp.(proto.Message); ok && err == nil {
+			helper.MaskProto(ctx, msg)
+		}
+		return resp, err
+	}
+}
+
+// maskingStream che PII trong từng message gửi đi của stream
+type maskingStream struct {
+	grpc.ServerStream
+}
+
+func (s *maskingStream) SendMsg(m interface{}) error {
+	if msg, ok := m.(proto.Message); ok {
+		helper.MaskProto(s.Context(), msg)
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+// PIIMaskStreamInterceptor là PIIMaskInterceptor cho streaming RPC
+func PIIMaskStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &maskingStream{ServerStream: ss})
+	}
+}
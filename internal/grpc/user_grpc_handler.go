@@ -1,78 +1,109 @@
 package userGrpc
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"errors"
+	"io"
 	"strconv"
 
-	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/internal/avatar"
 	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
 	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	"github.com/huynhthanhthao/hrm_user_service/internal/userio"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
 	userpb "github.com/huynhthanhthao/hrm_user_service/proto/user"
-	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type UserGRPCServer struct {
 	userpb.UnimplementedUserServiceServer
-	userService *service.UserService
+	userService   *service.UserService
+	avatarService *service.AvatarService
 }
 
-func NewUserGRPCServer(us *service.UserService) *UserGRPCServer {
+func NewUserGRPCServer(us *service.UserService, as *service.AvatarService) *UserGRPCServer {
 	return &UserGRPCServer{
-		userService: us,
+		userService:   us,
+		avatarService: as,
 	}
 }
 
-func entUserToProtoUser(u *ent.User) *userpb.User {
-	if u == nil {
-		return nil
+// toUserFilter chuyển bộ lọc proto sang dto.UserFilter
+func toUserFilter(f *userpb.UserFilter) dto.UserFilter {
+	if f == nil {
+		return dto.UserFilter{}
+	}
+	filter := dto.UserFilter{
+		Genders:       f.Genders,
+		AccountStatus: f.AccountStatus,
+		WardCodes:     f.WardCodes,
+		ProvinceCodes: f.ProvinceCodes,
+
+		Attributes:     f.Attributes,
+		AttributeOrgID: f.AttributeOrgId,
 	}
-	var (
-		email, wardCode, address, avatar *wrapperspb.StringValue
-	)
-	if u.Email != nil {
-		email = wrapperspb.String(*u.Email)
+	if f.CreatedFrom != nil {
+		t := f.CreatedFrom.AsTime()
+		filter.CreatedFrom = &t
 	}
-	if u.WardCode != nil {
-		wardCode = wrapperspb.String(*u.WardCode)
+	if f.CreatedTo != nil {
+		t := f.CreatedTo.AsTime()
+		filter.CreatedTo = &t
 	}
-	if u.Address != nil {
-		address = wrapperspb.String(*u.Address)
+	if f.UpdatedFrom != nil {
+		t := f.UpdatedFrom.AsTime()
+		filter.UpdatedFrom = &t
 	}
-	if u.Avatar != nil {
-		avatar = wrapperspb.String(*u.Avatar)
+	if f.UpdatedTo != nil {
+		t := f.UpdatedTo.AsTime()
+		filter.UpdatedTo = &t
 	}
-	return &userpb.User{
-		Id:        int32(u.ID),
-		FirstName: u.FirstName,
-		LastName:  u.LastName,
-		Gender:    string(u.Gender),
-		Phone:     wrapperspb.String(u.Phone),
-		Email:     email,
-		WardCode:  wardCode,
-		Address:   address,
-		Avatar:    avatar,
-		CreatedAt: u.CreatedAt.String(),
-		UpdatedAt: u.UpdatedAt.String(),
+	if f.HasAvatar != nil {
+		filter.HasAvatar = &f.HasAvatar.Value
 	}
+	if f.HasEmail != nil {
+		filter.HasEmail = &f.HasEmail.Value
+	}
+	return filter
+}
+
+func toOrderBy(orders []*userpb.OrderBy) []dto.OrderBy {
+	res := make([]dto.OrderBy, 0, len(orders))
+	for _, o := range orders {
+		res = append(res, dto.OrderBy{Field: o.Field, Desc: o.Desc})
+	}
+	return res
 }
 
 func (s *UserGRPCServer) ListUsers(ctx context.Context, req *userpb.ListUsersRequest) (*userpb.ListUsersResponse, error) {
-	users, err := s.userService.GetAllUsers(ctx)
+	page, err := s.userService.ListUsers(ctx, dto.ListUsersParams{
+		PaginationParams: dto.PaginationParams{
+			Page:     int(req.Page),
+			PageSize: int(req.PageSize),
+		},
+		Search:  req.Search,
+		Cursor:  req.Cursor,
+		Filter:  toUserFilter(req.Filter),
+		OrderBy: toOrderBy(req.OrderBy),
+	})
+	if errors.Is(err, service.ErrInvalidAttributeFilter) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	var res []*userpb.User
-	for _, u := range users {
-		res = append(res, entUserToProtoUser(u))
-	}
-
-	/*
-		Gọi qua lấy permission và map res
-	*/
-
 	return &userpb.ListUsersResponse{
-		Users: res,
+		Users:       helper.EntUsersToProtoUsers(page.Users),
+		TotalPages:  int32(page.TotalPages),
+		TotalUsers:  int32(page.TotalUsers),
+		CurrentPage: int32(page.CurrentPage),
+		NextCursor:  page.NextCursor,
 	}, nil
 }
 
@@ -82,47 +113,22 @@ func (s *UserGRPCServer) GetUserById(ctx context.Context, req *userpb.GetUserByI
 		return nil, err
 	}
 
-	// Lấy vai trò
-	rolesResp, err := s.userService.GetUserRolesByUserId(ctx, strconv.Itoa(int(req.Id)))
+	// Lấy vai trò (theo user.ID: id cũ của user đã bị gộp trả về survivor)
+	rolesResp, err := s.userService.GetUserRolesByUserId(ctx, strconv.Itoa(user.ID))
 	if err != nil {
 		return nil, err
 	}
 
 	// Lấy quyền
-	permsResp, err := s.userService.GetUserPermsByUserId(ctx, strconv.Itoa(int(req.Id)))
+	permsResp, err := s.userService.GetUserPermsByUserId(ctx, strconv.Itoa(user.ID))
 	if err != nil {
 		return nil, err
 	}
 
-	// Map roles
-	var roles []*userpb.RoleExt
-	for _, r := range rolesResp.Roles {
-		roles = append(roles, &userpb.RoleExt{
-			Id:          r.Id,
-			Code:        r.Code,
-			Name:        r.Name,
-			Color:       r.Color,
-			Description: r.Description,
-			CreatedAt:   r.CreatedAt,
-			UpdatedAt:   r.UpdatedAt,
-		})
-	}
-
-	// Map permissions
-	var perms []*userpb.PermExt
-	for _, p := range permsResp.Perms {
-		perms = append(perms, &userpb.PermExt{
-			Id:          p.Id,
-			Code:        p.Code,
-			Name:        p.Name,
-			Description: p.Description,
-		})
-	}
-
 	return &userpb.GetUserByIdResponse{
-		User:  entUserToProtoUser(user),
-		Roles: roles,
-		Perms: perms,
+		User:  helper.EntUserToProtoUser(user),
+		Roles: helper.ToProtoRoles(rolesResp.Roles),
+		Perms: helper.ToProtoPerms(permsResp.Perms),
 	}, nil
 }
 
@@ -145,25 +151,41 @@ func (s *UserGRPCServer) GetUsersByIDs(ctx context.Context, req *userpb.GetUsers
 		return nil, err
 	}
 
-	var res []*userpb.User
-	for _, u := range users {
-		res = append(res, entUserToProtoUser(u))
+	return &userpb.GetUsersByIDsResponse{
+		Users: helper.EntUsersToProtoUsers(users),
+	}, nil
+}
+
+func (s *UserGRPCServer) SearchUsers(ctx context.Context, req *userpb.SearchUsersRequest) (*userpb.SearchUsersResponse, error) {
+	hits, err := s.userService.SearchUsers(ctx, req.Query, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*userpb.UserSearchHit, 0, len(hits))
+	for _, h := range hits {
+		res = append(res, &userpb.UserSearchHit{
+			User:  helper.EntUserToProtoUser(h.User),
+			Score: h.Score,
+		})
 	}
 
-	return &userpb.GetUsersByIDsResponse{
-		Users: res,
+	return &userpb.SearchUsersResponse{
+		Hits: res,
 	}, nil
 }
 
 func (s *UserGRPCServer) CreateUser(ctx context.Context, req *userpb.CreateUserRequest) (*userpb.CreateUserResponse, error) {
 	user, err := s.userService.CreateUser(ctx, req)
-
+	if errors.Is(err, service.ErrInvalidCustomAttributes) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	return &userpb.CreateUserResponse{
-		User: entUserToProtoUser(user),
+		User: helper.EntUserToProtoUser(user),
 	}, nil
 }
 
@@ -175,6 +197,13 @@ func (s *UserGRPCServer) UpdateUserByID(ctx context.Context, req *userpb.UpdateU
 	defer tx.Rollback()
 
 	user, err := s.userService.UpdateUserByID(ctx, tx, int(req.Id), req)
+	var conflict *service.VersionConflictError
+	if errors.As(err, &conflict) {
+		return nil, versionConflictError(ctx, conflict)
+	}
+	if errors.Is(err, service.ErrInvalidUpdateMask) || errors.Is(err, service.ErrInvalidCustomAttributes) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -184,10 +213,21 @@ func (s *UserGRPCServer) UpdateUserByID(ctx context.Context, req *userpb.UpdateU
 	}
 
 	return &userpb.UpdateUserResponse{
-		User: entUserToProtoUser(user),
+		User: helper.EntUserToProtoUser(user),
 	}, nil
 }
 
+// versionConflictError trả về ABORTED, details chứa bản ghi hiện tại (đã che PII vì interceptor không xử lý lỗi)
+func versionConflictError(ctx context.Context, conflict *service.VersionConflictError) error {
+	detail := helper.ToProtoVersionConflict(conflict.Error(), conflict.Expected, conflict.Current)
+	helper.MaskProto(ctx, detail)
+	st, err := status.New(codes.Aborted, conflict.Error()).WithDetails(detail)
+	if err != nil {
+		return status.Error(codes.Aborted, conflict.Error())
+	}
+	return st.Err()
+}
+
 func (s *UserGRPCServer) DeleteUserByID(ctx context.Context, req *userpb.DeleteUserRequest) (*userpb.DeleteUserResponse, error) {
 	err := s.userService.DeleteUserByID(ctx, int(req.Id))
 	if err != nil {
@@ -198,3 +238,262 @@ func (s *UserGRPCServer) DeleteUserByID(ctx context.Context, req *userpb.DeleteU
 		Success: true,
 	}, nil
 }
+
+func (s *UserGRPCServer) RestoreUser(ctx context.Context, req *userpb.RestoreUserRequest) (*userpb.RestoreUserResponse, error) {
+	user, err := s.userService.RestoreUser(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	return &userpb.RestoreUserResponse{
+		User: helper.EntUserToProtoUser(user),
+	}, nil
+}
+
+func (s *UserGRPCServer) PurgeUser(ctx context.Context, req *userpb.PurgeUserRequest) (*userpb.PurgeUserResponse, error) {
+	avatar, err := s.userService.PurgeUser(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if avatar != nil {
+		s.avatarService.DeleteAvatarFiles(ctx, *avatar)
+	}
+
+	return &userpb.PurgeUserResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *UserGRPCServer) UploadAvatar(ctx context.Context, req *userpb.UploadAvatarRequest) (*userpb.UploadAvatarResponse, error) {
+	if err := requireSelfOrPerm(ctx, int(req.UserId), viewer.PermUserUpdate); err != nil {
+		return nil, err
+	}
+	user, err := s.avatarService.SetAvatar(ctx, int(req.UserId), req.Data)
+	switch {
+	case errors.Is(err, avatar.ErrFileTooLarge), errors.Is(err, avatar.ErrImageTooLarge),
+		errors.Is(err, avatar.ErrUnsupportedType), errors.Is(err, avatar.ErrEmptyFile):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		return nil, err
+	}
+
+	return &userpb.UploadAvatarResponse{
+		User: helper.EntUserToProtoUser(user),
+	}, nil
+}
+
+func profileError(err error) error {
+	if errors.Is(err, service.ErrProfileForbidden) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return err
+}
+
+func (s *UserGRPCServer) GetUserProfile(ctx context.Context, req *userpb.GetUserProfileRequest) (*userpb.GetUserProfileResponse, error) {
+	p, err := s.userService.GetUserProfile(ctx, int(req.UserId))
+	if err != nil {
+		return nil, profileError(err)
+	}
+
+	profile := helper.ToProtoProfile(int(req.UserId), p)
+	if profile == nil {
+		profile = &userpb.UserProfile{UserId: req.UserId}
+	}
+	return &userpb.GetUserProfileResponse{
+		Profile: profile,
+	}, nil
+}
+
+func (s *UserGRPCServer) UpdateUserProfile(ctx context.Context, req *userpb.UpdateUserProfileRequest) (*userpb.UpdateUserProfileResponse, error) {
+	p, err := s.userService.UpdateUserProfile(ctx, int(req.UserId), req.Profile)
+	if err != nil {
+		return nil, profileError(err)
+	}
+
+	return &userpb.UpdateUserProfileResponse{
+		Profile: helper.ToProtoProfile(int(req.UserId), p),
+	}, nil
+}
+
+// toBatchItems chuyển kết quả batch sang proto và đếm số phần tử thành công/thất bại
+func toBatchItems(results []service.BatchItemResult) ([]*userpb.BatchItemStatus, int32, int32) {
+	items := make([]*userpb.BatchItemStatus, 0, len(results))
+	var succeeded, failed int32
+	for _, r := range results {
+		item := &userpb.BatchItemStatus{
+			Index:   int32(r.Index),
+			Success: r.Err == nil,
+			Id:      int32(r.ID),
+			User:    helper.EntUserToProtoUser(r.User),
+		}
+		if r.Err != nil {
+			item.Error = r.Err.Error()
+			failed++
+		} else {
+			succeeded++
+		}
+		items = append(items, item)
+	}
+	return items, succeeded, failed
+}
+
+func batchError(err error) error {
+	if errors.Is(err, service.ErrBatchEmpty) || errors.Is(err, service.ErrBatchTooLarge) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+func (s *UserGRPCServer) BatchCreateUsers(ctx context.Context, req *userpb.BatchCreateUsersRequest) (*userpb.BatchCreateUsersResponse, error) {
+	results, err := s.userService.BatchCreateUsers(ctx, req.Items, req.AllOrNothing)
+	if err != nil {
+		return nil, batchError(err)
+	}
+
+	items, succeeded, failed := toBatchItems(results)
+	return &userpb.BatchCreateUsersResponse{
+		Items:     items,
+		Succeeded: succeeded,
+		Failed:    failed,
+	}, nil
+}
+
+func (s *UserGRPCServer) BatchUpdateUsers(ctx context.Context, req *userpb.BatchUpdateUsersRequest) (*userpb.BatchUpdateUsersResponse, error) {
+	results, err := s.userService.BatchUpdateUsers(ctx, req.Items, req.AllOrNothing)
+	if err != nil {
+		return nil, batchError(err)
+	}
+
+	items, succeeded, failed := toBatchItems(results)
+	return &userpb.BatchUpdateUsersResponse{
+		Items:     items,
+		Succeeded: succeeded,
+		Failed:    failed,
+	}, nil
+}
+
+func (s *UserGRPCServer) BatchDeleteUsers(ctx context.Context, req *userpb.BatchDeleteUsersRequest) (*userpb.BatchDeleteUsersResponse, error) {
+	ids := make([]int, len(req.Ids))
+	for i, id := range req.Ids {
+		ids[i] = int(id)
+	}
+
+	results, err := s.userService.BatchDeleteUsers(ctx, ids, req.AllOrNothing)
+	if err != nil {
+		return nil, batchError(err)
+	}
+
+	items, succeeded, failed := toBatchItems(results)
+	return &userpb.BatchDeleteUsersResponse{
+		Items:     items,
+		Succeeded: succeeded,
+		Failed:    failed,
+	}, nil
+}
+
+func (s *UserGRPCServer) ImportUsers(stream userpb.UserService_ImportUsersServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	opts := first.GetOptions()
+	if opts == nil {
+		return status.Error(codes.InvalidArgument, "first message must contain import options")
+	}
+	format, err := userio.ParseFormat(opts.Format, opts.Filename)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var buf bytes.Buffer
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if buf.Len()+len(msg.GetChunk()) > userio.MaxFileSize {
+			return status.Error(codes.InvalidArgument, userio.ErrFileTooLarge.Error())
+		}
+		buf.Write(msg.GetChunk())
+	}
+
+	rows, err := userio.Parse(&buf, format, opts.ColumnMapping)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	summary, err := s.userService.ImportUsers(stream.Context(), rows, service.ImportOptions{
+		DryRun:    opts.DryRun,
+		BatchSize: int(opts.BatchSize),
+		OrgIDs:    opts.OrgIds,
+	}, func(r userio.ImportRowResult) error {
+		return stream.Send(&userpb.ImportUsersResponse{
+			Payload: &userpb.ImportUsersResponse_Row{Row: helper.ToProtoImportRow(r)},
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&userpb.ImportUsersResponse{
+		Payload: &userpb.ImportUsersResponse_Summary{Summary: helper.ToProtoImportSummary(summary)},
+	})
+}
+
+// exportChunkSize là kích thước tối đa của mỗi chunk gửi cho client
+const exportChunkSize = 64 << 10
+
+// chunkWriter gửi dữ liệu export thành các message ExportUsersChunk
+type chunkWriter struct {
+	stream      userpb.UserService_ExportUsersServer
+	contentType string
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	chunk := &userpb.ExportUsersChunk{Data: append([]byte(nil), p...)}
+	if w.contentType != "" {
+		chunk.ContentType = w.contentType
+		w.contentType = ""
+	}
+	if err := w.stream.Send(chunk); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *UserGRPCServer) ExportUsers(req *userpb.ExportUsersRequest, stream userpb.UserService_ExportUsersServer) error {
+	ctx := stream.Context()
+
+	format := userio.FormatCSV
+	if req.Format != "" {
+		var err error
+		if format, err = userio.ParseFormat(req.Format, ""); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	columns, err := userio.ResolveColumns(req.Columns, service.CanExportSensitive(ctx))
+	if errors.Is(err, userio.ErrSensitiveColumn) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	out := bufio.NewWriterSize(&chunkWriter{stream: stream, contentType: userio.ContentType(format)}, exportChunkSize)
+	exporter, err := userio.NewExporter(out, format, columns, req.Lang)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := s.userService.ExportUsers(ctx, req.Search, toUserFilter(req.Filter), columns, exporter); err != nil {
+		return err
+	}
+	if err := exporter.Close(); err != nil {
+		return err
+	}
+	return out.Flush()
+}
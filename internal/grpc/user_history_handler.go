@@ -0,0 +1,65 @@
+package userGrpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	userpb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func historyError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrNoUserVersion), errors.Is(err, service.ErrUserVersionNotFound), ent.IsNotFound(err):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+func toProtoFieldChanges(changes []service.FieldChange) []*userpb.FieldChange {
+	res := make([]*userpb.FieldChange, 0, len(changes))
+	for _, c := range changes {
+		res = append(res, helper.ToProtoFieldChange(c.Field, c.From, c.To))
+	}
+	return res
+}
+
+func toProtoUserVersions(entries []*service.UserVersionEntry) []*userpb.UserVersion {
+	res := make([]*userpb.UserVersion, 0, len(entries))
+	for _, e := range entries {
+		res = append(res, helper.ToProtoUserVersion(e.Version, toProtoFieldChanges(e.Changes)))
+	}
+	return res
+}
+
+func (s *UserGRPCServer) GetUserAsOf(ctx context.Context, req *userpb.GetUserAsOfRequest) (*userpb.GetUserAsOfResponse, error) {
+	if req.At == nil {
+		return nil, status.Error(codes.InvalidArgument, "at is required")
+	}
+	v, err := s.userService.GetUserAsOf(ctx, int(req.Id), req.At.AsTime())
+	if err != nil {
+		return nil, historyError(err)
+	}
+	return &userpb.GetUserAsOfResponse{Version: helper.ToProtoUserVersion(v, nil)}, nil
+}
+
+func (s *UserGRPCServer) ListUserVersions(ctx context.Context, req *userpb.ListUserVersionsRequest) (*userpb.ListUserVersionsResponse, error) {
+	entries, err := s.userService.ListUserVersions(ctx, int(req.Id))
+	if err != nil {
+		return nil, historyError(err)
+	}
+	return &userpb.ListUserVersionsResponse{Versions: toProtoUserVersions(entries)}, nil
+}
+
+func (s *UserGRPCServer) DiffUserVersions(ctx context.Context, req *userpb.DiffUserVersionsRequest) (*userpb.DiffUserVersionsResponse, error) {
+	changes, err := s.userService.DiffUserVersions(ctx, int(req.Id), int(req.FromVersion), int(req.ToVersion))
+	if err != nil {
+		return nil, historyError(err)
+	}
+	return &userpb.DiffUserVersionsResponse{UserId: req.Id, Changes: toProtoFieldChanges(changes)}, nil
+}
@@ -0,0 +1,55 @@
+package userGrpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	userpb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func mergeError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrMergeForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrMergeSameUser):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case ent.IsNotFound(err):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+func (s *UserGRPCServer) FindDuplicateUsers(ctx context.Context, req *userpb.FindDuplicateUsersRequest) (*userpb.FindDuplicateUsersResponse, error) {
+	candidates, err := s.userService.FindDuplicateUsers(ctx, service.DuplicateOptions{
+		MinScore: req.MinScore,
+		Limit:    int(req.Limit),
+	})
+	if err != nil {
+		return nil, mergeError(err)
+	}
+
+	res := make([]*userpb.DuplicateCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		res = append(res, &userpb.DuplicateCandidate{
+			User:    helper.EntUserToProtoUser(c.User),
+			Other:   helper.EntUserToProtoUser(c.Other),
+			Score:   c.Score,
+			Reasons: c.Reasons,
+		})
+	}
+	return &userpb.FindDuplicateUsersResponse{Candidates: res}, nil
+}
+
+func (s *UserGRPCServer) MergeUsers(ctx context.Context, req *userpb.MergeUsersRequest) (*userpb.MergeUsersResponse, error) {
+	usr, err := s.userService.MergeUsers(ctx, int(req.SurvivorId), int(req.MergedId))
+	if err != nil {
+		return nil, mergeError(err)
+	}
+	return &userpb.MergeUsersResponse{User: helper.EntUserToProtoUser(usr)}, nil
+}
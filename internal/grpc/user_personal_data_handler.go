@@ -0,0 +1,45 @@
+package userGrpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	userpb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func personalDataError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrPersonalDataForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrUserAnonymized):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case ent.IsNotFound(err):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+func (s *UserGRPCServer) ExportPersonalData(ctx context.Context, req *userpb.ExportPersonalDataRequest) (*userpb.PersonalDataExport, error) {
+	data, err := s.userService.ExportPersonalData(ctx, int(req.UserId))
+	if err != nil {
+		return nil, personalDataError(err)
+	}
+	return helper.ToProtoPersonalData(data.ExportedAt, data.User, data.LoginHistory, data.Sessions, toProtoUserVersions(data.Versions)), nil
+}
+
+func (s *UserGRPCServer) AnonymizeUser(ctx context.Context, req *userpb.AnonymizeUserRequest) (*userpb.AnonymizeUserResponse, error) {
+	res, err := s.userService.AnonymizeUser(ctx, int(req.UserId))
+	if err != nil {
+		return nil, personalDataError(err)
+	}
+	if res.PreviousAvatar != nil {
+		s.avatarService.DeleteAvatarFiles(ctx, *res.PreviousAvatar)
+	}
+	return &userpb.AnonymizeUserResponse{User: helper.EntUserToProtoUser(res.User)}, nil
+}
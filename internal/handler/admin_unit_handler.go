@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/adminunit"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminUnitHandler phục vụ danh mục đơn vị hành chính (dữ liệu công khai, không cần đăng nhập)
+type AdminUnitHandler struct{}
+
+func NewAdminUnitHandler() *AdminUnitHandler {
+	return &AdminUnitHandler{}
+}
+
+// GET /admin-units/provinces
+func (h *AdminUnitHandler) ListProvinces(c *gin.Context) {
+	helper.RespondWithProto(c, http.StatusOK, &userPb.ListAdminUnitsResponse{
+		Units: helper.ToProtoProvinces(adminunit.Default().Provinces),
+	})
+}
+
+// GET /admin-units/provinces/:code/districts
+func (h *AdminUnitHandler) ListDistricts(c *gin.Context) {
+	p, ok := adminunit.Default().Province(c.Param("code"))
+	if !ok {
+		helper.RespondWithError(c, http.StatusNotFound, adminunit.ErrUnknownProvince)
+		return
+	}
+
+	helper.RespondWithProto(c, http.StatusOK, &userPb.ListAdminUnitsResponse{
+		Units: helper.ToProtoDistricts(p.Districts),
+	})
+}
+
+// GET /admin-units/districts/:code/wards
+func (h *AdminUnitHandler) ListWards(c *gin.Context) {
+	d, ok := adminunit.Default().District(c.Param("code"))
+	if !ok {
+		helper.RespondWithError(c, http.StatusNotFound, adminunit.ErrUnknownDistrict)
+		return
+	}
+
+	helper.RespondWithProto(c, http.StatusOK, &userPb.ListAdminUnitsResponse{
+		Units: helper.ToProtoWards(d.Wards),
+	})
+}
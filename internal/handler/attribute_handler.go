@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+func respondWithAttributeError(c *gin.Context, err error) {
+	if errors.Is(err, service.ErrAttributeForbidden) {
+		helper.RespondWithError(c, http.StatusForbidden, err)
+		return
+	}
+	respondWithServiceError(c, err)
+}
+
+func parseAttributeDefinitionID(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		helper.RespondWithError(c, http.StatusBadRequest, errors.New("invalid attribute definition id"))
+		return 0, false
+	}
+	return id, true
+}
+
+// GET /attribute-definitions?org_id=
+func (h *UserHandler) ListAttributeDefinitions(c *gin.Context) {
+	var orgID int64
+	if v := c.Query("org_id"); v != "" {
+		var err error
+		if orgID, err = strconv.ParseInt(v, 10, 64); err != nil || orgID <= 0 {
+			helper.RespondWithError(c, http.StatusBadRequest, errors.New("invalid org_id"))
+			return
+		}
+	}
+
+	defs, err := h.userService.ListAttributeDefinitions(c.Request.Context(), orgID)
+	if err != nil {
+		respondWithAttributeError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.ListAttributeDefinitionsResponse{
+		Definitions: helper.ToProtoAttributeDefinitions(defs),
+	})
+}
+
+// POST /attribute-definitions
+func (h *UserHandler) CreateAttributeDefinition(c *gin.Context) {
+	var req dto.CreateAttributeDefinitionDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	def, err := h.userService.CreateAttributeDefinition(c.Request.Context(), req)
+	if err != nil {
+		respondWithAttributeError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusCreated, &userPb.AttributeDefinitionResponse{
+		Definition: helper.ToProtoAttributeDefinition(def),
+	})
+}
+
+// PUT /attribute-definitions/:id
+func (h *UserHandler) UpdateAttributeDefinition(c *gin.Context) {
+	id, ok := parseAttributeDefinitionID(c)
+	if !ok {
+		return
+	}
+	var req dto.UpdateAttributeDefinitionDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	def, err := h.userService.UpdateAttributeDefinition(c.Request.Context(), id, req)
+	if err != nil {
+		respondWithAttributeError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.AttributeDefinitionResponse{
+		Definition: helper.ToProtoAttributeDefinition(def),
+	})
+}
+
+// DELETE /attribute-definitions/:id
+func (h *UserHandler) DeleteAttributeDefinition(c *gin.Context) {
+	id, ok := parseAttributeDefinitionID(c)
+	if !ok {
+		return
+	}
+
+	affected, err := h.userService.DeleteAttributeDefinition(c.Request.Context(), id)
+	if err != nil {
+		respondWithAttributeError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.DeleteAttributeDefinitionResponse{
+		Success:       true,
+		AffectedUsers: int32(affected),
+	})
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GET /audit-logs?action=&entity_type=&entity_id=&actor_id=&target_user_id=&from=&to=&page=&page_size=
+func (h *UserHandler) ListAuditLogs(c *gin.Context) {
+	var params dto.ListAuditLogsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	page, err := h.userService.ListAuditLogs(c.Request.Context(), params)
+	if err != nil {
+		if errors.Is(err, service.ErrAuditForbidden) {
+			helper.RespondWithError(c, http.StatusForbidden, err)
+			return
+		}
+		respondWithServiceError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.ListAuditLogsResponse{
+		Logs:        helper.ToProtoAuditLogs(page.Logs),
+		Total:       int32(page.Total),
+		TotalPages:  int32(page.TotalPages),
+		CurrentPage: int32(page.CurrentPage),
+	})
+}
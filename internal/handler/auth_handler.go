@@ -2,7 +2,6 @@ package handler
 
 import (
 	"net/http"
-	"strings"
 
 	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
 	"github.com/huynhthanhthao/hrm_user_service/internal/service"
@@ -32,23 +31,13 @@ func (h *AuthHandler) LoginHandler(c *gin.Context) {
 }
 
 func (h *AuthHandler) GetMe(c *gin.Context) {
-	// Lấy token từ header Authorization
-	authHeader := c.GetHeader("Authorization")
-
-	if authHeader == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization header is missing!"})
-		return
-	}
-
-	// Extract Bearer token
-	parts := strings.SplitN(authHeader, " ", 2)
-	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid Authorization header format"})
+	claims := ClaimsFromContext(c)
+	if claims == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
 		return
 	}
-	tokenString := parts[1]
 
-	h.authService.DecodeToken(c.Request.Context(), tokenString, c)
+	h.authService.GetMe(c.Request.Context(), c, claims.UserID)
 }
 
 func (h *AuthHandler) RefreshTokenHandler(c *gin.Context) {
@@ -59,5 +48,5 @@ func (h *AuthHandler) RefreshTokenHandler(c *gin.Context) {
 		return
 	}
 
-	h.authService.RefreshToken(c.Request.Context(), c, req.RefreshToken)
+	h.authService.RefreshToken(c.Request.Context(), c, req.RefreshToken, req.OrgID)
 }
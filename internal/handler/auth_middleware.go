@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+
+	"github.com/gin-gonic/gin"
+)
+
+const claimsContextKey = "claims"
+
+// AuthMiddleware xác thực access token và lưu claims vào gin context.
+// Token có perm_version cũ hơn của user bị từ chối với header X-Token-Stale
+// để client biết cần gọi /refresh-token thay vì đăng nhập lại.
+func AuthMiddleware(authService *service.AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization header is missing!"})
+			return
+		}
+
+		// Extract Bearer token
+		parts := strings.SplitN(authHeader, " ", 2)
+		if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid Authorization header format"})
+			return
+		}
+
+		claims, err := authService.ValidateAccessToken(c.Request.Context(), parts[1])
+		if err != nil {
+			if errors.Is(err, service.ErrStaleToken) {
+				c.Header("X-Token-Stale", "true")
+			}
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Set(claimsContextKey, claims)
+
+		// Viewer trong request context giới hạn các truy vấn User theo tổ chức của token
+		ctx := viewer.NewContext(c.Request.Context(), &viewer.Viewer{
+			UserID:    claims.UserID,
+			OrgID:     claims.OrgID,
+			PermCodes: claims.PermCodes,
+		})
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// ClaimsFromContext trả về claims do AuthMiddleware lưu lại
+func ClaimsFromContext(c *gin.Context) *service.AccessClaims {
+	v, ok := c.Get(claimsContextKey)
+	if !ok {
+		return nil
+	}
+	claims, _ := v.(*service.AccessClaims)
+	return claims
+}
+
+// RequirePerms chỉ cho phép request có đủ các perm code trong access token.
+// Phải đặt sau AuthMiddleware.
+func RequirePerms(codes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		v := viewer.FromContext(c.Request.Context())
+		for _, code := range codes {
+			if !v.HasPerm(code) {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "missing permission: " + code})
+				return
+			}
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/avatar"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AvatarHandler struct {
+	avatarService *service.AvatarService
+}
+
+func NewAvatarHandler(avatarService *service.AvatarService) *AvatarHandler {
+	return &AvatarHandler{avatarService: avatarService}
+}
+
+// POST /me/avatar (multipart: file)
+func (h *AvatarHandler) UploadMyAvatar(c *gin.Context) {
+	claims := ClaimsFromContext(c)
+	if claims == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	// Chừa thêm 1MB cho phần header của multipart
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, avatar.MaxFileSize+1<<20)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			helper.RespondWithError(c, http.StatusRequestEntityTooLarge, avatar.ErrFileTooLarge)
+			return
+		}
+		helper.RespondWithError(c, http.StatusBadRequest, errors.New("file is required"))
+		return
+	}
+	if fileHeader.Size > avatar.MaxFileSize {
+		helper.RespondWithError(c, http.StatusRequestEntityTooLarge, avatar.ErrFileTooLarge)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, avatar.MaxFileSize+1))
+	if err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	user, err := h.avatarService.SetAvatar(c.Request.Context(), claims.UserID, data)
+	if err != nil {
+		respondWithAvatarError(c, err)
+		return
+	}
+
+	helper.RespondWithProto(c, http.StatusOK, &userPb.UploadAvatarResponse{
+		User: helper.EntUserToProtoUser(user),
+	})
+}
+
+// GET /avatars/default/:initials?seed=&size=&format=svg|png
+// Public, không tra cứu user: ảnh chỉ phụ thuộc tham số nên không lộ thông tin khi dò id.
+func (h *AvatarHandler) DefaultAvatar(c *gin.Context) {
+	initials, err := avatar.ValidInitials(c.Param("initials"))
+	if err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+	seed, err := strconv.Atoi(c.DefaultQuery("seed", "0"))
+	if err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, errors.New("seed must be an integer"))
+		return
+	}
+	size, err := strconv.Atoi(c.DefaultQuery("size", strconv.Itoa(avatar.DefaultSize)))
+	if err != nil || size < avatar.MinSize || size > avatar.MaxSize {
+		helper.RespondWithError(c, http.StatusBadRequest,
+			fmt.Errorf("size must be between %d and %d", avatar.MinSize, avatar.MaxSize))
+		return
+	}
+	format := c.DefaultQuery("format", "svg")
+	if format != "svg" && format != "png" {
+		helper.RespondWithError(c, http.StatusBadRequest, errors.New("format must be svg or png"))
+		return
+	}
+
+	// Ảnh xác định hoàn toàn bởi tham số nên ETag tính được trước khi render
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%d|%d|%s", initials, seed, size, format)))
+	etag := `"` + hex.EncodeToString(sum[:8]) + `"`
+	c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	c.Header("ETag", etag)
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	if format == "png" {
+		data, err := avatar.RenderPNG(initials, seed, size)
+		if err != nil {
+			helper.RespondWithError(c, http.StatusInternalServerError, err)
+			return
+		}
+		c.Data(http.StatusOK, "image/png", data)
+		return
+	}
+	c.Data(http.StatusOK, "image/svg+xml; charset=utf-8", avatar.RenderSVG(initials, seed, size))
+}
+
+func respondWithAvatarError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, avatar.ErrFileTooLarge), errors.Is(err, avatar.ErrImageTooLarge):
+		helper.RespondWithError(c, http.StatusRequestEntityTooLarge, err)
+	case errors.Is(err, avatar.ErrUnsupportedType), errors.Is(err, avatar.ErrEmptyFile):
+		helper.RespondWithError(c, http.StatusUnsupportedMediaType, err)
+	default:
+		respondWithServiceError(c, err)
+	}
+}
@@ -0,0 +1,291 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+func respondWithGroupError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrGroupForbidden):
+		helper.RespondWithError(c, http.StatusForbidden, err)
+	case errors.Is(err, service.ErrGroupCycle), errors.Is(err, service.ErrLastGroupOwner):
+		helper.RespondWithError(c, http.StatusConflict, err)
+	default:
+		respondWithServiceError(c, err)
+	}
+}
+
+func parseGroupParam(c *gin.Context, name string) (int, bool) {
+	id, err := strconv.Atoi(c.Param(name))
+	if err != nil || id <= 0 {
+		helper.RespondWithError(c, http.StatusBadRequest, errors.New("invalid "+name))
+		return 0, false
+	}
+	return id, true
+}
+
+// GET /groups?search=&page=&page_size=
+func (h *UserHandler) ListGroups(c *gin.Context) {
+	var params dto.ListGroupsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	page, err := h.userService.ListGroups(c.Request.Context(), params)
+	if err != nil {
+		respondWithGroupError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.ListGroupsResponse{
+		Groups:      helper.ToProtoGroups(page.Groups),
+		Total:       int32(page.Total),
+		TotalPages:  int32(page.TotalPages),
+		CurrentPage: int32(page.CurrentPage),
+	})
+}
+
+// GET /groups/:id
+func (h *UserHandler) GetGroup(c *gin.Context) {
+	id, ok := parseGroupParam(c, "id")
+	if !ok {
+		return
+	}
+
+	g, err := h.userService.GetGroup(c.Request.Context(), id)
+	if err != nil {
+		respondWithGroupError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.GroupResponse{Group: helper.ToProtoGroup(g)})
+}
+
+// POST /groups
+func (h *UserHandler) CreateGroup(c *gin.Context) {
+	var req dto.CreateGroupDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	g, err := h.userService.CreateGroup(c.Request.Context(), req)
+	if err != nil {
+		respondWithGroupError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusCreated, &userPb.GroupResponse{Group: helper.ToProtoGroup(g)})
+}
+
+// PATCH /groups/:id
+func (h *UserHandler) UpdateGroup(c *gin.Context) {
+	id, ok := parseGroupParam(c, "id")
+	if !ok {
+		return
+	}
+	var req dto.UpdateGroupDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	g, err := h.userService.UpdateGroup(c.Request.Context(), id, req)
+	if err != nil {
+		respondWithGroupError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.GroupResponse{Group: helper.ToProtoGroup(g)})
+}
+
+// DELETE /groups/:id
+func (h *UserHandler) DeleteGroup(c *gin.Context) {
+	id, ok := parseGroupParam(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.userService.DeleteGroup(c.Request.Context(), id); err != nil {
+		respondWithGroupError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.DeleteGroupResponse{Success: true})
+}
+
+// GET /groups/:id/members
+func (h *UserHandler) ListGroupMembers(c *gin.Context) {
+	id, ok := parseGroupParam(c, "id")
+	if !ok {
+		return
+	}
+
+	members, err := h.userService.ListGroupMembers(c.Request.Context(), id)
+	if err != nil {
+		respondWithGroupError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.ListGroupMembersResponse{
+		Members: helper.ToProtoGroupMembers(members),
+	})
+}
+
+// POST /groups/:id/members
+func (h *UserHandler) AddGroupMembers(c *gin.Context) {
+	id, ok := parseGroupParam(c, "id")
+	if !ok {
+		return
+	}
+	var req dto.AddGroupMembersDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	members, err := h.userService.AddGroupMembers(c.Request.Context(), id, req.Members)
+	if err != nil {
+		respondWithGroupError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusCreated, &userPb.ListGroupMembersResponse{
+		Members: helper.ToProtoGroupMembers(members),
+	})
+}
+
+// PATCH /groups/:id/members/:user_id
+func (h *UserHandler) UpdateGroupMember(c *gin.Context) {
+	id, ok := parseGroupParam(c, "id")
+	if !ok {
+		return
+	}
+	userID, ok := parseGroupParam(c, "user_id")
+	if !ok {
+		return
+	}
+	var req dto.UpdateGroupMemberDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	m, err := h.userService.UpdateGroupMember(c.Request.Context(), id, userID, req.Role)
+	if err != nil {
+		respondWithGroupError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.GroupMemberResponse{Member: helper.ToProtoGroupMember(m)})
+}
+
+// DELETE /groups/:id/members/:user_id
+func (h *UserHandler) RemoveGroupMember(c *gin.Context) {
+	id, ok := parseGroupParam(c, "id")
+	if !ok {
+		return
+	}
+	userID, ok := parseGroupParam(c, "user_id")
+	if !ok {
+		return
+	}
+
+	if err := h.userService.RemoveGroupMember(c.Request.Context(), id, userID); err != nil {
+		respondWithGroupError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.RemoveGroupMemberResponse{Success: true})
+}
+
+// POST /groups/:id/subgroups
+func (h *UserHandler) AddSubgroup(c *gin.Context) {
+	id, ok := parseGroupParam(c, "id")
+	if !ok {
+		return
+	}
+	var req dto.AddSubgroupDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	g, err := h.userService.AddSubgroup(c.Request.Context(), id, req.SubgroupID)
+	if err != nil {
+		respondWithGroupError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.GroupResponse{Group: helper.ToProtoGroup(g)})
+}
+
+// DELETE /groups/:id/subgroups/:subgroup_id
+func (h *UserHandler) RemoveSubgroup(c *gin.Context) {
+	id, ok := parseGroupParam(c, "id")
+	if !ok {
+		return
+	}
+	subgroupID, ok := parseGroupParam(c, "subgroup_id")
+	if !ok {
+		return
+	}
+
+	g, err := h.userService.RemoveSubgroup(c.Request.Context(), id, subgroupID)
+	if err != nil {
+		respondWithGroupError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.GroupResponse{Group: helper.ToProtoGroup(g)})
+}
+
+// GET /groups/:id/expanded-members
+func (h *UserHandler) ExpandGroupMembers(c *gin.Context) {
+	id, ok := parseGroupParam(c, "id")
+	if !ok {
+		return
+	}
+
+	members, err := h.userService.ExpandGroupMembers(c.Request.Context(), id)
+	if err != nil {
+		respondWithGroupError(c, err)
+		return
+	}
+	res := make([]*userPb.ExpandedGroupMember, 0, len(members))
+	for _, m := range members {
+		res = append(res, helper.ToProtoExpandedGroupMember(m.UserID, m.Direct, m.Role, m.ViaGroupIDs))
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.ExpandGroupMembersResponse{Members: res})
+}
+
+// GET /users/:id/groups
+func (h *UserHandler) ListUserGroups(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+	h.respondWithUserGroups(c, id)
+}
+
+// GET /me/groups
+func (h *UserHandler) ListMyGroups(c *gin.Context) {
+	claims := ClaimsFromContext(c)
+	if claims == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+	h.respondWithUserGroups(c, claims.UserID)
+}
+
+func (h *UserHandler) respondWithUserGroups(c *gin.Context, userID int) {
+	groups, err := h.userService.ListUserGroups(c.Request.Context(), userID)
+	if err != nil {
+		respondWithGroupError(c, err)
+		return
+	}
+	res := make([]*userPb.UserGroup, 0, len(groups))
+	for _, g := range groups {
+		res = append(res, helper.ToProtoUserGroup(g.Group, g.Direct, g.Role))
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.ListUserGroupsResponse{Groups: res})
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+func toProtoFieldChanges(changes []service.FieldChange) []*userPb.FieldChange {
+	res := make([]*userPb.FieldChange, 0, len(changes))
+	for _, c := range changes {
+		res = append(res, helper.ToProtoFieldChange(c.Field, c.From, c.To))
+	}
+	return res
+}
+
+func toProtoUserVersions(entries []*service.UserVersionEntry) []*userPb.UserVersion {
+	res := make([]*userPb.UserVersion, 0, len(entries))
+	for _, e := range entries {
+		res = append(res, helper.ToProtoUserVersion(e.Version, toProtoFieldChanges(e.Changes)))
+	}
+	return res
+}
+
+// GET /users/:id/as-of?at=2024-01-31T00:00:00+07:00
+func (h *UserHandler) GetUserAsOf(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+	var params dto.UserAsOfParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	v, err := h.userService.GetUserAsOf(c.Request.Context(), id, params.At)
+	if err != nil {
+		respondWithHistoryError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.GetUserAsOfResponse{
+		Version: helper.ToProtoUserVersion(v, nil),
+	})
+}
+
+// GET /users/:id/versions
+func (h *UserHandler) ListUserVersions(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	entries, err := h.userService.ListUserVersions(c.Request.Context(), id)
+	if err != nil {
+		respondWithHistoryError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.ListUserVersionsResponse{
+		Versions: toProtoUserVersions(entries),
+	})
+}
+
+// GET /users/:id/versions/diff?from=1&to=3
+func (h *UserHandler) DiffUserVersions(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+	var params dto.DiffUserVersionsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	changes, err := h.userService.DiffUserVersions(c.Request.Context(), id, params.From, params.To)
+	if err != nil {
+		respondWithHistoryError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.DiffUserVersionsResponse{
+		UserId:  int32(id),
+		Changes: toProtoFieldChanges(changes),
+	})
+}
+
+func respondWithHistoryError(c *gin.Context, err error) {
+	if errors.Is(err, service.ErrNoUserVersion) || errors.Is(err, service.ErrUserVersionNotFound) {
+		helper.RespondWithError(c, http.StatusNotFound, err)
+		return
+	}
+	respondWithServiceError(c, err)
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GET /users/duplicates?min_score=0.5&limit=100
+func (h *UserHandler) FindDuplicateUsers(c *gin.Context) {
+	var params dto.FindDuplicateUsersParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	candidates, err := h.userService.FindDuplicateUsers(c.Request.Context(), service.DuplicateOptions{
+		MinScore: params.MinScore,
+		Limit:    params.Limit,
+	})
+	if err != nil {
+		respondWithMergeError(c, err)
+		return
+	}
+
+	res := make([]*userPb.DuplicateCandidate, 0, len(candidates))
+	for _, cand := range candidates {
+		res = append(res, &userPb.DuplicateCandidate{
+			User:    helper.EntUserToProtoUser(cand.User),
+			Other:   helper.EntUserToProtoUser(cand.Other),
+			Score:   cand.Score,
+			Reasons: cand.Reasons,
+		})
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.FindDuplicateUsersResponse{Candidates: res})
+}
+
+// POST /users/merge
+func (h *UserHandler) MergeUsers(c *gin.Context) {
+	var req dto.MergeUsersDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	usr, err := h.userService.MergeUsers(c.Request.Context(), req.SurvivorID, req.MergedID)
+	if err != nil {
+		respondWithMergeError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.MergeUsersResponse{
+		User: helper.EntUserToProtoUser(usr),
+	})
+}
+
+func respondWithMergeError(c *gin.Context, err error) {
+	if errors.Is(err, service.ErrMergeForbidden) {
+		helper.RespondWithError(c, http.StatusForbidden, err)
+		return
+	}
+	respondWithServiceError(c, err)
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GET /users/:id/personal-data
+func (h *UserHandler) ExportPersonalData(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+	h.respondWithPersonalData(c, id)
+}
+
+// GET /me/personal-data
+func (h *UserHandler) ExportMyPersonalData(c *gin.Context) {
+	claims := ClaimsFromContext(c)
+	if claims == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+	h.respondWithPersonalData(c, claims.UserID)
+}
+
+func (h *UserHandler) respondWithPersonalData(c *gin.Context, userID int) {
+	data, err := h.userService.ExportPersonalData(c.Request.Context(), userID)
+	if err != nil {
+		respondWithPersonalDataError(c, err)
+		return
+	}
+	helper.RespondWithProto(c, http.StatusOK,
+		helper.ToProtoPersonalData(data.ExportedAt, data.User, data.LoginHistory, data.Sessions, toProtoUserVersions(data.Versions)))
+}
+
+// POST /users/:id/anonymize
+func (h *UserHandler) AnonymizeUser(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	res, err := h.userService.AnonymizeUser(c.Request.Context(), id)
+	if err != nil {
+		respondWithPersonalDataError(c, err)
+		return
+	}
+	if res.PreviousAvatar != nil {
+		h.avatarService.DeleteAvatarFiles(c.Request.Context(), *res.PreviousAvatar)
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.AnonymizeUserResponse{
+		User: helper.EntUserToProtoUser(res.User),
+	})
+}
+
+func respondWithPersonalDataError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrPersonalDataForbidden):
+		helper.RespondWithError(c, http.StatusForbidden, err)
+	case errors.Is(err, service.ErrUserAnonymized):
+		helper.RespondWithError(c, http.StatusConflict, err)
+	default:
+		respondWithServiceError(c, err)
+	}
+}
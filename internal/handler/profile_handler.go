@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GET /users/:id/profile
+func (h *UserHandler) GetUserProfile(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+	h.respondWithProfile(c, id)
+}
+
+// GET /me/profile
+func (h *UserHandler) GetMyProfile(c *gin.Context) {
+	claims := ClaimsFromContext(c)
+	if claims == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+	h.respondWithProfile(c, claims.UserID)
+}
+
+func (h *UserHandler) respondWithProfile(c *gin.Context, userID int) {
+	p, err := h.userService.GetUserProfile(c.Request.Context(), userID)
+	if err != nil {
+		respondWithProfileError(c, err)
+		return
+	}
+
+	profile := helper.ToProtoProfile(userID, p)
+	if profile == nil {
+		profile = &userPb.UserProfile{UserId: int32(userID)}
+	}
+	helper.RespondWithProto(c, http.StatusOK, &userPb.GetUserProfileResponse{
+		Profile: profile,
+	})
+}
+
+// PUT /users/:id/profile
+func (h *UserHandler) UpdateUserProfile(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UserProfileDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	p, err := h.userService.UpdateUserProfile(c.Request.Context(), id, toProtoProfile(req))
+	if err != nil {
+		respondWithProfileError(c, err)
+		return
+	}
+
+	helper.RespondWithProto(c, http.StatusOK, &userPb.UpdateUserProfileResponse{
+		Profile: helper.ToProtoProfile(id, p),
+	})
+}
+
+func toProtoProfile(req dto.UserProfileDTO) *userPb.UserProfile {
+	contacts := make([]*userPb.EmergencyContact, 0, len(req.EmergencyContacts))
+	for _, c := range req.EmergencyContacts {
+		contacts = append(contacts, &userPb.EmergencyContact{
+			Name:         c.Name,
+			Relationship: c.Relationship,
+			Phone:        c.Phone,
+			Address:      optionalString(c.Address),
+		})
+	}
+	return &userPb.UserProfile{
+		DateOfBirth:           optionalString(req.DateOfBirth),
+		PlaceOfBirth:          optionalString(req.PlaceOfBirth),
+		NationalId:            optionalString(req.NationalID),
+		NationalIdIssueDate:   optionalString(req.NationalIDIssueDate),
+		NationalIdIssuePlace:  optionalString(req.NationalIDIssuePlace),
+		Ethnicity:             optionalString(req.Ethnicity),
+		Religion:              optionalString(req.Religion),
+		MaritalStatus:         optionalString(req.MaritalStatus),
+		TaxCode:               optionalString(req.TaxCode),
+		SocialInsuranceNumber: optionalString(req.SocialInsuranceNumber),
+		EmergencyContacts:     contacts,
+	}
+}
+
+func respondWithProfileError(c *gin.Context, err error) {
+	if errors.Is(err, service.ErrProfileForbidden) {
+		helper.RespondWithError(c, http.StatusForbidden, err)
+		return
+	}
+	respondWithServiceError(c, err)
+}
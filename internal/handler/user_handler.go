@@ -1,13 +1,515 @@
 package handler
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
 	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	"github.com/huynhthanhthao/hrm_user_service/internal/userio"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type UserHandler struct {
-	userService service.UserService
+	userService   *service.UserService
+	avatarService *service.AvatarService
+}
+
+func NewUserHandler(userService *service.UserService, avatarService *service.AvatarService) *UserHandler {
+	return &UserHandler{userService: userService, avatarService: avatarService}
+}
+
+// Map lỗi của service sang HTTP status
+func respondWithServiceError(c *gin.Context, err error) {
+	var notFound *ent.NotFoundError
+	switch {
+	case errors.As(err, &notFound):
+		helper.RespondWithError(c, http.StatusNotFound, err)
+	case ent.IsConstraintError(err):
+		helper.RespondWithError(c, http.StatusConflict, err)
+	default:
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+	}
+}
+
+func parseUserID(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		helper.RespondWithError(c, http.StatusBadRequest, errors.New("invalid user id"))
+		return 0, false
+	}
+	return id, true
+}
+
+// Chuỗi rỗng được xem như không truyền giá trị
+func optionalString(v string) *wrapperspb.StringValue {
+	if v == "" {
+		return nil
+	}
+	return wrapperspb.String(v)
+}
+
+// ETag của user là version hiện tại
+func userETag(u *ent.User) string {
+	return `"` + strconv.Itoa(u.Version) + `"`
+}
+
+// parseIfMatch đọc version mong đợi từ header If-Match, chấp nhận cả dạng weak (W/"3").
+// Không có header hoặc "*" thì không kiểm tra version.
+func parseIfMatch(header string) (*wrapperspb.Int32Value, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+	if strings.Contains(header, ",") {
+		return nil, errors.New("If-Match must contain a single ETag")
+	}
+	tag := strings.TrimPrefix(header, "W/")
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return nil, errors.New("invalid If-Match ETag")
+	}
+	version, err := strconv.Atoi(tag[1 : len(tag)-1])
+	if err != nil || version < 0 {
+		return nil, errors.New("invalid If-Match ETag")
+	}
+	return wrapperspb.Int32(int32(version)), nil
+}
+
+const mergePatchContentType = "application/merge-patch+json"
+
+// mergePatchMask trả về update_mask gồm các key có trong JSON Merge Patch (RFC 7396), kể cả key có giá trị null.
+// custom_attributes được gộp theo từng thuộc tính: mỗi key của tổ chức thành path "custom_attributes.<org_id>.<key>",
+// tổ chức có giá trị null thành "custom_attributes.<org_id>" (xóa thuộc tính của tổ chức đó).
+// Key không hợp lệ được service từ chối khi kiểm tra mask.
+func mergePatchMask(body []byte) (*fieldmaskpb.FieldMask, error) {
+	var patch map[string]json.RawMessage
+	if err := json.Unmarshal(body, &patch); err != nil {
+		return nil, errors.New("merge patch must be a JSON object")
+	}
+
+	paths := make([]string, 0, len(patch))
+	for key, raw := range patch {
+		if key == "custom_attributes" && string(raw) != "null" {
+			attrPaths, err := customAttributesPatchPaths(raw)
+			if err != nil {
+				return nil, err
+			}
+			paths = append(paths, attrPaths...)
+			continue
+		}
+		if key != "account" {
+			paths = append(paths, key)
+			continue
+		}
+		if string(raw) == "null" {
+			return nil, errors.New("account cannot be null")
+		}
+		var acc map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &acc); err != nil {
+			return nil, errors.New("account must be a JSON object")
+		}
+		for sub := range acc {
+			paths = append(paths, "account."+sub)
+		}
+	}
+	sort.Strings(paths)
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// customAttributesPatchPaths trả về các path của custom_attributes trong merge patch
+func customAttributesPatchPaths(raw json.RawMessage) ([]string, error) {
+	var orgs map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &orgs); err != nil {
+		return nil, errors.New("custom_attributes must be a JSON object")
+	}
+	var paths []string
+	for org, orgRaw := range orgs {
+		if string(orgRaw) == "null" {
+			paths = append(paths, "custom_attributes."+org)
+			continue
+		}
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(orgRaw, &values); err != nil {
+			return nil, fmt.Errorf("custom_attributes.%s must be a JSON object", org)
+		}
+		for key := range values {
+			paths = append(paths, "custom_attributes."+org+"."+key)
+		}
+	}
+	return paths, nil
+}
+
+// GET /users?page=&page_size=&search=&cursor=&attr[key]=&attribute_org_id=
+func (h *UserHandler) ListUsers(c *gin.Context) {
+	var params dto.ListUsersParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+	params.Filter.Attributes = c.QueryMap("attr")
+
+	page, err := h.userService.ListUsers(c.Request.Context(), params)
+	if err != nil {
+		respondWithServiceError(c, err)
+		return
+	}
+
+	helper.RespondWithProto(c, http.StatusOK, &userPb.ListUsersResponse{
+		Users:       helper.EntUsersToProtoUsers(page.Users),
+		TotalPages:  int32(page.TotalPages),
+		TotalUsers:  int32(page.TotalUsers),
+		CurrentPage: int32(page.CurrentPage),
+		NextCursor:  page.NextCursor,
+	})
+}
+
+// GET /users/search?q=&limit=
+func (h *UserHandler) SearchUsers(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	hits, err := h.userService.SearchUsers(c.Request.Context(), c.Query("q"), limit)
+	if err != nil {
+		respondWithServiceError(c, err)
+		return
+	}
+
+	res := make([]*userPb.UserSearchHit, 0, len(hits))
+	for _, hit := range hits {
+		res = append(res, &userPb.UserSearchHit{
+			User:  helper.EntUserToProtoUser(hit.User),
+			Score: hit.Score,
+		})
+	}
+
+	helper.RespondWithProto(c, http.StatusOK, &userPb.SearchUsersResponse{
+		Hits: res,
+	})
+}
+
+// GET /users/:id
+func (h *UserHandler) GetUser(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+	ctx := c.Request.Context()
+
+	user, err := h.userService.GetUserById(ctx, id)
+	if err != nil {
+		respondWithServiceError(c, err)
+		return
+	}
+	// id cũ của user đã bị gộp: chuyển hướng tới user còn lại
+	if user.ID != id {
+		location := strings.TrimSuffix(c.Request.URL.Path, c.Param("id")) + strconv.Itoa(user.ID)
+		if c.Request.URL.RawQuery != "" {
+			location += "?" + c.Request.URL.RawQuery
+		}
+		c.Redirect(http.StatusPermanentRedirect, location)
+		return
+	}
+
+	rolesResp, err := h.userService.GetUserRolesByUserId(ctx, strconv.Itoa(id))
+	if err != nil {
+		respondWithServiceError(c, err)
+		return
+	}
+
+	permsResp, err := h.userService.GetUserPermsByUserId(ctx, strconv.Itoa(id))
+	if err != nil {
+		respondWithServiceError(c, err)
+		return
+	}
+
+	c.Header("ETag", userETag(user))
+	helper.RespondWithProto(c, http.StatusOK, &userPb.GetUserByIdResponse{
+		User:  helper.EntUserToProtoUser(user),
+		Roles: helper.ToProtoRoles(rolesResp.Roles),
+		Perms: helper.ToProtoPerms(permsResp.Perms),
+	})
+}
+
+// POST /users
+func (h *UserHandler) CreateUser(c *gin.Context) {
+	var req dto.CreateUserDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	user, err := h.userService.CreateUser(c.Request.Context(), &userPb.CreateUserRequest{
+		FirstName:    req.FirstName,
+		LastName:     req.LastName,
+		Gender:       req.Gender,
+		Phone:        req.Phone,
+		Email:        optionalString(req.Email),
+		WardCode:     optionalString(req.WardCode),
+		ProvinceCode: optionalString(req.ProvinceCode),
+		Address:      optionalString(req.Address),
+		Avatar:       optionalString(req.Avatar),
+		Account: &userPb.Account{
+			Username: req.Account.Username,
+			Password: req.Account.Password,
+		},
+		PermIds: req.PermIDs,
+		RoleIds: req.RoleIDs,
+		OrgIds:  req.OrgIDs,
+
+		CustomAttributes: helper.ToProtoCustomAttributes(req.CustomAttributes),
+	})
+	if err != nil {
+		respondWithServiceError(c, err)
+		return
+	}
+
+	helper.RespondWithProto(c, http.StatusCreated, &userPb.CreateUserResponse{
+		User: helper.EntUserToProtoUser(user),
+	})
+}
+
+// PATCH /users/:id
+// Content-Type application/merge-patch+json: chỉ ghi các key có trong body, null hoặc chuỗi rỗng
+// xóa email, ward_code, province_code, address, avatar về NULL; custom_attributes được gộp theo từng
+// thuộc tính (null xóa thuộc tính hoặc cả tổ chức).
+// application/json: giá trị rỗng được xem như không đổi.
+func (h *UserHandler) UpdateUser(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	expectedVersion, err := parseIfMatch(c.GetHeader("If-Match"))
+	if err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var req dto.UpdateUserDTO
+	var updateMask *fieldmaskpb.FieldMask
+	if c.ContentType() == mergePatchContentType {
+		body, err := c.GetRawData()
+		if err != nil {
+			helper.RespondWithError(c, http.StatusBadRequest, err)
+			return
+		}
+		if updateMask, err = mergePatchMask(body); err != nil {
+			helper.RespondWithError(c, http.StatusBadRequest, err)
+			return
+		}
+		if err := binding.JSON.BindBody(body, &req); err != nil {
+			helper.RespondWithError(c, http.StatusBadRequest, err)
+			return
+		}
+	} else if err := c.ShouldBindJSON(&req); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	input := &userPb.UpdateUserRequest{
+		Id:           int32(id),
+		FirstName:    req.FirstName,
+		LastName:     req.LastName,
+		Gender:       req.Gender,
+		Phone:        req.Phone,
+		Email:        optionalString(req.Email),
+		WardCode:     optionalString(req.WardCode),
+		ProvinceCode: optionalString(req.ProvinceCode),
+		Address:      optionalString(req.Address),
+		Avatar:       optionalString(req.Avatar),
+		PermIds:      req.PermIDs,
+		RoleIds:      req.RoleIDs,
+		OrgIds:       req.OrgIDs,
+
+		ExpectedVersion:  expectedVersion,
+		UpdateMask:       updateMask,
+		CustomAttributes: helper.ToProtoCustomAttributes(req.CustomAttributes),
+	}
+	if req.Account != nil {
+		input.Account = &userPb.Account{
+			Password: req.Account.Password,
+			Status:   req.Account.Status,
+		}
+	}
+
+	ctx := c.Request.Context()
+	tx, err := h.userService.BeginTx(ctx)
+	if err != nil {
+		respondWithServiceError(c, err)
+		return
+	}
+	defer tx.Rollback()
+
+	user, err := h.userService.UpdateUserByID(ctx, tx, id, input)
+	// If-Match không khớp: trả về bản ghi hiện tại kèm ETag mới để client tải lại
+	var conflict *service.VersionConflictError
+	if errors.As(err, &conflict) {
+		c.Header("ETag", userETag(conflict.Current))
+		helper.RespondWithProto(c, http.StatusPreconditionFailed,
+			helper.ToProtoVersionConflict(conflict.Error(), conflict.Expected, conflict.Current))
+		return
+	}
+	if err != nil {
+		respondWithServiceError(c, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		respondWithServiceError(c, err)
+		return
+	}
+
+	c.Header("ETag", userETag(user))
+	helper.RespondWithProto(c, http.StatusOK, &userPb.UpdateUserResponse{
+		User: helper.EntUserToProtoUser(user),
+	})
+}
+
+// DELETE /users/:id
+func (h *UserHandler) DeleteUser(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.userService.DeleteUserByID(c.Request.Context(), id); err != nil {
+		respondWithServiceError(c, err)
+		return
+	}
+
+	helper.RespondWithProto(c, http.StatusOK, &userPb.DeleteUserResponse{
+		Success: true,
+	})
 }
 
-func NewUserHandler(userService *service.UserService) *UserHandler {
-	return &UserHandler{userService: *userService}
+// POST /users/import (multipart: file, format, dry_run, batch_size, column_mapping, org_ids)
+func (h *UserHandler) ImportUsers(c *gin.Context) {
+	var form dto.ImportUsersForm
+	if err := c.ShouldBind(&form); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, errors.New("file is required"))
+		return
+	}
+	if fileHeader.Size > userio.MaxFileSize {
+		helper.RespondWithError(c, http.StatusRequestEntityTooLarge, userio.ErrFileTooLarge)
+		return
+	}
+
+	format, err := userio.ParseFormat(form.Format, fileHeader.Filename)
+	if err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var mapping userio.ColumnMapping
+	if form.ColumnMapping != "" {
+		if err := json.Unmarshal([]byte(form.ColumnMapping), &mapping); err != nil {
+			helper.RespondWithError(c, http.StatusBadRequest, errors.New("column_mapping must be a JSON object"))
+			return
+		}
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+	defer file.Close()
+
+	rows, err := userio.Parse(file, format, mapping)
+	if err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	report := &userPb.ImportUsersReport{}
+	summary, err := h.userService.ImportUsers(c.Request.Context(), rows, service.ImportOptions{
+		DryRun:    form.DryRun,
+		BatchSize: form.BatchSize,
+		OrgIDs:    form.OrgIDs,
+	}, func(r userio.ImportRowResult) error {
+		report.Rows = append(report.Rows, helper.ToProtoImportRow(r))
+		return nil
+	})
+	if err != nil {
+		respondWithServiceError(c, err)
+		return
+	}
+	report.Summary = helper.ToProtoImportSummary(summary)
+
+	helper.RespondWithProto(c, http.StatusOK, report)
+}
+
+// GET /users/export?format=&columns=&lang= (cùng bộ lọc với GET /users)
+func (h *UserHandler) ExportUsers(c *gin.Context) {
+	var params dto.ExportUsersParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+	params.Filter.Attributes = c.QueryMap("attr")
+
+	format := userio.FormatCSV
+	if params.Format != "" {
+		format = userio.Format(params.Format)
+	}
+
+	var keys []string
+	if params.Columns != "" {
+		keys = strings.Split(params.Columns, ",")
+	}
+	if err := userio.ValidateExport(format, params.Lang); err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+	ctx := c.Request.Context()
+	columns, err := userio.ResolveColumns(keys, service.CanExportSensitive(ctx))
+	if errors.Is(err, userio.ErrSensitiveColumn) {
+		helper.RespondWithError(c, http.StatusForbidden, err)
+		return
+	}
+	if err != nil {
+		helper.RespondWithError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	// Định dạng đã được kiểm tra nên lỗi ở đây là lỗi ghi, không còn trả JSON được
+	c.Header("Content-Type", userio.ContentType(format))
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="users_%s.%s"`, time.Now().Format("20060102_150405"), format))
+	c.Status(http.StatusOK)
+
+	exporter, err := userio.NewExporter(c.Writer, format, columns, params.Lang)
+	if err != nil {
+		logger.Printf("ExportUsers: %v", err)
+		c.Abort()
+		return
+	}
+
+	// Header đã gửi đi nên lỗi giữa chừng chỉ có thể ghi log và dừng stream
+	if _, err := h.userService.ExportUsers(ctx, params.Search, params.Filter, columns, exporter); err != nil {
+		logger.Printf("ExportUsers: %v", err)
+		c.Abort()
+		return
+	}
+	if err := exporter.Close(); err != nil {
+		logger.Printf("ExportUsers: %v", err)
+		c.Abort()
+	}
 }
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergePatchMask(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    []string
+		wantErr bool
+	}{
+		{name: "rỗng", body: `{}`, want: []string{}},
+		{name: "giữ key có giá trị null", body: `{"email": null, "first_name": "An"}`, want: []string{"email", "first_name"}},
+		{name: "account theo từng field", body: `{"account": {"status": "inactive", "password": "secret123"}}`, want: []string{"account.password", "account.status"}},
+		{name: "account rỗng", body: `{"account": {}}`, want: []string{}},
+		{name: "custom_attributes theo từng thuộc tính", body: `{"custom_attributes": {"5": {"dept": "IT", "level": null}}}`, want: []string{"custom_attributes.5.dept", "custom_attributes.5.level"}},
+		{name: "xóa thuộc tính của một tổ chức", body: `{"custom_attributes": {"5": null, "6": {"dept": "HR"}}}`, want: []string{"custom_attributes.5", "custom_attributes.6.dept"}},
+		{name: "xóa toàn bộ custom_attributes", body: `{"custom_attributes": null}`, want: []string{"custom_attributes"}},
+		{name: "custom_attributes rỗng không đổi gì", body: `{"custom_attributes": {}}`, want: []string{}},
+		{name: "không phải object", body: `[1, 2]`, wantErr: true},
+		{name: "JSON hỏng", body: `{"email":`, wantErr: true},
+		{name: "account null", body: `{"account": null}`, wantErr: true},
+		{name: "account không phải object", body: `{"account": "x"}`, wantErr: true},
+		{name: "custom_attributes không phải object", body: `{"custom_attributes": [1]}`, wantErr: true},
+		{name: "thuộc tính của tổ chức không phải object", body: `{"custom_attributes": {"5": "IT"}}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mask, err := mergePatchMask([]byte(tt.body))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("mergePatchMask(%s) = %v, want error", tt.body, mask.GetPaths())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("mergePatchMask(%s): %v", tt.body, err)
+			}
+			if got := mask.GetPaths(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergePatchMask(%s) = %v, want %v", tt.body, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package helper
+
+import (
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ToProtoCustomAttributes chuyển thuộc tính tùy chỉnh (org_id -> key -> giá trị) sang Struct
+func ToProtoCustomAttributes(attrs map[string]map[string]any) *structpb.Struct {
+	m := make(map[string]any, len(attrs))
+	for org, values := range attrs {
+		m[org] = values
+	}
+	return toProtoStruct(m)
+}
+
+func ToProtoAttributeDefinition(d *ent.AttributeDefinition) *userPb.AttributeDefinition {
+	var pattern *wrapperspb.StringValue
+	if d.Pattern != nil {
+		pattern = wrapperspb.String(*d.Pattern)
+	}
+	return &userPb.AttributeDefinition{
+		Id:         int32(d.ID),
+		OrgId:      d.OrgID,
+		Key:        d.Key,
+		Label:      d.Label,
+		Type:       d.Type.String(),
+		Required:   d.Required,
+		EnumValues: d.EnumValues,
+		Pattern:    pattern,
+		CreatedAt:  timestamppb.New(d.CreatedAt),
+		UpdatedAt:  timestamppb.New(d.UpdatedAt),
+	}
+}
+
+func ToProtoAttributeDefinitions(defs []*ent.AttributeDefinition) []*userPb.AttributeDefinition {
+	res := make([]*userPb.AttributeDefinition, 0, len(defs))
+	for _, d := range defs {
+		res = append(res, ToProtoAttributeDefinition(d))
+	}
+	return res
+}
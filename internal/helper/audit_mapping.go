@@ -0,0 +1,56 @@
+package helper
+
+import (
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func optionalInt32(v *int) *wrapperspb.Int32Value {
+	if v == nil {
+		return nil
+	}
+	return wrapperspb.Int32(int32(*v))
+}
+
+// toProtoStruct bỏ qua map rỗng; giá trị đọc từ cột JSON luôn chuyển được sang Struct
+func toProtoStruct(m map[string]any) *structpb.Struct {
+	if len(m) == 0 {
+		return nil
+	}
+	s, err := structpb.NewStruct(m)
+	if err != nil {
+		return nil
+	}
+	return s
+}
+
+func ToProtoAuditLogs(logs []*ent.AuditLog) []*userPb.AuditLog {
+	res := make([]*userPb.AuditLog, 0, len(logs))
+	for _, l := range logs {
+		var orgID *wrapperspb.Int64Value
+		if l.OrgID != nil {
+			orgID = wrapperspb.Int64(*l.OrgID)
+		}
+		var entityType string
+		if l.EntityType != nil {
+			entityType = *l.EntityType
+		}
+		res = append(res, &userPb.AuditLog{
+			Id:           int32(l.ID),
+			Action:       l.Action,
+			ActorId:      optionalInt32(l.ActorID),
+			OrgId:        orgID,
+			TargetUserId: optionalInt32(l.TargetUserID),
+			EntityType:   entityType,
+			EntityId:     optionalInt32(l.EntityID),
+			Changes:      toProtoStruct(l.Changes),
+			Details:      toProtoStruct(l.Details),
+			CreatedAt:    timestamppb.New(l.CreatedAt),
+		})
+	}
+	return res
+}
@@ -0,0 +1,75 @@
+package helper
+
+import (
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func ToProtoGroup(g *ent.Group) *userPb.Group {
+	var orgID *wrapperspb.Int64Value
+	if g.OrgID != nil {
+		orgID = wrapperspb.Int64(*g.OrgID)
+	}
+	subgroupIDs := make([]int32, 0, len(g.Edges.Subgroups))
+	for _, sub := range g.Edges.Subgroups {
+		subgroupIDs = append(subgroupIDs, int32(sub.ID))
+	}
+	return &userPb.Group{
+		Id:          int32(g.ID),
+		OrgId:       orgID,
+		Name:        g.Name,
+		Description: g.Description,
+		SubgroupIds: subgroupIDs,
+		CreatedAt:   timestamppb.New(g.CreatedAt),
+		UpdatedAt:   timestamppb.New(g.UpdatedAt),
+	}
+}
+
+func ToProtoGroups(groups []*ent.Group) []*userPb.Group {
+	res := make([]*userPb.Group, 0, len(groups))
+	for _, g := range groups {
+		res = append(res, ToProtoGroup(g))
+	}
+	return res
+}
+
+func ToProtoGroupMember(m *ent.GroupMember) *userPb.GroupMember {
+	return &userPb.GroupMember{
+		GroupId:   int32(m.GroupID),
+		UserId:    int32(m.UserID),
+		Role:      m.Role.String(),
+		CreatedAt: timestamppb.New(m.CreatedAt),
+	}
+}
+
+func ToProtoGroupMembers(members []*ent.GroupMember) []*userPb.GroupMember {
+	res := make([]*userPb.GroupMember, 0, len(members))
+	for _, m := range members {
+		res = append(res, ToProtoGroupMember(m))
+	}
+	return res
+}
+
+func ToProtoUserGroup(g *ent.Group, direct bool, role string) *userPb.UserGroup {
+	return &userPb.UserGroup{
+		Group:  ToProtoGroup(g),
+		Direct: direct,
+		Role:   role,
+	}
+}
+
+func ToProtoExpandedGroupMember(userID int, direct bool, role string, viaGroupIDs []int) *userPb.ExpandedGroupMember {
+	via := make([]int32, 0, len(viaGroupIDs))
+	for _, id := range viaGroupIDs {
+		via = append(via, int32(id))
+	}
+	return &userPb.ExpandedGroupMember{
+		UserId:      int32(userID),
+		Direct:      direct,
+		Role:        role,
+		ViaGroupIds: via,
+	}
+}
@@ -0,0 +1,102 @@
+package helper
+
+import (
+	"context"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/pii"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// MaskUser che phone, email, address của u theo quyền của viewer trong ctx
+func MaskUser(ctx context.Context, u *userPb.User) {
+	if u == nil {
+		return
+	}
+	level := pii.LevelFor(ctx, int(u.Id))
+	if level == pii.Full {
+		return
+	}
+	u.Phone = maskValue(u.Phone, func(s string) string { return pii.Phone(level, s) })
+	u.Email = maskValue(u.Email, func(s string) string { return pii.Email(level, s) })
+	u.Address = maskValue(u.Address, func(s string) string { return pii.Address(level, s) })
+}
+
+// MaskFieldChanges che giá trị phone, email, address trong lịch sử thay đổi của user ownerID
+func MaskFieldChanges(ctx context.Context, ownerID int, changes []*userPb.FieldChange) {
+	level := pii.LevelFor(ctx, ownerID)
+	if level == pii.Full {
+		return
+	}
+	for _, c := range changes {
+		var fn func(string) string
+		switch c.Field {
+		case "phone":
+			fn = func(s string) string { return pii.Phone(level, s) }
+		case "email":
+			fn = func(s string) string { return pii.Email(level, s) }
+		case "address":
+			fn = func(s string) string { return pii.Address(level, s) }
+		default:
+			continue
+		}
+		c.From = maskValue(c.From, fn)
+		c.To = maskValue(c.To, fn)
+	}
+}
+
+func maskValue(v *wrapperspb.StringValue, fn func(string) string) *wrapperspb.StringValue {
+	if v == nil {
+		return nil
+	}
+	masked := fn(v.Value)
+	if masked == "" {
+		return nil
+	}
+	return wrapperspb.String(masked)
+}
+
+// MaskProto tìm mọi User trong msg (kể cả lồng trong message/list/map) và che PII theo quyền của viewer.
+// Dùng cho mọi response gRPC và REST để không handler nào quên che.
+func MaskProto(ctx context.Context, msg proto.Message) {
+	if msg == nil {
+		return
+	}
+	maskMessage(ctx, msg.ProtoReflect())
+}
+
+func maskMessage(ctx context.Context, m protoreflect.Message) {
+	if !m.IsValid() {
+		return
+	}
+	switch msg := m.Interface().(type) {
+	case *userPb.User:
+		MaskUser(ctx, msg)
+	case *userPb.UserVersion:
+		if msg.User != nil {
+			MaskFieldChanges(ctx, int(msg.User.Id), msg.Changes)
+		}
+	case *userPb.DiffUserVersionsResponse:
+		MaskFieldChanges(ctx, int(msg.UserId), msg.Changes)
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				maskMessage(ctx, list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				maskMessage(ctx, mv.Message())
+				return true
+			})
+		case fd.Message() != nil && !fd.IsMap():
+			maskMessage(ctx, v.Message())
+		}
+		return true
+	})
+}
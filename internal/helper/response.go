@@ -1,9 +1,31 @@
 package helper
 
 import (
+	"net/http"
+
 	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 func RespondWithError(c *gin.Context, statusCode int, err error) {
 	c.JSON(statusCode, gin.H{"error": err.Error()})
 }
+
+// protojson giữ đúng tên field và giá trị null của message gRPC
+var protoJSON = protojson.MarshalOptions{
+	UseProtoNames:   true,
+	EmitUnpopulated: true,
+}
+
+// RespondWithProto trả về message protobuf dưới dạng JSON cùng shape với gRPC.
+// PII của user trong msg được che theo quyền của viewer như gRPC.
+func RespondWithProto(c *gin.Context, statusCode int, msg proto.Message) {
+	MaskProto(c.Request.Context(), msg)
+	data, err := protoJSON.Marshal(msg)
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.Data(statusCode, "application/json; charset=utf-8", data)
+}
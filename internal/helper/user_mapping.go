@@ -0,0 +1,320 @@
+package helper
+
+import (
+	"time"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/internal/adminunit"
+	avatarPkg "github.com/huynhthanhthao/hrm_user_service/internal/avatar"
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/userio"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+	permPb "github.com/longgggwwww/hrm-ms-permission/ent/proto/entpb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// EntUserToProtoUser chuyển ent.User sang userPb.User, dùng chung cho gRPC và REST
+func EntUserToProtoUser(u *ent.User) *userPb.User {
+	if u == nil {
+		return nil
+	}
+	var (
+		email, wardCode, provinceCode, address, avatar *wrapperspb.StringValue
+	)
+	if u.Email != nil {
+		email = wrapperspb.String(*u.Email)
+	}
+	if u.WardCode != nil {
+		wardCode = wrapperspb.String(*u.WardCode)
+	}
+	if u.ProvinceCode != nil {
+		provinceCode = wrapperspb.String(*u.ProvinceCode)
+	}
+	if u.Address != nil {
+		address = wrapperspb.String(*u.Address)
+	}
+	avatarURL := avatarPkg.DefaultURL(u.ID, u.FirstName, u.LastName)
+	if u.Avatar != nil {
+		avatar = wrapperspb.String(*u.Avatar)
+		avatarURL = *u.Avatar
+	}
+	province, district, ward := userAdminUnits(u)
+	orgIDs := make([]int64, 0, len(u.Edges.Memberships))
+	for _, m := range u.Edges.Memberships {
+		orgIDs = append(orgIDs, m.OrgID)
+	}
+	return &userPb.User{
+		Id:           int32(u.ID),
+		FirstName:    u.FirstName,
+		LastName:     u.LastName,
+		Gender:       string(u.Gender),
+		Phone:        wrapperspb.String(u.Phone),
+		Email:        email,
+		WardCode:     wardCode,
+		ProvinceCode: provinceCode,
+		Address:      address,
+		Avatar:       avatar,
+		AvatarUrl:    avatarURL,
+		CreatedAt:    u.CreatedAt.String(),
+		UpdatedAt:    u.UpdatedAt.String(),
+		OrgIds:       orgIDs,
+		Profile:      ToProtoProfile(u.ID, u.Edges.Profile),
+		Province:     province,
+		District:     district,
+		Ward:         ward,
+		Version:      int32(u.Version),
+
+		CustomAttributes: ToProtoCustomAttributes(u.CustomAttributes),
+	}
+}
+
+// ToProtoVersionConflict trả về chi tiết lỗi cập nhật khi version của user đã thay đổi
+func ToProtoVersionConflict(message string, expected int, current *ent.User) *userPb.UserVersionConflict {
+	return &userPb.UserVersionConflict{
+		Error:           message,
+		ExpectedVersion: int32(expected),
+		Current:         EntUserToProtoUser(current),
+	}
+}
+
+func toProtoAdminUnit(u adminunit.Unit) *userPb.AdminUnit {
+	return &userPb.AdminUnit{Code: u.Code, Name: u.Name}
+}
+
+// userAdminUnits tra tên tỉnh/thành, quận/huyện, phường/xã của user theo danh mục đang dùng.
+// Mã không có trong danh mục (dữ liệu cũ) thì bỏ trống.
+func userAdminUnits(u *ent.User) (province, district, ward *userPb.AdminUnit) {
+	ds := adminunit.Default()
+	if u.WardCode != nil {
+		if w, ok := ds.Ward(*u.WardCode); ok {
+			return toProtoAdminUnit(w.Province().Unit), toProtoAdminUnit(w.District.Unit), toProtoAdminUnit(w.Unit)
+		}
+	}
+	if u.ProvinceCode != nil {
+		if p, ok := ds.Province(*u.ProvinceCode); ok {
+			province = toProtoAdminUnit(p.Unit)
+		}
+	}
+	return province, nil, nil
+}
+
+func ToProtoProvinces(provinces []*adminunit.Province) []*userPb.AdminUnit {
+	res := make([]*userPb.AdminUnit, 0, len(provinces))
+	for _, p := range provinces {
+		res = append(res, toProtoAdminUnit(p.Unit))
+	}
+	return res
+}
+
+func ToProtoDistricts(districts []*adminunit.District) []*userPb.AdminUnit {
+	res := make([]*userPb.AdminUnit, 0, len(districts))
+	for _, d := range districts {
+		res = append(res, toProtoAdminUnit(d.Unit))
+	}
+	return res
+}
+
+func ToProtoWards(wards []*adminunit.Ward) []*userPb.AdminUnit {
+	res := make([]*userPb.AdminUnit, 0, len(wards))
+	for _, w := range wards {
+		res = append(res, toProtoAdminUnit(w.Unit))
+	}
+	return res
+}
+
+func optionalString(v *string) *wrapperspb.StringValue {
+	if v == nil {
+		return nil
+	}
+	return wrapperspb.String(*v)
+}
+
+func optionalDate(v *time.Time) *wrapperspb.StringValue {
+	if v == nil {
+		return nil
+	}
+	return wrapperspb.String(v.Format(dto.ProfileDateLayout))
+}
+
+// ToProtoProfile chuyển hồ sơ nhân sự sang proto; p == nil (chưa nạp hoặc chưa có hồ sơ) trả về nil
+func ToProtoProfile(userID int, p *ent.Profile) *userPb.UserProfile {
+	if p == nil {
+		return nil
+	}
+	var maritalStatus *wrapperspb.StringValue
+	if p.MaritalStatus != nil {
+		maritalStatus = wrapperspb.String(string(*p.MaritalStatus))
+	}
+	contacts := make([]*userPb.EmergencyContact, 0, len(p.Edges.EmergencyContacts))
+	for _, c := range p.Edges.EmergencyContacts {
+		contacts = append(contacts, &userPb.EmergencyContact{
+			Name:         c.Name,
+			Relationship: c.Relationship,
+			Phone:        c.Phone,
+			Address:      optionalString(c.Address),
+		})
+	}
+	return &userPb.UserProfile{
+		UserId:                int32(userID),
+		DateOfBirth:           optionalDate(p.DateOfBirth),
+		PlaceOfBirth:          optionalString(p.PlaceOfBirth),
+		NationalId:            optionalString(p.NationalID),
+		NationalIdIssueDate:   optionalDate(p.NationalIDIssueDate),
+		NationalIdIssuePlace:  optionalString(p.NationalIDIssuePlace),
+		Ethnicity:             optionalString(p.Ethnicity),
+		Religion:              optionalString(p.Religion),
+		MaritalStatus:         maritalStatus,
+		TaxCode:               optionalString(p.TaxCode),
+		SocialInsuranceNumber: optionalString(p.SocialInsuranceNumber),
+		EmergencyContacts:     contacts,
+		UpdatedAt:             p.UpdatedAt.String(),
+	}
+}
+
+func EntUsersToProtoUsers(users []*ent.User) []*userPb.User {
+	res := make([]*userPb.User, 0, len(users))
+	for _, u := range users {
+		res = append(res, EntUserToProtoUser(u))
+	}
+	return res
+}
+
+// Map roles của permission service sang userPb.RoleExt
+func ToProtoRoles(roles []*permPb.RoleExt) []*userPb.RoleExt {
+	var res []*userPb.RoleExt
+	for _, r := range roles {
+		res = append(res, &userPb.RoleExt{
+			Id:          r.Id,
+			Code:        r.Code,
+			Name:        r.Name,
+			Color:       r.Color,
+			Description: r.Description,
+			CreatedAt:   r.CreatedAt,
+			UpdatedAt:   r.UpdatedAt,
+		})
+	}
+	return res
+}
+
+// Map perms của permission service sang userPb.PermExt
+func ToProtoPerms(perms []*permPb.PermExt) []*userPb.PermExt {
+	var res []*userPb.PermExt
+	for _, p := range perms {
+		res = append(res, &userPb.PermExt{
+			Id:          p.Id,
+			Code:        p.Code,
+			Name:        p.Name,
+			Description: p.Description,
+		})
+	}
+	return res
+}
+
+func ToProtoImportRow(r userio.ImportRowResult) *userPb.ImportRowResult {
+	return &userPb.ImportRowResult{
+		Line:     int32(r.Line),
+		Username: r.Username,
+		UserId:   int32(r.UserID),
+		Status:   r.Status,
+		Errors:   r.Errors,
+	}
+}
+
+func ToProtoImportSummary(s *userio.ImportSummary) *userPb.ImportSummary {
+	return &userPb.ImportSummary{
+		DryRun:  s.DryRun,
+		Total:   int32(s.Total),
+		Valid:   int32(s.Valid),
+		Invalid: int32(s.Invalid),
+		Created: int32(s.Created),
+		Failed:  int32(s.Failed),
+	}
+}
+
+func optionalTimestamp(v *time.Time) *timestamppb.Timestamp {
+	if v == nil {
+		return nil
+	}
+	return timestamppb.New(*v)
+}
+
+// ToProtoAccountInfo chuyển metadata của account sang proto (không có mật khẩu)
+func ToProtoAccountInfo(a *ent.Account) *userPb.AccountInfo {
+	if a == nil {
+		return nil
+	}
+	return &userPb.AccountInfo{
+		Username:    a.Username,
+		Status:      string(a.Status),
+		LastLoginAt: optionalTimestamp(a.LastLoginAt),
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+		UpdatedAt:   timestamppb.New(a.UpdatedAt),
+	}
+}
+
+func ToProtoLoginEvents(events []*ent.LoginEvent) []*userPb.LoginEvent {
+	res := make([]*userPb.LoginEvent, 0, len(events))
+	for _, e := range events {
+		var orgID *wrapperspb.Int64Value
+		if e.OrgID != nil {
+			orgID = wrapperspb.Int64(*e.OrgID)
+		}
+		res = append(res, &userPb.LoginEvent{
+			Success:       e.Success,
+			FailureReason: optionalString(e.FailureReason),
+			OrgId:         orgID,
+			Ip:            optionalString(e.IP),
+			UserAgent:     optionalString(e.UserAgent),
+			CreatedAt:     timestamppb.New(e.CreatedAt),
+			ExpiresAt:     optionalTimestamp(e.ExpiresAt),
+		})
+	}
+	return res
+}
+
+// ToProtoPersonalData gom dữ liệu cá nhân đã xuất; u cần nạp sẵn account, membership và hồ sơ
+func ToProtoPersonalData(exportedAt time.Time, u *ent.User, history, sessions []*ent.LoginEvent, versions []*userPb.UserVersion) *userPb.PersonalDataExport {
+	return &userPb.PersonalDataExport{
+		ExportedAt:   timestamppb.New(exportedAt),
+		User:         EntUserToProtoUser(u),
+		Account:      ToProtoAccountInfo(u.Edges.Account),
+		LoginHistory: ToProtoLoginEvents(history),
+		Sessions:     ToProtoLoginEvents(sessions),
+		DeletedAt:    optionalTimestamp(u.DeletedAt),
+		AnonymizedAt: optionalTimestamp(u.AnonymizedAt),
+		Versions:     versions,
+	}
+}
+
+// ToProtoUserVersion chuyển một phiên bản sang proto; User chỉ gồm các field được lưu trong lịch sử
+func ToProtoUserVersion(v *ent.UserVersion, changes []*userPb.FieldChange) *userPb.UserVersion {
+	u := EntUserToProtoUser(&ent.User{
+		ID:           v.UserID,
+		FirstName:    v.FirstName,
+		LastName:     v.LastName,
+		Gender:       user.Gender(v.Gender),
+		Phone:        v.Phone,
+		Email:        v.Email,
+		Address:      v.Address,
+		WardCode:     v.WardCode,
+		ProvinceCode: v.ProvinceCode,
+		Avatar:       v.Avatar,
+	})
+	u.CreatedAt = ""
+	u.UpdatedAt = v.ValidFrom.String()
+	return &userPb.UserVersion{
+		Version:   int32(v.Version),
+		User:      u,
+		ValidFrom: timestamppb.New(v.ValidFrom),
+		ValidTo:   optionalTimestamp(v.ValidTo),
+		ChangedBy: optionalInt32(v.ChangedBy),
+		Changes:   changes,
+	}
+}
+
+func ToProtoFieldChange(field string, from, to *string) *userPb.FieldChange {
+	return &userPb.FieldChange{Field: field, From: optionalString(from), To: optionalString(to)}
+}
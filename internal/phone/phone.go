@@ -0,0 +1,102 @@
+// Package phone chuẩn hóa số điện thoại về dạng E.164 (+84xxxxxxxxx) để ràng buộc unique có ý nghĩa:
+// "0912345678", "+84912345678" và "84 912 345 678" là cùng một số.
+package phone
+
+import (
+	"errors"
+	"strings"
+)
+
+const vnCountryCode = "84"
+
+var (
+	ErrInvalid        = errors.New("invalid phone number")
+	ErrInvalidVNPhone = errors.New("invalid Vietnamese mobile number")
+)
+
+// Đầu số di động Việt Nam (2 chữ số sau mã quốc gia, tương ứng 0xx trong nước) và nhà mạng
+var vnMobilePrefixes = map[string]string{
+	"32": "Viettel", "33": "Viettel", "34": "Viettel", "35": "Viettel", "36": "Viettel",
+	"37": "Viettel", "38": "Viettel", "39": "Viettel", "86": "Viettel", "96": "Viettel",
+	"97": "Viettel", "98": "Viettel",
+
+	"81": "Vinaphone", "82": "Vinaphone", "83": "Vinaphone", "84": "Vinaphone", "85": "Vinaphone",
+	"88": "Vinaphone", "91": "Vinaphone", "94": "Vinaphone",
+
+	"70": "Mobifone", "76": "Mobifone", "77": "Mobifone", "78": "Mobifone", "79": "Mobifone",
+	"89": "Mobifone", "90": "Mobifone", "93": "Mobifone",
+
+	"52": "Vietnamobile", "56": "Vietnamobile", "58": "Vietnamobile", "92": "Vietnamobile",
+	"59": "Gmobile", "99": "Gmobile",
+	"87": "Itelecom",
+	"55": "Reddi",
+}
+
+// Normalize chuyển số điện thoại sang E.164.
+//   - Số Việt Nam ("09...", "84...", "+84...", "0084...") phải là số di động 10 chữ số với đầu số hợp lệ
+//   - Số nước ngoài phải có tiền tố "+" hoặc "00" và 8-15 chữ số
+//
+// Khoảng trắng, dấu chấm, gạch ngang và ngoặc được bỏ qua.
+func Normalize(s string) (string, error) {
+	var b strings.Builder
+	for i, r := range strings.TrimSpace(s) {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == '+' && i == 0:
+			b.WriteRune(r)
+		case r == ' ' || r == '.' || r == '-' || r == '(' || r == ')':
+		default:
+			return "", ErrInvalid
+		}
+	}
+	digits := b.String()
+
+	international := false
+	switch {
+	case strings.HasPrefix(digits, "+"):
+		digits, international = digits[1:], true
+	case strings.HasPrefix(digits, "00"):
+		digits, international = digits[2:], true
+	}
+
+	var national string
+	switch {
+	case international && strings.HasPrefix(digits, vnCountryCode):
+		national = digits[len(vnCountryCode):]
+	case international:
+		if len(digits) < 8 || len(digits) > 15 || digits[0] == '0' {
+			return "", ErrInvalid
+		}
+		return "+" + digits, nil
+	case strings.HasPrefix(digits, "0"):
+		national = digits[1:]
+	case strings.HasPrefix(digits, vnCountryCode) && len(digits) == len(vnCountryCode)+9:
+		national = digits[len(vnCountryCode):]
+	default:
+		return "", ErrInvalidVNPhone
+	}
+
+	if len(national) != 9 {
+		return "", ErrInvalidVNPhone
+	}
+	if _, ok := vnMobilePrefixes[national[:2]]; !ok {
+		return "", ErrInvalidVNPhone
+	}
+	return "+" + vnCountryCode + national, nil
+}
+
+// Valid cho biết s có chuẩn hóa được hay không
+func Valid(s string) bool {
+	_, err := Normalize(s)
+	return err == nil
+}
+
+// Carrier trả về nhà mạng của số di động Việt Nam (đã hoặc chưa chuẩn hóa); rỗng nếu không xác định
+func Carrier(s string) string {
+	e164, err := Normalize(s)
+	if err != nil || !strings.HasPrefix(e164, "+"+vnCountryCode) {
+		return ""
+	}
+	return vnMobilePrefixes[e164[3:5]]
+}
@@ -0,0 +1,70 @@
+package phone
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr error
+	}{
+		{name: "trong nước", in: "0912345678", want: "+84912345678"},
+		{name: "E.164", in: "+84912345678", want: "+84912345678"},
+		{name: "mã quốc gia không có +", in: "84912345678", want: "+84912345678"},
+		{name: "tiền tố 00", in: "0084912345678", want: "+84912345678"},
+		{name: "có khoảng trắng", in: " +84 912 345 678 ", want: "+84912345678"},
+		{name: "có ngoặc, dấu chấm, gạch ngang", in: "(091) 234.56-78", want: "+84912345678"},
+		{name: "nước ngoài", in: "+1 415 555 2671", want: "+14155552671"},
+		{name: "nước ngoài tiền tố 00", in: "0044 20 7946 0958", want: "+442079460958"},
+		{name: "ký tự lạ", in: "09123x5678", wantErr: ErrInvalid},
+		{name: "dấu + giữa chuỗi", in: "091+2345678", wantErr: ErrInvalid},
+		{name: "nước ngoài quá ngắn", in: "+1234567", wantErr: ErrInvalid},
+		{name: "nước ngoài quá dài", in: "+1234567890123456", wantErr: ErrInvalid},
+		{name: "nước ngoài bắt đầu bằng 0", in: "+0123456789", wantErr: ErrInvalid},
+		{name: "đầu số không tồn tại", in: "0112345678", wantErr: ErrInvalidVNPhone},
+		{name: "thiếu chữ số", in: "091234567", wantErr: ErrInvalidVNPhone},
+		{name: "thừa chữ số", in: "09123456789", wantErr: ErrInvalidVNPhone},
+		{name: "+84 thiếu chữ số", in: "+8491234567", wantErr: ErrInvalidVNPhone},
+		{name: "không có mã vùng", in: "12345", wantErr: ErrInvalidVNPhone},
+		{name: "rỗng", in: "", wantErr: ErrInvalidVNPhone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.in)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Normalize(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Normalize(%q) unexpected error: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCarrier(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "0981234567", want: "Viettel"},
+		{in: "+84912345678", want: "Vinaphone"},
+		{in: "0901234567", want: "Mobifone"},
+		{in: "+14155552671", want: ""},
+		{in: "abc", want: ""},
+	}
+	for _, tt := range tests {
+		if got := Carrier(tt.in); got != tt.want {
+			t.Errorf("Carrier(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Package pii che số điện thoại, email, địa chỉ của user theo quyền của người gọi.
+// Dùng chung cho gRPC, REST và export để cùng một người gọi luôn thấy cùng một mức dữ liệu.
+package pii
+
+import (
+	"context"
+	"strings"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+)
+
+type Level int
+
+const (
+	// Full: lời gọi nội bộ, chính user đó hoặc viewer có quyền user.pii.read
+	Full Level = iota
+	// Masked: người dùng không có quyền, ví dụ 0912***678, a***@company.vn
+	Masked
+	// Hidden: service khác gọi thay mặt tổ chức (x-org-id) chỉ cần tên để hiển thị
+	Hidden
+)
+
+const mask = "***"
+
+// LevelFor trả về mức dữ liệu viewer trong ctx được thấy với user ownerID
+func LevelFor(ctx context.Context, ownerID int) Level {
+	v := viewer.FromContext(ctx)
+	switch {
+	case v == nil, v.HasPerm(viewer.PermUserPIIRead):
+		return Full
+	case v.UserID == 0:
+		return Hidden
+	case v.UserID == ownerID:
+		return Full
+	}
+	return Masked
+}
+
+// MaskPhone giữ 4 số đầu và 3 số cuối; số Việt Nam được hiển thị theo dạng trong nước (0912***678)
+func MaskPhone(s string) string {
+	if strings.HasPrefix(s, "+84") {
+		s = "0" + s[3:]
+	}
+	if len(s) <= 7 {
+		return mask
+	}
+	return s[:4] + mask + s[len(s)-3:]
+}
+
+// MaskEmail giữ ký tự đầu và tên miền: a***@company.vn
+func MaskEmail(s string) string {
+	at := strings.LastIndexByte(s, '@')
+	if at <= 0 {
+		return mask
+	}
+	return s[:1] + mask + s[at:]
+}
+
+// MaskAddress chỉ giữ phần cuối (thường là tỉnh/thành): ***, Hà Nội
+func MaskAddress(s string) string {
+	comma := strings.LastIndexByte(s, ',')
+	if comma < 0 {
+		return mask
+	}
+	last := strings.TrimSpace(s[comma+1:])
+	if last == "" {
+		return mask
+	}
+	return mask + ", " + last
+}
+
+// Phone trả về số điện thoại theo level; rỗng nếu Hidden
+func Phone(level Level, s string) string {
+	return apply(level, s, MaskPhone)
+}
+
+// Email trả về email theo level; rỗng nếu Hidden
+func Email(level Level, s string) string {
+	return apply(level, s, MaskEmail)
+}
+
+// Address trả về địa chỉ theo level; rỗng nếu Hidden
+func Address(level Level, s string) string {
+	return apply(level, s, MaskAddress)
+}
+
+func apply(level Level, s string, maskFn func(string) string) string {
+	switch {
+	case s == "" || level == Full:
+		return s
+	case level == Hidden:
+		return ""
+	}
+	return maskFn(s)
+}
@@ -0,0 +1,108 @@
+package pii
+
+import (
+	"context"
+	"testing"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+)
+
+func TestMaskPhone(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "+84912345678", want: "0912***678"},
+		{in: "0912345678", want: "0912***678"},
+		{in: "+14155552671", want: "+141***671"},
+		{in: "1234567", want: "***"},
+		{in: "+84123", want: "***"},
+	}
+	for _, tt := range tests {
+		if got := MaskPhone(tt.in); got != tt.want {
+			t.Errorf("MaskPhone(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestMaskEmail(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "nguyenvana@company.vn", want: "n***@company.vn"},
+		{in: "a@company.vn", want: "a***@company.vn"},
+		{in: "@company.vn", want: "***"},
+		{in: "not-an-email", want: "***"},
+	}
+	for _, tt := range tests {
+		if got := MaskEmail(tt.in); got != tt.want {
+			t.Errorf("MaskEmail(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestMaskAddress(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "12 Lê Lợi, Hoàn Kiếm, Hà Nội", want: "***, Hà Nội"},
+		{in: "12 Lê Lợi,  Hà Nội ", want: "***, Hà Nội"},
+		{in: "12 Lê Lợi", want: "***"},
+		{in: "12 Lê Lợi, ", want: "***"},
+	}
+	for _, tt := range tests {
+		if got := MaskAddress(tt.in); got != tt.want {
+			t.Errorf("MaskAddress(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestApplyLevel(t *testing.T) {
+	tests := []struct {
+		name  string
+		level Level
+		in    string
+		want  string
+	}{
+		{name: "full", level: Full, in: "+84912345678", want: "+84912345678"},
+		{name: "masked", level: Masked, in: "+84912345678", want: "0912***678"},
+		{name: "hidden", level: Hidden, in: "+84912345678", want: ""},
+		{name: "rỗng giữ nguyên", level: Masked, in: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Phone(tt.level, tt.in); got != tt.want {
+				t.Errorf("Phone(%v, %q) = %q, want %q", tt.level, tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLevelFor(t *testing.T) {
+	orgID := int64(7)
+	tests := []struct {
+		name    string
+		viewer  *viewer.Viewer
+		ownerID int
+		want    Level
+	}{
+		{name: "lời gọi nội bộ", viewer: nil, ownerID: 1, want: Full},
+		{name: "chính user đó", viewer: &viewer.Viewer{UserID: 1, OrgID: &orgID}, ownerID: 1, want: Full},
+		{name: "có quyền xem PII", viewer: &viewer.Viewer{UserID: 2, OrgID: &orgID, PermCodes: []string{viewer.PermUserPIIRead}}, ownerID: 1, want: Full},
+		{name: "không có quyền", viewer: &viewer.Viewer{UserID: 2, OrgID: &orgID, PermCodes: []string{viewer.PermUserRead}}, ownerID: 1, want: Masked},
+		{name: "service thay mặt tổ chức", viewer: &viewer.Viewer{OrgID: &orgID, Internal: true}, ownerID: 1, want: Hidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.viewer != nil {
+				ctx = viewer.NewContext(ctx, tt.viewer)
+			}
+			if got := LevelFor(ctx, tt.ownerID); got != tt.want {
+				t.Errorf("LevelFor = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
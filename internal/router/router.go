@@ -2,8 +2,11 @@ package router
 
 import (
 	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/internal/avatar"
 	"github.com/huynhthanhthao/hrm_user_service/internal/handler"
 	"github.com/huynhthanhthao/hrm_user_service/internal/service"
+	"github.com/huynhthanhthao/hrm_user_service/internal/storage"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
 
 	"github.com/gin-gonic/gin"
 )
@@ -12,6 +15,7 @@ func SetupRouter(
 	client *ent.Client,
 	hrClients *service.HRServiceClients,
 	perClients *service.PermissionServiceClients,
+	store storage.Storage,
 ) *gin.Engine {
 
 	r := gin.Default()
@@ -23,8 +27,88 @@ func SetupRouter(
 	authHandler := handler.NewAuthHandler(authService)
 
 	r.POST("/login", authHandler.LoginHandler)
-	r.GET("/me", authHandler.GetMe)
+	r.GET("/me", handler.AuthMiddleware(authService), authHandler.GetMe)
 	r.POST("/refresh-token", authHandler.RefreshTokenHandler)
 
+	userService, err := service.NewUserService(client, hrClients, perClients)
+	if err != nil {
+		panic("failed to create user service: " + err.Error())
+	}
+	avatarService, err := service.NewAvatarService(client, store)
+	if err != nil {
+		panic("failed to create avatar service: " + err.Error())
+	}
+	userHandler := handler.NewUserHandler(userService, avatarService)
+
+	users := r.Group("/users", handler.AuthMiddleware(authService))
+	{
+		users.GET("", handler.RequirePerms(viewer.PermUserRead), userHandler.ListUsers)
+		users.POST("", handler.RequirePerms(viewer.PermUserCreate), userHandler.CreateUser)
+		users.POST("/import", handler.RequirePerms(viewer.PermUserCreate), userHandler.ImportUsers)
+		users.GET("/export", handler.RequirePerms(viewer.PermUserExport), userHandler.ExportUsers)
+		users.GET("/search", handler.RequirePerms(viewer.PermUserRead), userHandler.SearchUsers)
+		users.GET("/duplicates", handler.RequirePerms(viewer.PermUserMerge), userHandler.FindDuplicateUsers)
+		users.POST("/merge", handler.RequirePerms(viewer.PermUserMerge), userHandler.MergeUsers)
+		users.GET("/:id", handler.RequirePerms(viewer.PermUserRead), userHandler.GetUser)
+		users.PATCH("/:id", handler.RequirePerms(viewer.PermUserUpdate), userHandler.UpdateUser)
+		users.DELETE("/:id", handler.RequirePerms(viewer.PermUserDelete), userHandler.DeleteUser)
+		users.GET("/:id/profile", handler.RequirePerms(viewer.PermUserProfileRead), userHandler.GetUserProfile)
+		users.PUT("/:id/profile", handler.RequirePerms(viewer.PermUserProfileUpdate), userHandler.UpdateUserProfile)
+		users.GET("/:id/as-of", handler.RequirePerms(viewer.PermUserRead), userHandler.GetUserAsOf)
+		users.GET("/:id/versions", handler.RequirePerms(viewer.PermUserRead), userHandler.ListUserVersions)
+		users.GET("/:id/versions/diff", handler.RequirePerms(viewer.PermUserRead), userHandler.DiffUserVersions)
+		users.GET("/:id/personal-data", handler.RequirePerms(viewer.PermUserPersonalData), userHandler.ExportPersonalData)
+		users.POST("/:id/anonymize", handler.RequirePerms(viewer.PermUserPersonalData), userHandler.AnonymizeUser)
+		users.GET("/:id/groups", handler.RequirePerms(viewer.PermUserRead), userHandler.ListUserGroups)
+	}
+
+	r.GET("/audit-logs", handler.AuthMiddleware(authService), handler.RequirePerms(viewer.PermUserAuditRead), userHandler.ListAuditLogs)
+
+	attributes := r.Group("/attribute-definitions", handler.AuthMiddleware(authService))
+	{
+		attributes.GET("", handler.RequirePerms(viewer.PermUserRead), userHandler.ListAttributeDefinitions)
+		attributes.POST("", handler.RequirePerms(viewer.PermUserAttributeManage), userHandler.CreateAttributeDefinition)
+		attributes.PUT("/:id", handler.RequirePerms(viewer.PermUserAttributeManage), userHandler.UpdateAttributeDefinition)
+		attributes.DELETE("/:id", handler.RequirePerms(viewer.PermUserAttributeManage), userHandler.DeleteAttributeDefinition)
+	}
+
+	// Quyền sửa nhóm (owner/admin của nhóm hoặc user.group.manage) được kiểm tra trong service
+	groups := r.Group("/groups", handler.AuthMiddleware(authService))
+	{
+		groups.GET("", handler.RequirePerms(viewer.PermUserRead), userHandler.ListGroups)
+		groups.POST("", userHandler.CreateGroup)
+		groups.GET("/:id", handler.RequirePerms(viewer.PermUserRead), userHandler.GetGroup)
+		groups.PATCH("/:id", userHandler.UpdateGroup)
+		groups.DELETE("/:id", userHandler.DeleteGroup)
+		groups.GET("/:id/members", handler.RequirePerms(viewer.PermUserRead), userHandler.ListGroupMembers)
+		groups.POST("/:id/members", userHandler.AddGroupMembers)
+		groups.PATCH("/:id/members/:user_id", userHandler.UpdateGroupMember)
+		groups.DELETE("/:id/members/:user_id", userHandler.RemoveGroupMember)
+		groups.POST("/:id/subgroups", userHandler.AddSubgroup)
+		groups.DELETE("/:id/subgroups/:subgroup_id", userHandler.RemoveSubgroup)
+		groups.GET("/:id/expanded-members", handler.RequirePerms(viewer.PermUserRead), userHandler.ExpandGroupMembers)
+	}
+
+	r.GET("/me/profile", handler.AuthMiddleware(authService), userHandler.GetMyProfile)
+	r.GET("/me/personal-data", handler.AuthMiddleware(authService), userHandler.ExportMyPersonalData)
+	r.GET("/me/groups", handler.AuthMiddleware(authService), userHandler.ListMyGroups)
+
+	avatarHandler := handler.NewAvatarHandler(avatarService)
+	r.POST("/me/avatar", handler.AuthMiddleware(authService), avatarHandler.UploadMyAvatar)
+	r.GET(avatar.DefaultPath+":initials", avatarHandler.DefaultAvatar)
+
+	adminUnitHandler := handler.NewAdminUnitHandler()
+	adminUnits := r.Group("/admin-units")
+	{
+		adminUnits.GET("/provinces", adminUnitHandler.ListProvinces)
+		adminUnits.GET("/provinces/:code/districts", adminUnitHandler.ListDistricts)
+		adminUnits.GET("/districts/:code/wards", adminUnitHandler.ListWards)
+	}
+
+	// Backend local: phục vụ file trực tiếp từ thư mục lưu trữ
+	if local, ok := store.(*storage.LocalStorage); ok {
+		r.Static(storage.LocalMountPath, local.Dir())
+	}
+
 	return r
 }
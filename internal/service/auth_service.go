@@ -11,9 +11,12 @@ import (
 
 	"github.com/huynhthanhthao/hrm_user_service/ent"
 	"github.com/huynhthanhthao/hrm_user_service/ent/account"
+	"github.com/huynhthanhthao/hrm_user_service/ent/membership"
 	"github.com/huynhthanhthao/hrm_user_service/ent/user"
 	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/fieldcrypt"
 	"github.com/huynhthanhthao/hrm_user_service/internal/helper"
+	"github.com/huynhthanhthao/hrm_user_service/internal/phone"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
@@ -23,6 +26,13 @@ import (
 	permPb "github.com/longgggwwww/hrm-ms-permission/ent/proto/entpb"
 )
 
+var (
+	ErrInvalidToken    = errors.New("invalid access token")
+	ErrStaleToken      = errors.New("access token permissions are outdated, please refresh the token")
+	ErrAccountInactive = errors.New("account is inactive")
+	ErrNotOrgMember    = errors.New("user is not a member of the requested organization")
+)
+
 type AuthService struct {
 	client     *ent.Client
 	hrClients  *HRServiceClients
@@ -53,12 +63,29 @@ type LoginResult struct {
 	OrgID      *int64
 }
 
-// Lấy account theo username
+// Lấy account theo username; không có thì thử xem username là số điện thoại của user.
+// Số điện thoại được chuẩn hóa E.164 nên "0912345678" và "+84912345678" cùng đăng nhập được.
 func (s *AuthService) getAccountByUsername(ctx context.Context, username string) (*ent.Account, error) {
-	return s.client.Account.
+	acc, err := s.client.Account.
 		Query().
 		Where(account.UsernameEQ(username)).
 		Only(ctx)
+	if err == nil || !ent.IsNotFound(err) {
+		return acc, err
+	}
+
+	phoneNumber, phoneErr := phone.Normalize(username)
+	if phoneErr != nil {
+		return nil, err
+	}
+	phoneHash, hashErr := fieldcrypt.PhoneIndex(phoneNumber)
+	if hashErr != nil {
+		return nil, hashErr
+	}
+	return s.client.Account.
+		Query().
+		Where(account.HasUserWith(user.PhoneHash(phoneHash))).
+		Only(ctx)
 }
 
 // Kiểm tra password
@@ -109,6 +136,61 @@ func (s *AuthService) getEmployeeInfo(ctx context.Context, userID int) (*hrPb.Em
 	return employee, employeeID, orgID
 }
 
+// selectOrg chọn tổ chức sẽ ghi vào token.
+// User chưa có membership nhưng có tổ chức bên HR service sẽ được tạo membership mặc định.
+// Không chỉ định tổ chức thì dùng membership mặc định (hoặc membership đầu tiên).
+func (s *AuthService) selectOrg(ctx context.Context, userID int, requested, hrOrgID *int64) (*int64, []*ent.Membership, error) {
+	memberships, err := s.client.Membership.Query().
+		Where(membership.HasUserWith(user.ID(userID))).
+		Order(ent.Asc(membership.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("#1 selectOrg: failed to query memberships: %w", err)
+	}
+
+	if len(memberships) == 0 && hrOrgID != nil {
+		m, err := s.client.Membership.Create().
+			SetUserID(userID).
+			SetOrgID(*hrOrgID).
+			SetIsDefault(true).
+			Save(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("#2 selectOrg: failed to create membership: %w", err)
+		}
+		memberships = append(memberships, m)
+	}
+
+	if requested != nil {
+		for _, m := range memberships {
+			if m.OrgID == *requested {
+				return &m.OrgID, memberships, nil
+			}
+		}
+		return nil, memberships, ErrNotOrgMember
+	}
+
+	for _, m := range memberships {
+		if m.IsDefault {
+			return &m.OrgID, memberships, nil
+		}
+	}
+	if len(memberships) > 0 {
+		return &memberships[0].OrgID, memberships, nil
+	}
+	return nil, memberships, nil
+}
+
+func toOrganizationArr(memberships []*ent.Membership) []map[string]interface{} {
+	arr := make([]map[string]interface{}, 0, len(memberships))
+	for _, m := range memberships {
+		arr = append(arr, map[string]interface{}{
+			"org_id":     m.OrgID,
+			"is_default": m.IsDefault,
+		})
+	}
+	return arr
+}
+
 func (s *AuthService) Login(ctx context.Context, c *gin.Context, input dto.LoginInput) {
 	acc, err := s.getAccountByUsername(ctx, input.Username)
 
@@ -118,11 +200,13 @@ func (s *AuthService) Login(ctx context.Context, c *gin.Context, input dto.Login
 	}
 
 	if acc.Status == account.StatusInactive {
+		s.recordLoginFailure(ctx, c, acc, LoginFailureInactive)
 		helper.RespondWithError(c, http.StatusUnauthorized, fmt.Errorf("#1 Login: account is inactive"))
 		return
 	}
 
 	if err := checkPassword(acc.Password, input.Password); err != nil {
+		s.recordLoginFailure(ctx, c, acc, LoginFailureWrongPassword)
 		helper.RespondWithError(c, http.StatusBadRequest, err)
 		return
 	}
@@ -132,12 +216,27 @@ func (s *AuthService) Login(ctx context.Context, c *gin.Context, input dto.Login
 		helper.RespondWithError(c, http.StatusBadRequest, err)
 		return
 	}
+
+	// Ghi nhận thời điểm đăng nhập, không chặn đăng nhập nếu cập nhật thất bại
+	if updated, err := s.client.Account.UpdateOneID(acc.ID).SetLastLoginAt(time.Now()).Save(ctx); err == nil {
+		acc = updated
+	}
 	rolesArr, permsArr, permCodes, err := s.getUserRolesPerms(ctx, usr.ID)
 	if err != nil {
 		helper.RespondWithError(c, http.StatusBadRequest, err)
 		return
 	}
-	employee, employeeID, orgID := s.getEmployeeInfo(ctx, usr.ID)
+	employee, employeeID, hrOrgID := s.getEmployeeInfo(ctx, usr.ID)
+
+	orgID, memberships, err := s.selectOrg(ctx, usr.ID, input.OrgID, hrOrgID)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, ErrNotOrgMember) {
+			status = http.StatusForbidden
+		}
+		helper.RespondWithError(c, status, err)
+		return
+	}
 
 	var employeeMap map[string]interface{}
 	if employee != nil {
@@ -156,18 +255,28 @@ func (s *AuthService) Login(ctx context.Context, c *gin.Context, input dto.Login
 		OrgID:          orgID,
 		Duration:       accessDur,
 		Perms:          permCodes,
+		PermVersion:    usr.PermVersion,
 	})
 	if err != nil {
 		helper.RespondWithError(c, http.StatusBadRequest, err)
 		return
 	}
 
-	refreshToken, err := GenerateRefreshToken(usr.ID, refreshDur)
+	refreshToken, err := GenerateRefreshToken(usr.ID, orgID, refreshDur)
 	if err != nil {
 		helper.RespondWithError(c, http.StatusBadRequest, err)
 		return
 	}
 
+	// Lịch sử đăng nhập không chặn đăng nhập nếu ghi thất bại
+	expiresAt := time.Now().Add(refreshDur)
+	_ = s.recordLoginEvent(ctx, c, loginAttempt{
+		userID:    usr.ID,
+		success:   true,
+		orgID:     orgID,
+		expiresAt: &expiresAt,
+	})
+
 	c.JSON(http.StatusOK, gin.H{
 		"access_token":  accessToken,
 		"refresh_token": refreshToken,
@@ -175,37 +284,90 @@ func (s *AuthService) Login(ctx context.Context, c *gin.Context, input dto.Login
 		"employee":      employeeMap,
 		"roles":         rolesArr,
 		"perms":         permsArr,
+		"org_id":        orgID,
+		"organizations": toOrganizationArr(memberships),
 	})
 }
 
-func (s *AuthService) DecodeToken(ctx context.Context, token string, c *gin.Context) {
-	// Parse the token
+// Claims đã được xác thực của access token
+type AccessClaims struct {
+	UserID      int
+	OrgID       *int64
+	EmployeeID  *int64
+	PermVersion int
+	PermCodes   []string
+}
+
+// ValidateAccessToken kiểm tra chữ ký, hạn dùng và perm_version của access token.
+// Token được cấp trước lần thay đổi quyền/vai trò gần nhất sẽ trả về ErrStaleToken.
+func (s *AuthService) ValidateAccessToken(ctx context.Context, token string) (*AccessClaims, error) {
 	parsedToken, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("#1 DecodeToken: unexpected signing method: %v", token.Header["alg"])
+			return nil, fmt.Errorf("#1 ValidateAccessToken: unexpected signing method: %v", token.Header["alg"])
 		}
 		return []byte(os.Getenv("JWT_SECRET")), nil
 	})
-
 	if err != nil || !parsedToken.Valid {
-		helper.RespondWithError(c, http.StatusBadRequest, err)
-		return
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
 	}
 
-	// Extract claims
 	claims, ok := parsedToken.Claims.(jwt.MapClaims)
 	if !ok {
-		helper.RespondWithError(c, http.StatusBadRequest, fmt.Errorf("#2 DecodeToken: invalid token claims"))
-		return
+		return nil, fmt.Errorf("%w: invalid token claims", ErrInvalidToken)
 	}
 
 	userIDFloat, ok := claims["user_id"].(float64)
 	if !ok {
-		helper.RespondWithError(c, http.StatusBadRequest, fmt.Errorf("#3 DecodeToken: user_id not found in token claims"))
-		return
+		return nil, fmt.Errorf("%w: user_id not found in token claims", ErrInvalidToken)
+	}
+
+	// Token cũ không có perm_version được xem như phiên bản 0
+	permVersion, _ := claims["perm_version"].(float64)
+
+	result := &AccessClaims{
+		UserID:      int(userIDFloat),
+		OrgID:       claimInt64(claims, "org_id"),
+		EmployeeID:  claimInt64(claims, "employee_id"),
+		PermVersion: int(permVersion),
+	}
+	if codes, ok := claims["perm_codes"].([]interface{}); ok {
+		for _, code := range codes {
+			if str, ok := code.(string); ok {
+				result.PermCodes = append(result.PermCodes, str)
+			}
+		}
 	}
-	userID := int(userIDFloat)
 
+	usr, err := s.client.User.Query().
+		Where(user.IDEQ(result.UserID)).
+		WithAccount().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	if usr.Edges.Account != nil && usr.Edges.Account.Status == account.StatusInactive {
+		return nil, ErrAccountInactive
+	}
+
+	if usr.PermVersion != result.PermVersion {
+		return nil, ErrStaleToken
+	}
+
+	return result, nil
+}
+
+func claimInt64(claims jwt.MapClaims, key string) *int64 {
+	v, ok := claims[key].(float64)
+	if !ok {
+		return nil
+	}
+	i := int64(v)
+	return &i
+}
+
+// GET /me: trả về thông tin user của access token đã được middleware xác thực
+func (s *AuthService) GetMe(ctx context.Context, c *gin.Context, userID int) {
 	// Query the user by ID
 	usr, err := s.client.User.Query().Where(user.IDEQ(userID)).Only(ctx)
 	if err != nil {
@@ -219,12 +381,6 @@ func (s *AuthService) DecodeToken(ctx context.Context, token string, c *gin.Cont
 		helper.RespondWithError(c, http.StatusBadRequest, err)
 		return
 	}
-
-	// Validate account status
-	if acc.Status == account.StatusInactive {
-		helper.RespondWithError(c, http.StatusUnauthorized, fmt.Errorf("#4 DecodeToken: account is inactive"))
-		return
-	}
 	usr.Edges.Account = acc
 
 	// Query employee
@@ -238,28 +394,16 @@ func (s *AuthService) DecodeToken(ctx context.Context, token string, c *gin.Cont
 	userIDStr := strconv.Itoa(usr.ID)
 	permsResp, err := s.perClients.PermExt.GetUserPerms(ctx, &permPb.GetUserPermsRequest{UserId: userIDStr})
 	if err != nil {
-		helper.RespondWithError(c, http.StatusBadRequest, fmt.Errorf("#4 DecodeToken: failed to get user perms: %w", err))
+		helper.RespondWithError(c, http.StatusBadRequest, fmt.Errorf("#1 GetMe: failed to get user perms: %w", err))
 		return
 	}
 
 	rolesResp, err := s.perClients.PermExt.GetUserRoles(ctx, &permPb.GetUserRolesRequest{UserId: userIDStr})
 	if err != nil {
-		helper.RespondWithError(c, http.StatusBadRequest, fmt.Errorf("#5 DecodeToken: failed to get user roles: %w", err))
+		helper.RespondWithError(c, http.StatusBadRequest, fmt.Errorf("#2 GetMe: failed to get user roles: %w", err))
 		return
 	}
 
-	// Lấy tất cả perm_codes từ các role (gộp, loại trùng)
-	permCodeSet := make(map[string]struct{})
-	for _, r := range rolesResp.Roles {
-		for _, p := range r.Perms {
-			permCodeSet[p.Code] = struct{}{}
-		}
-	}
-	permCodes := make([]string, 0, len(permCodeSet))
-	for code := range permCodeSet {
-		permCodes = append(permCodes, code)
-	}
-
 	c.JSON(http.StatusOK, gin.H{
 		"user":     usr,
 		"employee": employeeMap,
@@ -277,6 +421,7 @@ type TokenClaimsInput struct {
 	Duration       time.Duration
 	Roles          []string
 	Perms          []string
+	PermVersion    int
 }
 
 func GenerateAccessToken(input TokenClaimsInput) (string, error) {
@@ -288,6 +433,7 @@ func GenerateAccessToken(input TokenClaimsInput) (string, error) {
 		"exp":             time.Now().Add(input.Duration).Unix(),
 		"iss":             os.Getenv("ISS_KEY"),
 		"perm_codes":      input.Perms,
+		"perm_version":    input.PermVersion,
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	secret := os.Getenv("JWT_SECRET")
@@ -297,10 +443,11 @@ func GenerateAccessToken(input TokenClaimsInput) (string, error) {
 	return token.SignedString([]byte(secret))
 }
 
-// Generate refresh token: chỉ chứa user_id và exp
-func GenerateRefreshToken(userID int, duration time.Duration) (string, error) {
+// Generate refresh token: chỉ chứa user_id, tổ chức đã chọn và exp
+func GenerateRefreshToken(userID int, orgID *int64, duration time.Duration) (string, error) {
 	claims := jwt.MapClaims{
 		"user_id": userID,
+		"org_id":  orgID,
 		"exp":     time.Now().Add(duration).Unix(),
 		"iss":     os.Getenv("ISS_KEY"),
 	}
@@ -313,7 +460,8 @@ func GenerateRefreshToken(userID int, duration time.Duration) (string, error) {
 }
 
 // POST /auth/refresh-token
-func (s *AuthService) RefreshToken(ctx context.Context, c *gin.Context, refreshToken string) {
+// orgID khác nil để chuyển sang tổ chức khác mà user là thành viên
+func (s *AuthService) RefreshToken(ctx context.Context, c *gin.Context, refreshToken string, orgID *int64) {
 	if refreshToken == "" {
 		helper.RespondWithError(c, http.StatusUnauthorized, fmt.Errorf("refresh token missing"))
 		return
@@ -365,7 +513,18 @@ func (s *AuthService) RefreshToken(ctx context.Context, c *gin.Context, refreshT
 	}
 
 	// Get employee info for claims
-	employee, employeeID, orgID := s.getEmployeeInfo(ctx, usr.ID)
+	employee, employeeID, hrOrgID := s.getEmployeeInfo(ctx, usr.ID)
+
+	// Giữ tổ chức đã chọn lúc đăng nhập nếu không yêu cầu chuyển tổ chức
+	if orgID == nil {
+		orgID = claimInt64(claims, "org_id")
+	}
+	orgID, _, err = s.selectOrg(ctx, usr.ID, orgID, hrOrgID)
+	if err != nil {
+		helper.RespondWithError(c, http.StatusForbidden, err)
+		return
+	}
+
 	var employeeStatus string
 	if employee != nil {
 		employeeMap := helper.ToEmployeeMap(employee)
@@ -402,6 +561,7 @@ func (s *AuthService) RefreshToken(ctx context.Context, c *gin.Context, refreshT
 		OrgID:          orgID,
 		Duration:       accessDur,
 		Perms:          permCodes,
+		PermVersion:    usr.PermVersion,
 	})
 	if err != nil {
 		helper.RespondWithError(c, http.StatusUnauthorized, err)
@@ -412,5 +572,6 @@ func (s *AuthService) RefreshToken(ctx context.Context, c *gin.Context, refreshT
 		"access_token": accessToken,
 		"token_type":   "Bearer",
 		"expires_in":   int(accessDur.Seconds()),
+		"org_id":       orgID,
 	})
 }
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/internal/avatar"
+	"github.com/huynhthanhthao/hrm_user_service/internal/storage"
+)
+
+type AvatarService struct {
+	client *ent.Client
+	store  storage.Storage
+}
+
+func NewAvatarService(client *ent.Client, store storage.Storage) (*AvatarService, error) {
+	if store == nil {
+		return nil, fmt.Errorf("#1 NewAvatarService: storage is not initialized")
+	}
+	return &AvatarService{
+		client: client,
+		store:  store,
+	}, nil
+}
+
+// avatarKey: avatars/{userID}/{token}/{variant}{ext}.
+// Mỗi lần upload dùng token mới nên URL cũ không bị cache nhầm ảnh mới.
+func avatarKey(userID int, token string, img avatar.Image) string {
+	return fmt.Sprintf("avatars/%d/%s/%s%s", userID, token, img.Variant.Name, img.Ext)
+}
+
+// SetAvatar xử lý ảnh, lưu mọi variant rồi ghi URL ảnh gốc vào user.avatar.
+// Các file của avatar cũ (nếu do storage này quản lý) được xóa sau khi cập nhật thành công.
+func (s *AvatarService) SetAvatar(ctx context.Context, userID int, data []byte) (*ent.User, error) {
+	usr, err := s.client.User.Query().Where(user.ID(userID)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#1 SetAvatar: user not found: %w", err)
+	}
+
+	images, err := avatar.Process(data)
+	if err != nil {
+		return nil, err
+	}
+
+	token := strings.ReplaceAll(uuid.NewString(), "-", "")
+	var uploaded []string
+	for _, img := range images {
+		key := avatarKey(userID, token, img)
+		if err := s.store.Put(ctx, key, bytes.NewReader(img.Data), int64(len(img.Data)), img.ContentType); err != nil {
+			s.deleteKeys(ctx, uploaded)
+			return nil, fmt.Errorf("#2 SetAvatar: failed to store avatar: %w", err)
+		}
+		uploaded = append(uploaded, key)
+	}
+
+	err = s.client.User.UpdateOneID(userID).
+		SetAvatar(s.store.URL(uploaded[0])).
+		Exec(ctx)
+	if err != nil {
+		s.deleteKeys(ctx, uploaded)
+		return nil, fmt.Errorf("#3 SetAvatar: failed to update user: %w", err)
+	}
+
+	if usr.Avatar != nil {
+		s.deleteAvatarFiles(ctx, *usr.Avatar)
+	}
+
+	updated, err := s.client.User.Query().
+		Where(user.ID(userID)).
+		WithMemberships().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#4 SetAvatar: failed to reload user: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteAvatarFiles xóa file của avatar đã bị gỡ khỏi user (ví dụ khi ẩn danh hóa)
+func (s *AvatarService) DeleteAvatarFiles(ctx context.Context, avatarURL string) {
+	s.deleteAvatarFiles(ctx, avatarURL)
+}
+
+// deleteAvatarFiles xóa mọi variant của avatar có URL avatarURL.
+// URL không do storage quản lý (ảnh client tự host) được bỏ qua.
+func (s *AvatarService) deleteAvatarFiles(ctx context.Context, avatarURL string) {
+	key, ok := storage.KeyFromURL(s.store, avatarURL)
+	if !ok || !strings.HasPrefix(key, "avatars/") {
+		return
+	}
+	dir, ext := path.Dir(key), path.Ext(key)
+	keys := make([]string, 0, len(avatar.Variants))
+	for _, v := range avatar.Variants {
+		keys = append(keys, path.Join(dir, v.Name+ext))
+	}
+	s.deleteKeys(ctx, keys)
+}
+
+// deleteKeys dọn file theo kiểu best-effort: file sót lại không ảnh hưởng dữ liệu user
+func (s *AvatarService) deleteKeys(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		_ = s.store.Delete(ctx, key)
+	}
+}
@@ -0,0 +1,17 @@
+package service
+
+// Kích thước lô cho các migration dữ liệu, tránh tải toàn bộ bảng vào bộ nhớ
+const backfillBatchSize = 500
+
+// FailedRow là dòng bị bỏ qua khi chạy migration dữ liệu vì lỗi; các dòng khác vẫn được xử lý
+type FailedRow struct {
+	Table string
+	ID    int
+	Err   error
+}
+
+// BackfillReport là kết quả của migration dữ liệu chỉ đếm số dòng đã cập nhật
+type BackfillReport struct {
+	Updated int
+	Failed  []FailedRow
+}
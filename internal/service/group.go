@@ -0,0 +1,475 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/group"
+	"github.com/huynhthanhthao/hrm_user_service/ent/groupmember"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+
+	"github.com/lib/pq"
+)
+
+var (
+	ErrGroupForbidden     = errors.New("missing permission to manage group")
+	ErrGroupCycle         = errors.New("group nesting would create a cycle")
+	ErrGroupOrgMismatch   = errors.New("groups belong to different organizations")
+	ErrLastGroupOwner     = errors.New("group must keep at least one owner")
+	ErrInvalidGroupMember = errors.New("invalid group member")
+)
+
+// Kết quả một trang danh sách nhóm
+type GroupPage struct {
+	Groups      []*ent.Group
+	Total       int
+	TotalPages  int
+	CurrentPage int
+}
+
+// canManageGroup: lời gọi nội bộ, viewer có quyền user.group.manage, hoặc owner của nhóm
+// (admin cũng được nếu ownerOnly = false)
+func canManageGroup(ctx context.Context, client *ent.Client, groupID int, ownerOnly bool) (bool, error) {
+	v := viewer.FromContext(ctx)
+	if v == nil || v.HasPerm(viewer.PermUserGroupManage) {
+		return true, nil
+	}
+	m, err := client.GroupMember.Query().
+		Where(groupmember.GroupID(groupID), groupmember.UserID(v.UserID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return m.Role == groupmember.RoleOwner || (!ownerOnly && m.Role == groupmember.RoleAdmin), nil
+}
+
+// getGroup lấy nhóm kèm id các nhóm con trực tiếp; nhóm của tổ chức khác được xem như không tồn tại
+func getGroup(ctx context.Context, client *ent.Client, id int) (*ent.Group, error) {
+	return client.Group.Query().
+		Where(group.ID(id)).
+		WithSubgroups(func(q *ent.GroupQuery) {
+			q.Select(group.FieldID)
+		}).
+		Only(ctx)
+}
+
+// ensureUsersInScope kiểm tra các user tồn tại và viewer nhìn thấy được
+func ensureUsersInScope(ctx context.Context, client *ent.Client, userIDs []int) error {
+	found, err := client.User.Query().Where(user.IDIn(userIDs...)).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range userIDs {
+		if !slices.Contains(found, id) {
+			return fmt.Errorf("%w: user %d not found", ErrInvalidGroupMember, id)
+		}
+	}
+	return nil
+}
+
+func (s *UserService) ListGroups(ctx context.Context, params dto.ListGroupsParams) (*GroupPage, error) {
+	params.Normalize()
+
+	query := s.client.Group.Query()
+	if params.Search != "" {
+		query = query.Where(group.NameContainsFold(params.Search))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#1 ListGroups: failed to count groups: %w", err)
+	}
+	groups, err := query.
+		Order(group.ByName(), group.ByID()).
+		Limit(params.PageSize).
+		Offset((params.Page - 1) * params.PageSize).
+		WithSubgroups(func(q *ent.GroupQuery) {
+			q.Select(group.FieldID)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#2 ListGroups: failed to query groups: %w", err)
+	}
+	return &GroupPage{
+		Groups:      groups,
+		Total:       total,
+		TotalPages:  (total + params.PageSize - 1) / params.PageSize,
+		CurrentPage: params.Page,
+	}, nil
+}
+
+func (s *UserService) GetGroup(ctx context.Context, id int) (*ent.Group, error) {
+	g, err := getGroup(ctx, s.client, id)
+	if err != nil {
+		return nil, fmt.Errorf("#1 GetGroup: %w", err)
+	}
+	return g, nil
+}
+
+// CreateGroup tạo nhóm trong tổ chức của viewer; owner_ids trống thì viewer là owner
+func (s *UserService) CreateGroup(ctx context.Context, input dto.CreateGroupDTO) (*ent.Group, error) {
+	var orgID *int64
+	if scoped, ok := viewer.OrgFromContext(ctx); ok {
+		if input.OrgID != 0 && input.OrgID != scoped {
+			return nil, fmt.Errorf("#1 CreateGroup: %w", ErrGroupForbidden)
+		}
+		orgID = &scoped
+	} else if input.OrgID != 0 {
+		orgID = &input.OrgID
+	}
+
+	ownerIDs := input.OwnerIDs
+	if len(ownerIDs) == 0 {
+		v := viewer.FromContext(ctx)
+		if v == nil {
+			return nil, fmt.Errorf("#2 CreateGroup: %w: owner_ids is required", ErrInvalidGroupMember)
+		}
+		ownerIDs = []int{v.UserID}
+	}
+	slices.Sort(ownerIDs)
+	ownerIDs = slices.Compact(ownerIDs)
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := ensureUsersInScope(ctx, tx.Client(), ownerIDs); err != nil {
+		return nil, fmt.Errorf("#3 CreateGroup: %w", err)
+	}
+	g, err := tx.Group.Create().
+		SetNillableOrgID(orgID).
+		SetName(input.Name).
+		SetDescription(input.Description).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#4 CreateGroup: failed to create group: %w", err)
+	}
+	builders := make([]*ent.GroupMemberCreate, 0, len(ownerIDs))
+	for _, id := range ownerIDs {
+		builders = append(builders, tx.GroupMember.Create().
+			SetGroupID(g.ID).
+			SetUserID(id).
+			SetRole(groupmember.RoleOwner))
+	}
+	if _, err := tx.GroupMember.CreateBulk(builders...).Save(ctx); err != nil {
+		return nil, fmt.Errorf("#5 CreateGroup: failed to add owners: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// UpdateGroup đổi tên, mô tả; cần là owner hoặc admin của nhóm
+func (s *UserService) UpdateGroup(ctx context.Context, id int, input dto.UpdateGroupDTO) (*ent.Group, error) {
+	if _, err := getGroup(ctx, s.client, id); err != nil {
+		return nil, fmt.Errorf("#1 UpdateGroup: %w", err)
+	}
+	ok, err := canManageGroup(ctx, s.client, id, false)
+	if err != nil {
+		return nil, fmt.Errorf("#2 UpdateGroup: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("#3 UpdateGroup: %w", ErrGroupForbidden)
+	}
+
+	update := s.client.Group.UpdateOneID(id)
+	if input.Name != nil {
+		update = update.SetName(*input.Name)
+	}
+	if input.Description != nil {
+		update = update.SetDescription(*input.Description)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#4 UpdateGroup: failed to update group: %w", err)
+	}
+	return s.GetGroup(ctx, id)
+}
+
+// DeleteGroup xóa nhóm cùng thành viên và liên kết nhóm cha/con; cần là owner của nhóm
+func (s *UserService) DeleteGroup(ctx context.Context, id int) error {
+	if _, err := getGroup(ctx, s.client, id); err != nil {
+		return fmt.Errorf("#1 DeleteGroup: %w", err)
+	}
+	ok, err := canManageGroup(ctx, s.client, id, true)
+	if err != nil {
+		return fmt.Errorf("#2 DeleteGroup: %w", err)
+	}
+	if !ok {
+		return fmt.Errorf("#3 DeleteGroup: %w", ErrGroupForbidden)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.GroupMember.Delete().Where(groupmember.GroupID(id)).Exec(ctx); err != nil {
+		return fmt.Errorf("#4 DeleteGroup: failed to delete members: %w", err)
+	}
+	if err := tx.Group.UpdateOneID(id).ClearSubgroups().ClearParentGroups().Exec(ctx); err != nil {
+		return fmt.Errorf("#5 DeleteGroup: failed to detach nested groups: %w", err)
+	}
+	if err := tx.Group.DeleteOneID(id).Exec(ctx); err != nil {
+		return fmt.Errorf("#6 DeleteGroup: failed to delete group: %w", err)
+	}
+	return tx.Commit()
+}
+
+// ListGroupMembers trả về thành viên trực tiếp của nhóm
+func (s *UserService) ListGroupMembers(ctx context.Context, groupID int) ([]*ent.GroupMember, error) {
+	if _, err := getGroup(ctx, s.client, groupID); err != nil {
+		return nil, fmt.Errorf("#1 ListGroupMembers: %w", err)
+	}
+	members, err := s.client.GroupMember.Query().
+		Where(groupmember.GroupID(groupID)).
+		Order(groupmember.ByUserID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#2 ListGroupMembers: failed to query members: %w", err)
+	}
+	return members, nil
+}
+
+// AddGroupMembers thêm thành viên mới (user đã là thành viên thì lỗi trùng).
+// Owner/admin được thêm member, admin; chỉ owner được thêm owner.
+func (s *UserService) AddGroupMembers(ctx context.Context, groupID int, members []dto.GroupMemberDTO) ([]*ent.GroupMember, error) {
+	if _, err := getGroup(ctx, s.client, groupID); err != nil {
+		return nil, fmt.Errorf("#1 AddGroupMembers: %w", err)
+	}
+	ownerOnly := slices.ContainsFunc(members, func(m dto.GroupMemberDTO) bool {
+		return m.Role == groupmember.RoleOwner.String()
+	})
+	ok, err := canManageGroup(ctx, s.client, groupID, ownerOnly)
+	if err != nil {
+		return nil, fmt.Errorf("#2 AddGroupMembers: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("#3 AddGroupMembers: %w", ErrGroupForbidden)
+	}
+
+	userIDs := make([]int, 0, len(members))
+	for _, m := range members {
+		userIDs = append(userIDs, m.UserID)
+	}
+	if err := ensureUsersInScope(ctx, s.client, userIDs); err != nil {
+		return nil, fmt.Errorf("#4 AddGroupMembers: %w", err)
+	}
+
+	builders := make([]*ent.GroupMemberCreate, 0, len(members))
+	for _, m := range members {
+		role := groupmember.RoleMember
+		if m.Role != "" {
+			role = groupmember.Role(m.Role)
+		}
+		builders = append(builders, s.client.GroupMember.Create().
+			SetGroupID(groupID).
+			SetUserID(m.UserID).
+			SetRole(role))
+	}
+	if _, err := s.client.GroupMember.CreateBulk(builders...).Save(ctx); err != nil {
+		return nil, fmt.Errorf("#5 AddGroupMembers: failed to add members: %w", err)
+	}
+	return s.ListGroupMembers(ctx, groupID)
+}
+
+// UpdateGroupMember đổi vai trò; thao tác liên quan tới vai trò owner cần là owner
+func (s *UserService) UpdateGroupMember(ctx context.Context, groupID, userID int, role string) (*ent.GroupMember, error) {
+	if _, err := getGroup(ctx, s.client, groupID); err != nil {
+		return nil, fmt.Errorf("#1 UpdateGroupMember: %w", err)
+	}
+	m, err := s.client.GroupMember.Query().
+		Where(groupmember.GroupID(groupID), groupmember.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#2 UpdateGroupMember: %w", err)
+	}
+	newRole := groupmember.Role(role)
+	ownerOnly := m.Role == groupmember.RoleOwner || newRole == groupmember.RoleOwner
+	ok, err := canManageGroup(ctx, s.client, groupID, ownerOnly)
+	if err != nil {
+		return nil, fmt.Errorf("#3 UpdateGroupMember: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("#4 UpdateGroupMember: %w", ErrGroupForbidden)
+	}
+	if m.Role == groupmember.RoleOwner && newRole != groupmember.RoleOwner {
+		if err := ensureOtherOwner(ctx, s.client, groupID, userID); err != nil {
+			return nil, fmt.Errorf("#5 UpdateGroupMember: %w", err)
+		}
+	}
+
+	m, err = m.Update().SetRole(newRole).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#6 UpdateGroupMember: failed to update member: %w", err)
+	}
+	return m, nil
+}
+
+// RemoveGroupMember gỡ thành viên; user luôn tự rời nhóm được, trừ khi là owner cuối cùng
+func (s *UserService) RemoveGroupMember(ctx context.Context, groupID, userID int) error {
+	if _, err := getGroup(ctx, s.client, groupID); err != nil {
+		return fmt.Errorf("#1 RemoveGroupMember: %w", err)
+	}
+	m, err := s.client.GroupMember.Query().
+		Where(groupmember.GroupID(groupID), groupmember.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("#2 RemoveGroupMember: %w", err)
+	}
+	if v := viewer.FromContext(ctx); v == nil || v.UserID != userID {
+		ok, err := canManageGroup(ctx, s.client, groupID, m.Role == groupmember.RoleOwner)
+		if err != nil {
+			return fmt.Errorf("#3 RemoveGroupMember: %w", err)
+		}
+		if !ok {
+			return fmt.Errorf("#4 RemoveGroupMember: %w", ErrGroupForbidden)
+		}
+	}
+	if m.Role == groupmember.RoleOwner {
+		if err := ensureOtherOwner(ctx, s.client, groupID, userID); err != nil {
+			return fmt.Errorf("#5 RemoveGroupMember: %w", err)
+		}
+	}
+
+	if err := s.client.GroupMember.DeleteOne(m).Exec(ctx); err != nil {
+		return fmt.Errorf("#6 RemoveGroupMember: failed to remove member: %w", err)
+	}
+	return nil
+}
+
+// groupRoleRank: owner > admin > member
+func groupRoleRank(r groupmember.Role) int {
+	switch r {
+	case groupmember.RoleOwner:
+		return 2
+	case groupmember.RoleAdmin:
+		return 1
+	}
+	return 0
+}
+
+func ensureOtherOwner(ctx context.Context, client *ent.Client, groupID, userID int) error {
+	others, err := client.GroupMember.Query().
+		Where(
+			groupmember.GroupID(groupID),
+			groupmember.RoleEQ(groupmember.RoleOwner),
+			groupmember.UserIDNEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !others {
+		return ErrLastGroupOwner
+	}
+	return nil
+}
+
+// Số lần thử lại AddSubgroup khi transaction serializable bị Postgres hủy
+const addSubgroupAttempts = 3
+
+// AddSubgroup lồng subgroupID vào groupID; cần quản lý được cả hai nhóm.
+// Từ chối nếu subgroupID là groupID hoặc groupID đã nằm trong cây con của subgroupID.
+func (s *UserService) AddSubgroup(ctx context.Context, groupID, subgroupID int) (*ent.Group, error) {
+	for attempt := 1; ; attempt++ {
+		err := s.addSubgroup(ctx, groupID, subgroupID)
+		if err == nil {
+			return s.GetGroup(ctx, groupID)
+		}
+		if !isSerializationFailure(err) || attempt == addSubgroupAttempts {
+			return nil, err
+		}
+	}
+}
+
+// addSubgroup kiểm tra chu trình và thêm cạnh trong một transaction serializable.
+// Khóa riêng hai nhóm là không đủ: A→B và C→D chạy đồng thời khi đã có B⇝C, D⇝A cùng qua kiểm tra
+// dù không chạm cùng dòng nào. Postgres hủy một trong hai transaction, lần thử lại thấy cạnh mới và báo chu trình.
+func (s *UserService) addSubgroup(ctx context.Context, groupID, subgroupID int) error {
+	tx, err := s.client.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	parent, err := getGroup(ctx, tx.Client(), groupID)
+	if err != nil {
+		return fmt.Errorf("#1 AddSubgroup: %w", err)
+	}
+	child, err := getGroup(ctx, tx.Client(), subgroupID)
+	if err != nil {
+		return fmt.Errorf("#2 AddSubgroup: %w", err)
+	}
+	if (parent.OrgID == nil) != (child.OrgID == nil) || (parent.OrgID != nil && *parent.OrgID != *child.OrgID) {
+		return fmt.Errorf("#3 AddSubgroup: %w", ErrGroupOrgMismatch)
+	}
+	for _, id := range []int{groupID, subgroupID} {
+		ok, err := canManageGroup(ctx, tx.Client(), id, false)
+		if err != nil {
+			return fmt.Errorf("#4 AddSubgroup: %w", err)
+		}
+		if !ok {
+			return fmt.Errorf("#5 AddSubgroup: %w", ErrGroupForbidden)
+		}
+	}
+
+	if subgroupID == groupID {
+		return fmt.Errorf("#6 AddSubgroup: %w", ErrGroupCycle)
+	}
+	descendants, err := descendantGroupIDs(viewer.Unscoped(ctx), tx.Group, []int{subgroupID})
+	if err != nil {
+		return fmt.Errorf("#7 AddSubgroup: %w", err)
+	}
+	if slices.Contains(descendants, groupID) {
+		return fmt.Errorf("#8 AddSubgroup: %w", ErrGroupCycle)
+	}
+
+	if err := tx.Group.UpdateOneID(groupID).AddSubgroupIDs(subgroupID).Exec(ctx); err != nil {
+		return fmt.Errorf("#9 AddSubgroup: failed to add subgroup: %w", err)
+	}
+	return tx.Commit()
+}
+
+// isSerializationFailure: lỗi 40001 của Postgres, transaction có thể chạy lại
+func isSerializationFailure(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "40001"
+}
+
+// RemoveSubgroup bỏ liên kết nhóm con trực tiếp; cần là owner hoặc admin của nhóm cha
+func (s *UserService) RemoveSubgroup(ctx context.Context, groupID, subgroupID int) (*ent.Group, error) {
+	if _, err := getGroup(ctx, s.client, groupID); err != nil {
+		return nil, fmt.Errorf("#1 RemoveSubgroup: %w", err)
+	}
+	ok, err := canManageGroup(ctx, s.client, groupID, false)
+	if err != nil {
+		return nil, fmt.Errorf("#2 RemoveSubgroup: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("#3 RemoveSubgroup: %w", ErrGroupForbidden)
+	}
+	_, err = s.client.Group.Query().
+		Where(group.ID(subgroupID), group.HasParentGroupsWith(group.ID(groupID))).
+		OnlyID(viewer.Unscoped(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("#4 RemoveSubgroup: %w", err)
+	}
+
+	if err := s.client.Group.UpdateOneID(groupID).RemoveSubgroupIDs(subgroupID).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#5 RemoveSubgroup: failed to remove subgroup: %w", err)
+	}
+	return s.GetGroup(ctx, groupID)
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/group"
+	"github.com/huynhthanhthao/hrm_user_service/ent/groupmember"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+)
+
+// UserGroup là một nhóm mà user thuộc về: trực tiếp (Direct, có Role) hoặc qua nhóm con
+type UserGroup struct {
+	Group  *ent.Group
+	Direct bool
+	Role   string
+}
+
+// ExpandedGroupMember là một user thuộc nhóm sau khi mở rộng mọi nhóm con.
+// ViaGroupIDs là các nhóm con mà user là thành viên trực tiếp.
+type ExpandedGroupMember struct {
+	UserID      int
+	Direct      bool
+	Role        string
+	ViaGroupIDs []int
+}
+
+// walkGroups duyệt đồ thị nhóm theo từng cấp bắt đầu từ roots (không gồm roots),
+// mỗi cấp một truy vấn; step dựng điều kiện chọn các nhóm kề với frontier
+func walkGroups(ctx context.Context, client *ent.GroupClient, roots []int, step func(...int) predicate.Group) ([]int, error) {
+	visited := make(map[int]bool, len(roots))
+	for _, id := range roots {
+		visited[id] = true
+	}
+	var result []int
+	frontier := roots
+	for len(frontier) > 0 {
+		next, err := client.Query().Where(step(frontier...)).IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		frontier = nil
+		for _, id := range next {
+			if visited[id] {
+				continue
+			}
+			visited[id] = true
+			result = append(result, id)
+			frontier = append(frontier, id)
+		}
+	}
+	return result, nil
+}
+
+// descendantGroupIDs trả về mọi nhóm con (các cấp) của roots
+func descendantGroupIDs(ctx context.Context, client *ent.GroupClient, roots []int) ([]int, error) {
+	return walkGroups(ctx, client, roots, func(ids ...int) predicate.Group {
+		return group.HasParentGroupsWith(group.IDIn(ids...))
+	})
+}
+
+// ancestorGroupIDs trả về mọi nhóm cha (các cấp) của roots
+func ancestorGroupIDs(ctx context.Context, client *ent.GroupClient, roots []int) ([]int, error) {
+	return walkGroups(ctx, client, roots, func(ids ...int) predicate.Group {
+		return group.HasSubgroupsWith(group.IDIn(ids...))
+	})
+}
+
+// ListUserGroups trả về các nhóm user là thành viên trực tiếp và các nhóm cha của chúng
+func (s *UserService) ListUserGroups(ctx context.Context, userID int) ([]UserGroup, error) {
+	if _, err := s.client.User.Query().Where(user.ID(userID)).OnlyID(ctx); err != nil {
+		return nil, fmt.Errorf("#1 ListUserGroups: %w", err)
+	}
+
+	memberships, err := s.client.GroupMember.Query().
+		Where(groupmember.UserID(userID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#2 ListUserGroups: failed to query memberships: %w", err)
+	}
+	roles := make(map[int]string, len(memberships))
+	directIDs := make([]int, 0, len(memberships))
+	for _, m := range memberships {
+		roles[m.GroupID] = m.Role.String()
+		directIDs = append(directIDs, m.GroupID)
+	}
+	ancestorIDs, err := ancestorGroupIDs(ctx, s.client.Group, directIDs)
+	if err != nil {
+		return nil, fmt.Errorf("#3 ListUserGroups: failed to resolve parent groups: %w", err)
+	}
+
+	groups, err := s.client.Group.Query().
+		Where(group.IDIn(append(directIDs, ancestorIDs...)...)).
+		Order(group.ByName(), group.ByID()).
+		WithSubgroups(func(q *ent.GroupQuery) {
+			q.Select(group.FieldID)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#4 ListUserGroups: failed to query groups: %w", err)
+	}
+	result := make([]UserGroup, 0, len(groups))
+	for _, g := range groups {
+		role, direct := roles[g.ID]
+		result = append(result, UserGroup{Group: g, Direct: direct, Role: role})
+	}
+	return result, nil
+}
+
+// ExpandGroupMembers trả về thành viên của nhóm và mọi nhóm con, mỗi user một lần.
+// User đã xóa hoặc ngoài phạm vi viewer bị loại.
+func (s *UserService) ExpandGroupMembers(ctx context.Context, groupID int) ([]ExpandedGroupMember, error) {
+	if _, err := getGroup(ctx, s.client, groupID); err != nil {
+		return nil, fmt.Errorf("#1 ExpandGroupMembers: %w", err)
+	}
+	descendants, err := descendantGroupIDs(ctx, s.client.Group, []int{groupID})
+	if err != nil {
+		return nil, fmt.Errorf("#2 ExpandGroupMembers: failed to resolve subgroups: %w", err)
+	}
+	members, err := s.client.GroupMember.Query().
+		Where(groupmember.GroupIDIn(append(descendants, groupID)...)).
+		Order(groupmember.ByUserID(), groupmember.ByGroupID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#3 ExpandGroupMembers: failed to query members: %w", err)
+	}
+
+	byUser := make(map[int]*ExpandedGroupMember)
+	var userIDs []int
+	for _, m := range members {
+		e, ok := byUser[m.UserID]
+		if !ok {
+			e = &ExpandedGroupMember{UserID: m.UserID}
+			byUser[m.UserID] = e
+			userIDs = append(userIDs, m.UserID)
+		}
+		if m.GroupID == groupID {
+			e.Direct = true
+			e.Role = m.Role.String()
+		} else {
+			e.ViaGroupIDs = append(e.ViaGroupIDs, m.GroupID)
+		}
+	}
+	if len(userIDs) == 0 {
+		return []ExpandedGroupMember{}, nil
+	}
+
+	visible, err := s.client.User.Query().Where(user.IDIn(userIDs...)).IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#4 ExpandGroupMembers: failed to query users: %w", err)
+	}
+	slices.Sort(visible)
+	result := make([]ExpandedGroupMember, 0, len(visible))
+	for _, id := range visible {
+		result = append(result, *byUser[id])
+	}
+	return result, nil
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent/enttest"
+)
+
+func TestAddSubgroupCycle(t *testing.T) {
+	// Cây ban đầu: a → b → c, d đứng riêng
+	edges := [][2]string{{"a", "b"}, {"b", "c"}}
+	tests := []struct {
+		name          string
+		parent, child string
+		wantErr       error
+	}{
+		{name: "tự lồng vào chính nó", parent: "a", child: "a", wantErr: ErrGroupCycle},
+		{name: "chu trình trực tiếp", parent: "b", child: "a", wantErr: ErrGroupCycle},
+		{name: "chu trình gián tiếp", parent: "c", child: "a", wantErr: ErrGroupCycle},
+		{name: "lồng nhóm riêng lẻ", parent: "c", child: "d"},
+		{name: "nhóm con đã có cha khác", parent: "d", child: "c"},
+		{name: "lối tắt không tạo chu trình", parent: "a", child: "c"},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := enttest.Open(t, dialect.SQLite, fmt.Sprintf("file:groups%d?mode=memory&cache=shared&_fk=1", i))
+			defer client.Close()
+
+			ids := make(map[string]int)
+			for _, name := range []string{"a", "b", "c", "d"} {
+				ids[name] = client.Group.Create().SetName(name).SaveX(ctx).ID
+			}
+			for _, e := range edges {
+				client.Group.UpdateOneID(ids[e[0]]).AddSubgroupIDs(ids[e[1]]).ExecX(ctx)
+			}
+
+			s, err := NewUserService(client, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			g, err := s.AddSubgroup(ctx, ids[tt.parent], ids[tt.child])
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("AddSubgroup(%s, %s) error = %v, want %v", tt.parent, tt.child, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AddSubgroup(%s, %s): %v", tt.parent, tt.child, err)
+			}
+			subIDs := make([]int, 0, len(g.Edges.Subgroups))
+			for _, sg := range g.Edges.Subgroups {
+				subIDs = append(subIDs, sg.ID)
+			}
+			if !slices.Contains(subIDs, ids[tt.child]) {
+				t.Errorf("subgroups of %s = %v, want %d included", tt.parent, subIDs, ids[tt.child])
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Lý do đăng nhập thất bại được ghi vào lịch sử đăng nhập
+const (
+	LoginFailureInactive      = "account_inactive"
+	LoginFailureWrongPassword = "wrong_password"
+)
+
+// loginAttempt là một lần đăng nhập cần ghi vào lịch sử
+type loginAttempt struct {
+	userID        int
+	success       bool
+	failureReason string
+	orgID         *int64
+	expiresAt     *time.Time
+}
+
+// recordLoginEvent ghi lịch sử đăng nhập kèm IP và user agent của request.
+// Lỗi ghi lịch sử không được chặn đăng nhập nên chỉ trả về để bên gọi bỏ qua.
+func (s *AuthService) recordLoginEvent(ctx context.Context, c *gin.Context, a loginAttempt) error {
+	create := s.client.LoginEvent.Create().
+		SetUserID(a.userID).
+		SetSuccess(a.success).
+		SetNillableOrgID(a.orgID).
+		SetNillableExpiresAt(a.expiresAt)
+	if a.failureReason != "" {
+		create = create.SetFailureReason(a.failureReason)
+	}
+	if ip := c.ClientIP(); ip != "" {
+		create = create.SetIP(ip)
+	}
+	if ua := c.Request.UserAgent(); ua != "" {
+		create = create.SetUserAgent(ua)
+	}
+	return create.Exec(ctx)
+}
+
+// recordLoginFailure ghi lần đăng nhập thất bại của account đã xác định được user
+func (s *AuthService) recordLoginFailure(ctx context.Context, c *gin.Context, acc *ent.Account, reason string) {
+	userID, err := acc.QueryUser().OnlyID(ctx)
+	if err != nil {
+		return
+	}
+	_ = s.recordLoginEvent(ctx, c, loginAttempt{userID: userID, failureReason: reason})
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent/schema"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+
+	hrPb "github.com/longgggwwww/hrm-ms-hr/ent/proto/entpb"
+)
+
+// BackfillMemberships tạo membership mặc định theo tổ chức bên HR service cho các user chưa có membership
+// (tạo trước khi có membership). Thiếu membership thì admin theo tổ chức không thấy được user.
+// User không có hồ sơ nhân viên bên HR được bỏ qua; user gọi HR lỗi được ghi vào báo cáo để chạy lại.
+func (s *UserService) BackfillMemberships(ctx context.Context) (*BackfillReport, error) {
+	if s.hrClients == nil || s.hrClients.HrExt == nil {
+		return nil, errors.New("#1 BackfillMemberships: HR client is not initialized")
+	}
+
+	report := &BackfillReport{}
+	lastID := 0
+	for {
+		ids, err := s.client.User.Query().
+			Where(user.IDGT(lastID), user.Not(user.HasMemberships())).
+			Order(user.ByID()).
+			Limit(backfillBatchSize).
+			IDs(schema.SkipSoftDelete(ctx))
+		if err != nil {
+			return report, fmt.Errorf("#2 BackfillMemberships: failed to query users: %w", err)
+		}
+		if len(ids) == 0 {
+			return report, nil
+		}
+		lastID = ids[len(ids)-1]
+
+		for _, id := range ids {
+			employee, err := s.hrClients.HrExt.GetEmployeeByUserId(ctx, &hrPb.GetEmployeeByUserIdRequest{
+				UserId: strconv.Itoa(id),
+			})
+			if status.Code(err) == codes.NotFound || (err == nil && (employee == nil || employee.OrgId == 0)) {
+				continue
+			}
+			if err == nil {
+				err = s.client.Membership.Create().
+					SetUserID(id).
+					SetOrgID(employee.OrgId).
+					SetIsDefault(true).
+					Exec(ctx)
+			}
+			if err != nil {
+				report.Failed = append(report.Failed, FailedRow{Table: user.Table, ID: id, Err: err})
+				continue
+			}
+			report.Updated++
+		}
+	}
+}
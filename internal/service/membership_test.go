@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent/membership"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+
+	hrPb "github.com/longgggwwww/hrm-ms-hr/ent/proto/entpb"
+)
+
+// fakeHRExt trả về tổ chức theo user id; user không có trong orgs coi như không có hồ sơ nhân viên
+type fakeHRExt struct {
+	hrPb.ExtServiceClient
+	orgs   map[string]int64
+	failed map[string]bool
+}
+
+func (f fakeHRExt) GetEmployeeByUserId(_ context.Context, in *hrPb.GetEmployeeByUserIdRequest, _ ...grpc.CallOption) (*hrPb.Employee, error) {
+	if f.failed[in.UserId] {
+		return nil, status.Error(codes.Unavailable, "hr service unavailable")
+	}
+	org, ok := f.orgs[in.UserId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "employee not found")
+	}
+	return &hrPb.Employee{Id: 1, OrgId: org}, nil
+}
+
+func TestBackfillMemberships(t *testing.T) {
+	ctx := context.Background()
+	s, client := newTestService(t, "backfill_memberships")
+
+	newUser := func(phone string) int {
+		return client.User.Create().SetFirstName("U").SetLastName("U").SetPhone(phone).SaveX(ctx).ID
+	}
+	withOrg, noEmployee, member, hrDown := newUser("+84900000001"), newUser("+84900000002"), newUser("+84900000003"), newUser("+84900000004")
+	client.Membership.Create().SetUserID(member).SetOrgID(7).SetIsDefault(true).ExecX(ctx)
+
+	s.hrClients = &HRServiceClients{HrExt: fakeHRExt{
+		orgs:   map[string]int64{strconv.Itoa(withOrg): 5, strconv.Itoa(member): 9},
+		failed: map[string]bool{strconv.Itoa(hrDown): true},
+	}}
+
+	report, err := s.BackfillMemberships(ctx)
+	if err != nil {
+		t.Fatalf("BackfillMemberships: %v", err)
+	}
+	if report.Updated != 1 {
+		t.Errorf("Updated = %d, want 1", report.Updated)
+	}
+	if len(report.Failed) != 1 || report.Failed[0].ID != hrDown {
+		t.Errorf("Failed = %v, want user %d", report.Failed, hrDown)
+	}
+
+	tests := []struct {
+		name   string
+		userID int
+		want   []int64
+	}{
+		{name: "có tổ chức bên HR", userID: withOrg, want: []int64{5}},
+		{name: "không có hồ sơ nhân viên", userID: noEmployee},
+		{name: "đã có membership", userID: member, want: []int64{7}},
+		{name: "HR lỗi", userID: hrDown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms := client.Membership.Query().Where(membership.HasUserWith(user.ID(tt.userID))).AllX(ctx)
+			if len(ms) != len(tt.want) {
+				t.Fatalf("memberships = %v, want orgs %v", ms, tt.want)
+			}
+			for i, m := range ms {
+				if m.OrgID != tt.want[i] || !m.IsDefault {
+					t.Errorf("membership %d = org %d default %v, want org %d default", i, m.OrgID, m.IsDefault, tt.want[i])
+				}
+			}
+		})
+	}
+
+	// Chạy lại khi HR đã hoạt động: chỉ user còn thiếu được xử lý
+	s.hrClients = &HRServiceClients{HrExt: fakeHRExt{orgs: map[string]int64{strconv.Itoa(hrDown): 3}}}
+	report, err = s.BackfillMemberships(ctx)
+	if err != nil || report.Updated != 1 || len(report.Failed) != 0 {
+		t.Errorf("rerun = %+v, %v; want 1 updated", report, err)
+	}
+
+	s.hrClients = nil
+	if _, err := s.BackfillMemberships(ctx); err == nil {
+		t.Errorf("BackfillMemberships without HR client error = %v, want error", err)
+	}
+}
@@ -0,0 +1,484 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/attributedefinition"
+	"github.com/huynhthanhthao/hrm_user_service/ent/membership"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+	"github.com/huynhthanhthao/hrm_user_service/ent/schema"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+)
+
+var (
+	ErrAttributeForbidden         = errors.New("missing permission to manage custom attributes")
+	ErrInvalidAttributeDefinition = errors.New("invalid attribute definition")
+	ErrInvalidCustomAttributes    = errors.New("invalid custom attributes")
+	ErrInvalidAttributeFilter     = errors.New("invalid attribute filter")
+)
+
+// Định dạng giá trị của thuộc tính kiểu date
+const attributeDateLayout = "2006-01-02"
+
+// CanManageAttributes: lời gọi nội bộ hoặc viewer có quyền user.attribute.manage trong tổ chức orgID
+func CanManageAttributes(ctx context.Context, orgID int64) bool {
+	v := viewer.FromContext(ctx)
+	if v == nil {
+		return true
+	}
+	if scoped, ok := viewer.OrgFromContext(ctx); ok && scoped != orgID {
+		return false
+	}
+	return v.HasPerm(viewer.PermUserAttributeManage)
+}
+
+// attributeOrgID trả về tổ chức của thao tác: viewer đang chọn tổ chức chỉ làm việc với tổ chức đó
+func attributeOrgID(ctx context.Context, orgID int64) (int64, error) {
+	if scoped, ok := viewer.OrgFromContext(ctx); ok {
+		if orgID != 0 && orgID != scoped {
+			return 0, ErrAttributeForbidden
+		}
+		return scoped, nil
+	}
+	if orgID <= 0 {
+		return 0, errors.New("org_id is required")
+	}
+	return orgID, nil
+}
+
+func (s *UserService) ListAttributeDefinitions(ctx context.Context, orgID int64) ([]*ent.AttributeDefinition, error) {
+	orgID, err := attributeOrgID(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("#1 ListAttributeDefinitions: %w", err)
+	}
+	defs, err := s.client.AttributeDefinition.Query().
+		Where(attributedefinition.OrgID(orgID)).
+		Order(attributedefinition.ByKey()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#2 ListAttributeDefinitions: failed to query definitions: %w", err)
+	}
+	return defs, nil
+}
+
+func (s *UserService) CreateAttributeDefinition(ctx context.Context, input dto.CreateAttributeDefinitionDTO) (*ent.AttributeDefinition, error) {
+	orgID, err := attributeOrgID(ctx, input.OrgID)
+	if err != nil {
+		return nil, fmt.Errorf("#1 CreateAttributeDefinition: %w", err)
+	}
+	if !CanManageAttributes(ctx, orgID) {
+		return nil, fmt.Errorf("#2 CreateAttributeDefinition: %w", ErrAttributeForbidden)
+	}
+	if err := validateAttributeDefinition(input.UpdateAttributeDefinitionDTO); err != nil {
+		return nil, fmt.Errorf("#3 CreateAttributeDefinition: %w", err)
+	}
+
+	def, err := s.client.AttributeDefinition.Create().
+		SetOrgID(orgID).
+		SetKey(input.Key).
+		SetLabel(input.Label).
+		SetType(attributedefinition.Type(input.Type)).
+		SetRequired(input.Required).
+		SetEnumValues(input.EnumValues).
+		SetNillablePattern(input.Pattern).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#4 CreateAttributeDefinition: failed to create definition: %w", err)
+	}
+	return def, nil
+}
+
+// UpdateAttributeDefinition thay toàn bộ cấu hình của định nghĩa (trừ org_id, key).
+// Giá trị đã lưu không bị kiểm tra lại, chỉ áp dụng cho các lần ghi sau.
+func (s *UserService) UpdateAttributeDefinition(ctx context.Context, id int, input dto.UpdateAttributeDefinitionDTO) (*ent.AttributeDefinition, error) {
+	def, err := s.getAttributeDefinition(ctx, s.client, id)
+	if err != nil {
+		return nil, fmt.Errorf("#1 UpdateAttributeDefinition: %w", err)
+	}
+	if !CanManageAttributes(ctx, def.OrgID) {
+		return nil, fmt.Errorf("#2 UpdateAttributeDefinition: %w", ErrAttributeForbidden)
+	}
+	if err := validateAttributeDefinition(input); err != nil {
+		return nil, fmt.Errorf("#3 UpdateAttributeDefinition: %w", err)
+	}
+
+	update := def.Update().
+		SetLabel(input.Label).
+		SetType(attributedefinition.Type(input.Type)).
+		SetRequired(input.Required)
+	if len(input.EnumValues) > 0 {
+		update = update.SetEnumValues(input.EnumValues)
+	} else {
+		update = update.ClearEnumValues()
+	}
+	if input.Pattern != nil {
+		update = update.SetPattern(*input.Pattern)
+	} else {
+		update = update.ClearPattern()
+	}
+	def, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#4 UpdateAttributeDefinition: failed to update definition: %w", err)
+	}
+	return def, nil
+}
+
+// DeleteAttributeDefinition xóa định nghĩa và giá trị của thuộc tính đó khỏi mọi user (kể cả user đã xóa mềm).
+// Trả về số user bị ảnh hưởng.
+func (s *UserService) DeleteAttributeDefinition(ctx context.Context, id int) (int, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	def, err := s.getAttributeDefinition(ctx, tx.Client(), id)
+	if err != nil {
+		return 0, fmt.Errorf("#1 DeleteAttributeDefinition: %w", err)
+	}
+	if !CanManageAttributes(ctx, def.OrgID) {
+		return 0, fmt.Errorf("#2 DeleteAttributeDefinition: %w", ErrAttributeForbidden)
+	}
+	if err := tx.AttributeDefinition.DeleteOne(def).Exec(ctx); err != nil {
+		return 0, fmt.Errorf("#3 DeleteAttributeDefinition: failed to delete definition: %w", err)
+	}
+
+	org := strconv.FormatInt(def.OrgID, 10)
+	allCtx := viewer.Unscoped(schema.SkipSoftDelete(ctx))
+	users, err := tx.User.Query().
+		Where(customAttributePredicate(sqljson.HasKey(user.FieldCustomAttributes, sqljson.Path(org, def.Key)))).
+		All(allCtx)
+	if err != nil {
+		return 0, fmt.Errorf("#4 DeleteAttributeDefinition: failed to query users: %w", err)
+	}
+	for _, u := range users {
+		attrs := maps.Clone(u.CustomAttributes)
+		attrs[org] = maps.Clone(attrs[org])
+		delete(attrs[org], def.Key)
+		if len(attrs[org]) == 0 {
+			delete(attrs, org)
+		}
+		if err := setCustomAttributes(tx.User.UpdateOneID(u.ID), attrs).Exec(allCtx); err != nil {
+			return 0, fmt.Errorf("#5 DeleteAttributeDefinition: failed to update user %d: %w", u.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(users), nil
+}
+
+// getAttributeDefinition lấy định nghĩa theo id, viewer đang chọn tổ chức không thấy định nghĩa của tổ chức khác
+func (s *UserService) getAttributeDefinition(ctx context.Context, client *ent.Client, id int) (*ent.AttributeDefinition, error) {
+	query := client.AttributeDefinition.Query().Where(attributedefinition.ID(id))
+	if orgID, ok := viewer.OrgFromContext(ctx); ok {
+		query = query.Where(attributedefinition.OrgID(orgID))
+	}
+	return query.Only(ctx)
+}
+
+func validateAttributeDefinition(input dto.UpdateAttributeDefinitionDTO) error {
+	if input.Type != attributedefinition.TypeString.String() && (len(input.EnumValues) > 0 || input.Pattern != nil) {
+		return fmt.Errorf("%w: enum_values and pattern are only allowed for type string", ErrInvalidAttributeDefinition)
+	}
+	if input.Pattern != nil {
+		if _, err := regexp.Compile(*input.Pattern); err != nil {
+			return fmt.Errorf("%w: invalid pattern: %v", ErrInvalidAttributeDefinition, err)
+		}
+	}
+	return nil
+}
+
+func setCustomAttributes(update *ent.UserUpdateOne, attrs map[string]map[string]any) *ent.UserUpdateOne {
+	if len(attrs) == 0 {
+		return update.ClearCustomAttributes()
+	}
+	return update.SetCustomAttributes(attrs)
+}
+
+// customAttributesFromProto chuyển Struct {"<org_id>": {"key": value}} sang dạng lưu trữ; giá trị null bị bỏ qua
+func customAttributesFromProto(in *structpb.Struct) (map[string]map[string]any, error) {
+	attrs := make(map[string]map[string]any, len(in.GetFields()))
+	for org, v := range in.GetFields() {
+		if id, err := strconv.ParseInt(org, 10, 64); err != nil || id <= 0 {
+			return nil, fmt.Errorf("%w: %q is not an organization id", ErrInvalidCustomAttributes, org)
+		}
+		if _, isNull := v.GetKind().(*structpb.Value_NullValue); isNull {
+			continue
+		}
+		obj := v.GetStructValue()
+		if obj == nil {
+			return nil, fmt.Errorf("%w: attributes of organization %s must be an object", ErrInvalidCustomAttributes, org)
+		}
+		values := make(map[string]any, len(obj.Fields))
+		for key, val := range obj.Fields {
+			if _, isNull := val.GetKind().(*structpb.Value_NullValue); isNull {
+				continue
+			}
+			values[key] = val.AsInterface()
+		}
+		if len(values) > 0 {
+			attrs[org] = values
+		}
+	}
+	return attrs, nil
+}
+
+// validateCustomAttributes kiểm tra attrs theo định nghĩa của các tổ chức orgIDs:
+// chỉ chứa tổ chức trong orgIDs và key đã định nghĩa, đúng kiểu/enum/regex, đủ các thuộc tính bắt buộc
+func validateCustomAttributes(ctx context.Context, client *ent.AttributeDefinitionClient, attrs map[string]map[string]any, orgIDs []int64) error {
+	defs, err := client.Query().Where(attributedefinition.OrgIDIn(orgIDs...)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load attribute definitions: %w", err)
+	}
+	byOrg := make(map[string]map[string]*ent.AttributeDefinition, len(orgIDs))
+	for _, orgID := range orgIDs {
+		byOrg[strconv.FormatInt(orgID, 10)] = map[string]*ent.AttributeDefinition{}
+	}
+	for _, d := range defs {
+		byOrg[strconv.FormatInt(d.OrgID, 10)][d.Key] = d
+	}
+
+	for org, values := range attrs {
+		orgDefs, ok := byOrg[org]
+		if !ok {
+			return fmt.Errorf("%w: user is not a member of organization %s", ErrInvalidCustomAttributes, org)
+		}
+		for key, value := range values {
+			def, ok := orgDefs[key]
+			if !ok {
+				return fmt.Errorf("%w: attribute %q is not defined in organization %s", ErrInvalidCustomAttributes, key, org)
+			}
+			if err := validateAttributeValue(def, value); err != nil {
+				return fmt.Errorf("%w: %s.%s %v", ErrInvalidCustomAttributes, org, key, err)
+			}
+		}
+	}
+	for org, orgDefs := range byOrg {
+		for key, def := range orgDefs {
+			if _, ok := attrs[org][key]; def.Required && !ok {
+				return fmt.Errorf("%w: %s.%s is required", ErrInvalidCustomAttributes, org, key)
+			}
+		}
+	}
+	return nil
+}
+
+func validateAttributeValue(def *ent.AttributeDefinition, value any) error {
+	switch def.Type {
+	case attributedefinition.TypeNumber:
+		if _, ok := value.(float64); !ok {
+			return errors.New("must be a number")
+		}
+	case attributedefinition.TypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return errors.New("must be a boolean")
+		}
+	case attributedefinition.TypeDate:
+		s, ok := value.(string)
+		if !ok {
+			return errors.New("must be a date (YYYY-MM-DD)")
+		}
+		if _, err := time.Parse(attributeDateLayout, s); err != nil {
+			return errors.New("must be a date (YYYY-MM-DD)")
+		}
+	default:
+		s, ok := value.(string)
+		if !ok {
+			return errors.New("must be a string")
+		}
+		if len(def.EnumValues) > 0 && !slices.Contains(def.EnumValues, s) {
+			return fmt.Errorf("must be one of %v", def.EnumValues)
+		}
+		if def.Pattern != nil {
+			re, err := regexp.Compile(`^(?:` + *def.Pattern + `)$`)
+			if err != nil || !re.MatchString(s) {
+				return fmt.Errorf("must match %s", *def.Pattern)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveCustomAttributes trả về custom_attributes mới của user khi cập nhật.
+// Thuộc tính của tổ chức user không còn thuộc về bị bỏ; viewer đang chọn tổ chức chỉ thay thuộc tính của tổ chức đó.
+func resolveCustomAttributes(ctx context.Context, tx *ent.Tx, userID int, input *userPb.UpdateUserRequest, fields map[string]bool) (map[string]map[string]any, error) {
+	// Đọc đủ thuộc tính của mọi tổ chức để không làm mất dữ liệu của tổ chức khác
+	current, err := tx.User.Query().Where(user.ID(userID)).Only(viewer.Unscoped(ctx))
+	if err != nil {
+		return nil, err
+	}
+	orgIDs := input.OrgIds
+	if !fields["org_ids"] {
+		memberships, err := tx.Membership.Query().Where(membership.HasUserWith(user.ID(userID))).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		orgIDs = make([]int64, 0, len(memberships))
+		for _, m := range memberships {
+			orgIDs = append(orgIDs, m.OrgID)
+		}
+	}
+
+	attrs := make(map[string]map[string]any, len(current.CustomAttributes))
+	for _, orgID := range orgIDs {
+		org := strconv.FormatInt(orgID, 10)
+		if values, ok := current.CustomAttributes[org]; ok {
+			attrs[org] = values
+		}
+	}
+	paths := customAttributePaths(fields)
+	if !fields["custom_attributes"] && len(paths) == 0 {
+		return attrs, nil
+	}
+
+	in, err := customAttributesFromProto(input.CustomAttributes)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) > 0 {
+		return patchCustomAttributes(ctx, tx.AttributeDefinition, attrs, in, paths, orgIDs)
+	}
+	scopedOrg, scoped := viewer.OrgFromContext(ctx)
+	if !scoped {
+		return in, validateCustomAttributes(ctx, tx.AttributeDefinition, in, orgIDs)
+	}
+
+	org := strconv.FormatInt(scopedOrg, 10)
+	for key := range in {
+		if key != org {
+			return nil, fmt.Errorf("%w: cannot set attributes of organization %s", ErrInvalidCustomAttributes, key)
+		}
+	}
+	delete(attrs, org)
+	validateOrgs := []int64{}
+	if slices.Contains(orgIDs, scopedOrg) {
+		validateOrgs = append(validateOrgs, scopedOrg)
+	}
+	if err := validateCustomAttributes(ctx, tx.AttributeDefinition, in, validateOrgs); err != nil {
+		return nil, err
+	}
+	if values, ok := in[org]; ok {
+		attrs[org] = values
+	}
+	return attrs, nil
+}
+
+// patchCustomAttributes chỉ ghi các phần của attrs nêu trong paths ("custom_attributes.<org_id>[.<key>]"):
+// request có giá trị thì đặt, không có (hoặc null) thì xóa. Chỉ kiểm tra lại thuộc tính của các tổ chức bị ghi.
+func patchCustomAttributes(ctx context.Context, client *ent.AttributeDefinitionClient, attrs, in map[string]map[string]any, paths []string, orgIDs []int64) (map[string]map[string]any, error) {
+	scopedOrg, scoped := viewer.OrgFromContext(ctx)
+	touched := make(map[string]bool)
+	for _, path := range paths {
+		org, key, hasKey := strings.Cut(strings.TrimPrefix(path, customAttributesPathPrefix), ".")
+		if scoped && org != strconv.FormatInt(scopedOrg, 10) {
+			return nil, fmt.Errorf("%w: cannot set attributes of organization %s", ErrInvalidCustomAttributes, org)
+		}
+		touched[org] = true
+		if !hasKey {
+			if values, ok := in[org]; ok {
+				attrs[org] = values
+			} else {
+				delete(attrs, org)
+			}
+			continue
+		}
+		values := maps.Clone(attrs[org])
+		if values == nil {
+			values = make(map[string]any)
+		}
+		if value, ok := in[org][key]; ok {
+			values[key] = value
+		} else {
+			delete(values, key)
+		}
+		if len(values) == 0 {
+			delete(attrs, org)
+		} else {
+			attrs[org] = values
+		}
+	}
+
+	check := make(map[string]map[string]any, len(touched))
+	validateOrgs := []int64{}
+	for _, orgID := range orgIDs {
+		if org := strconv.FormatInt(orgID, 10); touched[org] {
+			validateOrgs = append(validateOrgs, orgID)
+		}
+	}
+	for org := range touched {
+		if values, ok := attrs[org]; ok {
+			check[org] = values
+		}
+	}
+	if err := validateCustomAttributes(ctx, client, check, validateOrgs); err != nil {
+		return nil, err
+	}
+	return attrs, nil
+}
+
+func customAttributePredicate(p *sql.Predicate) predicate.User {
+	return func(s *sql.Selector) {
+		s.Where(p)
+	}
+}
+
+// attributePredicates lọc user theo thuộc tính tùy chỉnh, giá trị được chuyển theo kiểu của định nghĩa
+func attributePredicates(ctx context.Context, client *ent.AttributeDefinitionClient, f dto.UserFilter) ([]predicate.User, error) {
+	if len(f.Attributes) == 0 {
+		return nil, nil
+	}
+	orgID, err := attributeOrgID(ctx, f.AttributeOrgID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAttributeFilter, err)
+	}
+	defs, err := client.Query().
+		Where(attributedefinition.OrgID(orgID), attributedefinition.KeyIn(slices.Collect(maps.Keys(f.Attributes))...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load attribute definitions: %w", err)
+	}
+	byKey := make(map[string]*ent.AttributeDefinition, len(defs))
+	for _, d := range defs {
+		byKey[d.Key] = d
+	}
+
+	org := strconv.FormatInt(orgID, 10)
+	preds := make([]predicate.User, 0, len(f.Attributes))
+	for key, raw := range f.Attributes {
+		def, ok := byKey[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: attribute %q is not defined", ErrInvalidAttributeFilter, key)
+		}
+		var value any = raw
+		switch def.Type {
+		case attributedefinition.TypeNumber:
+			if value, err = strconv.ParseFloat(raw, 64); err != nil {
+				return nil, fmt.Errorf("%w: %s must be a number", ErrInvalidAttributeFilter, key)
+			}
+		case attributedefinition.TypeBoolean:
+			if value, err = strconv.ParseBool(raw); err != nil {
+				return nil, fmt.Errorf("%w: %s must be a boolean", ErrInvalidAttributeFilter, key)
+			}
+		}
+		preds = append(preds, customAttributePredicate(sqljson.ValueEQ(user.FieldCustomAttributes, value, sqljson.Path(org, key))))
+	}
+	return preds, nil
+}
@@ -0,0 +1,404 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	permPb "github.com/longgggwwww/hrm-ms-permission/ent/proto/entpb"
+)
+
+// MaxBatchItems là số phần tử tối đa của một lời gọi batch
+const MaxBatchItems = 100
+
+var (
+	ErrBatchTooLarge = fmt.Errorf("batch must contain at most %d items", MaxBatchItems)
+	ErrBatchEmpty    = errors.New("batch must contain at least one item")
+	// Phần tử hợp lệ nhưng không được áp dụng vì phần tử khác trong batch all-or-nothing bị lỗi
+	ErrBatchAborted = errors.New("not applied: another item in the batch failed")
+	// Phần tử đã được lưu nhưng quyền/vai trò chưa được đồng bộ sang permission service
+	ErrAccessNotSynced = errors.New("saved, but permissions/roles were not synced")
+)
+
+// BatchItemResult là kết quả của một phần tử trong batch, theo đúng thứ tự request
+type BatchItemResult struct {
+	Index int
+	ID    int
+	User  *ent.User
+	Err   error
+}
+
+func checkBatchSize(n int) error {
+	if n == 0 {
+		return ErrBatchEmpty
+	}
+	if n > MaxBatchItems {
+		return ErrBatchTooLarge
+	}
+	return nil
+}
+
+// abortBatch đánh dấu mọi phần tử chưa có lỗi là ErrBatchAborted
+func abortBatch(results []BatchItemResult) {
+	for i := range results {
+		results[i].User = nil
+		if results[i].Err == nil {
+			results[i].Err = ErrBatchAborted
+		}
+	}
+}
+
+// createDraft chuyển CreateUserRequest sang userDraft
+func createDraft(ctx context.Context, index int, in *userPb.CreateUserRequest) *userDraft {
+	d := &userDraft{
+		ref:       fmt.Sprintf("item %d", index),
+		firstName: in.FirstName,
+		lastName:  in.LastName,
+		gender:    in.Gender,
+		phone:     in.Phone,
+	}
+	if in.Email != nil {
+		d.email = &in.Email.Value
+	}
+	if in.WardCode != nil {
+		d.wardCode = &in.WardCode.Value
+	}
+	if in.ProvinceCode != nil {
+		d.provinceCode = &in.ProvinceCode.Value
+	}
+	if in.Address != nil {
+		d.address = &in.Address.Value
+	}
+	if in.Avatar != nil {
+		d.avatar = &in.Avatar.Value
+	}
+	if in.Account == nil {
+		d.addError("account: account info is required")
+	} else {
+		d.username = in.Account.Username
+		d.password = in.Account.Password
+	}
+	d.normalizePhone()
+	d.resolveAdminUnit()
+	d.setOrgIDs(ctx, in.OrgIds)
+	d.setPermRoleIDs(in.PermIds, in.RoleIds)
+	return d
+}
+
+// BatchCreateUsers tạo nhiều user bằng CreateBulk và một lời gọi BatchCreate quyền/vai trò.
+//   - allOrNothing: chỉ tạo khi mọi phần tử hợp lệ, lỗi bất kỳ thì không tạo gì cả
+//   - best-effort: tạo các phần tử hợp lệ; nếu ghi cả nhóm thất bại thì thử lại từng phần tử
+//     để mỗi phần tử có trạng thái riêng
+func (s *UserService) BatchCreateUsers(ctx context.Context, inputs []*userPb.CreateUserRequest, allOrNothing bool) ([]BatchItemResult, error) {
+	if err := checkBatchSize(len(inputs)); err != nil {
+		return nil, fmt.Errorf("#1 BatchCreateUsers: %w", err)
+	}
+
+	drafts := make([]*userDraft, len(inputs))
+	for i, in := range inputs {
+		drafts[i] = createDraft(ctx, i, in)
+	}
+	markDraftDuplicates(drafts)
+	if err := s.markExistingDuplicates(ctx, drafts); err != nil {
+		return nil, fmt.Errorf("#2 BatchCreateUsers: %w", err)
+	}
+
+	results := make([]BatchItemResult, len(drafts))
+	var valid []*userDraft
+	for i, d := range drafts {
+		results[i].Index = i
+		if !d.valid() {
+			results[i].Err = errors.New(strings.Join(d.errors, "; "))
+			continue
+		}
+		valid = append(valid, d)
+	}
+
+	if allOrNothing && len(valid) < len(drafts) {
+		abortBatch(results)
+		return results, nil
+	}
+
+	err := s.createDraftsInTx(ctx, valid)
+	if err != nil && allOrNothing {
+		for i := range results {
+			results[i].Err = err
+		}
+		return results, nil
+	}
+	if err != nil {
+		// Ghi cả nhóm lỗi (ví dụ dữ liệu không qua validator của ent): tạo lại từng phần tử
+		for _, d := range valid {
+			d.user = nil
+			if itemErr := s.createDraftsInTx(ctx, []*userDraft{d}); itemErr != nil {
+				d.addError("%v", itemErr)
+			}
+		}
+	}
+
+	for i, d := range drafts {
+		if results[i].Err != nil {
+			continue
+		}
+		if d.user == nil {
+			results[i].Err = errors.New(strings.Join(d.errors, "; "))
+			continue
+		}
+		results[i].ID = d.user.ID
+		results[i].User = d.user
+	}
+	return results, nil
+}
+
+func (s *UserService) createDraftsInTx(ctx context.Context, drafts []*userDraft) error {
+	if len(drafts) == 0 {
+		return nil
+	}
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := s.createUsersBulk(ctx, tx, drafts); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// BatchUpdateUsers cập nhật nhiều user.
+//   - allOrNothing: mọi cập nhật nằm trong một transaction; quyền/vai trò chỉ được đồng bộ
+//     sang permission service sau khi commit, gộp thành một lời gọi BatchCreate cho cả batch.
+//     Phần tử đã lưu nhưng đồng bộ lỗi có lỗi ErrAccessNotSynced (User vẫn được trả về)
+//   - best-effort: mỗi phần tử một transaction riêng
+func (s *UserService) BatchUpdateUsers(ctx context.Context, inputs []*userPb.UpdateUserRequest, allOrNothing bool) ([]BatchItemResult, error) {
+	if err := checkBatchSize(len(inputs)); err != nil {
+		return nil, fmt.Errorf("#1 BatchUpdateUsers: %w", err)
+	}
+
+	results := make([]BatchItemResult, len(inputs))
+	for i, in := range inputs {
+		results[i] = BatchItemResult{Index: i, ID: int(in.Id)}
+	}
+
+	if !allOrNothing {
+		for i, in := range inputs {
+			results[i].User, results[i].Err = s.updateUserInTx(ctx, in)
+		}
+		return results, nil
+	}
+
+	// Kiểm tra id quyền/vai trò trước khi ghi để id sai không để lại batch đã commit mà chưa đồng bộ
+	syncs := make([]*accessSync, 0, len(inputs))
+	for i, in := range inputs {
+		sync, err := newAccessSync(i, in)
+		if err != nil {
+			results[i].Err = err
+			abortBatch(results)
+			return results, nil
+		}
+		if sync != nil {
+			syncs = append(syncs, sync)
+		}
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	for i, in := range inputs {
+		updated, err := s.updateUserByID(ctx, tx, int(in.Id), in)
+		if err != nil {
+			results[i].Err = err
+			abortBatch(results)
+			return results, nil
+		}
+		results[i].User = updated
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("#2 BatchUpdateUsers: failed to commit: %w", err)
+	}
+
+	for index, err := range s.syncBatchAccess(ctx, syncs) {
+		results[index].Err = fmt.Errorf("%w: %v", ErrAccessNotSynced, err)
+	}
+	return results, nil
+}
+
+// accessSync là quyền/vai trò mới của một phần tử batch, đồng bộ sang permission service sau khi commit
+type accessSync struct {
+	index        int
+	userID       string
+	perms, roles bool
+	permIDs      []uuid.UUID
+	roleIDs      []uuid.UUID
+}
+
+// newAccessSync trả về nil nếu phần tử không đổi quyền/vai trò
+func newAccessSync(index int, in *userPb.UpdateUserRequest) (*accessSync, error) {
+	fields, err := updateFields(in)
+	if err != nil {
+		return nil, err
+	}
+	if !fields["perm_ids"] && !fields["role_ids"] {
+		return nil, nil
+	}
+	sync := &accessSync{
+		index:  index,
+		userID: fmt.Sprintf("%d", in.Id),
+		perms:  fields["perm_ids"],
+		roles:  fields["role_ids"],
+	}
+	parse := func(field string, ids []string) ([]uuid.UUID, error) {
+		parsed := make([]uuid.UUID, 0, len(ids))
+		for _, id := range ids {
+			u, err := uuid.Parse(id)
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid id %q", field, id)
+			}
+			parsed = append(parsed, u)
+		}
+		return parsed, nil
+	}
+	if sync.perms {
+		if sync.permIDs, err = parse("perm_ids", in.PermIds); err != nil {
+			return nil, err
+		}
+	}
+	if sync.roles {
+		if sync.roleIDs, err = parse("role_ids", in.RoleIds); err != nil {
+			return nil, err
+		}
+	}
+	return sync, nil
+}
+
+// syncBatchAccess thay quyền/vai trò của các user trong batch: xóa quyền/vai trò cũ từng user
+// (permission service không có API thay thế theo lô) rồi gán mới bằng một lời gọi BatchCreate.
+// Trả về lỗi theo index của phần tử; phần tử lỗi ở bước xóa không được gán mới.
+func (s *UserService) syncBatchAccess(ctx context.Context, syncs []*accessSync) map[int]error {
+	failed := make(map[int]error)
+	now := timestamppb.Now()
+
+	var permRequests []*permPb.CreateUserPermRequest
+	var permOwners []int
+	for _, sync := range syncs {
+		if !sync.perms {
+			continue
+		}
+		if _, err := s.perClients.PermExt.DeleteUserPermsByUserID(ctx, &permPb.DeleteUserPermsByUserIDRequest{
+			UserId: sync.userID,
+		}); err != nil {
+			failed[sync.index] = fmt.Errorf("failed to clear user permissions: %w", err)
+			continue
+		}
+		for _, id := range sync.permIDs {
+			permRequests = append(permRequests, &permPb.CreateUserPermRequest{
+				UserPerm: &permPb.UserPerm{UserId: sync.userID, PermId: id[:], CreatedAt: now},
+			})
+		}
+		permOwners = append(permOwners, sync.index)
+	}
+	if len(permRequests) > 0 {
+		if _, err := s.perClients.UserPerm.BatchCreate(ctx, &permPb.BatchCreateUserPermsRequest{
+			Requests: permRequests,
+		}); err != nil {
+			for _, index := range permOwners {
+				failed[index] = fmt.Errorf("failed to create user permissions: %w", err)
+			}
+		}
+	}
+
+	var roleRequests []*permPb.CreateUserRoleRequest
+	var roleOwners []int
+	for _, sync := range syncs {
+		if !sync.roles || failed[sync.index] != nil {
+			continue
+		}
+		if _, err := s.perClients.PermExt.DeleteUserRolesByUserID(ctx, &permPb.DeleteUserRolesByUserIDRequest{
+			UserId: sync.userID,
+		}); err != nil {
+			failed[sync.index] = fmt.Errorf("failed to clear user roles: %w", err)
+			continue
+		}
+		for _, id := range sync.roleIDs {
+			roleRequests = append(roleRequests, &permPb.CreateUserRoleRequest{
+				UserRole: &permPb.UserRole{UserId: sync.userID, RoleId: id[:], CreatedAt: now},
+			})
+		}
+		roleOwners = append(roleOwners, sync.index)
+	}
+	if len(roleRequests) > 0 {
+		if _, err := s.perClients.UserRole.BatchCreate(ctx, &permPb.BatchCreateUserRolesRequest{
+			Requests: roleRequests,
+		}); err != nil {
+			for _, index := range roleOwners {
+				failed[index] = fmt.Errorf("failed to create user roles: %w", err)
+			}
+		}
+	}
+	return failed
+}
+
+func (s *UserService) updateUserInTx(ctx context.Context, in *userPb.UpdateUserRequest) (*ent.User, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	updated, err := s.UpdateUserByID(ctx, tx, int(in.Id), in)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// BatchDeleteUsers xóa (mềm) nhiều user, cùng quy tắc với DeleteUserByID
+func (s *UserService) BatchDeleteUsers(ctx context.Context, ids []int, allOrNothing bool) ([]BatchItemResult, error) {
+	if err := checkBatchSize(len(ids)); err != nil {
+		return nil, fmt.Errorf("#1 BatchDeleteUsers: %w", err)
+	}
+
+	results := make([]BatchItemResult, len(ids))
+	for i, id := range ids {
+		results[i] = BatchItemResult{Index: i, ID: id}
+	}
+
+	if !allOrNothing {
+		for i, id := range ids {
+			results[i].Err = s.DeleteUserByID(ctx, id)
+		}
+		return results, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	for i, id := range ids {
+		if err := s.deleteUserByID(ctx, tx, id); err != nil {
+			results[i].Err = err
+			abortBatch(results)
+			return results, nil
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("#2 BatchDeleteUsers: failed to commit: %w", err)
+	}
+	return results, nil
+}
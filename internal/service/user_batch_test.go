@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+
+	permPb "github.com/longgggwwww/hrm-ms-permission/ent/proto/entpb"
+)
+
+// recordingPerms ghi lại các lời gọi sang permission service; batchErr làm BatchCreate quyền bị lỗi
+type recordingPerms struct {
+	fakePermExt
+	calls    []string
+	batchErr error
+}
+
+func (r *recordingPerms) DeleteUserPermsByUserID(_ context.Context, in *permPb.DeleteUserPermsByUserIDRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	r.calls = append(r.calls, "delete perms "+in.UserId)
+	return &emptypb.Empty{}, nil
+}
+
+func (r *recordingPerms) DeleteUserRolesByUserID(_ context.Context, in *permPb.DeleteUserRolesByUserIDRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	r.calls = append(r.calls, "delete roles "+in.UserId)
+	return &emptypb.Empty{}, nil
+}
+
+func (r *recordingPerms) UpdateUserPerms(_ context.Context, in *permPb.UpdateUserPermsRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	r.calls = append(r.calls, "update perms "+in.UserId)
+	return &emptypb.Empty{}, nil
+}
+
+func (r *recordingPerms) UpdateUserRoles(_ context.Context, in *permPb.UpdateUserRolesRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	r.calls = append(r.calls, "update roles "+in.UserId)
+	return &emptypb.Empty{}, nil
+}
+
+type recordingUserPerms struct{ *recordingPerms }
+
+func (r recordingUserPerms) BatchCreate(_ context.Context, in *permPb.BatchCreateUserPermsRequest, _ ...grpc.CallOption) (*permPb.BatchCreateUserPermsResponse, error) {
+	r.calls = append(r.calls, fmt.Sprintf("create %d perms", len(in.Requests)))
+	return &permPb.BatchCreateUserPermsResponse{}, r.batchErr
+}
+
+type recordingUserRoles struct{ *recordingPerms }
+
+func (r recordingUserRoles) BatchCreate(_ context.Context, in *permPb.BatchCreateUserRolesRequest, _ ...grpc.CallOption) (*permPb.BatchCreateUserRolesResponse, error) {
+	r.calls = append(r.calls, fmt.Sprintf("create %d roles", len(in.Requests)))
+	return &permPb.BatchCreateUserRolesResponse{}, nil
+}
+
+func TestBatchUpdateUsersAllOrNothing(t *testing.T) {
+	permA, permB, role := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	permUpdate := func(id int, perms ...string) *userPb.UpdateUserRequest {
+		return &userPb.UpdateUserRequest{
+			Id:         int32(id),
+			PermIds:    perms,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"perm_ids"}},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		items      func(a, b int) []*userPb.UpdateUserRequest
+		batchErr   error
+		wantCalls  func(a, b int) []string
+		wantFailed []bool
+		wantErr    error
+		wantSaved  bool
+	}{
+		{
+			name: "một lời gọi BatchCreate cho cả batch",
+			items: func(a, b int) []*userPb.UpdateUserRequest {
+				roles := &userPb.UpdateUserRequest{
+					Id:         int32(b),
+					PermIds:    []string{permB},
+					RoleIds:    []string{role},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"perm_ids", "role_ids"}},
+				}
+				return []*userPb.UpdateUserRequest{permUpdate(a, permA, permB), roles}
+			},
+			wantCalls: func(a, b int) []string {
+				return []string{
+					fmt.Sprintf("delete perms %d", a), fmt.Sprintf("delete perms %d", b), "create 3 perms",
+					fmt.Sprintf("delete roles %d", b), "create 1 roles",
+				}
+			},
+			wantFailed: []bool{false, false},
+			wantSaved:  true,
+		},
+		{
+			name: "phần tử lỗi thì không gọi permission service",
+			items: func(a, b int) []*userPb.UpdateUserRequest {
+				return []*userPb.UpdateUserRequest{permUpdate(a, permA), permUpdate(9999, permB)}
+			},
+			wantFailed: []bool{true, true},
+		},
+		{
+			name: "id quyền sai bị từ chối trước khi lưu",
+			items: func(a, b int) []*userPb.UpdateUserRequest {
+				return []*userPb.UpdateUserRequest{permUpdate(a, permA), permUpdate(b, "not-a-uuid")}
+			},
+			wantFailed: []bool{true, true},
+		},
+		{
+			name: "đồng bộ lỗi được báo theo từng phần tử",
+			items: func(a, b int) []*userPb.UpdateUserRequest {
+				return []*userPb.UpdateUserRequest{permUpdate(a, permA), {
+					Id:         int32(b),
+					FirstName:  "Bình",
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"first_name"}},
+				}}
+			},
+			batchErr: errors.New("permission service unavailable"),
+			wantCalls: func(a, b int) []string {
+				return []string{fmt.Sprintf("delete perms %d", a), "create 1 perms"}
+			},
+			wantFailed: []bool{true, false},
+			wantErr:    ErrAccessNotSynced,
+			wantSaved:  true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s, client := newTestService(t, fmt.Sprintf("batch_update%d", i))
+			perms := &recordingPerms{batchErr: tt.batchErr}
+			s.perClients = &PermissionServiceClients{
+				PermExt:  perms,
+				UserPerm: recordingUserPerms{perms},
+				UserRole: recordingUserRoles{perms},
+			}
+
+			ids := make([]int, 2)
+			for j, phone := range []string{"+84900000001", "+84900000002"} {
+				u := client.User.Create().SetFirstName("U").SetLastName("U").SetPhone(phone).SaveX(ctx)
+				client.Account.Create().SetUsername(phone).SetPassword("secret").SetUser(u).ExecX(ctx)
+				ids[j] = u.ID
+			}
+
+			results, err := s.BatchUpdateUsers(ctx, tt.items(ids[0], ids[1]), true)
+			if err != nil {
+				t.Fatalf("BatchUpdateUsers: %v", err)
+			}
+
+			var wantCalls []string
+			if tt.wantCalls != nil {
+				wantCalls = tt.wantCalls(ids[0], ids[1])
+			}
+			if !reflect.DeepEqual(perms.calls, wantCalls) {
+				t.Errorf("permission calls = %v, want %v", perms.calls, wantCalls)
+			}
+			for j, failed := range tt.wantFailed {
+				err := results[j].Err
+				if (err != nil) != failed {
+					t.Errorf("item %d error = %v, want failed = %v", j, err, failed)
+				}
+				if err != nil && tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+					t.Errorf("item %d error = %v, want %v", j, err, tt.wantErr)
+				}
+			}
+			if tt.wantErr != nil && results[0].User == nil {
+				t.Error("saved item with sync error must still return the user")
+			}
+			if saved := client.User.Query().Where(user.ID(ids[0]), user.PermVersion(1)).ExistX(ctx); saved != tt.wantSaved {
+				t.Errorf("user a saved = %v, want %v", saved, tt.wantSaved)
+			}
+		})
+	}
+}
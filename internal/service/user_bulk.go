@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/google/uuid"
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/account"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+	user "github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/internal/fieldcrypt"
+	"github.com/huynhthanhthao/hrm_user_service/internal/phone"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+	permPb "github.com/longgggwwww/hrm-ms-permission/ent/proto/entpb"
+)
+
+// userDraft là dữ liệu đã chuẩn hóa của một user sắp được tạo hàng loạt (import, batch create).
+// ref dùng trong thông báo lỗi để chỉ tới dòng/phần tử gốc, ví dụ "line 5" hoặc "item 2".
+type userDraft struct {
+	ref string
+
+	firstName, lastName, gender, phone     string
+	email, wardCode, provinceCode, address *string
+	avatar                                 *string
+	username, password                     string
+
+	orgIDs  []int64
+	permIDs []uuid.UUID
+	roleIDs []uuid.UUID
+
+	errors []string
+	user   *ent.User
+}
+
+func (d *userDraft) addError(format string, args ...interface{}) {
+	d.errors = append(d.errors, fmt.Sprintf(format, args...))
+}
+
+func (d *userDraft) valid() bool {
+	return len(d.errors) == 0
+}
+
+// setOrgIDs giống resolveOrgIDs: viewer đang ở một tổ chức chỉ được tạo user trong chính tổ chức đó
+func (d *userDraft) setOrgIDs(ctx context.Context, requested []int64) {
+	d.orgIDs = requested
+	if orgID, scoped := viewer.OrgFromContext(ctx); scoped {
+		for _, id := range requested {
+			if id != orgID {
+				d.addError("org_ids: cannot assign user to organization %d", id)
+			}
+		}
+		d.orgIDs = []int64{orgID}
+	}
+}
+
+// normalizePhone chuyển phone sang E.164 để kiểm tra trùng đúng với dữ liệu đã lưu
+func (d *userDraft) normalizePhone() {
+	n, err := phone.Normalize(d.phone)
+	if err != nil {
+		d.addError("%s: %v", user.FieldPhone, err)
+		return
+	}
+	d.phone = n
+}
+
+// resolveAdminUnit kiểm tra ward_code/province_code theo danh mục và suy ra province_code từ ward_code
+func (d *userDraft) resolveAdminUnit() {
+	var ward, province string
+	if d.wardCode != nil {
+		ward = *d.wardCode
+	}
+	if d.provinceCode != nil {
+		province = *d.provinceCode
+	}
+	provinceCode, err := resolveProvinceCode(ward, province)
+	if err != nil {
+		d.addError("%v", err)
+		return
+	}
+	d.provinceCode = provinceCode
+}
+
+func (d *userDraft) setPermRoleIDs(permIDs, roleIDs []string) {
+	for _, id := range permIDs {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			d.addError("perm_ids: invalid id %q", id)
+			continue
+		}
+		d.permIDs = append(d.permIDs, parsed)
+	}
+	for _, id := range roleIDs {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			d.addError("role_ids: invalid id %q", id)
+			continue
+		}
+		d.roleIDs = append(d.roleIDs, parsed)
+	}
+}
+
+// markDraftDuplicates báo lỗi các draft trùng phone/email/username với một draft đứng trước
+func markDraftDuplicates(drafts []*userDraft) {
+	phones := make(map[string]string)
+	emails := make(map[string]string)
+	usernames := make(map[string]string)
+	check := func(d *userDraft, seen map[string]string, field, value string) {
+		if value == "" {
+			return
+		}
+		if ref, ok := seen[value]; ok {
+			d.addError("%s: duplicates %s", field, ref)
+			return
+		}
+		seen[value] = d.ref
+	}
+	for _, d := range drafts {
+		check(d, phones, user.FieldPhone, d.phone)
+		if d.email != nil {
+			check(d, emails, user.FieldEmail, strings.ToLower(*d.email))
+		}
+		check(d, usernames, account.FieldUsername, d.username)
+	}
+}
+
+// markExistingDuplicates báo lỗi các draft có phone/email/username đã tồn tại.
+// Kiểm tra trên toàn hệ thống vì ràng buộc unique không phụ thuộc tổ chức.
+func (s *UserService) markExistingDuplicates(ctx context.Context, drafts []*userDraft) error {
+	if len(drafts) == 0 {
+		return nil
+	}
+
+	// Phone, email được mã hóa nên so theo blind index
+	var phoneHashes, emailHashes, usernames []string
+	for _, d := range drafts {
+		h, err := fieldcrypt.PhoneIndex(d.phone)
+		if err != nil {
+			return fmt.Errorf("#1 markExistingDuplicates: %w", err)
+		}
+		phoneHashes = append(phoneHashes, h)
+		if d.email != nil {
+			h, err := fieldcrypt.EmailIndex(*d.email)
+			if err != nil {
+				return fmt.Errorf("#2 markExistingDuplicates: %w", err)
+			}
+			emailHashes = append(emailHashes, h)
+		}
+		usernames = append(usernames, d.username)
+	}
+
+	unscoped := viewer.Unscoped(ctx)
+	preds := []predicate.User{user.PhoneHashIn(phoneHashes...)}
+	if len(emailHashes) > 0 {
+		preds = append(preds, user.EmailHashIn(emailHashes...))
+	}
+	existing, err := s.client.User.Query().
+		Where(user.Or(preds...)).
+		Select(user.FieldPhone, user.FieldEmail).
+		All(unscoped)
+	if err != nil {
+		return fmt.Errorf("#3 markExistingDuplicates: failed to query users: %w", err)
+	}
+	takenPhones := make(map[string]bool, len(existing))
+	takenEmails := make(map[string]bool, len(existing))
+	for _, u := range existing {
+		takenPhones[u.Phone] = true
+		if u.Email != nil {
+			takenEmails[strings.ToLower(*u.Email)] = true
+		}
+	}
+
+	names, err := s.client.Account.Query().
+		Where(account.UsernameIn(usernames...)).
+		Select(account.FieldUsername).
+		Strings(unscoped)
+	if err != nil {
+		return fmt.Errorf("#4 markExistingDuplicates: failed to query accounts: %w", err)
+	}
+	takenUsernames := make(map[string]bool, len(names))
+	for _, name := range names {
+		takenUsernames[name] = true
+	}
+
+	for _, d := range drafts {
+		if takenPhones[d.phone] {
+			d.addError("%s: already exists", user.FieldPhone)
+		}
+		if d.email != nil && takenEmails[strings.ToLower(*d.email)] {
+			d.addError("%s: already exists", user.FieldEmail)
+		}
+		if takenUsernames[d.username] {
+			d.addError("%s: already exists", account.FieldUsername)
+		}
+	}
+	return nil
+}
+
+// createUsersBulk tạo user, account, membership bằng CreateBulk trong tx
+// và gán quyền/vai trò bằng một lời gọi BatchCreate cho cả nhóm.
+// Thành công thì d.user được gán user vừa tạo (kèm memberships).
+func (s *UserService) createUsersBulk(ctx context.Context, tx *ent.Tx, drafts []*userDraft) error {
+	if len(drafts) == 0 {
+		return nil
+	}
+
+	userBuilders := make([]*ent.UserCreate, len(drafts))
+	for i, d := range drafts {
+		userBuilders[i] = tx.User.Create().
+			SetFirstName(d.firstName).
+			SetLastName(d.lastName).
+			SetGender(user.Gender(d.gender)).
+			SetPhone(d.phone).
+			SetNillableEmail(d.email).
+			SetNillableWardCode(d.wardCode).
+			SetNillableProvinceCode(d.provinceCode).
+			SetNillableAddress(d.address).
+			SetNillableAvatar(d.avatar)
+	}
+	users, err := tx.User.CreateBulk(userBuilders...).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("#1 createUsersBulk: failed to create users: %w", err)
+	}
+
+	accountBuilders := make([]*ent.AccountCreate, len(drafts))
+	var membershipBuilders []*ent.MembershipCreate
+	var membershipOwners []int
+	var userPerms []*permPb.CreateUserPermRequest
+	var userRoles []*permPb.CreateUserRoleRequest
+	for i, d := range drafts {
+		userID := users[i].ID
+		hashedPwd, err := bcrypt.GenerateFromPassword([]byte(d.password), bcrypt.DefaultCost)
+		if err != nil {
+			return fmt.Errorf("#2 createUsersBulk: failed to hash password: %w", err)
+		}
+		accountBuilders[i] = tx.Account.Create().
+			SetUsername(d.username).
+			SetPassword(string(hashedPwd)).
+			SetUserID(userID)
+
+		seen := make(map[int64]struct{}, len(d.orgIDs))
+		for _, orgID := range d.orgIDs {
+			if _, ok := seen[orgID]; ok {
+				continue
+			}
+			membershipBuilders = append(membershipBuilders, tx.Membership.Create().
+				SetOrgID(orgID).
+				SetUserID(userID).
+				SetIsDefault(len(seen) == 0))
+			membershipOwners = append(membershipOwners, i)
+			seen[orgID] = struct{}{}
+		}
+
+		for _, permID := range d.permIDs {
+			userPerms = append(userPerms, &permPb.CreateUserPermRequest{
+				UserPerm: &permPb.UserPerm{
+					UserId:    fmt.Sprintf("%d", userID),
+					PermId:    permID[:],
+					CreatedAt: timestamppb.Now(),
+				},
+			})
+		}
+		for _, roleID := range d.roleIDs {
+			userRoles = append(userRoles, &permPb.CreateUserRoleRequest{
+				UserRole: &permPb.UserRole{
+					UserId:    fmt.Sprintf("%d", userID),
+					RoleId:    roleID[:],
+					CreatedAt: timestamppb.Now(),
+				},
+			})
+		}
+	}
+
+	if _, err := tx.Account.CreateBulk(accountBuilders...).Save(ctx); err != nil {
+		return fmt.Errorf("#3 createUsersBulk: failed to create accounts: %w", err)
+	}
+	if len(membershipBuilders) > 0 {
+		memberships, err := tx.Membership.CreateBulk(membershipBuilders...).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("#4 createUsersBulk: failed to create memberships: %w", err)
+		}
+		for i, m := range memberships {
+			owner := users[membershipOwners[i]]
+			owner.Edges.Memberships = append(owner.Edges.Memberships, m)
+		}
+	}
+
+	if len(userPerms) > 0 {
+		_, err := s.perClients.UserPerm.BatchCreate(ctx, &permPb.BatchCreateUserPermsRequest{
+			Requests: userPerms,
+		})
+		if err != nil {
+			return fmt.Errorf("#5 createUsersBulk: failed to create user permissions: %w", err)
+		}
+	}
+	if len(userRoles) > 0 {
+		_, err := s.perClients.UserRole.BatchCreate(ctx, &permPb.BatchCreateUserRolesRequest{
+			Requests: userRoles,
+		})
+		if err != nil {
+			return fmt.Errorf("#6 createUsersBulk: failed to create user roles: %w", err)
+		}
+	}
+
+	for i, d := range drafts {
+		d.user = users[i]
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+)
+
+var ErrVersionConflict = errors.New("user was modified by another request")
+
+// VersionConflictError trả về khi version hiện tại của user khác version người gọi mong đợi.
+// Current là bản ghi hiện tại để người gọi hiển thị lại và thử cập nhật lần nữa.
+type VersionConflictError struct {
+	Expected int
+	Current  *ent.User
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("%s: expected version %d, current version %d", ErrVersionConflict, e.Expected, e.Current.Version)
+}
+
+func (e *VersionConflictError) Unwrap() error {
+	return ErrVersionConflict
+}
+
+// versionConflict tải bản ghi hiện tại sau khi UPDATE có điều kiện version không khớp dòng nào
+func versionConflict(ctx context.Context, tx *ent.Tx, userID int, expected int) error {
+	current, err := tx.User.Query().
+		Where(user.ID(userID)).
+		WithMemberships().
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("#1 versionConflict: failed to load current user: %w", err)
+	}
+	return &VersionConflictError{Expected: expected, Current: current}
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/unaccent"
+)
+
+const (
+	DefaultDuplicateMinScore = 0.5
+	DefaultDuplicateLimit    = 100
+	MaxDuplicateLimit        = 500
+	// Nhóm quá lớn (ví dụ tên rất phổ biến) bị bỏ qua để số cặp không tăng theo bình phương
+	maxDuplicateBlockSize = 200
+)
+
+// Lý do hai user bị nghi trùng
+const (
+	DuplicateReasonName        = "name"
+	DuplicateReasonSimilarName = "similar_name"
+	DuplicateReasonPhone       = "phone"
+	DuplicateReasonEmail       = "email"
+	DuplicateReasonEmailLocal  = "email_local"
+	DuplicateReasonDateOfBirth = "date_of_birth"
+)
+
+// Trọng số của từng tiêu chí, tổng điểm tối đa là 1
+var duplicateWeights = map[string]float64{
+	DuplicateReasonName:        0.35,
+	DuplicateReasonSimilarName: 0.15,
+	DuplicateReasonPhone:       0.3,
+	DuplicateReasonEmail:       0.25,
+	DuplicateReasonEmailLocal:  0.1,
+	DuplicateReasonDateOfBirth: 0.2,
+}
+
+type DuplicateOptions struct {
+	MinScore float64
+	Limit    int
+}
+
+// DuplicateCandidate là một cặp user nghi là cùng một người, User luôn có id nhỏ hơn Other
+type DuplicateCandidate struct {
+	User    *ent.User
+	Other   *ent.User
+	Score   float64
+	Reasons []string
+}
+
+// duplicateKeys là các giá trị đã chuẩn hóa dùng để so sánh
+type duplicateKeys struct {
+	name, firstName, family string
+	phone                   string
+	email, emailLocal       string
+	dob                     string
+}
+
+func newDuplicateKeys(u *ent.User) duplicateKeys {
+	k := duplicateKeys{
+		name:      strings.Join(strings.Fields(unaccent.Fold(u.LastName+" "+u.FirstName)), " "),
+		firstName: strings.Join(strings.Fields(unaccent.Fold(u.FirstName)), " "),
+	}
+	if family := strings.Fields(unaccent.Fold(u.LastName)); len(family) > 0 {
+		k.family = family[0]
+	}
+	// So 9 số cuối để số chưa chuẩn hóa (dữ liệu cũ) vẫn khớp
+	if digits := strings.TrimPrefix(u.Phone, "+"); len(digits) >= 9 {
+		k.phone = digits[len(digits)-9:]
+	}
+	if u.Email != nil {
+		k.email = strings.ToLower(strings.TrimSpace(*u.Email))
+		if at := strings.IndexByte(k.email, '@'); at >= 3 {
+			k.emailLocal = k.email[:at]
+		}
+	}
+	if p := u.Edges.Profile; p != nil && p.DateOfBirth != nil {
+		k.dob = p.DateOfBirth.Format(dto.ProfileDateLayout)
+	}
+	return k
+}
+
+// blocks trả về các khóa gom nhóm: chỉ so sánh các cặp chung ít nhất một khóa
+func (k duplicateKeys) blocks() []string {
+	var res []string
+	if k.name != "" {
+		res = append(res, "n:"+k.name)
+	}
+	if k.firstName != "" && k.family != "" {
+		res = append(res, "f:"+k.family+"|"+k.firstName)
+	}
+	if k.phone != "" {
+		res = append(res, "p:"+k.phone)
+	}
+	if k.emailLocal != "" {
+		res = append(res, "e:"+k.emailLocal)
+	}
+	if k.dob != "" {
+		res = append(res, "d:"+k.dob)
+	}
+	return res
+}
+
+func scoreDuplicate(a, b duplicateKeys) (float64, []string) {
+	var reasons []string
+	switch {
+	case a.name != "" && a.name == b.name:
+		reasons = append(reasons, DuplicateReasonName)
+	case a.firstName != "" && a.firstName == b.firstName && a.family == b.family:
+		reasons = append(reasons, DuplicateReasonSimilarName)
+	}
+	if a.phone != "" && a.phone == b.phone {
+		reasons = append(reasons, DuplicateReasonPhone)
+	}
+	switch {
+	case a.email != "" && a.email == b.email:
+		reasons = append(reasons, DuplicateReasonEmail)
+	case a.emailLocal != "" && a.emailLocal == b.emailLocal:
+		reasons = append(reasons, DuplicateReasonEmailLocal)
+	}
+	if a.dob != "" && a.dob == b.dob {
+		reasons = append(reasons, DuplicateReasonDateOfBirth)
+	}
+
+	var score float64
+	for _, r := range reasons {
+		score += duplicateWeights[r]
+	}
+	return math.Round(math.Min(score, 1)*100) / 100, reasons
+}
+
+// FindDuplicateUsers chấm điểm các cặp user (trong phạm vi tổ chức của viewer) theo họ tên đã bỏ dấu,
+// số điện thoại, email và ngày sinh. Trả về các cặp có điểm >= MinScore, điểm cao trước.
+func (s *UserService) FindDuplicateUsers(ctx context.Context, opts DuplicateOptions) ([]DuplicateCandidate, error) {
+	if !CanMergeUsers(ctx) {
+		return nil, fmt.Errorf("#1 FindDuplicateUsers: %w", ErrMergeForbidden)
+	}
+	if opts.MinScore <= 0 {
+		opts.MinScore = DefaultDuplicateMinScore
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = DefaultDuplicateLimit
+	}
+	if opts.Limit > MaxDuplicateLimit {
+		opts.Limit = MaxDuplicateLimit
+	}
+
+	// User đã ẩn danh hóa có tên giả giống nhau nên không đưa vào so sánh
+	users, err := s.client.User.Query().
+		Where(user.AnonymizedAtIsNil()).
+		WithMemberships().
+		WithProfile().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#2 FindDuplicateUsers: failed to query users: %w", err)
+	}
+
+	keys := make([]duplicateKeys, len(users))
+	blocks := make(map[string][]int)
+	for i, u := range users {
+		keys[i] = newDuplicateKeys(u)
+		for _, b := range keys[i].blocks() {
+			blocks[b] = append(blocks[b], i)
+		}
+		// Ngày sinh chỉ dùng để chấm điểm, không trả hồ sơ cho viewer không có quyền xem
+		if !CanReadProfile(ctx, u.ID) {
+			u.Edges.Profile = nil
+		}
+	}
+
+	type pair struct{ a, b int }
+	seen := make(map[pair]bool)
+	var candidates []DuplicateCandidate
+	for _, members := range blocks {
+		if len(members) < 2 || len(members) > maxDuplicateBlockSize {
+			continue
+		}
+		for x := 0; x < len(members); x++ {
+			for y := x + 1; y < len(members); y++ {
+				p := pair{members[x], members[y]}
+				if users[p.a].ID > users[p.b].ID {
+					p.a, p.b = p.b, p.a
+				}
+				if seen[p] {
+					continue
+				}
+				seen[p] = true
+
+				score, reasons := scoreDuplicate(keys[p.a], keys[p.b])
+				if score < opts.MinScore {
+					continue
+				}
+				candidates = append(candidates, DuplicateCandidate{
+					User:    users[p.a],
+					Other:   users[p.b],
+					Score:   score,
+					Reasons: reasons,
+				})
+			}
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		if candidates[i].User.ID != candidates[j].User.ID {
+			return candidates[i].User.ID < candidates[j].User.ID
+		}
+		return candidates[i].Other.ID < candidates[j].Other.ID
+	})
+	if len(candidates) > opts.Limit {
+		candidates = candidates[:opts.Limit]
+	}
+	return candidates, nil
+}
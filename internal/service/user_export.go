@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/pii"
+	"github.com/huynhthanhthao/hrm_user_service/internal/userio"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+	hrPb "github.com/longgggwwww/hrm-ms-hr/ent/proto/entpb"
+	permPb "github.com/longgggwwww/hrm-ms-permission/ent/proto/entpb"
+)
+
+const (
+	// Số user đọc mỗi lần khi export
+	exportBatchSize = 500
+	// Số lời gọi đồng thời tới HR/permission service khi lấy cột roles/employee_code
+	exportEnrichConcurrency = 8
+)
+
+// CanExportSensitive: lời gọi nội bộ (không có viewer) hoặc viewer có quyền export cột nhạy cảm
+func CanExportSensitive(ctx context.Context) bool {
+	v := viewer.FromContext(ctx)
+	return v == nil || v.HasPerm(viewer.PermUserExportSensitive)
+}
+
+// maskUserPII che phone, email, address của u theo quyền của viewer, giống response gRPC/REST
+func maskUserPII(ctx context.Context, u *ent.User) {
+	level := pii.LevelFor(ctx, u.ID)
+	if level == pii.Full {
+		return
+	}
+	u.Phone = pii.Phone(level, u.Phone)
+	if u.Email != nil {
+		email := pii.Email(level, *u.Email)
+		u.Email = &email
+	}
+	if u.Address != nil {
+		address := pii.Address(level, *u.Address)
+		u.Address = &address
+	}
+}
+
+// ExportUsers duyệt toàn bộ user khớp search/filter theo thứ tự id và ghi ra exporter.
+// Dữ liệu được đọc theo batch (keyset theo id) nên không giữ toàn bộ danh sách trong bộ nhớ.
+func (s *UserService) ExportUsers(ctx context.Context, search string, filter dto.UserFilter, columns []userio.ExportColumn, out userio.Exporter) (int, error) {
+	withRoles := userio.HasColumn(columns, userio.ColumnRoles)
+	withEmployee := userio.HasColumn(columns, userio.ColumnEmployeeCode)
+
+	query := s.client.User.Query()
+	if p := searchPredicate(search); p != nil {
+		query = query.Where(p)
+	}
+	query = query.Where(filterPredicates(filter)...)
+	attrPreds, err := attributePredicates(ctx, s.client.AttributeDefinition, filter)
+	if err != nil {
+		return 0, fmt.Errorf("#4 ExportUsers: %w", err)
+	}
+	query = query.Where(attrPreds...)
+
+	count, lastID := 0, 0
+	for {
+		users, err := query.Clone().
+			Where(user.IDGT(lastID)).
+			Order(user.ByID()).
+			Limit(exportBatchSize).
+			WithAccount().
+			WithMemberships().
+			All(ctx)
+		if err != nil {
+			return count, fmt.Errorf("#1 ExportUsers: failed to retrieve users: %w", err)
+		}
+		if len(users) == 0 {
+			return count, nil
+		}
+
+		records := make([]*userio.ExportRecord, len(users))
+		for i, u := range users {
+			maskUserPII(ctx, u)
+			records[i] = &userio.ExportRecord{User: u}
+		}
+		if withRoles || withEmployee {
+			if err := s.enrichExportRecords(ctx, records, withRoles, withEmployee); err != nil {
+				return count, fmt.Errorf("#2 ExportUsers: %w", err)
+			}
+		}
+
+		for _, r := range records {
+			if err := out.Write(r); err != nil {
+				return count, fmt.Errorf("#3 ExportUsers: failed to write user %d: %w", r.User.ID, err)
+			}
+			count++
+		}
+		lastID = users[len(users)-1].ID
+	}
+}
+
+// enrichExportRecords lấy vai trò (permission service) và mã nhân viên (HR service) cho từng user
+func (s *UserService) enrichExportRecords(ctx context.Context, records []*userio.ExportRecord, withRoles, withEmployee bool) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	sem := make(chan struct{}, exportEnrichConcurrency)
+	for _, r := range records {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(r *userio.ExportRecord) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			userID := strconv.Itoa(r.User.ID)
+
+			if withRoles {
+				rolesResp, err := s.perClients.PermExt.GetUserRoles(ctx, &permPb.GetUserRolesRequest{UserId: userID})
+				if err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = fmt.Errorf("failed to get roles of user %s: %w", userID, err)
+					}
+					mu.Unlock()
+					return
+				}
+				for _, role := range rolesResp.Roles {
+					r.Roles = append(r.Roles, role.Code)
+				}
+			}
+
+			// User chưa là nhân viên thì để trống mã nhân viên
+			if withEmployee {
+				employee, err := s.hrClients.HrExt.GetEmployeeByUserId(ctx, &hrPb.GetEmployeeByUserIdRequest{UserId: userID})
+				if err == nil && employee != nil {
+					r.EmployeeCode = employee.Code
+				}
+			}
+		}(r)
+	}
+	wg.Wait()
+	return firstErr
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/schema"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/ent/userversion"
+)
+
+var (
+	ErrNoUserVersion       = errors.New("user has no recorded version at the given time")
+	ErrUserVersionNotFound = errors.New("user version not found")
+)
+
+// FieldChange là giá trị trước/sau của một field giữa hai phiên bản; nil là không có giá trị
+type FieldChange struct {
+	Field string
+	From  *string
+	To    *string
+}
+
+// UserVersionEntry là một phiên bản kèm thay đổi so với phiên bản liền trước
+type UserVersionEntry struct {
+	Version *ent.UserVersion
+	Changes []FieldChange
+}
+
+func versionValues(v *ent.UserVersion) []FieldChange {
+	str := func(s string) *string { return &s }
+	return []FieldChange{
+		{Field: userversion.FieldFirstName, To: str(v.FirstName)},
+		{Field: userversion.FieldLastName, To: str(v.LastName)},
+		{Field: userversion.FieldGender, To: str(v.Gender)},
+		{Field: userversion.FieldPhone, To: str(v.Phone)},
+		{Field: userversion.FieldEmail, To: v.Email},
+		{Field: userversion.FieldAddress, To: v.Address},
+		{Field: userversion.FieldWardCode, To: v.WardCode},
+		{Field: userversion.FieldProvinceCode, To: v.ProvinceCode},
+		{Field: userversion.FieldAvatar, To: v.Avatar},
+	}
+}
+
+// diffVersions liệt kê các field khác nhau giữa from và to; from == nil (phiên bản đầu tiên) thì mọi field có giá trị đều là thay đổi
+func diffVersions(from, to *ent.UserVersion) []FieldChange {
+	after := versionValues(to)
+	var before []FieldChange
+	if from != nil {
+		before = versionValues(from)
+	}
+	changes := make([]FieldChange, 0, len(after))
+	for i, a := range after {
+		var old *string
+		if before != nil {
+			old = before[i].To
+		}
+		if equalStringPtr(old, a.To) {
+			continue
+		}
+		changes = append(changes, FieldChange{Field: a.Field, From: old, To: a.To})
+	}
+	return changes
+}
+
+func equalStringPtr(a, b *string) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+// ensureUserVisible kiểm tra user (kể cả đã xóa mềm) nằm trong phạm vi viewer được xem
+func (s *UserService) ensureUserVisible(ctx context.Context, id int) error {
+	_, err := s.client.User.Query().
+		Where(user.ID(id)).
+		OnlyID(schema.SkipSoftDelete(ctx))
+	return err
+}
+
+// GetUserAsOf trả về phiên bản thông tin của user có hiệu lực tại thời điểm at
+func (s *UserService) GetUserAsOf(ctx context.Context, id int, at time.Time) (*ent.UserVersion, error) {
+	if id <= 0 {
+		return nil, errors.New("#1 GetUserAsOf: invalid user ID")
+	}
+	if err := s.ensureUserVisible(ctx, id); err != nil {
+		return nil, fmt.Errorf("#2 GetUserAsOf: user not found: %w", err)
+	}
+
+	v, err := s.client.UserVersion.Query().
+		Where(
+			userversion.UserID(id),
+			userversion.ValidFromLTE(at),
+			userversion.Or(userversion.ValidToIsNil(), userversion.ValidToGT(at)),
+		).
+		Order(ent.Desc(userversion.FieldVersion)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("#3 GetUserAsOf: %w", ErrNoUserVersion)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("#4 GetUserAsOf: failed to query user versions: %w", err)
+	}
+	return v, nil
+}
+
+// ListUserVersions trả về mọi phiên bản của user, mới nhất trước, kèm thay đổi so với phiên bản liền trước
+func (s *UserService) ListUserVersions(ctx context.Context, id int) ([]*UserVersionEntry, error) {
+	if id <= 0 {
+		return nil, errors.New("#1 ListUserVersions: invalid user ID")
+	}
+	if err := s.ensureUserVisible(ctx, id); err != nil {
+		return nil, fmt.Errorf("#2 ListUserVersions: user not found: %w", err)
+	}
+
+	versions, err := s.client.UserVersion.Query().
+		Where(userversion.UserID(id)).
+		Order(ent.Asc(userversion.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#3 ListUserVersions: failed to query user versions: %w", err)
+	}
+
+	entries := make([]*UserVersionEntry, len(versions))
+	var prev *ent.UserVersion
+	for i, v := range versions {
+		entries[len(versions)-1-i] = &UserVersionEntry{Version: v, Changes: diffVersions(prev, v)}
+		prev = v
+	}
+	return entries, nil
+}
+
+// DiffUserVersions so sánh hai phiên bản bất kỳ của user theo từng field
+func (s *UserService) DiffUserVersions(ctx context.Context, id, fromVersion, toVersion int) ([]FieldChange, error) {
+	if id <= 0 {
+		return nil, errors.New("#1 DiffUserVersions: invalid user ID")
+	}
+	if fromVersion <= 0 || toVersion <= 0 {
+		return nil, errors.New("#2 DiffUserVersions: invalid version")
+	}
+	if err := s.ensureUserVisible(ctx, id); err != nil {
+		return nil, fmt.Errorf("#3 DiffUserVersions: user not found: %w", err)
+	}
+
+	versions, err := s.client.UserVersion.Query().
+		Where(userversion.UserID(id), userversion.VersionIn(fromVersion, toVersion)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#4 DiffUserVersions: failed to query user versions: %w", err)
+	}
+	byVersion := make(map[int]*ent.UserVersion, len(versions))
+	for _, v := range versions {
+		byVersion[v.Version] = v
+	}
+	from, to := byVersion[fromVersion], byVersion[toVersion]
+	if from == nil || to == nil {
+		return nil, fmt.Errorf("#5 DiffUserVersions: %w", ErrUserVersionNotFound)
+	}
+	return diffVersions(from, to), nil
+}
+
+// BackfillUserVersions lấy thông tin hiện tại làm phiên bản đầu tiên (hiệu lực từ lúc tạo user)
+// cho các user chưa có lịch sử. Thông tin trước đó không còn nên không khôi phục được.
+// Lô lỗi được ghi lại từng user một; user vẫn lỗi được ghi vào báo cáo và bỏ qua.
+func (s *UserService) BackfillUserVersions(ctx context.Context) (*BackfillReport, error) {
+	skipCtx := schema.SkipSoftDelete(ctx)
+	noVersion := func(sel *entsql.Selector) {
+		sel.Where(entsql.NotIn(
+			sel.C(user.FieldID),
+			entsql.Select(userversion.FieldUserID).From(entsql.Table(userversion.Table)),
+		))
+	}
+
+	report := &BackfillReport{}
+	lastID := 0
+	for {
+		users, err := s.client.User.Query().
+			Where(user.IDGT(lastID), noVersion).
+			Order(user.ByID()).
+			Limit(backfillBatchSize).
+			All(skipCtx)
+		if err != nil {
+			return report, fmt.Errorf("#1 BackfillUserVersions: failed to query users: %w", err)
+		}
+		if len(users) == 0 {
+			return report, nil
+		}
+		lastID = users[len(users)-1].ID
+
+		builders := make([]*ent.UserVersionCreate, 0, len(users))
+		for _, u := range users {
+			builders = append(builders, initialUserVersion(s.client.UserVersion, u))
+		}
+		if err := s.client.UserVersion.CreateBulk(builders...).Exec(ctx); err == nil {
+			report.Updated += len(users)
+			continue
+		}
+		for _, u := range users {
+			if err := initialUserVersion(s.client.UserVersion, u).Exec(ctx); err != nil {
+				report.Failed = append(report.Failed, FailedRow{Table: user.Table, ID: u.ID, Err: err})
+				continue
+			}
+			report.Updated++
+		}
+	}
+}
+
+func initialUserVersion(client *ent.UserVersionClient, u *ent.User) *ent.UserVersionCreate {
+	return schema.NewUserVersion(client, u).
+		SetVersion(1).
+		SetValidFrom(u.CreatedAt)
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/userio"
+)
+
+const (
+	DefaultImportBatchSize = 50
+	MaxImportBatchSize     = 500
+)
+
+type ImportOptions struct {
+	DryRun    bool
+	BatchSize int
+	// Tổ chức mặc định cho các dòng không có cột org_ids
+	OrgIDs []int64
+}
+
+// importDraft chuyển một dòng của file import sang userDraft
+func importDraft(ctx context.Context, row userio.Row, defaultOrgIDs []int64) *userDraft {
+	in := row.User
+	d := &userDraft{
+		ref:          fmt.Sprintf("line %d", row.Line),
+		firstName:    in.FirstName,
+		lastName:     in.LastName,
+		gender:       in.Gender,
+		phone:        in.Phone,
+		email:        nonEmpty(in.Email),
+		wardCode:     nonEmpty(in.WardCode),
+		provinceCode: nonEmpty(in.ProvinceCode),
+		address:      nonEmpty(in.Address),
+		avatar:       nonEmpty(in.Avatar),
+		username:     in.Account.Username,
+		password:     in.Account.Password,
+		errors:       append([]string(nil), row.Errors...),
+	}
+
+	orgIDs := in.OrgIDs
+	if len(orgIDs) == 0 {
+		orgIDs = defaultOrgIDs
+	}
+	d.resolveAdminUnit()
+	d.setOrgIDs(ctx, orgIDs)
+	d.setPermRoleIDs(in.PermIDs, in.RoleIDs)
+	return d
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// ImportUsers kiểm tra và (nếu không phải dry-run) tạo user theo batch.
+// Kết quả từng dòng được gửi qua emit ngay khi có, mỗi batch nằm trong một transaction riêng:
+// batch lỗi thì các dòng của batch đó được báo "failed", các batch khác vẫn được tạo.
+func (s *UserService) ImportUsers(ctx context.Context, rows []userio.Row, opts ImportOptions, emit func(userio.ImportRowResult) error) (*userio.ImportSummary, error) {
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultImportBatchSize
+	}
+	if batchSize > MaxImportBatchSize {
+		batchSize = MaxImportBatchSize
+	}
+
+	summary := &userio.ImportSummary{DryRun: opts.DryRun, Total: len(rows)}
+
+	drafts := make([]*userDraft, len(rows))
+	for i, row := range rows {
+		drafts[i] = importDraft(ctx, row, opts.OrgIDs)
+	}
+	markDraftDuplicates(drafts)
+	if err := s.markExistingDuplicates(ctx, drafts); err != nil {
+		return nil, err
+	}
+
+	batch := make([]*userDraft, 0, batchSize)
+	lines := make([]int, 0, batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		status := userio.ImportStatusValid
+		var batchErr error
+		if !opts.DryRun {
+			status = userio.ImportStatusCreated
+			batchErr = s.createImportBatch(ctx, batch)
+		}
+		for i, d := range batch {
+			res := userio.ImportRowResult{Line: lines[i], Username: d.username, Status: status}
+			switch {
+			case batchErr != nil:
+				res.Status = userio.ImportStatusFailed
+				res.Errors = []string{batchErr.Error()}
+				summary.Failed++
+			case opts.DryRun:
+				summary.Valid++
+			default:
+				res.UserID = d.user.ID
+				summary.Valid++
+				summary.Created++
+			}
+			if err := emit(res); err != nil {
+				return err
+			}
+		}
+		batch, lines = batch[:0], lines[:0]
+		return nil
+	}
+
+	for i, d := range drafts {
+		if !d.valid() {
+			summary.Invalid++
+			err := emit(userio.ImportRowResult{
+				Line:     rows[i].Line,
+				Username: d.username,
+				Status:   userio.ImportStatusInvalid,
+				Errors:   d.errors,
+			})
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		batch = append(batch, d)
+		lines = append(lines, rows[i].Line)
+		if len(batch) == batchSize {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// createImportBatch tạo các dòng của một batch trong một transaction
+func (s *UserService) createImportBatch(ctx context.Context, batch []*userDraft) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := s.createUsersBulk(ctx, tx, batch); err != nil {
+		return fmt.Errorf("#1 createImportBatch: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("#2 createImportBatch: failed to commit: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/account"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/unaccent"
+)
+
+var (
+	ErrInvalidCursor   = errors.New("invalid cursor")
+	ErrInvalidOrderBy  = errors.New("invalid order_by field")
+	ErrCursorNotSorted = errors.New("cursor paging supports ordering by at most one of first_name, last_name, created_at, updated_at")
+)
+
+// Các field được phép sắp xếp. last_login_at nằm ở bảng accounts nên không dùng được với cursor.
+var sortableFields = map[string]bool{
+	user.FieldFirstName:      true,
+	user.FieldLastName:       true,
+	user.FieldCreatedAt:      true,
+	user.FieldUpdatedAt:      true,
+	account.FieldLastLoginAt: false,
+}
+
+// Kết quả một trang danh sách user
+type UserPage struct {
+	Users       []*ent.User
+	TotalUsers  int
+	TotalPages  int
+	CurrentPage int
+	NextCursor  string
+}
+
+// Nội dung cursor, được encode base64 để client xem như chuỗi opaque.
+// Field/Desc/Value lưu giá trị sắp xếp của dòng cuối cùng để phân trang theo keyset.
+type userCursor struct {
+	ID    int    `json:"id"`
+	Field string `json:"f,omitempty"`
+	Desc  bool   `json:"d,omitempty"`
+	Value string `json:"v,omitempty"`
+}
+
+func encodeUserCursor(c userCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeUserCursor(s string) (*userCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c userCursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID <= 0 {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// searchPredicate: mỗi từ khóa (đã bỏ dấu) phải khớp một phần họ tên (search_text) hoặc username,
+// hoặc khớp chính xác số điện thoại/email đầy đủ (qua blind index). Phone, email được mã hóa
+// nên không tìm theo một phần được.
+func searchPredicate(search string) predicate.User {
+	words := strings.Fields(unaccent.Fold(search))
+	if len(words) == 0 {
+		return nil
+	}
+	preds := make([]predicate.User, 0, len(words))
+	for _, w := range words {
+		match := []predicate.User{
+			user.SearchTextContains(w),
+			user.HasAccountWith(account.UsernameContainsFold(w)),
+		}
+		// Số điện thoại, email đầy đủ được so theo blind index
+		if p := exactPIIMatch(w); p != nil {
+			match = append(match, p)
+		}
+		preds = append(preds, user.Or(match...))
+	}
+	return user.And(preds...)
+}
+
+// filterPredicates chuyển bộ lọc sang các predicate của ent
+func filterPredicates(f dto.UserFilter) []predicate.User {
+	var preds []predicate.User
+	if len(f.Genders) > 0 {
+		genders := make([]user.Gender, 0, len(f.Genders))
+		for _, g := range f.Genders {
+			genders = append(genders, user.Gender(g))
+		}
+		preds = append(preds, user.GenderIn(genders...))
+	}
+	if f.AccountStatus != "" {
+		preds = append(preds, user.HasAccountWith(account.StatusEQ(account.Status(f.AccountStatus))))
+	}
+	if f.CreatedFrom != nil {
+		preds = append(preds, user.CreatedAtGTE(*f.CreatedFrom))
+	}
+	if f.CreatedTo != nil {
+		preds = append(preds, user.CreatedAtLTE(*f.CreatedTo))
+	}
+	if f.UpdatedFrom != nil {
+		preds = append(preds, user.UpdatedAtGTE(*f.UpdatedFrom))
+	}
+	if f.UpdatedTo != nil {
+		preds = append(preds, user.UpdatedAtLTE(*f.UpdatedTo))
+	}
+	if len(f.WardCodes) > 0 {
+		preds = append(preds, user.WardCodeIn(f.WardCodes...))
+	}
+	if len(f.ProvinceCodes) > 0 {
+		preds = append(preds, user.ProvinceCodeIn(f.ProvinceCodes...))
+	}
+	if f.HasAvatar != nil {
+		if *f.HasAvatar {
+			preds = append(preds, user.AvatarNotNil(), user.AvatarNEQ(""))
+		} else {
+			preds = append(preds, user.Or(user.AvatarIsNil(), user.AvatarEQ("")))
+		}
+	}
+	if f.HasEmail != nil {
+		if *f.HasEmail {
+			preds = append(preds, user.EmailHashNotNil())
+		} else {
+			preds = append(preds, user.EmailHashIsNil())
+		}
+	}
+	return preds
+}
+
+// orderOptions kiểm tra whitelist và luôn thêm id làm tiêu chí phụ để thứ tự ổn định
+func orderOptions(orders []dto.OrderBy) ([]user.OrderOption, error) {
+	opts := make([]user.OrderOption, 0, len(orders)+1)
+	for _, o := range orders {
+		if _, ok := sortableFields[o.Field]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidOrderBy, o.Field)
+		}
+		dir := sql.OrderAsc()
+		if o.Desc {
+			dir = sql.OrderDesc()
+		}
+		if o.Field == account.FieldLastLoginAt {
+			opts = append(opts, user.ByAccountField(o.Field, dir, sql.OrderNullsLast()))
+			continue
+		}
+		opts = append(opts, sql.OrderByField(o.Field, dir).ToFunc())
+	}
+	return append(opts, user.ByID()), nil
+}
+
+// cursorValue lấy giá trị của field sắp xếp trên dòng cuối cùng
+func cursorValue(u *ent.User, field string) string {
+	switch field {
+	case user.FieldFirstName:
+		return u.FirstName
+	case user.FieldLastName:
+		return u.LastName
+	case user.FieldCreatedAt:
+		return u.CreatedAt.Format(time.RFC3339Nano)
+	case user.FieldUpdatedAt:
+		return u.UpdatedAt.Format(time.RFC3339Nano)
+	}
+	return ""
+}
+
+// cursorPredicate: các dòng nằm sau cursor theo (field, id)
+func cursorPredicate(c *userCursor) (predicate.User, error) {
+	if c.Field == "" {
+		return user.IDGT(c.ID), nil
+	}
+	var value any = c.Value
+	if c.Field == user.FieldCreatedAt || c.Field == user.FieldUpdatedAt {
+		t, err := time.Parse(time.RFC3339Nano, c.Value)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		value = t
+	}
+	after := sql.FieldGT(c.Field, value)
+	if c.Desc {
+		after = sql.FieldLT(c.Field, value)
+	}
+	return user.Or(
+		predicate.User(after),
+		user.And(predicate.User(sql.FieldEQ(c.Field, value)), user.IDGT(c.ID)),
+	), nil
+}
+
+// ListUsers trả về một trang user theo page/page_size hoặc theo cursor.
+// Chế độ cursor (keyset) sắp xếp theo id, hoặc theo một field trong sortableFields
+// (first_name, last_name, created_at, updated_at) với id làm tiêu chí phụ, nên không bị
+// trùng/sót khi dữ liệu thay đổi giữa các trang.
+func (s *UserService) ListUsers(ctx context.Context, params dto.ListUsersParams) (*UserPage, error) {
+	params.Normalize()
+	if params.Sort != "" {
+		params.OrderBy = append(params.OrderBy, dto.ParseSort(params.Sort)...)
+	}
+
+	query := s.client.User.Query()
+	if p := searchPredicate(params.Search); p != nil {
+		query = query.Where(p)
+	}
+	query = query.Where(filterPredicates(params.Filter)...)
+	attrPreds, err := attributePredicates(ctx, s.client.AttributeDefinition, params.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("#7 ListUsers: %w", err)
+	}
+	query = query.Where(attrPreds...)
+
+	orders, err := orderOptions(params.OrderBy)
+	if err != nil {
+		return nil, fmt.Errorf("#1 ListUsers: %w", err)
+	}
+
+	// Cursor chỉ hỗ trợ tối đa một field sắp xếp nằm trên bảng users
+	var sortField string
+	var sortDesc bool
+	if len(params.OrderBy) > 0 {
+		sortField, sortDesc = params.OrderBy[0].Field, params.OrderBy[0].Desc
+	}
+	cursorable := len(params.OrderBy) == 0 || (len(params.OrderBy) == 1 && sortableFields[sortField])
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#2 ListUsers: failed to count users: %w", err)
+	}
+
+	page := &UserPage{
+		TotalUsers: total,
+		TotalPages: (total + params.PageSize - 1) / params.PageSize,
+	}
+
+	query = query.Order(orders...).Limit(params.PageSize)
+	if params.Cursor != "" {
+		if !cursorable {
+			return nil, fmt.Errorf("#3 ListUsers: %w", ErrCursorNotSorted)
+		}
+		cursor, err := decodeUserCursor(params.Cursor)
+		if err != nil || cursor.Field != sortField || cursor.Desc != sortDesc {
+			return nil, fmt.Errorf("#4 ListUsers: %w", ErrInvalidCursor)
+		}
+		after, err := cursorPredicate(cursor)
+		if err != nil {
+			return nil, fmt.Errorf("#5 ListUsers: %w", err)
+		}
+		query = query.Where(after)
+	} else {
+		page.CurrentPage = params.Page
+		query = query.Offset((params.Page - 1) * params.PageSize)
+	}
+
+	users, err := query.WithMemberships().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#6 ListUsers: failed to retrieve users: %w", err)
+	}
+	page.Users = users
+
+	if cursorable && len(users) == params.PageSize {
+		last := users[len(users)-1]
+		page.NextCursor = encodeUserCursor(userCursor{
+			ID:    last.ID,
+			Field: sortField,
+			Desc:  sortDesc,
+			Value: cursorValue(last, sortField),
+		})
+	}
+
+	return page, nil
+}
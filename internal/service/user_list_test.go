@@ -0,0 +1,118 @@
+package service
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+)
+
+func TestUserCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor userCursor
+	}{
+		{name: "theo id", cursor: userCursor{ID: 42}},
+		{name: "theo tên", cursor: userCursor{ID: 42, Field: user.FieldFirstName, Value: "Ánh"}},
+		{name: "giảm dần theo thời gian", cursor: userCursor{ID: 7, Field: user.FieldCreatedAt, Desc: true, Value: "2024-01-02T03:04:05.123456789Z"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeUserCursor(encodeUserCursor(tt.cursor))
+			if err != nil {
+				t.Fatalf("decodeUserCursor: %v", err)
+			}
+			if !reflect.DeepEqual(*got, tt.cursor) {
+				t.Errorf("decodeUserCursor = %+v, want %+v", *got, tt.cursor)
+			}
+		})
+	}
+}
+
+func TestDecodeUserCursorInvalid(t *testing.T) {
+	encode := func(s string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(s))
+	}
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{name: "rỗng", in: ""},
+		{name: "không phải base64", in: "!!!"},
+		{name: "không phải JSON", in: encode("id=1")},
+		{name: "thiếu id", in: encode(`{"f":"first_name","v":"An"}`)},
+		{name: "id âm", in: encode(`{"id":-1}`)},
+		{name: "sai kiểu", in: encode(`{"id":"1"}`)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodeUserCursor(tt.in); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("decodeUserCursor(%q) error = %v, want %v", tt.in, err, ErrInvalidCursor)
+			}
+		})
+	}
+}
+
+func TestCursorPredicate(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 123000000, time.UTC)
+	tests := []struct {
+		name     string
+		cursor   userCursor
+		wantSQL  string
+		wantArgs []any
+		wantErr  error
+	}{
+		{
+			name:     "theo id",
+			cursor:   userCursor{ID: 5},
+			wantSQL:  `SELECT * FROM "users" WHERE "users"."id" > $1`,
+			wantArgs: []any{5},
+		},
+		{
+			name:     "tăng dần theo tên",
+			cursor:   userCursor{ID: 5, Field: user.FieldFirstName, Value: "An"},
+			wantSQL:  `SELECT * FROM "users" WHERE "users"."first_name" > $1 OR ("users"."first_name" = $2 AND "users"."id" > $3)`,
+			wantArgs: []any{"An", "An", 5},
+		},
+		{
+			name:     "giảm dần theo thời gian tạo",
+			cursor:   userCursor{ID: 5, Field: user.FieldCreatedAt, Desc: true, Value: createdAt.Format(time.RFC3339Nano)},
+			wantSQL:  `SELECT * FROM "users" WHERE "users"."created_at" < $1 OR ("users"."created_at" = $2 AND "users"."id" > $3)`,
+			wantArgs: []any{createdAt, createdAt, 5},
+		},
+		{
+			name:    "thời gian không hợp lệ",
+			cursor:  userCursor{ID: 5, Field: user.FieldUpdatedAt, Value: "yesterday"},
+			wantErr: ErrInvalidCursor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := cursorPredicate(&tt.cursor)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("cursorPredicate error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("cursorPredicate: %v", err)
+			}
+			s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table(user.Table))
+			p(s)
+			query, args := s.Query()
+			if query != tt.wantSQL {
+				t.Errorf("query = %s\nwant    %s", query, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
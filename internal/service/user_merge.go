@@ -0,0 +1,404 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/auditlog"
+	"github.com/huynhthanhthao/hrm_user_service/ent/groupmember"
+	"github.com/huynhthanhthao/hrm_user_service/ent/loginevent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/membership"
+	"github.com/huynhthanhthao/hrm_user_service/ent/schema"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/ent/usermerge"
+	"github.com/huynhthanhthao/hrm_user_service/ent/userversion"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+
+	permPb "github.com/longgggwwww/hrm-ms-permission/ent/proto/entpb"
+)
+
+var (
+	ErrMergeForbidden = errors.New("missing permission to merge users")
+	ErrMergeSameUser  = errors.New("cannot merge a user into itself")
+)
+
+// CanMergeUsers: lời gọi nội bộ hoặc viewer có quyền user.merge
+func CanMergeUsers(ctx context.Context) bool {
+	v := viewer.FromContext(ctx)
+	return v == nil || v.HasPerm(viewer.PermUserMerge)
+}
+
+// permRoleIDs lấy id quyền và vai trò trực tiếp của user bên permission service
+func (s *UserService) permRoleIDs(ctx context.Context, userID int) ([]string, []string, error) {
+	userIDStr := fmt.Sprintf("%d", userID)
+	permsResp, err := s.perClients.PermExt.GetUserPerms(ctx, &permPb.GetUserPermsRequest{UserId: userIDStr})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get user perms: %w", err)
+	}
+	rolesResp, err := s.perClients.PermExt.GetUserRoles(ctx, &permPb.GetUserRolesRequest{UserId: userIDStr})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get user roles: %w", err)
+	}
+
+	var permIDs, roleIDs []string
+	for _, p := range permsResp.Perms {
+		id, err := uuid.FromBytes(p.Id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid perm id: %w", err)
+		}
+		permIDs = append(permIDs, id.String())
+	}
+	for _, r := range rolesResp.Roles {
+		id, err := uuid.FromBytes(r.Id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid role id: %w", err)
+		}
+		roleIDs = append(roleIDs, id.String())
+	}
+	return permIDs, roleIDs, nil
+}
+
+// unionIDs nối b vào a, bỏ các id trùng và giữ thứ tự
+func unionIDs(a, b []string) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	res := make([]string, 0, len(a)+len(b))
+	for _, id := range append(append([]string{}, a...), b...) {
+		if !seen[id] {
+			seen[id] = true
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+// MergeUsers gộp user mergedID vào survivorID:
+//   - membership ở tổ chức survivor chưa tham gia được chuyển sang survivor, phần còn lại bị xóa
+//   - account và hồ sơ nhân sự được chuyển sang nếu survivor chưa có; account thừa bị xóa mềm
+//   - thành viên nhóm được chuyển sang survivor; nhóm cả hai cùng tham gia giữ vai trò cao hơn
+//   - lịch sử đăng nhập, lịch sử thay đổi thông tin và audit log của user bị gộp được chuyển sang survivor
+//   - email, địa chỉ, ảnh đại diện còn trống của survivor được lấy từ user bị gộp
+//   - quyền và vai trò bên permission service được hợp nhất vào survivor
+//
+// User bị gộp bị xóa mềm và để lại bản ghi UserMerge để chuyển hướng id cũ.
+func (s *UserService) MergeUsers(ctx context.Context, survivorID, mergedID int) (*ent.User, error) {
+	if !CanMergeUsers(ctx) {
+		return nil, fmt.Errorf("#1 MergeUsers: %w", ErrMergeForbidden)
+	}
+	if survivorID <= 0 || mergedID <= 0 {
+		return nil, errors.New("#2 MergeUsers: invalid user ID")
+	}
+	if survivorID == mergedID {
+		return nil, fmt.Errorf("#3 MergeUsers: %w", ErrMergeSameUser)
+	}
+	if s.perClients.PermExt == nil {
+		return nil, errors.New("#4 MergeUsers: permission client is not initialized")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	load := func(id int) (*ent.User, error) {
+		return tx.User.Query().
+			Where(user.ID(id)).
+			WithAccount().
+			WithMemberships().
+			WithProfile().
+			Only(ctx)
+	}
+	survivor, err := load(survivorID)
+	if err != nil {
+		return nil, fmt.Errorf("#5 MergeUsers: survivor not found: %w", err)
+	}
+	merged, err := load(mergedID)
+	if err != nil {
+		return nil, fmt.Errorf("#6 MergeUsers: merged user not found: %w", err)
+	}
+
+	// Đọc quyền trước khi ghi để lỗi permission service không để lại dữ liệu gộp dở
+	survivorPerms, survivorRoles, err := s.permRoleIDs(ctx, survivorID)
+	if err != nil {
+		return nil, fmt.Errorf("#7 MergeUsers: %w", err)
+	}
+	mergedPerms, mergedRoles, err := s.permRoleIDs(ctx, mergedID)
+	if err != nil {
+		return nil, fmt.Errorf("#8 MergeUsers: %w", err)
+	}
+
+	// Membership
+	survivorOrgs := make(map[int64]bool, len(survivor.Edges.Memberships))
+	for _, m := range survivor.Edges.Memberships {
+		survivorOrgs[m.OrgID] = true
+	}
+	var movedOrgIDs []int64
+	for _, m := range merged.Edges.Memberships {
+		if survivorOrgs[m.OrgID] {
+			continue
+		}
+		update := tx.Membership.UpdateOneID(m.ID).SetUserID(survivorID)
+		// Giữ tổ chức mặc định của survivor
+		if len(survivor.Edges.Memberships) > 0 {
+			update = update.SetIsDefault(false)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return nil, fmt.Errorf("#9 MergeUsers: failed to move membership: %w", err)
+		}
+		movedOrgIDs = append(movedOrgIDs, m.OrgID)
+	}
+	if _, err := tx.Membership.Delete().Where(membership.HasUserWith(user.ID(mergedID))).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#10 MergeUsers: failed to delete memberships: %w", err)
+	}
+
+	// Account
+	var mergedUsername *string
+	if acc := merged.Edges.Account; acc != nil {
+		if survivor.Edges.Account == nil {
+			if err := tx.Account.UpdateOneID(acc.ID).SetUserID(survivorID).Exec(ctx); err != nil {
+				return nil, fmt.Errorf("#11 MergeUsers: failed to move account: %w", err)
+			}
+		} else {
+			if err := tx.Account.DeleteOneID(acc.ID).Exec(ctx); err != nil {
+				return nil, fmt.Errorf("#12 MergeUsers: failed to delete account: %w", err)
+			}
+			mergedUsername = &acc.Username
+		}
+	}
+
+	// Hồ sơ nhân sự
+	if p := merged.Edges.Profile; p != nil && survivor.Edges.Profile == nil {
+		if err := tx.Profile.UpdateOneID(p.ID).SetUserID(survivorID).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("#13 MergeUsers: failed to move profile: %w", err)
+		}
+	}
+
+	// Nhóm
+	if err := mergeGroupMembers(ctx, tx, survivorID, mergedID); err != nil {
+		return nil, fmt.Errorf("#14 MergeUsers: failed to move group memberships: %w", err)
+	}
+
+	// Lịch sử đăng nhập và audit log chuyển sang survivor để không mất khi user bị gộp bị purge.
+	// Chạy trước khi xóa mềm: các dòng audit do chính lần gộp này tạo vẫn gắn với user bị gộp.
+	// user_id/target_user_id là field immutable nên được đổi thẳng bằng câu UPDATE.
+	if err := tx.LoginEvent.Update().
+		Where(loginevent.UserID(mergedID)).
+		Modify(func(u *sql.UpdateBuilder) {
+			u.Set(loginevent.FieldUserID, survivorID)
+		}).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#15 MergeUsers: failed to move login history: %w", err)
+	}
+	if err := tx.AuditLog.Update().
+		Where(auditlog.TargetUserID(mergedID)).
+		Modify(func(u *sql.UpdateBuilder) {
+			u.Set(auditlog.FieldTargetUserID, survivorID)
+		}).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#16 MergeUsers: failed to move audit logs: %w", err)
+	}
+
+	// Xóa mềm trước để phone/email của user bị gộp không vướng ràng buộc unique khi chép sang survivor
+	if err := tx.User.DeleteOneID(mergedID).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#17 MergeUsers: failed to delete merged user: %w", err)
+	}
+
+	update := tx.User.UpdateOneID(survivorID).AddPermVersion(1)
+	if survivor.Email == nil && merged.Email != nil {
+		update = update.SetEmail(*merged.Email)
+	}
+	if survivor.WardCode == nil && survivor.ProvinceCode == nil {
+		update = update.SetNillableWardCode(merged.WardCode).SetNillableProvinceCode(merged.ProvinceCode)
+	}
+	if survivor.Address == nil && merged.Address != nil {
+		update = update.SetAddress(*merged.Address)
+	}
+	if survivor.Avatar == nil && merged.Avatar != nil {
+		update = update.SetAvatar(*merged.Avatar)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#18 MergeUsers: failed to update survivor: %w", err)
+	}
+	// Chạy sau khi cập nhật survivor để phiên bản mới nhất là thông tin sau khi gộp
+	if err := mergeUserVersions(ctx, tx, survivorID, mergedID); err != nil {
+		return nil, fmt.Errorf("#19 MergeUsers: failed to move user versions: %w", err)
+	}
+
+	// Cả hai user đang hoạt động nên bản ghi chuyển hướng cũ của họ (user được khôi phục trước khi
+	// RestoreUser chặn user đã gộp) đã lỗi thời; giữ lại thì lần gộp này tạo chuyển hướng về chính nó
+	if _, err := tx.UserMerge.Delete().
+		Where(usermerge.MergedUserIDIn(survivorID, mergedID)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#20 MergeUsers: failed to delete stale merge records: %w", err)
+	}
+	record := tx.UserMerge.Create().
+		SetMergedUserID(mergedID).
+		SetSurvivorUserID(survivorID).
+		SetNillableMergedUsername(mergedUsername).
+		SetMovedOrgIds(movedOrgIDs)
+	if v := viewer.FromContext(ctx); v != nil {
+		record = record.SetMergedBy(v.UserID)
+	}
+	if err := record.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#21 MergeUsers: failed to create merge record: %w", err)
+	}
+	// Các id đã gộp vào user bị gộp trước đó chuyển thẳng tới survivor
+	if err := tx.UserMerge.Update().
+		Where(usermerge.SurvivorUserID(mergedID)).
+		SetSurvivorUserID(survivorID).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#22 MergeUsers: failed to update merge records: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	// Permission service chỉ được gọi sau khi gộp xong: commit lỗi thì survivor không nhận quyền của user bị gộp.
+	// Lỗi ở bước này không hoàn tác việc gộp; quyền của user bị gộp chỉ bị xóa sau khi hợp nhất thành công nên không bị mất.
+	if err := s.UpdateUserPerms(ctx, survivorID, unionIDs(survivorPerms, mergedPerms)); err != nil {
+		return nil, fmt.Errorf("#23 MergeUsers: users merged but permissions were not: %w", err)
+	}
+	if err := s.UpdateUserRoles(ctx, survivorID, unionIDs(survivorRoles, mergedRoles)); err != nil {
+		return nil, fmt.Errorf("#24 MergeUsers: users merged but roles were not: %w", err)
+	}
+
+	// Quyền của user bị gộp đã được hợp nhất vào survivor
+	mergedIDStr := fmt.Sprintf("%d", mergedID)
+	if _, err := s.perClients.PermExt.DeleteUserPermsByUserID(ctx, &permPb.DeleteUserPermsByUserIDRequest{
+		UserId: mergedIDStr,
+	}); err != nil {
+		return nil, fmt.Errorf("#25 MergeUsers: failed to delete merged user permissions: %w", err)
+	}
+	if _, err := s.perClients.PermExt.DeleteUserRolesByUserID(ctx, &permPb.DeleteUserRolesByUserIDRequest{
+		UserId: mergedIDStr,
+	}); err != nil {
+		return nil, fmt.Errorf("#26 MergeUsers: failed to delete merged user roles: %w", err)
+	}
+
+	return s.GetUserById(ctx, survivorID)
+}
+
+// mergeUserVersions chuyển lịch sử của mergedID sang survivorID, xếp sau các phiên bản của survivor.
+// Phiên bản đang hiệu lực của cả hai được đóng lại và survivor nhận một phiên bản mới nhất từ thông tin
+// hiện tại, để hook lịch sử (lấy số của phiên bản đang hiệu lực + 1) không đánh trùng số phiên bản.
+func mergeUserVersions(ctx context.Context, tx *ent.Tx, survivorID, mergedID int) error {
+	mergedCount, err := tx.UserVersion.Query().Where(userversion.UserID(mergedID)).Count(ctx)
+	if err != nil || mergedCount == 0 {
+		return err
+	}
+	lastVersion := func(userID int) (int, error) {
+		v, err := tx.UserVersion.Query().
+			Where(userversion.UserID(userID)).
+			Order(ent.Desc(userversion.FieldVersion)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		return v.Version, nil
+	}
+	survivorLast, err := lastVersion(survivorID)
+	if err != nil {
+		return err
+	}
+	mergedLast, err := lastVersion(mergedID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if err := tx.UserVersion.Update().
+		Where(userversion.UserIDIn(survivorID, mergedID), userversion.ValidToIsNil()).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if err := tx.UserVersion.Update().
+		Where(userversion.UserID(mergedID)).
+		Modify(func(u *sql.UpdateBuilder) {
+			u.Set(userversion.FieldUserID, survivorID).Add(userversion.FieldVersion, survivorLast)
+		}).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	survivor, err := tx.User.Query().Where(user.ID(survivorID)).Only(ctx)
+	if err != nil {
+		return err
+	}
+	create := schema.NewUserVersion(tx.UserVersion, survivor).
+		SetVersion(survivorLast + mergedLast + 1).
+		SetValidFrom(now)
+	if v := viewer.FromContext(ctx); v != nil && v.UserID != 0 {
+		create = create.SetChangedBy(v.UserID)
+	}
+	return create.Exec(ctx)
+}
+
+// mergeGroupMembers chuyển thành viên nhóm của mergedID sang survivorID.
+// user_id không đổi được nên bản ghi được tạo lại cho survivor (giữ vai trò và thời điểm tham gia).
+func mergeGroupMembers(ctx context.Context, tx *ent.Tx, survivorID, mergedID int) error {
+	survivorMembers, err := tx.GroupMember.Query().Where(groupmember.UserID(survivorID)).All(ctx)
+	if err != nil {
+		return err
+	}
+	mergedMembers, err := tx.GroupMember.Query().Where(groupmember.UserID(mergedID)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byGroup := make(map[int]*ent.GroupMember, len(survivorMembers))
+	for _, m := range survivorMembers {
+		byGroup[m.GroupID] = m
+	}
+
+	var builders []*ent.GroupMemberCreate
+	for _, m := range mergedMembers {
+		existing, ok := byGroup[m.GroupID]
+		if !ok {
+			builders = append(builders, tx.GroupMember.Create().
+				SetGroupID(m.GroupID).
+				SetUserID(survivorID).
+				SetRole(m.Role).
+				SetCreatedAt(m.CreatedAt))
+			continue
+		}
+		// Không để nhóm mất owner khi user bị gộp là owner còn survivor chỉ là thành viên
+		if groupRoleRank(m.Role) > groupRoleRank(existing.Role) {
+			if err := tx.GroupMember.UpdateOne(existing).SetRole(m.Role).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	if _, err := tx.GroupMember.Delete().Where(groupmember.UserID(mergedID)).Exec(ctx); err != nil {
+		return err
+	}
+	if len(builders) > 0 {
+		if _, err := tx.GroupMember.CreateBulk(builders...).Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveMergedUserID trả về id của survivor nếu id đã bị gộp
+func (s *UserService) resolveMergedUserID(ctx context.Context, id int) (int, bool, error) {
+	record, err := s.client.UserMerge.Query().
+		Where(usermerge.MergedUserID(id)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return record.SurvivorUserID, true, nil
+}
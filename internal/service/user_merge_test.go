@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/auditlog"
+	"github.com/huynhthanhthao/hrm_user_service/ent/enttest"
+	"github.com/huynhthanhthao/hrm_user_service/ent/loginevent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/schema"
+	"github.com/huynhthanhthao/hrm_user_service/ent/usermerge"
+	"github.com/huynhthanhthao/hrm_user_service/ent/userversion"
+	"github.com/huynhthanhthao/hrm_user_service/internal/fieldcrypt"
+
+	permPb "github.com/longgggwwww/hrm-ms-permission/ent/proto/entpb"
+)
+
+// fakePermExt: permission service không có quyền/vai trò nào, mọi lệnh ghi đều thành công
+type fakePermExt struct {
+	permPb.ExtServiceClient
+}
+
+func (fakePermExt) GetUserPerms(context.Context, *permPb.GetUserPermsRequest, ...grpc.CallOption) (*permPb.GetUserPermsResponse, error) {
+	return &permPb.GetUserPermsResponse{}, nil
+}
+
+func (fakePermExt) GetUserRoles(context.Context, *permPb.GetUserRolesRequest, ...grpc.CallOption) (*permPb.GetUserRolesResponse, error) {
+	return &permPb.GetUserRolesResponse{}, nil
+}
+
+func (fakePermExt) UpdateUserPerms(context.Context, *permPb.UpdateUserPermsRequest, ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (fakePermExt) UpdateUserRoles(context.Context, *permPb.UpdateUserRolesRequest, ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (fakePermExt) DeleteUserPermsByUserID(context.Context, *permPb.DeleteUserPermsByUserIDRequest, ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (fakePermExt) DeleteUserRolesByUserID(context.Context, *permPb.DeleteUserRolesByUserIDRequest, ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func newTestService(t *testing.T, name string) (*UserService, *ent.Client) {
+	t.Helper()
+	const key = "MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTIzNDU2Nzg5MDE="
+	kr, err := fieldcrypt.ParseKeyring(strings.NewReader(`{"current": "1", "keys": {"1": "` + key + `"}, "index_key": "` + key + `"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fieldcrypt.Replace(kr)
+	t.Cleanup(func() { fieldcrypt.Replace(nil) })
+
+	client := enttest.Open(t, dialect.SQLite, "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	s, err := NewUserService(client, nil, &PermissionServiceClients{PermExt: fakePermExt{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, client
+}
+
+// Gộp A vào B, khôi phục A, gộp B vào A rồi xóa A từng tạo chuyển hướng A→A
+// khiến GetUserById(A) đệ quy vô hạn
+func TestMergeRedirectAfterRestore(t *testing.T) {
+	ctx := context.Background()
+	s, client := newTestService(t, "merge_redirect")
+	a := client.User.Create().SetFirstName("A").SetLastName("A").SetPhone("+84900000001").SaveX(ctx).ID
+	b := client.User.Create().SetFirstName("B").SetLastName("B").SetPhone("+84900000002").SaveX(ctx).ID
+
+	if _, err := s.MergeUsers(ctx, b, a); err != nil {
+		t.Fatalf("MergeUsers(%d, %d): %v", b, a, err)
+	}
+	if _, err := s.RestoreUser(ctx, a); !errors.Is(err, ErrRestoreMerged) {
+		t.Fatalf("RestoreUser(%d) error = %v, want %v", a, err, ErrRestoreMerged)
+	}
+
+	// Dữ liệu cũ: A đã được khôi phục trước khi RestoreUser chặn user đã gộp
+	client.User.UpdateOneID(a).ClearDeletedAt().ExecX(schema.SkipSoftDelete(ctx))
+	if _, err := s.MergeUsers(ctx, a, b); err != nil {
+		t.Fatalf("MergeUsers(%d, %d): %v", a, b, err)
+	}
+	if n := client.UserMerge.Query().Where(usermerge.MergedUserID(a)).CountX(ctx); n != 0 {
+		t.Fatalf("merge records for survivor %d = %d, want 0", a, n)
+	}
+	if got, err := s.GetUserById(ctx, b); err != nil || got.ID != a {
+		t.Fatalf("GetUserById(%d) = %v, %v; want user %d", b, got, err, a)
+	}
+
+	client.User.DeleteOneID(a).ExecX(ctx)
+	for _, id := range []int{a, b} {
+		if _, err := s.GetUserById(ctx, id); !ent.IsNotFound(err) {
+			t.Errorf("GetUserById(%d) error = %v, want not found", id, err)
+		}
+	}
+}
+
+func TestMergeMovesHistory(t *testing.T) {
+	ctx := context.Background()
+	s, client := newTestService(t, "merge_history")
+	survivor := client.User.Create().SetFirstName("B").SetLastName("B").SetPhone("+84900000002").SaveX(ctx).ID
+	merged := client.User.Create().SetFirstName("A").SetLastName("A").SetPhone("+84900000001").SaveX(ctx).ID
+	client.User.UpdateOneID(merged).SetFirstName("An").ExecX(ctx)
+	client.LoginEvent.Create().SetUserID(merged).SetSuccess(true).ExecX(ctx)
+	client.AuditLog.Create().SetAction("user.update").SetTargetUserID(merged).ExecX(ctx)
+
+	if _, err := s.MergeUsers(ctx, survivor, merged); err != nil {
+		t.Fatalf("MergeUsers: %v", err)
+	}
+
+	if n := client.LoginEvent.Query().Where(loginevent.UserID(survivor)).CountX(ctx); n != 1 {
+		t.Errorf("login events of survivor = %d, want 1", n)
+	}
+	if n := client.AuditLog.Query().Where(auditlog.TargetUserID(survivor)).CountX(ctx); n != 1 {
+		t.Errorf("audit logs of survivor = %d, want 1", n)
+	}
+
+	// B v1, A v1-v2 xếp sau thành v2-v3, v4 là thông tin của survivor sau khi gộp
+	versions := client.UserVersion.Query().
+		Where(userversion.UserID(survivor)).
+		Order(ent.Asc(userversion.FieldVersion)).
+		AllX(ctx)
+	wantNames := []string{"B", "A", "An", "B"}
+	if len(versions) != len(wantNames) {
+		t.Fatalf("versions of survivor = %d, want %d", len(versions), len(wantNames))
+	}
+	for i, v := range versions {
+		if v.Version != i+1 || v.FirstName != wantNames[i] {
+			t.Errorf("version %d = (%d, %s), want (%d, %s)", i, v.Version, v.FirstName, i+1, wantNames[i])
+		}
+		if open := v.ValidTo == nil; open != (i == len(versions)-1) {
+			t.Errorf("version %d open = %v", v.Version, open)
+		}
+	}
+	if n := client.UserVersion.Query().Where(userversion.UserID(merged)).CountX(ctx); n != 0 {
+		t.Errorf("versions left on merged user = %d, want 0", n)
+	}
+
+	// Phiên bản tiếp theo của survivor không trùng số với lịch sử đã chuyển sang
+	client.User.UpdateOneID(survivor).SetFirstName("Bình").ExecX(ctx)
+	last := client.UserVersion.Query().
+		Where(userversion.UserID(survivor), userversion.ValidToIsNil()).
+		OnlyX(ctx)
+	if last.Version != 5 || last.FirstName != "Bình" {
+		t.Errorf("current version = (%d, %s), want (5, Bình)", last.Version, last.FirstName)
+	}
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/account"
+	"github.com/huynhthanhthao/hrm_user_service/ent/auditlog"
+	"github.com/huynhthanhthao/hrm_user_service/ent/loginevent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/profile"
+	"github.com/huynhthanhthao/hrm_user_service/ent/schema"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/ent/usermerge"
+	"github.com/huynhthanhthao/hrm_user_service/ent/userversion"
+	"github.com/huynhthanhthao/hrm_user_service/internal/audit"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+)
+
+var (
+	ErrPersonalDataForbidden = errors.New("missing permission to access personal data")
+	ErrUserAnonymized        = errors.New("user is already anonymized")
+)
+
+// Tên thay thế cho user đã ẩn danh hóa
+const AnonymizedFirstName = "Anonymized"
+
+// CanExportPersonalData: lời gọi nội bộ, chính user đó hoặc viewer có quyền user.personal_data.manage
+func CanExportPersonalData(ctx context.Context, userID int) bool {
+	v := viewer.FromContext(ctx)
+	return v == nil || v.UserID == userID || v.HasPerm(viewer.PermUserPersonalData)
+}
+
+// CanAnonymizeUser: lời gọi nội bộ hoặc viewer có quyền user.personal_data.manage
+func CanAnonymizeUser(ctx context.Context) bool {
+	v := viewer.FromContext(ctx)
+	return v == nil || v.HasPerm(viewer.PermUserPersonalData)
+}
+
+// PersonalData là toàn bộ dữ liệu cá nhân service đang lưu về một user
+type PersonalData struct {
+	ExportedAt time.Time
+	// Kèm account, membership và hồ sơ nhân sự
+	User         *ent.User
+	LoginHistory []*ent.LoginEvent
+	// Các lần đăng nhập thành công có refresh token còn hạn
+	Sessions []*ent.LoginEvent
+	// Lịch sử thông tin, mới nhất trước
+	Versions []*UserVersionEntry
+}
+
+// ExportPersonalData gom dữ liệu cá nhân của user (kể cả user đã xóa mềm) và ghi audit log.
+// User xuất dữ liệu của chính mình không bị giới hạn theo tổ chức đang chọn.
+func (s *UserService) ExportPersonalData(ctx context.Context, userID int) (*PersonalData, error) {
+	if userID <= 0 {
+		return nil, errors.New("#1 ExportPersonalData: invalid user ID")
+	}
+	if !CanExportPersonalData(ctx, userID) {
+		return nil, fmt.Errorf("#2 ExportPersonalData: %w", ErrPersonalDataForbidden)
+	}
+
+	queryCtx := schema.SkipSoftDelete(ctx)
+	if v := viewer.FromContext(ctx); v != nil && v.UserID == userID {
+		queryCtx = viewer.Unscoped(queryCtx)
+	}
+	usr, err := s.client.User.Query().
+		Where(user.ID(userID)).
+		WithAccount().
+		WithMemberships().
+		WithProfile(withProfile).
+		Only(queryCtx)
+	if err != nil {
+		return nil, fmt.Errorf("#3 ExportPersonalData: user not found: %w", err)
+	}
+
+	events, err := s.client.LoginEvent.Query().
+		Where(loginevent.UserID(userID)).
+		Order(ent.Desc(loginevent.FieldCreatedAt), ent.Desc(loginevent.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#4 ExportPersonalData: failed to query login history: %w", err)
+	}
+
+	versions, err := s.ListUserVersions(queryCtx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("#5 ExportPersonalData: %w", err)
+	}
+
+	now := time.Now()
+	data := &PersonalData{
+		ExportedAt:   now,
+		User:         usr,
+		LoginHistory: events,
+		Sessions:     []*ent.LoginEvent{},
+		Versions:     versions,
+	}
+	for _, e := range events {
+		if e.Success && e.ExpiresAt != nil && e.ExpiresAt.After(now) {
+			data.Sessions = append(data.Sessions, e)
+		}
+	}
+
+	if err := recordAudit(ctx, s.client.AuditLog, AuditActionPersonalDataExport, userID, nil); err != nil {
+		return nil, fmt.Errorf("#6 ExportPersonalData: failed to record audit log: %w", err)
+	}
+	return data, nil
+}
+
+// AnonymizeResult là kết quả ẩn danh hóa; PreviousAvatar để bên gọi dọn file ảnh trên storage
+type AnonymizeResult struct {
+	User           *ent.User
+	PreviousAvatar *string
+}
+
+// AnonymizeUser xóa dữ liệu cá nhân của user nhưng giữ lại id để các tham chiếu bên HR vẫn hợp lệ:
+//   - họ tên được thay bằng tên giả, số điện thoại bằng giá trị giả duy nhất
+//   - email, địa chỉ, ảnh đại diện, đơn vị hành chính bị xóa
+//   - hồ sơ nhân sự và account bị xóa vĩnh viễn nên user không đăng nhập được nữa
+//   - IP và user agent trong lịch sử đăng nhập, username trong bản ghi gộp bị xóa
+//   - lịch sử thông tin chỉ còn phiên bản đã ẩn danh
+//   - giá trị cũ/mới trong các audit log về user bị ẩn, chỉ giữ tên field bị đổi
+//
+// Membership được giữ; thao tác được ghi audit log cùng transaction.
+func (s *UserService) AnonymizeUser(ctx context.Context, userID int) (*AnonymizeResult, error) {
+	if userID <= 0 {
+		return nil, errors.New("#1 AnonymizeUser: invalid user ID")
+	}
+	if !CanAnonymizeUser(ctx) {
+		return nil, fmt.Errorf("#2 AnonymizeUser: %w", ErrPersonalDataForbidden)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	skipCtx := schema.SkipSoftDelete(ctx)
+	usr, err := tx.User.Query().
+		Where(user.ID(userID)).
+		WithAccount().
+		WithProfile().
+		Only(skipCtx)
+	if err != nil {
+		return nil, fmt.Errorf("#3 AnonymizeUser: user not found: %w", err)
+	}
+	if usr.AnonymizedAt != nil {
+		return nil, fmt.Errorf("#4 AnonymizeUser: %w", ErrUserAnonymized)
+	}
+
+	// Không ghi audit log tự động: dòng user.update sẽ chứa lại họ tên thật ở giá trị cũ.
+	// Thao tác được ghi bằng một dòng AuditActionAnonymize ở cuối.
+	noAuditCtx := audit.Skip(skipCtx)
+	err = tx.User.UpdateOneID(userID).
+		SetFirstName(AnonymizedFirstName).
+		SetLastName(fmt.Sprintf("#%d", userID)).
+		SetPhone(fmt.Sprintf("anonymized-%d", userID)).
+		ClearEmail().
+		ClearAddress().
+		ClearAvatar().
+		ClearWardCode().
+		ClearProvinceCode().
+		ClearCustomAttributes().
+		SetGender(user.GenderOther).
+		SetAnonymizedAt(time.Now()).
+		AddPermVersion(1).
+		Exec(noAuditCtx)
+	if err != nil {
+		return nil, fmt.Errorf("#5 AnonymizeUser: failed to update user: %w", err)
+	}
+	// Người liên hệ khẩn cấp bị xóa theo (ON DELETE CASCADE)
+	if _, err := tx.Profile.Delete().Where(profile.HasUserWith(user.ID(userID))).Exec(skipCtx); err != nil {
+		return nil, fmt.Errorf("#6 AnonymizeUser: failed to delete profile: %w", err)
+	}
+	if _, err := tx.Account.Delete().Where(account.HasUserWith(user.ID(userID))).Exec(noAuditCtx); err != nil {
+		return nil, fmt.Errorf("#7 AnonymizeUser: failed to delete account: %w", err)
+	}
+	if err := tx.LoginEvent.Update().
+		Where(loginevent.UserID(userID)).
+		ClearIP().
+		ClearUserAgent().
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#8 AnonymizeUser: failed to scrub login history: %w", err)
+	}
+	if err := tx.UserMerge.Update().
+		Where(usermerge.MergedUserID(userID)).
+		ClearMergedUsername().
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#9 AnonymizeUser: failed to scrub merge record: %w", err)
+	}
+	// Chỉ giữ phiên bản ẩn danh vừa được ghi, coi như hiệu lực từ lúc tạo user
+	if _, err := tx.UserVersion.Delete().
+		Where(userversion.UserID(userID), userversion.ValidToNotNil()).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#10 AnonymizeUser: failed to delete user versions: %w", err)
+	}
+	if err := tx.UserVersion.Update().
+		Where(userversion.UserID(userID), userversion.ValidToIsNil()).
+		SetValidFrom(usr.CreatedAt).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#11 AnonymizeUser: failed to update user version: %w", err)
+	}
+
+	if err := scrubAuditLogs(ctx, tx, userID); err != nil {
+		return nil, fmt.Errorf("#12 AnonymizeUser: failed to scrub audit logs: %w", err)
+	}
+
+	details := map[string]any{
+		"account_deleted": usr.Edges.Account != nil,
+		"profile_deleted": usr.Edges.Profile != nil,
+	}
+	if err := recordAudit(ctx, tx.AuditLog, AuditActionAnonymize, userID, details); err != nil {
+		return nil, fmt.Errorf("#13 AnonymizeUser: failed to record audit log: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.client.User.Query().
+		Where(user.ID(userID)).
+		WithMemberships().
+		Only(skipCtx)
+	if err != nil {
+		return nil, fmt.Errorf("#14 AnonymizeUser: failed to reload user: %w", err)
+	}
+	return &AnonymizeResult{User: updated, PreviousAvatar: usr.Avatar}, nil
+}
+
+// scrubAuditLogs ẩn giá trị trong changes của các audit log về userID.
+// changes là field immutable nên được ghi đè thẳng bằng câu UPDATE.
+func scrubAuditLogs(ctx context.Context, tx *ent.Tx, userID int) error {
+	logs, err := tx.AuditLog.Query().
+		Where(auditlog.TargetUserID(userID), auditlog.ChangesNotNil()).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, l := range logs {
+		data, err := json.Marshal(audit.RedactChanges(l.Changes))
+		if err != nil {
+			return err
+		}
+		if err := tx.AuditLog.UpdateOneID(l.ID).
+			Modify(func(u *entsql.UpdateBuilder) {
+				u.Set(auditlog.FieldChanges, data)
+			}).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
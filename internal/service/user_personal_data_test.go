@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent/auditlog"
+	"github.com/huynhthanhthao/hrm_user_service/internal/audit"
+)
+
+func TestAnonymizeUserScrubsAuditLogs(t *testing.T) {
+	ctx := context.Background()
+	s, client := newTestService(t, "anonymize_audit")
+	client.Use(audit.Hook())
+
+	id := client.User.Create().
+		SetFirstName("Nguyễn").
+		SetLastName("Văn An").
+		SetPhone("+84912345678").
+		SetEmail("an@company.vn").
+		SaveX(ctx).ID
+	client.User.UpdateOneID(id).SetFirstName("Nguyễn Thị").SetAddress("12 Lê Lợi, Hà Nội").ExecX(ctx)
+	updates := client.AuditLog.Query().Where(auditlog.Action("user.update")).CountX(ctx)
+
+	if _, err := s.AnonymizeUser(ctx, id); err != nil {
+		t.Fatalf("AnonymizeUser: %v", err)
+	}
+
+	if n := client.AuditLog.Query().Where(auditlog.Action("user.update")).CountX(ctx); n != updates {
+		t.Errorf("user.update audit logs = %d, want %d (anonymization must not be logged as an update)", n, updates)
+	}
+	if n := client.AuditLog.Query().Where(auditlog.Action(AuditActionAnonymize), auditlog.TargetUserID(id)).CountX(ctx); n != 1 {
+		t.Errorf("%s audit logs = %d, want 1", AuditActionAnonymize, n)
+	}
+	for _, l := range client.AuditLog.Query().Where(auditlog.TargetUserID(id)).AllX(ctx) {
+		data, err := json.Marshal(l.Changes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, pii := range []string{"Nguyễn", "Văn An", "0912", "an@", "Hà Nội"} {
+			if strings.Contains(string(data), pii) {
+				t.Errorf("audit log %d (%s) still contains %q: %s", l.ID, l.Action, pii, data)
+			}
+		}
+	}
+	created := client.AuditLog.Query().Where(auditlog.Action("user.create"), auditlog.TargetUserID(id)).OnlyX(ctx)
+	if _, ok := created.Changes["first_name"]; !ok {
+		t.Errorf("user.create changes = %v, want field names kept", created.Changes)
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/schema"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/internal/audit"
+	"github.com/huynhthanhthao/hrm_user_service/internal/fieldcrypt"
+	"github.com/huynhthanhthao/hrm_user_service/internal/phone"
+)
+
+// PhoneDuplicate là nhóm user (chưa xóa) có cùng số điện thoại sau khi chuẩn hóa
+type PhoneDuplicate struct {
+	Phone   string
+	UserIDs []int
+}
+
+// InvalidPhone là số điện thoại cũ không chuẩn hóa được, cần sửa tay
+type InvalidPhone struct {
+	UserID int
+	Phone  string
+	Err    error
+}
+
+type PhoneMigrationReport struct {
+	Total      int
+	Normalized int
+	Invalid    []InvalidPhone
+	Duplicates []PhoneDuplicate
+	Failed     []FailedRow
+}
+
+// NormalizePhones chuyển số điện thoại đã lưu (kể cả user đã xóa mềm) sang E.164, theo từng lô.
+// Số đã là E.164 nhưng chưa có blind index (dữ liệu cũ) cũng được lưu lại để tính phone_hash, nhờ đó trùng lặp
+// với số cũ được phát hiện ở đây thay vì khi mã hóa lại.
+// User chưa xóa mà số sau chuẩn hóa trùng với user khác (unique index của blind index) được giữ nguyên
+// và liệt kê trong báo cáo để xử lý thủ công; số không hợp lệ cũng được giữ nguyên. User đã ẩn danh hóa được bỏ qua.
+func (s *UserService) NormalizePhones(ctx context.Context) (*PhoneMigrationReport, error) {
+	// Chỉ đổi định dạng số, không ghi audit log cho từng user
+	skipCtx := schema.SkipSoftDelete(audit.Skip(ctx))
+	report := &PhoneMigrationReport{}
+	duplicates := make(map[string]*PhoneDuplicate)
+	lastID := 0
+	for {
+		users, err := s.client.User.Query().
+			Where(user.IDGT(lastID), user.AnonymizedAtIsNil()).
+			Select(user.FieldID, user.FieldPhone, user.FieldPhoneHash, user.FieldDeletedAt).
+			Order(user.ByID()).
+			Limit(backfillBatchSize).
+			All(skipCtx)
+		if err != nil {
+			return report, fmt.Errorf("#1 NormalizePhones: failed to query users: %w", err)
+		}
+		if len(users) == 0 {
+			break
+		}
+		lastID = users[len(users)-1].ID
+		report.Total += len(users)
+
+		for _, u := range users {
+			n, err := phone.Normalize(u.Phone)
+			if err != nil {
+				report.Invalid = append(report.Invalid, InvalidPhone{UserID: u.ID, Phone: u.Phone, Err: err})
+				continue
+			}
+			if n == u.Phone && u.PhoneHash != nil {
+				continue
+			}
+			err = s.client.User.UpdateOneID(u.ID).SetPhone(n).Exec(skipCtx)
+			if ent.IsConstraintError(err) && u.DeletedAt == nil {
+				if err := s.addPhoneDuplicate(skipCtx, duplicates, n, u.ID); err != nil {
+					report.Failed = append(report.Failed, FailedRow{Table: user.Table, ID: u.ID, Err: err})
+				}
+				continue
+			}
+			if err != nil {
+				report.Failed = append(report.Failed, FailedRow{Table: user.Table, ID: u.ID, Err: err})
+				continue
+			}
+			report.Normalized++
+		}
+	}
+
+	for _, d := range duplicates {
+		report.Duplicates = append(report.Duplicates, *d)
+	}
+	sort.Slice(report.Duplicates, func(i, j int) bool {
+		return report.Duplicates[i].Phone < report.Duplicates[j].Phone
+	})
+	return report, nil
+}
+
+// addPhoneDuplicate ghi nhận user id bị trùng số n với user chưa xóa đã mang số này
+func (s *UserService) addPhoneDuplicate(ctx context.Context, duplicates map[string]*PhoneDuplicate, n string, id int) error {
+	d, ok := duplicates[n]
+	if !ok {
+		h, err := fieldcrypt.PhoneIndex(n)
+		if err != nil {
+			return err
+		}
+		ownerID, err := s.client.User.Query().
+			Where(user.PhoneHash(h), user.DeletedAtIsNil()).
+			OnlyID(ctx)
+		if err != nil {
+			return err
+		}
+		d = &PhoneDuplicate{Phone: n, UserIDs: []int{ownerID}}
+		duplicates[n] = d
+	}
+	d.UserIDs = append(d.UserIDs, id)
+	return nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+)
+
+func TestNormalizePhonesLegacyE164(t *testing.T) {
+	tests := []struct {
+		name   string
+		phones []string
+	}{
+		{name: "số E.164 cũ tạo trước", phones: []string{"+84912345678", "0912345678"}},
+		{name: "số E.164 cũ tạo sau", phones: []string{"0912345678", "+84912345678"}},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s, client := newTestService(t, fmt.Sprintf("normalize_phones%d", i))
+
+			// Dòng cũ: số plaintext, chưa có blind index
+			var ids []int
+			for j, p := range tt.phones {
+				id := client.User.Create().SetFirstName("U").SetLastName("U").SetPhone(fmt.Sprintf("+8490000000%d", j)).SaveX(ctx).ID
+				client.User.UpdateOneID(id).Modify(func(u *sql.UpdateBuilder) {
+					u.Set(user.FieldPhone, p).SetNull(user.FieldPhoneHash)
+				}).ExecX(ctx)
+				ids = append(ids, id)
+			}
+
+			report, err := s.NormalizePhones(ctx)
+			if err != nil {
+				t.Fatalf("NormalizePhones: %v", err)
+			}
+			want := []PhoneDuplicate{{Phone: "+84912345678", UserIDs: ids}}
+			if !reflect.DeepEqual(report.Duplicates, want) {
+				t.Errorf("Duplicates = %+v, want %+v", report.Duplicates, want)
+			}
+			if len(report.Failed) != 0 {
+				t.Errorf("Failed = %v, want none", report.Failed)
+			}
+			if n := client.User.Query().Where(user.PhoneHashNotNil()).CountX(ctx); n != 1 {
+				t.Errorf("users with phone_hash = %d, want 1", n)
+			}
+		})
+	}
+}
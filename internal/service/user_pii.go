@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+	"github.com/huynhthanhthao/hrm_user_service/ent/profile"
+	"github.com/huynhthanhthao/hrm_user_service/ent/schema"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/ent/userversion"
+	"github.com/huynhthanhthao/hrm_user_service/internal/audit"
+	"github.com/huynhthanhthao/hrm_user_service/internal/fieldcrypt"
+	"github.com/huynhthanhthao/hrm_user_service/internal/phone"
+	"github.com/huynhthanhthao/hrm_user_service/internal/unaccent"
+)
+
+// exactPIIMatch khớp chính xác số điện thoại hoặc email qua blind index; nil nếu w không phải
+// số điện thoại/email hợp lệ
+func exactPIIMatch(w string) predicate.User {
+	if n, err := phone.Normalize(w); err == nil {
+		if h, err := fieldcrypt.PhoneIndex(n); err == nil {
+			return user.PhoneHash(h)
+		}
+		return nil
+	}
+	if strings.Contains(w, "@") {
+		if h, err := fieldcrypt.EmailIndex(w); err == nil {
+			return user.EmailHash(h)
+		}
+	}
+	return nil
+}
+
+// ReencryptReport: Conflicts là các user không mã hóa được vì blind index trùng với user khác
+// (ví dụ email chỉ khác hoa thường), cần xử lý thủ công; Failed là các dòng lỗi đã bỏ qua
+type ReencryptReport struct {
+	KeyID     string
+	Users     int
+	Profiles  int
+	Versions  int
+	Conflicts []int
+	Failed    []FailedRow
+}
+
+// ReencryptPII mã hóa lại (bằng key hiện tại của keyring) các cột PII còn là plaintext cũ hoặc được
+// mã hóa bằng key cũ, đồng thời tính blind index còn thiếu và bỏ phone/email khỏi search_text cũ.
+// Chạy cho cả user đã xóa mềm; dùng sau khi bật mã hóa hoặc khi đổi key hiện tại.
+// Nội dung user không đổi nên giữ nguyên version (ETag) và updated_at; dòng lỗi được ghi vào báo cáo và bỏ qua.
+func (s *UserService) ReencryptPII(ctx context.Context) (*ReencryptReport, error) {
+	kr := fieldcrypt.Default()
+	if kr == nil {
+		return nil, fmt.Errorf("#1 ReencryptPII: %w", fieldcrypt.ErrNoKeyring)
+	}
+	report := &ReencryptReport{KeyID: kr.CurrentKeyID()}
+	// Chỉ đổi cách lưu, nội dung không đổi nên không ghi audit log
+	skipCtx := schema.SkipSoftDelete(audit.Skip(ctx))
+
+	needs := func(v sql.NullString) bool {
+		return v.Valid && kr.NeedsReencrypt(v.String)
+	}
+
+	// Đọc giá trị thô (chưa giải mã) để biết dòng nào cần mã hóa lại
+	lastID := 0
+	for {
+		var rows []struct {
+			ID        int            `sql:"id"`
+			Phone     sql.NullString `sql:"phone"`
+			PhoneHash sql.NullString `sql:"phone_hash"`
+			Email     sql.NullString `sql:"email"`
+			EmailHash sql.NullString `sql:"email_hash"`
+			Address   sql.NullString `sql:"address"`
+		}
+		err := s.client.User.Query().
+			Where(user.IDGT(lastID)).
+			Order(user.ByID()).
+			Limit(backfillBatchSize).
+			Modify(func(sel *entsql.Selector) {
+				sel.Select(
+					sel.C(user.FieldID), sel.C(user.FieldPhone), sel.C(user.FieldPhoneHash),
+					sel.C(user.FieldEmail), sel.C(user.FieldEmailHash), sel.C(user.FieldAddress),
+				)
+			}).
+			Scan(skipCtx, &rows)
+		if err != nil {
+			return report, fmt.Errorf("#2 ReencryptPII: failed to scan users: %w", err)
+		}
+		if len(rows) == 0 {
+			break
+		}
+		lastID = rows[len(rows)-1].ID
+
+		var ids []int
+		for _, r := range rows {
+			if needs(r.Phone) || !r.PhoneHash.Valid ||
+				needs(r.Email) || (r.Email.Valid && !r.EmailHash.Valid) ||
+				needs(r.Address) {
+				ids = append(ids, r.ID)
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		users, err := s.client.User.Query().Where(user.IDIn(ids...)).All(skipCtx)
+		if err != nil {
+			return report, fmt.Errorf("#3 ReencryptPII: failed to load users: %w", err)
+		}
+		for _, u := range users {
+			// User được cập nhật đồng thời thì bỏ qua, lần chạy sau sẽ xử lý
+			err := s.client.User.UpdateOneID(u.ID).
+				Where(user.Version(u.Version)).
+				SetPhone(u.Phone).
+				SetNillableEmail(u.Email).
+				SetNillableAddress(u.Address).
+				SetSearchText(unaccent.SearchText(u.FirstName, u.LastName)).
+				SetVersion(u.Version).
+				SetUpdatedAt(u.UpdatedAt).
+				Exec(skipCtx)
+			if ent.IsNotFound(err) {
+				continue
+			}
+			if ent.IsConstraintError(err) {
+				report.Conflicts = append(report.Conflicts, u.ID)
+				continue
+			}
+			if err != nil {
+				report.Failed = append(report.Failed, FailedRow{Table: user.Table, ID: u.ID, Err: err})
+				continue
+			}
+			report.Users++
+		}
+	}
+
+	lastID = 0
+	for {
+		var rows []struct {
+			ID             int            `sql:"id"`
+			NationalID     sql.NullString `sql:"national_id"`
+			NationalIDHash sql.NullString `sql:"national_id_hash"`
+		}
+		err := s.client.Profile.Query().
+			Where(profile.IDGT(lastID)).
+			Order(profile.ByID()).
+			Limit(backfillBatchSize).
+			Modify(func(sel *entsql.Selector) {
+				sel.Select(sel.C(profile.FieldID), sel.C(profile.FieldNationalID), sel.C(profile.FieldNationalIDHash))
+			}).
+			Scan(ctx, &rows)
+		if err != nil {
+			return report, fmt.Errorf("#4 ReencryptPII: failed to scan profiles: %w", err)
+		}
+		if len(rows) == 0 {
+			break
+		}
+		lastID = rows[len(rows)-1].ID
+
+		var ids []int
+		for _, r := range rows {
+			if needs(r.NationalID) || (r.NationalID.Valid && !r.NationalIDHash.Valid) {
+				ids = append(ids, r.ID)
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		profiles, err := s.client.Profile.Query().Where(profile.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return report, fmt.Errorf("#5 ReencryptPII: failed to load profiles: %w", err)
+		}
+		for _, p := range profiles {
+			err := s.client.Profile.UpdateOneID(p.ID).
+				SetNillableNationalID(p.NationalID).
+				SetUpdatedAt(p.UpdatedAt).
+				Exec(ctx)
+			if err != nil {
+				report.Failed = append(report.Failed, FailedRow{Table: profile.Table, ID: p.ID, Err: err})
+				continue
+			}
+			report.Profiles++
+		}
+	}
+
+	lastID = 0
+	for {
+		var rows []struct {
+			ID      int            `sql:"id"`
+			Phone   sql.NullString `sql:"phone"`
+			Email   sql.NullString `sql:"email"`
+			Address sql.NullString `sql:"address"`
+		}
+		err := s.client.UserVersion.Query().
+			Where(userversion.IDGT(lastID)).
+			Order(userversion.ByID()).
+			Limit(backfillBatchSize).
+			Modify(func(sel *entsql.Selector) {
+				sel.Select(
+					sel.C(userversion.FieldID), sel.C(userversion.FieldPhone),
+					sel.C(userversion.FieldEmail), sel.C(userversion.FieldAddress),
+				)
+			}).
+			Scan(ctx, &rows)
+		if err != nil {
+			return report, fmt.Errorf("#6 ReencryptPII: failed to scan user versions: %w", err)
+		}
+		if len(rows) == 0 {
+			break
+		}
+		lastID = rows[len(rows)-1].ID
+
+		var ids []int
+		for _, r := range rows {
+			if needs(r.Phone) || needs(r.Email) || needs(r.Address) {
+				ids = append(ids, r.ID)
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		versions, err := s.client.UserVersion.Query().Where(userversion.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return report, fmt.Errorf("#7 ReencryptPII: failed to load user versions: %w", err)
+		}
+		for _, v := range versions {
+			err := s.client.UserVersion.UpdateOneID(v.ID).
+				SetPhone(v.Phone).
+				SetNillableEmail(v.Email).
+				SetNillableAddress(v.Address).
+				Exec(ctx)
+			if err != nil {
+				report.Failed = append(report.Failed, FailedRow{Table: userversion.Table, ID: v.ID, Err: err})
+				continue
+			}
+			report.Versions++
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/emergencycontact"
+	"github.com/huynhthanhthao/hrm_user_service/ent/profile"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/phone"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+)
+
+var ErrProfileForbidden = errors.New("missing permission to access user profile")
+
+// CanReadProfile: lời gọi nội bộ, chính user đó hoặc viewer có quyền user.profile.read
+func CanReadProfile(ctx context.Context, userID int) bool {
+	v := viewer.FromContext(ctx)
+	return v == nil || v.UserID == userID || v.HasPerm(viewer.PermUserProfileRead)
+}
+
+// CanUpdateProfile: lời gọi nội bộ hoặc viewer có quyền user.profile.update
+func CanUpdateProfile(ctx context.Context) bool {
+	v := viewer.FromContext(ctx)
+	return v == nil || v.HasPerm(viewer.PermUserProfileUpdate)
+}
+
+// withProfile nạp người liên hệ khẩn cấp theo đúng thứ tự đã lưu
+func withProfile(q *ent.ProfileQuery) {
+	q.WithEmergencyContacts(func(q *ent.EmergencyContactQuery) {
+		q.Order(ent.Asc(emergencycontact.FieldPosition))
+	})
+}
+
+// GetUserProfile trả về hồ sơ nhân sự của user; nil nếu user chưa có hồ sơ
+func (s *UserService) GetUserProfile(ctx context.Context, userID int) (*ent.Profile, error) {
+	if !CanReadProfile(ctx, userID) {
+		return nil, fmt.Errorf("#1 GetUserProfile: %w", ErrProfileForbidden)
+	}
+
+	usr, err := s.client.User.Query().
+		Where(user.ID(userID)).
+		WithProfile(withProfile).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#2 GetUserProfile: user not found: %w", err)
+	}
+	return usr.Edges.Profile, nil
+}
+
+func stringValue(v *wrapperspb.StringValue) string {
+	if v == nil {
+		return ""
+	}
+	return strings.TrimSpace(v.Value)
+}
+
+// profileDTOFromProto chuyển request gRPC sang DTO để dùng chung rule validate với REST
+func profileDTOFromProto(in *userPb.UserProfile) dto.UserProfileDTO {
+	out := dto.UserProfileDTO{
+		DateOfBirth:           stringValue(in.DateOfBirth),
+		PlaceOfBirth:          stringValue(in.PlaceOfBirth),
+		NationalID:            stringValue(in.NationalId),
+		NationalIDIssueDate:   stringValue(in.NationalIdIssueDate),
+		NationalIDIssuePlace:  stringValue(in.NationalIdIssuePlace),
+		Ethnicity:             stringValue(in.Ethnicity),
+		Religion:              stringValue(in.Religion),
+		MaritalStatus:         stringValue(in.MaritalStatus),
+		TaxCode:               stringValue(in.TaxCode),
+		SocialInsuranceNumber: stringValue(in.SocialInsuranceNumber),
+	}
+	for _, c := range in.EmergencyContacts {
+		out.EmergencyContacts = append(out.EmergencyContacts, dto.EmergencyContactDTO{
+			Name:         strings.TrimSpace(c.Name),
+			Relationship: strings.TrimSpace(c.Relationship),
+			Phone:        strings.TrimSpace(c.Phone),
+			Address:      stringValue(c.Address),
+		})
+	}
+	return out
+}
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// parseProfileDate đọc ngày YYYY-MM-DD; chuỗi rỗng trả về nil
+func parseProfileDate(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(dto.ProfileDateLayout, s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// applyProfile ghi đè toàn bộ field của hồ sơ: giá trị rỗng thì xóa field (khi cập nhật)
+func applyProfile(m *ent.ProfileMutation, in dto.UserProfileDTO, dob, issueDate *time.Time, update bool) {
+	setTime := func(v *time.Time, set func(time.Time), clear func()) {
+		switch {
+		case v != nil:
+			set(*v)
+		case update:
+			clear()
+		}
+	}
+	setString := func(v string, set func(string), clear func()) {
+		switch {
+		case v != "":
+			set(v)
+		case update:
+			clear()
+		}
+	}
+	setTime(dob, m.SetDateOfBirth, m.ClearDateOfBirth)
+	setTime(issueDate, m.SetNationalIDIssueDate, m.ClearNationalIDIssueDate)
+	setString(in.PlaceOfBirth, m.SetPlaceOfBirth, m.ClearPlaceOfBirth)
+	setString(in.NationalID, m.SetNationalID, m.ClearNationalID)
+	setString(in.NationalIDIssuePlace, m.SetNationalIDIssuePlace, m.ClearNationalIDIssuePlace)
+	setString(in.Ethnicity, m.SetEthnicity, m.ClearEthnicity)
+	setString(in.Religion, m.SetReligion, m.ClearReligion)
+	setString(in.TaxCode, m.SetTaxCode, m.ClearTaxCode)
+	setString(in.SocialInsuranceNumber, m.SetSocialInsuranceNumber, m.ClearSocialInsuranceNumber)
+	setString(in.MaritalStatus, func(v string) { m.SetMaritalStatus(profile.MaritalStatus(v)) }, m.ClearMaritalStatus)
+}
+
+// UpdateUserProfile ghi đè hồ sơ nhân sự của user (tạo mới nếu chưa có).
+// Danh sách người liên hệ khẩn cấp được thay toàn bộ theo thứ tự trong request.
+func (s *UserService) UpdateUserProfile(ctx context.Context, userID int, input *userPb.UserProfile) (*ent.Profile, error) {
+	if !CanUpdateProfile(ctx) {
+		return nil, fmt.Errorf("#1 UpdateUserProfile: %w", ErrProfileForbidden)
+	}
+	if input == nil {
+		input = &userPb.UserProfile{}
+	}
+
+	in := profileDTOFromProto(input)
+	if err := binding.Validator.ValidateStruct(&in); err != nil {
+		return nil, fmt.Errorf("#2 UpdateUserProfile: %w", err)
+	}
+	dob, err := parseProfileDate(in.DateOfBirth)
+	if err != nil {
+		return nil, fmt.Errorf("#3 UpdateUserProfile: invalid date_of_birth: %w", err)
+	}
+	issueDate, err := parseProfileDate(in.NationalIDIssueDate)
+	if err != nil {
+		return nil, fmt.Errorf("#4 UpdateUserProfile: invalid national_id_issue_date: %w", err)
+	}
+	now := time.Now()
+	if dob != nil && dob.After(now) {
+		return nil, fmt.Errorf("#5 UpdateUserProfile: date_of_birth must be in the past")
+	}
+	if issueDate != nil && (issueDate.After(now) || (dob != nil && issueDate.Before(*dob))) {
+		return nil, fmt.Errorf("#6 UpdateUserProfile: national_id_issue_date must be between date_of_birth and today")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := ensureUserInScope(ctx, tx, userID); err != nil {
+		return nil, fmt.Errorf("#7 UpdateUserProfile: user not found: %w", err)
+	}
+
+	existing, err := tx.Profile.Query().Where(profile.HasUserWith(user.ID(userID))).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("#8 UpdateUserProfile: failed to load profile: %w", err)
+	}
+
+	var profileID int
+	if existing == nil {
+		create := tx.Profile.Create().SetUserID(userID)
+		applyProfile(create.Mutation(), in, dob, issueDate, false)
+		created, err := create.Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("#9 UpdateUserProfile: failed to create profile: %w", err)
+		}
+		profileID = created.ID
+	} else {
+		update := tx.Profile.UpdateOneID(existing.ID)
+		applyProfile(update.Mutation(), in, dob, issueDate, true)
+		if err := update.Exec(ctx); err != nil {
+			return nil, fmt.Errorf("#10 UpdateUserProfile: failed to update profile: %w", err)
+		}
+		profileID = existing.ID
+		_, err := tx.EmergencyContact.Delete().
+			Where(emergencycontact.HasProfileWith(profile.ID(profileID))).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("#11 UpdateUserProfile: failed to clear emergency contacts: %w", err)
+		}
+	}
+
+	if len(in.EmergencyContacts) > 0 {
+		builders := make([]*ent.EmergencyContactCreate, len(in.EmergencyContacts))
+		for i, c := range in.EmergencyContacts {
+			// Đã qua rule "phone" nên luôn chuẩn hóa được
+			contactPhone, _ := phone.Normalize(c.Phone)
+			builders[i] = tx.EmergencyContact.Create().
+				SetName(c.Name).
+				SetRelationship(c.Relationship).
+				SetPhone(contactPhone).
+				SetNillableAddress(nilIfEmpty(c.Address)).
+				SetPosition(i).
+				SetProfileID(profileID)
+		}
+		if _, err := tx.EmergencyContact.CreateBulk(builders...).Save(ctx); err != nil {
+			return nil, fmt.Errorf("#12 UpdateUserProfile: failed to create emergency contacts: %w", err)
+		}
+	}
+
+	query := tx.Profile.Query().Where(profile.ID(profileID))
+	withProfile(query)
+	updated, err := query.Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#13 UpdateUserProfile: failed to reload profile: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("#14 UpdateUserProfile: failed to commit: %w", err)
+	}
+	return updated, nil
+}
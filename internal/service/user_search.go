@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+	"github.com/huynhthanhthao/hrm_user_service/ent/schema"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/internal/audit"
+	"github.com/huynhthanhthao/hrm_user_service/internal/unaccent"
+)
+
+const (
+	DefaultSearchLimit = 10
+	MaxSearchLimit     = 50
+)
+
+type UserSearchHit struct {
+	User  *ent.User
+	Score float64
+}
+
+// searchTextMatch khớp chuỗi con (ILIKE, dùng được index trigram) hoặc
+// gần đúng theo word_similarity của pg_trgm để chấp nhận gõ sai nhẹ
+func searchTextMatch(folded string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		col := s.C(user.FieldSearchText)
+		s.Where(sql.Or(
+			sql.Contains(col, folded),
+			sql.P(func(b *sql.Builder) {
+				b.Arg(folded).WriteString(" <% ").WriteString(col)
+			}),
+		))
+	})
+}
+
+// SearchUsers tìm kiếm không phân biệt dấu cho ô chọn user (type-ahead),
+// xếp hạng theo độ tương đồng trigram giữa từ khóa và search_text.
+func (s *UserService) SearchUsers(ctx context.Context, query string, limit int) ([]UserSearchHit, error) {
+	folded := unaccent.Fold(query)
+	if folded == "" {
+		return nil, nil
+	}
+	if limit < 1 {
+		limit = DefaultSearchLimit
+	}
+	if limit > MaxSearchLimit {
+		limit = MaxSearchLimit
+	}
+
+	var rows []struct {
+		ID    int     `sql:"id"`
+		Score float64 `sql:"score"`
+	}
+	match := searchTextMatch(folded)
+	// Số điện thoại, email đầy đủ được so theo blind index
+	if p := exactPIIMatch(folded); p != nil {
+		match = user.Or(match, p)
+	}
+	err := s.client.User.Query().
+		Where(match).
+		Limit(limit).
+		Modify(func(sel *sql.Selector) {
+			col := sel.C(user.FieldSearchText)
+			sel.Select(sel.C(user.FieldID))
+			sel.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+				b.WriteString("word_similarity(").Arg(folded).WriteString(", ").WriteString(col).WriteString(")")
+			}), "score")
+			sel.OrderExpr(sql.Expr("score DESC"), sql.Expr(sel.C(user.FieldID)))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("#1 SearchUsers: failed to search users: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int, len(rows))
+	for i, r := range rows {
+		ids[i] = r.ID
+	}
+	users, err := s.client.User.Query().
+		Where(user.IDIn(ids...)).
+		WithMemberships().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#2 SearchUsers: failed to retrieve users: %w", err)
+	}
+	byID := make(map[int]*ent.User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+
+	hits := make([]UserSearchHit, 0, len(rows))
+	for _, r := range rows {
+		if u, ok := byID[r.ID]; ok {
+			hits = append(hits, UserSearchHit{User: u, Score: r.Score})
+		}
+	}
+	return hits, nil
+}
+
+// RebuildSearchText tính lại search_text cho các user chưa có (dữ liệu trước khi thêm cột).
+// Giữ nguyên version vì thông tin trả về cho client không đổi; user được cập nhật đồng thời thì bỏ qua
+// (hook đã tính search_text khi lưu).
+func (s *UserService) RebuildSearchText(ctx context.Context) (*BackfillReport, error) {
+	skipCtx := schema.SkipSoftDelete(audit.Skip(ctx))
+	report := &BackfillReport{}
+	lastID := 0
+	for {
+		users, err := s.client.User.Query().
+			Where(user.IDGT(lastID), user.SearchTextEQ("")).
+			Order(user.ByID()).
+			Limit(backfillBatchSize).
+			All(skipCtx)
+		if err != nil {
+			return report, fmt.Errorf("#1 RebuildSearchText: failed to query users: %w", err)
+		}
+		if len(users) == 0 {
+			return report, nil
+		}
+		lastID = users[len(users)-1].ID
+
+		for _, u := range users {
+			err := s.client.User.UpdateOneID(u.ID).
+				Where(user.Version(u.Version)).
+				SetSearchText(unaccent.SearchText(u.FirstName, u.LastName)).
+				SetVersion(u.Version).
+				SetUpdatedAt(u.UpdatedAt).
+				Exec(skipCtx)
+			if ent.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				report.Failed = append(report.Failed, FailedRow{Table: user.Table, ID: u.ID, Err: err})
+				continue
+			}
+			report.Updated++
+		}
+	}
+}
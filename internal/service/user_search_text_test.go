@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/schema"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/internal/unaccent"
+)
+
+func TestSearchTextBulkUpdate(t *testing.T) {
+	tests := []struct {
+		name      string
+		update    func(*ent.UserUpdate) *ent.UserUpdate
+		wantErr   error
+		wantFirst string
+		wantLast  string
+	}{
+		{
+			name:      "đổi cả họ và tên",
+			update:    func(u *ent.UserUpdate) *ent.UserUpdate { return u.SetFirstName("Đức").SetLastName("Trần") },
+			wantFirst: "Đức",
+			wantLast:  "Trần",
+		},
+		{
+			name:    "chỉ đổi tên",
+			update:  func(u *ent.UserUpdate) *ent.UserUpdate { return u.SetFirstName("Đức") },
+			wantErr: schema.ErrPartialNameBulkUpdate,
+		},
+		{
+			name:    "chỉ đổi họ",
+			update:  func(u *ent.UserUpdate) *ent.UserUpdate { return u.SetLastName("Trần") },
+			wantErr: schema.ErrPartialNameBulkUpdate,
+		},
+		{
+			name:   "không đổi họ tên",
+			update: func(u *ent.UserUpdate) *ent.UserUpdate { return u.SetProvinceCode("79") },
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			_, client := newTestService(t, fmt.Sprintf("search_text_bulk%d", i))
+			firstNames := []string{"An", "Bình"}
+			for j, first := range firstNames {
+				client.User.Create().SetFirstName(first).SetLastName("Nguyễn").SetPhone(fmt.Sprintf("+8490000000%d", j)).ExecX(ctx)
+			}
+
+			_, err := tt.update(client.User.Update()).Save(ctx)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Save error = %v, want %v", err, tt.wantErr)
+			}
+
+			for j, u := range client.User.Query().Order(user.ByID()).AllX(ctx) {
+				first, last := tt.wantFirst, tt.wantLast
+				if first == "" {
+					first, last = firstNames[j], "Nguyễn"
+				}
+				if want := unaccent.SearchText(first, last); u.SearchText != want {
+					t.Errorf("user %d search_text = %q, want %q", u.ID, u.SearchText, want)
+				}
+			}
+		})
+	}
+}
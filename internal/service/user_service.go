@@ -11,8 +11,12 @@ import (
 	"github.com/google/uuid"
 	"github.com/huynhthanhthao/hrm_user_service/ent"
 	"github.com/huynhthanhthao/hrm_user_service/ent/account"
+	"github.com/huynhthanhthao/hrm_user_service/ent/membership"
 	user "github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/internal/adminunit"
 	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/phone"
+	"github.com/huynhthanhthao/hrm_user_service/internal/viewer"
 	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
 	permPb "github.com/longgggwwww/hrm-ms-permission/ent/proto/entpb"
 )
@@ -46,7 +50,7 @@ func (s *UserService) BeginTx(ctx context.Context) (*ent.Tx, error) {
 }
 
 func (s *UserService) GetAllUsers(ctx context.Context) ([]*ent.User, error) {
-	users, err := s.client.User.Query().All(ctx)
+	users, err := s.client.User.Query().WithMemberships().All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("#1 GetAllUsers: failed to retrieve users: %w", err)
 	}
@@ -63,7 +67,28 @@ func (s *UserService) GetUserById(ctx context.Context, id int) (*ent.User, error
 	}
 
 	// Retrieve user by ID
-	user, err := s.client.User.Get(ctx, id)
+	load := func(id int) (*ent.User, error) {
+		query := s.client.User.Query().
+			Where(user.ID(id)).
+			WithMemberships()
+		// Hồ sơ nhân sự chỉ trả về khi viewer được phép xem
+		if CanReadProfile(ctx, id) {
+			query = query.WithProfile(withProfile)
+		}
+		return query.Only(ctx)
+	}
+	user, err := load(id)
+	if ent.IsNotFound(err) {
+		// id cũ của user đã bị gộp: trả về survivor.
+		// Bản ghi gộp luôn trỏ thẳng tới survivor cuối cùng nên chỉ theo đúng một bước.
+		survivorID, merged, mergeErr := s.resolveMergedUserID(ctx, id)
+		if mergeErr != nil {
+			return nil, fmt.Errorf("#4 GetUserById: failed to resolve merged user: %w", mergeErr)
+		}
+		if merged {
+			user, err = load(survivorID)
+		}
+	}
 	if err != nil {
 		return nil, fmt.Errorf("#3 GetUserById: failed to retrieve user: %w", err)
 	}
@@ -103,6 +128,7 @@ func (s *UserService) GetUsersByIDs(ctx context.Context, params dto.UserParams)
 	// Query users by IDs with pagination
 	users, err := s.client.User.Query().
 		Where(user.IDIn(intIDs...)).
+		WithMemberships().
 		All(ctx)
 
 	if err != nil {
@@ -112,7 +138,78 @@ func (s *UserService) GetUsersByIDs(ctx context.Context, params dto.UserParams)
 	return users, nil
 }
 
+// resolveOrgIDs xác định các tổ chức của user mới.
+// Viewer đang ở một tổ chức chỉ được tạo user trong chính tổ chức đó.
+func resolveOrgIDs(ctx context.Context, requested []int64) ([]int64, error) {
+	orgID, scoped := viewer.OrgFromContext(ctx)
+	if !scoped {
+		return requested, nil
+	}
+	for _, id := range requested {
+		if id != orgID {
+			return nil, fmt.Errorf("#1 resolveOrgIDs: cannot assign user to organization %d", id)
+		}
+	}
+	return []int64{orgID}, nil
+}
+
+// resolveProvinceCode kiểm tra ward_code/province_code theo danh mục đơn vị hành chính
+// và trả về province_code (suy ra từ ward_code khi có)
+func resolveProvinceCode(wardCode, provinceCode string) (*string, error) {
+	p, err := adminunit.Default().Resolve(wardCode, provinceCode)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, nil
+	}
+	return &p.Code, nil
+}
+
+// ensureUserInScope trả về lỗi not found nếu user không thuộc tổ chức của viewer
+func ensureUserInScope(ctx context.Context, tx *ent.Tx, userID int) error {
+	_, err := tx.User.Query().Where(user.ID(userID)).OnlyID(ctx)
+	return err
+}
+
+func createMemberships(ctx context.Context, tx *ent.Tx, userID int, orgIDs []int64) ([]*ent.Membership, error) {
+	builders := make([]*ent.MembershipCreate, 0, len(orgIDs))
+	seen := make(map[int64]struct{}, len(orgIDs))
+	for _, orgID := range orgIDs {
+		if _, ok := seen[orgID]; ok {
+			continue
+		}
+		seen[orgID] = struct{}{}
+		builders = append(builders, tx.Membership.Create().
+			SetOrgID(orgID).
+			SetUserID(userID).
+			SetIsDefault(len(builders) == 0))
+	}
+	if len(builders) == 0 {
+		return nil, nil
+	}
+	return tx.Membership.CreateBulk(builders...).Save(ctx)
+}
+
 func (s *UserService) CreateUser(ctx context.Context, input *userPb.CreateUserRequest) (*ent.User, error) {
+	orgIDs, err := resolveOrgIDs(ctx, input.OrgIds)
+	if err != nil {
+		return nil, err
+	}
+
+	provinceCode, err := resolveProvinceCode(input.WardCode.GetValue(), input.ProvinceCode.GetValue())
+	if err != nil {
+		return nil, fmt.Errorf("#10 CreateUser: %w", err)
+	}
+	phoneNumber, err := phone.Normalize(input.Phone)
+	if err != nil {
+		return nil, fmt.Errorf("#11 CreateUser: %w", err)
+	}
+	customAttributes, err := customAttributesFromProto(input.CustomAttributes)
+	if err != nil {
+		return nil, fmt.Errorf("#12 CreateUser: %w", err)
+	}
+
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
 		return nil, err
@@ -123,7 +220,7 @@ func (s *UserService) CreateUser(ctx context.Context, input *userPb.CreateUserRe
 		SetFirstName(input.FirstName).
 		SetLastName(input.LastName).
 		SetGender(user.Gender(input.Gender)).
-		SetPhone(input.Phone)
+		SetPhone(phoneNumber)
 
 	if input.Email != nil {
 		userCreate = userCreate.SetEmail(input.Email.Value)
@@ -131,12 +228,19 @@ func (s *UserService) CreateUser(ctx context.Context, input *userPb.CreateUserRe
 	if input.WardCode != nil {
 		userCreate = userCreate.SetWardCode(input.WardCode.Value)
 	}
+	userCreate = userCreate.SetNillableProvinceCode(provinceCode)
 	if input.Address != nil {
 		userCreate = userCreate.SetAddress(input.Address.Value)
 	}
 	if input.Avatar != nil {
 		userCreate = userCreate.SetAvatar(input.Avatar.Value)
 	}
+	if err := validateCustomAttributes(ctx, tx.AttributeDefinition, customAttributes, orgIDs); err != nil {
+		return nil, fmt.Errorf("#13 CreateUser: %w", err)
+	}
+	if len(customAttributes) > 0 {
+		userCreate = userCreate.SetCustomAttributes(customAttributes)
+	}
 
 	user, err := userCreate.Save(ctx)
 	if err != nil {
@@ -160,6 +264,12 @@ func (s *UserService) CreateUser(ctx context.Context, input *userPb.CreateUserRe
 		return nil, fmt.Errorf("#4 CreateUser: failed to create account: %w", err)
 	}
 
+	memberships, err := createMemberships(ctx, tx, user.ID, orgIDs)
+	if err != nil {
+		return nil, fmt.Errorf("#9 CreateUser: failed to create memberships: %w", err)
+	}
+	user.Edges.Memberships = memberships
+
 	// Call grpc to permission service here
 	if len(input.PermIds) > 0 {
 		userPermRequests := make([]*permPb.CreateUserPermRequest, len(input.PermIds))
@@ -240,40 +350,123 @@ func (s *UserService) UpdateUserRoles(ctx context.Context, userID int, roleIDs [
 }
 
 func (s *UserService) UpdateUserByID(ctx context.Context, tx *ent.Tx, userID int, input *userPb.UpdateUserRequest) (*ent.User, error) {
+	updated, err := s.updateUserByID(ctx, tx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.syncUserAccess(ctx, userID, input); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// syncUserAccess thay quyền/vai trò của user bên permission service (nếu request có truyền)
+func (s *UserService) syncUserAccess(ctx context.Context, userID int, input *userPb.UpdateUserRequest) error {
+	fields, err := updateFields(input)
+	if err != nil {
+		return fmt.Errorf("#7 UpdateUserByID: %w", err)
+	}
+
+	if fields["perm_ids"] {
+		if err := s.UpdateUserPerms(ctx, userID, input.PermIds); err != nil {
+			return fmt.Errorf("#5 UpdateUserByID: failed to update user perms: %w", err)
+		}
+	}
+
+	if fields["role_ids"] {
+		if err := s.UpdateUserRoles(ctx, userID, input.RoleIds); err != nil {
+			return fmt.Errorf("#6 UpdateUserByID: failed to update user roles: %w", err)
+		}
+	}
+	return nil
+}
+
+// updateUserByID cập nhật dữ liệu của user trong tx, không gọi permission service
+func (s *UserService) updateUserByID(ctx context.Context, tx *ent.Tx, userID int, input *userPb.UpdateUserRequest) (*ent.User, error) {
+	fields, err := updateFields(input)
+	if err != nil {
+		return nil, fmt.Errorf("#16 UpdateUserByID: %w", err)
+	}
+
+	if err := ensureUserInScope(ctx, tx, userID); err != nil {
+		return nil, fmt.Errorf("#7 UpdateUserByID: user not found: %w", err)
+	}
+
 	userUpdate := tx.User.UpdateOneID(userID)
+	// Chỉ cập nhật khi user chưa bị người khác sửa kể từ lần đọc của người gọi
+	if input.ExpectedVersion != nil {
+		userUpdate = userUpdate.Where(user.Version(int(input.ExpectedVersion.Value)))
+	}
+
+	// Đổi phường/xã hoặc tỉnh/thành: kiểm tra theo danh mục, tỉnh/thành luôn khớp với phường/xã
+	if fields["ward_code"] || fields["province_code"] {
+		if err := applyAdminUnitUpdate(ctx, tx, userUpdate, userID, input, fields); err != nil {
+			return nil, err
+		}
+	}
 
-	if input.FirstName != "" {
+	if fields["first_name"] {
 		userUpdate = userUpdate.SetFirstName(input.FirstName)
 	}
 
-	if input.LastName != "" {
+	if fields["last_name"] {
 		userUpdate = userUpdate.SetLastName(input.LastName)
 	}
 
-	if input.Gender != "" {
+	if fields["gender"] {
 		userUpdate = userUpdate.SetGender(user.Gender(input.Gender))
 	}
 
-	// Nullable fields: email, ward_code, address, avatar
-	if input.Email != nil {
-		userUpdate = userUpdate.SetEmail(input.Email.Value)
+	// Nullable fields: email, address, avatar; không truyền wrapper thì xóa về NULL
+	if fields["email"] {
+		if input.Email != nil {
+			userUpdate = userUpdate.SetEmail(input.Email.Value)
+		} else {
+			userUpdate = userUpdate.ClearEmail()
+		}
 	}
-	if input.WardCode != nil {
-		userUpdate = userUpdate.SetWardCode(input.WardCode.Value)
+	if fields["address"] {
+		if input.Address != nil {
+			userUpdate = userUpdate.SetAddress(input.Address.Value)
+		} else {
+			userUpdate = userUpdate.ClearAddress()
+		}
 	}
-	if input.Address != nil {
-		userUpdate = userUpdate.SetAddress(input.Address.Value)
+	if fields["avatar"] {
+		if input.Avatar != nil {
+			userUpdate = userUpdate.SetAvatar(input.Avatar.Value)
+		} else {
+			userUpdate = userUpdate.ClearAvatar()
+		}
 	}
-	if input.Avatar != nil {
-		userUpdate = userUpdate.SetAvatar(input.Avatar.Value)
+
+	// Phone is not nullable: chuỗi rỗng trong update_mask bị từ chối khi chuẩn hóa
+	if fields["phone"] {
+		phoneNumber, err := phone.Normalize(input.Phone)
+		if err != nil {
+			return nil, fmt.Errorf("#14 UpdateUserByID: %w", err)
+		}
+		userUpdate = userUpdate.SetPhone(phoneNumber)
+	}
+
+	// Quyền, vai trò hoặc tổ chức thay đổi: tăng perm_version để các access token cũ hết hiệu lực
+	if fields["perm_ids"] || fields["role_ids"] || fields["org_ids"] {
+		userUpdate = userUpdate.AddPermVersion(1)
 	}
 
-	// Phone is not nullable, only update if not empty string
-	if input.Phone != "" {
-		userUpdate = userUpdate.SetPhone(input.Phone)
+	// Thay thuộc tính tùy chỉnh, hoặc bỏ thuộc tính của các tổ chức user không còn thuộc về
+	if fields["custom_attributes"] || fields["org_ids"] || len(customAttributePaths(fields)) > 0 {
+		attrs, err := resolveCustomAttributes(ctx, tx, userID, input, fields)
+		if err != nil {
+			return nil, fmt.Errorf("#18 UpdateUserByID: %w", err)
+		}
+		userUpdate = setCustomAttributes(userUpdate, attrs)
 	}
 
 	userCreated, err := userUpdate.Save(ctx)
+	if input.ExpectedVersion != nil && ent.IsNotFound(err) {
+		return nil, fmt.Errorf("#15 UpdateUserByID: %w", versionConflict(ctx, tx, userID, int(input.ExpectedVersion.Value)))
+	}
 	if err != nil {
 		return nil, fmt.Errorf("#1 UpdateUserByID: failed to update user: %w", err)
 	}
@@ -285,40 +478,88 @@ func (s *UserService) UpdateUserByID(ctx context.Context, tx *ent.Tx, userID int
 	}
 
 	accountUpdate := tx.Account.UpdateOneID(acc.ID)
-	if input.Account != nil {
-		if input.Account.Status != "" {
-			accountUpdate = accountUpdate.SetStatus(account.Status(input.Account.Status))
+	if fields["account.status"] {
+		accountUpdate = accountUpdate.SetStatus(account.Status(input.Account.GetStatus()))
+	}
+	if fields["account.password"] {
+		// Hash của chuỗi rỗng vẫn hợp lệ nên phải chặn ở đây
+		if input.Account.GetPassword() == "" {
+			return nil, fmt.Errorf("#17 UpdateUserByID: password must not be empty")
 		}
-		if input.Account.Password != "" {
-			hashedPwd, err := bcrypt.GenerateFromPassword([]byte(input.Account.Password), bcrypt.DefaultCost)
-			if err != nil {
-				return nil, fmt.Errorf("#3 UpdateUserByID: failed to hash password: %w", err)
-			}
-			accountUpdate = accountUpdate.SetPassword(string(hashedPwd))
+		hashedPwd, err := bcrypt.GenerateFromPassword([]byte(input.Account.GetPassword()), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, fmt.Errorf("#3 UpdateUserByID: failed to hash password: %w", err)
 		}
-		if input.Account.Status != "" || input.Account.Password != "" {
-			_, err = accountUpdate.Save(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("#4 UpdateUserByID: failed to update account: %w", err)
-			}
+		accountUpdate = accountUpdate.SetPassword(string(hashedPwd))
+	}
+	if fields["account.status"] || fields["account.password"] {
+		_, err = accountUpdate.Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("#4 UpdateUserByID: failed to update account: %w", err)
 		}
 	}
 
-	if input.PermIds != nil {
-		if err := s.UpdateUserPerms(ctx, userID, input.PermIds); err != nil {
-			return nil, fmt.Errorf("#5 UpdateUserByID: failed to update user perms: %w", err)
+	// Thay toàn bộ danh sách tổ chức, chỉ cho phép khi không bị giới hạn trong một tổ chức
+	if fields["org_ids"] {
+		if _, scoped := viewer.OrgFromContext(ctx); scoped {
+			return nil, fmt.Errorf("#8 UpdateUserByID: cannot change organizations of user %d", userID)
+		}
+		if _, err := tx.Membership.Delete().Where(membership.HasUserWith(user.ID(userID))).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("#9 UpdateUserByID: failed to clear memberships: %w", err)
+		}
+		if _, err := createMemberships(ctx, tx, userID, input.OrgIds); err != nil {
+			return nil, fmt.Errorf("#10 UpdateUserByID: failed to create memberships: %w", err)
 		}
 	}
 
-	if input.RoleIds != nil {
-		if err := s.UpdateUserRoles(ctx, userID, input.RoleIds); err != nil {
-			return nil, fmt.Errorf("#6 UpdateUserByID: failed to update user roles: %w", err)
-		}
+	userCreated.Edges.Memberships, err = tx.Membership.Query().
+		Where(membership.HasUserWith(user.ID(userID))).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#11 UpdateUserByID: failed to load memberships: %w", err)
 	}
 
 	return userCreated, nil
 }
 
+// applyAdminUnitUpdate ghi ward_code/province_code theo update_mask.
+// Tỉnh/thành được suy ra từ phường/xã; xóa phường/xã mà không nêu province_code thì giữ tỉnh/thành hiện tại.
+func applyAdminUnitUpdate(ctx context.Context, tx *ent.Tx, userUpdate *ent.UserUpdateOne, userID int, input *userPb.UpdateUserRequest, fields map[string]bool) error {
+	wardCode := input.WardCode.GetValue()
+	provinceCode := input.ProvinceCode.GetValue()
+	if !fields["ward_code"] || !fields["province_code"] {
+		current, err := tx.User.Query().Where(user.ID(userID)).Only(ctx)
+		if err != nil {
+			return fmt.Errorf("#12 UpdateUserByID: failed to load user: %w", err)
+		}
+		if !fields["ward_code"] && current.WardCode != nil {
+			wardCode = *current.WardCode
+		}
+		if !fields["province_code"] && wardCode == "" && current.ProvinceCode != nil {
+			provinceCode = *current.ProvinceCode
+		}
+	}
+
+	resolved, err := resolveProvinceCode(wardCode, provinceCode)
+	if err != nil {
+		return fmt.Errorf("#13 UpdateUserByID: %w", err)
+	}
+	if resolved != nil {
+		userUpdate.SetProvinceCode(*resolved)
+	} else {
+		userUpdate.ClearProvinceCode()
+	}
+
+	if fields["ward_code"] {
+		if input.WardCode != nil {
+			userUpdate.SetWardCode(input.WardCode.Value)
+		} else {
+			userUpdate.ClearWardCode()
+		}
+	}
+	return nil
+}
+
 func (s *UserService) DeleteUserByID(ctx context.Context, id int) error {
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
@@ -326,7 +567,43 @@ func (s *UserService) DeleteUserByID(ctx context.Context, id int) error {
 	}
 	defer tx.Rollback()
 
-	// Tìm account liên kết với user (nếu có)
+	if err := s.deleteUserByID(ctx, tx, id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// deleteUserByID xóa (mềm) user trong tx, hoặc chỉ gỡ khỏi tổ chức của viewer
+func (s *UserService) deleteUserByID(ctx context.Context, tx *ent.Tx, id int) error {
+	if err := ensureUserInScope(ctx, tx, id); err != nil {
+		return fmt.Errorf("#5 DeleteUserByID: user not found: %w", err)
+	}
+
+	// User còn thuộc tổ chức khác: chỉ gỡ khỏi tổ chức của viewer
+	if orgID, scoped := viewer.OrgFromContext(ctx); scoped {
+		others, err := tx.Membership.Query().
+			Where(membership.HasUserWith(user.ID(id)), membership.OrgIDNEQ(orgID)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("#6 DeleteUserByID: failed to query memberships: %w", err)
+		}
+		if others {
+			_, err := tx.Membership.Delete().
+				Where(membership.HasUserWith(user.ID(id)), membership.OrgID(orgID)).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("#7 DeleteUserByID: failed to remove membership: %w", err)
+			}
+			if err := tx.User.UpdateOneID(id).AddPermVersion(1).Exec(ctx); err != nil {
+				return fmt.Errorf("#9 DeleteUserByID: failed to bump perm version: %w", err)
+			}
+			return nil
+		}
+	}
+
+	// Xóa mềm: giữ lại membership và quyền/vai trò để có thể khôi phục,
+	// chúng chỉ bị xóa khi purge
 	acc, err := tx.Account.Query().Where(account.HasUserWith(user.ID(id))).Only(ctx)
 	if err == nil {
 		// Xóa account trước nếu tìm thấy
@@ -340,26 +617,5 @@ func (s *UserService) DeleteUserByID(ctx context.Context, id int) error {
 		return fmt.Errorf("#2 DeleteUserByID: failed to delete user: %w", err)
 	}
 
-	if err := tx.Commit(); err != nil {
-		return err
-	}
-
-	// Call grpc to permission service
-	if s.perClients.PermExt != nil {
-		userIDStr := fmt.Sprintf("%d", id)
-		_, err := s.perClients.PermExt.DeleteUserPermsByUserID(ctx, &permPb.DeleteUserPermsByUserIDRequest{
-			UserId: userIDStr,
-		})
-		if err != nil {
-			return fmt.Errorf("#3 DeleteUserByID: failed to delete user permissions: %w", err)
-		}
-		_, err = s.perClients.PermExt.DeleteUserRolesByUserID(ctx, &permPb.DeleteUserRolesByUserIDRequest{
-			UserId: userIDStr,
-		})
-		if err != nil {
-			return fmt.Errorf("#4 DeleteUserByID: failed to delete user roles: %w", err)
-		}
-	}
-
 	return nil
 }
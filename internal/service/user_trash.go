@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/account"
+	"github.com/huynhthanhthao/hrm_user_service/ent/groupmember"
+	"github.com/huynhthanhthao/hrm_user_service/ent/loginevent"
+	"github.com/huynhthanhthao/hrm_user_service/ent/membership"
+	"github.com/huynhthanhthao/hrm_user_service/ent/predicate"
+	"github.com/huynhthanhthao/hrm_user_service/ent/profile"
+	"github.com/huynhthanhthao/hrm_user_service/ent/schema"
+	"github.com/huynhthanhthao/hrm_user_service/ent/user"
+	"github.com/huynhthanhthao/hrm_user_service/ent/usermerge"
+	"github.com/huynhthanhthao/hrm_user_service/ent/userversion"
+	"github.com/huynhthanhthao/hrm_user_service/internal/fieldcrypt"
+
+	permPb "github.com/longgggwwww/hrm-ms-permission/ent/proto/entpb"
+)
+
+var (
+	ErrUserNotDeleted  = errors.New("user is not deleted")
+	ErrRestoreConflict = errors.New("cannot restore user: phone, email or username is already used by another user")
+	ErrRestoreMerged   = errors.New("cannot restore user: user was merged into another user")
+)
+
+// getDeletedUser lấy user đã bị xóa mềm (vẫn giới hạn theo tổ chức của viewer)
+func getDeletedUser(ctx context.Context, tx *ent.Tx, id int) (*ent.User, error) {
+	usr, err := tx.User.Query().
+		Where(user.ID(id)).
+		WithAccount().
+		Only(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if usr.DeletedAt == nil {
+		return nil, ErrUserNotDeleted
+	}
+	return usr, nil
+}
+
+// RestoreUser khôi phục user và account đã bị xóa mềm.
+// Trả về ErrRestoreConflict nếu phone/email/username đã được user khác sử dụng,
+// ErrRestoreMerged nếu user đã bị gộp (id cũ vẫn đang chuyển hướng tới survivor).
+func (s *UserService) RestoreUser(ctx context.Context, id int) (*ent.User, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	usr, err := getDeletedUser(ctx, tx, id)
+	if err != nil {
+		return nil, fmt.Errorf("#1 RestoreUser: %w", err)
+	}
+	merged, err := tx.UserMerge.Query().Where(usermerge.MergedUserID(id)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#2 RestoreUser: failed to check merge records: %w", err)
+	}
+	if merged {
+		return nil, fmt.Errorf("#3 RestoreUser: %w", ErrRestoreMerged)
+	}
+
+	// Phone, email được mã hóa nên so theo blind index
+	phoneHash, err := fieldcrypt.PhoneIndex(usr.Phone)
+	if err != nil {
+		return nil, fmt.Errorf("#4 RestoreUser: %w", err)
+	}
+	conflictPreds := []predicate.User{user.PhoneHash(phoneHash)}
+	if usr.Email != nil {
+		emailHash, err := fieldcrypt.EmailIndex(*usr.Email)
+		if err != nil {
+			return nil, fmt.Errorf("#5 RestoreUser: %w", err)
+		}
+		conflictPreds = append(conflictPreds, user.EmailHash(emailHash))
+	}
+	conflict, err := tx.User.Query().Where(user.Or(conflictPreds...)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#6 RestoreUser: failed to check conflicts: %w", err)
+	}
+	if !conflict && usr.Edges.Account != nil {
+		conflict, err = tx.Account.Query().Where(account.Username(usr.Edges.Account.Username)).Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("#7 RestoreUser: failed to check conflicts: %w", err)
+		}
+	}
+	if conflict {
+		return nil, ErrRestoreConflict
+	}
+
+	skipCtx := schema.SkipSoftDelete(ctx)
+	if usr.Edges.Account != nil {
+		if err := tx.Account.UpdateOneID(usr.Edges.Account.ID).ClearDeletedAt().Exec(skipCtx); err != nil {
+			return nil, fmt.Errorf("#8 RestoreUser: failed to restore account: %w", err)
+		}
+	}
+	restored, err := tx.User.UpdateOneID(id).ClearDeletedAt().Save(skipCtx)
+	if err != nil {
+		return nil, fmt.Errorf("#9 RestoreUser: failed to restore user: %w", err)
+	}
+
+	restored.Edges.Memberships, err = tx.Membership.Query().
+		Where(membership.HasUserWith(user.ID(id))).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("#10 RestoreUser: failed to load memberships: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+// PurgeUser xóa vĩnh viễn user đã bị xóa mềm cùng account, membership
+// và quyền/vai trò bên permission service. Trả về URL avatar cũ để bên gọi dọn file trên storage.
+//
+// Quyền/vai trò bên permission service được xóa trước khi commit: nếu lời gọi lỗi thì transaction
+// rollback và user vẫn ở trạng thái xóa mềm để lần purge sau thử lại (các lời gọi xóa là idempotent).
+func (s *UserService) PurgeUser(ctx context.Context, id int) (*string, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	usr, err := getDeletedUser(ctx, tx, id)
+	if err != nil {
+		return nil, fmt.Errorf("#1 PurgeUser: %w", err)
+	}
+
+	skipCtx := schema.SkipSoftDelete(ctx)
+	if _, err := tx.Account.Delete().Where(account.HasUserWith(user.ID(id))).Exec(skipCtx); err != nil {
+		return nil, fmt.Errorf("#2 PurgeUser: failed to delete account: %w", err)
+	}
+	if _, err := tx.Membership.Delete().Where(membership.HasUserWith(user.ID(id))).Exec(skipCtx); err != nil {
+		return nil, fmt.Errorf("#3 PurgeUser: failed to delete memberships: %w", err)
+	}
+	// Người liên hệ khẩn cấp bị xóa theo (ON DELETE CASCADE)
+	if _, err := tx.Profile.Delete().Where(profile.HasUserWith(user.ID(id))).Exec(skipCtx); err != nil {
+		return nil, fmt.Errorf("#4 PurgeUser: failed to delete profile: %w", err)
+	}
+	if _, err := tx.LoginEvent.Delete().Where(loginevent.UserID(id)).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#5 PurgeUser: failed to delete login history: %w", err)
+	}
+	if _, err := tx.UserVersion.Delete().Where(userversion.UserID(id)).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#6 PurgeUser: failed to delete user versions: %w", err)
+	}
+	if _, err := tx.GroupMember.Delete().Where(groupmember.UserID(id)).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("#7 PurgeUser: failed to delete group memberships: %w", err)
+	}
+	if err := tx.User.DeleteOneID(id).Exec(skipCtx); err != nil {
+		return nil, fmt.Errorf("#8 PurgeUser: failed to delete user: %w", err)
+	}
+
+	// Call grpc to permission service
+	if s.perClients.PermExt != nil {
+		userIDStr := fmt.Sprintf("%d", id)
+		_, err := s.perClients.PermExt.DeleteUserPermsByUserID(ctx, &permPb.DeleteUserPermsByUserIDRequest{
+			UserId: userIDStr,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("#9 PurgeUser: failed to delete user permissions: %w", err)
+		}
+		_, err = s.perClients.PermExt.DeleteUserRolesByUserID(ctx, &permPb.DeleteUserRolesByUserIDRequest{
+			UserId: userIDStr,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("#10 PurgeUser: failed to delete user roles: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return usr.Avatar, nil
+}
+
+// PurgeDeletedUsers xóa vĩnh viễn các user đã bị xóa mềm lâu hơn retention.
+// Dùng cho job định kỳ nên chạy không giới hạn tổ chức. User purge lỗi được bỏ qua
+// để job xử lý tiếp các user còn lại; lỗi được gom lại trả về cho bên gọi ghi log.
+// deleteAvatar (có thể nil) dọn file avatar của user đã purge.
+func (s *UserService) PurgeDeletedUsers(ctx context.Context, retention time.Duration, deleteAvatar func(context.Context, string)) (int, error) {
+	ids, err := s.client.User.Query().
+		Where(user.DeletedAtLT(time.Now().Add(-retention))).
+		IDs(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("#1 PurgeDeletedUsers: failed to query users: %w", err)
+	}
+
+	purged := 0
+	var errs []error
+	for _, id := range ids {
+		avatar, err := s.PurgeUser(ctx, id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("#2 PurgeDeletedUsers: user %d: %w", id, err))
+			continue
+		}
+		if avatar != nil && deleteAvatar != nil {
+			deleteAvatar(ctx, *avatar)
+		}
+		purged++
+	}
+	return purged, errors.Join(errs...)
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+)
+
+var ErrInvalidUpdateMask = errors.New("invalid update_mask")
+
+// Các path của UpdateUserRequest có thể nêu trong update_mask
+var updatableUserFields = map[string]bool{
+	"first_name":        true,
+	"last_name":         true,
+	"gender":            true,
+	"phone":             true,
+	"email":             true,
+	"ward_code":         true,
+	"province_code":     true,
+	"address":           true,
+	"avatar":            true,
+	"account.status":    true,
+	"account.password":  true,
+	"perm_ids":          true,
+	"role_ids":          true,
+	"org_ids":           true,
+	"custom_attributes": true,
+}
+
+// customAttributesPathPrefix là tiền tố của path ghi một phần custom_attributes:
+// "custom_attributes.<org_id>" (thuộc tính của một tổ chức) hoặc "custom_attributes.<org_id>.<key>" (một thuộc tính)
+const customAttributesPathPrefix = "custom_attributes."
+
+// updateFields trả về tập field cần ghi của request.
+// Có update_mask: đúng các path trong mask. Không có: các field có giá trị (chuỗi khác rỗng, wrapper/danh sách khác nil).
+func updateFields(input *userPb.UpdateUserRequest) (map[string]bool, error) {
+	paths := input.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		return presentUpdateFields(input), nil
+	}
+
+	fields := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		if path == "account" {
+			fields["account.status"] = true
+			fields["account.password"] = true
+			continue
+		}
+		if rest, ok := strings.CutPrefix(path, customAttributesPathPrefix); ok {
+			if !validCustomAttributePath(rest) {
+				return nil, fmt.Errorf("%w: invalid custom attribute path %q", ErrInvalidUpdateMask, path)
+			}
+			fields[path] = true
+			continue
+		}
+		if !updatableUserFields[path] {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidUpdateMask, path)
+		}
+		fields[path] = true
+	}
+	if fields["custom_attributes"] && len(customAttributePaths(fields)) > 0 {
+		return nil, fmt.Errorf("%w: custom_attributes cannot be combined with its subpaths", ErrInvalidUpdateMask)
+	}
+	return fields, nil
+}
+
+// validCustomAttributePath kiểm tra phần sau "custom_attributes.": "<org_id>" hoặc "<org_id>.<key>"
+func validCustomAttributePath(rest string) bool {
+	org, key, hasKey := strings.Cut(rest, ".")
+	if id, err := strconv.ParseInt(org, 10, 64); err != nil || id <= 0 {
+		return false
+	}
+	return !hasKey || (key != "" && !strings.Contains(key, "."))
+}
+
+// customAttributePaths trả về các path "custom_attributes.<org_id>[.<key>]" trong fields, đã sắp xếp
+func customAttributePaths(fields map[string]bool) []string {
+	var paths []string
+	for path, ok := range fields {
+		if ok && strings.HasPrefix(path, customAttributesPathPrefix) {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func presentUpdateFields(input *userPb.UpdateUserRequest) map[string]bool {
+	return map[string]bool{
+		"first_name":        input.FirstName != "",
+		"last_name":         input.LastName != "",
+		"gender":            input.Gender != "",
+		"phone":             input.Phone != "",
+		"email":             input.Email != nil,
+		"ward_code":         input.WardCode != nil,
+		"province_code":     input.ProvinceCode != nil,
+		"address":           input.Address != nil,
+		"avatar":            input.Avatar != nil,
+		"account.status":    input.Account.GetStatus() != "",
+		"account.password":  input.Account.GetPassword() != "",
+		"perm_ids":          input.PermIds != nil,
+		"role_ids":          input.RoleIds != nil,
+		"org_ids":           input.OrgIds != nil,
+		"custom_attributes": input.CustomAttributes != nil,
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	userPb "github.com/huynhthanhthao/hrm_user_service/proto/user"
+)
+
+func TestUpdateFieldsMask(t *testing.T) {
+	tests := []struct {
+		name      string
+		paths     []string
+		want      []string
+		wantPaths []string
+		wantErr   error
+	}{
+		{name: "field thường", paths: []string{"email", "first_name"}, want: []string{"email", "first_name"}},
+		{name: "account mở rộng thành các field con", paths: []string{"account"}, want: []string{"account.password", "account.status"}},
+		{name: "toàn bộ custom_attributes", paths: []string{"custom_attributes"}, want: []string{"custom_attributes"}},
+		{
+			name:      "custom_attributes theo tổ chức và thuộc tính",
+			paths:     []string{"custom_attributes.6.dept", "custom_attributes.5"},
+			want:      []string{"custom_attributes.5", "custom_attributes.6.dept"},
+			wantPaths: []string{"custom_attributes.5", "custom_attributes.6.dept"},
+		},
+		{name: "field không tồn tại", paths: []string{"password"}, wantErr: ErrInvalidUpdateMask},
+		{name: "org id không phải số", paths: []string{"custom_attributes.abc.dept"}, wantErr: ErrInvalidUpdateMask},
+		{name: "org id không dương", paths: []string{"custom_attributes.0"}, wantErr: ErrInvalidUpdateMask},
+		{name: "thiếu key", paths: []string{"custom_attributes.5."}, wantErr: ErrInvalidUpdateMask},
+		{name: "quá nhiều cấp", paths: []string{"custom_attributes.5.dept.name"}, wantErr: ErrInvalidUpdateMask},
+		{name: "trộn cả custom_attributes và path con", paths: []string{"custom_attributes", "custom_attributes.5.dept"}, wantErr: ErrInvalidUpdateMask},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := updateFields(&userPb.UpdateUserRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: tt.paths}})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("updateFields(%v) error = %v, want %v", tt.paths, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("updateFields(%v): %v", tt.paths, err)
+			}
+			want := make(map[string]bool, len(tt.want))
+			for _, f := range tt.want {
+				want[f] = true
+			}
+			if !reflect.DeepEqual(fields, want) {
+				t.Errorf("updateFields(%v) = %v, want %v", tt.paths, fields, want)
+			}
+			if got := customAttributePaths(fields); !reflect.DeepEqual(got, tt.wantPaths) {
+				t.Errorf("customAttributePaths = %v, want %v", got, tt.wantPaths)
+			}
+		})
+	}
+}
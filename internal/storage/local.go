@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalMountPath là đường dẫn HTTP phục vụ file của LocalStorage
+const LocalMountPath = "/media"
+
+// LocalStorage lưu file trên filesystem, dùng khi chạy một instance hoặc khi phát triển
+type LocalStorage struct {
+	dir       string
+	publicURL string
+}
+
+// NewLocalStorage: publicURL là URL tương ứng với LocalMountPath, ví dụ "http://localhost:8089/media"
+func NewLocalStorage(dir, publicURL string) (*LocalStorage, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("#1 NewLocalStorage: failed to create dir %s: %w", dir, err)
+	}
+	return &LocalStorage{dir: dir, publicURL: strings.TrimRight(publicURL, "/") + "/"}, nil
+}
+
+func (s *LocalStorage) Dir() string {
+	return s.dir
+}
+
+func (s *LocalStorage) path(key string) (string, error) {
+	if err := validKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
+
+// Put ghi vào file tạm rồi rename để không phục vụ file ghi dở
+func (s *LocalStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return fmt.Errorf("#1 LocalStorage.Put: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("#2 LocalStorage.Put: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("#3 LocalStorage.Put: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("#4 LocalStorage.Put: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return fmt.Errorf("#5 LocalStorage.Put: %w", err)
+	}
+	return os.Rename(tmp.Name(), p)
+}
+
+// Delete không báo lỗi nếu file không tồn tại
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("#1 LocalStorage.Delete: %w", err)
+	}
+	// Dọn thư mục rỗng, bỏ qua lỗi vì thư mục có thể còn file khác
+	os.Remove(filepath.Dir(p))
+	return nil
+}
+
+func (s *LocalStorage) URL(key string) string {
+	return s.publicURL + key
+}
@@ -0,0 +1,45 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+)
+
+var ErrInvalidKey = errors.New("invalid storage key")
+
+// Storage lưu file theo key (đường dẫn tương đối, ví dụ "avatars/12/abc/256.jpg")
+// và trả về URL công khai ổn định cho mỗi key.
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
+
+// KeyFromURL lấy lại key từ URL do storage sinh ra.
+// URL không thuộc storage (ví dụ ảnh do client tự host) trả về false.
+func KeyFromURL(s Storage, url string) (string, bool) {
+	prefix := s.URL("")
+	if prefix == "" || !strings.HasPrefix(url, prefix) {
+		return "", false
+	}
+	key := strings.TrimPrefix(url, prefix)
+	if validKey(key) != nil {
+		return "", false
+	}
+	return key, true
+}
+
+// validKey chặn key rỗng, key tuyệt đối và key chứa ".."
+func validKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return ErrInvalidKey
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
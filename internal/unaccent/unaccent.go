@@ -0,0 +1,29 @@
+package unaccent
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Fold bỏ dấu tiếng Việt và chuyển về chữ thường: "Nguyễn Văn Đức" -> "nguyen van duc"
+func Fold(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, s)
+	if err != nil {
+		folded = s
+	}
+	// "đ" không phải ký tự tổ hợp nên không bị NFD tách dấu
+	folded = strings.NewReplacer("đ", "d", "Đ", "D").Replace(folded)
+	return strings.ToLower(strings.Join(strings.Fields(folded), " "))
+}
+
+// SearchText ghép họ tên của user thành một chuỗi đã bỏ dấu.
+// Họ tên được ghi theo cả hai thứ tự để "van an nguyen" và "nguyen van an" đều khớp.
+// Số điện thoại, email được mã hóa nên không đưa vào; tìm theo chúng dùng blind index.
+func SearchText(firstName, lastName string) string {
+	return Fold(lastName+" "+firstName) + " " + Fold(firstName+" "+lastName)
+}
@@ -0,0 +1,358 @@
+package userio
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+)
+
+// Ngôn ngữ tiêu đề cột khi export
+const (
+	LangEN = "en"
+	LangVI = "vi"
+)
+
+var (
+	ErrUnknownColumn   = errors.New("unknown export column")
+	ErrSensitiveColumn = errors.New("exporting sensitive columns requires permission")
+	ErrUnsupportedLang = errors.New("unsupported header language, expected en or vi")
+)
+
+// ExportRecord là dữ liệu của một user khi export.
+// Roles và EmployeeCode chỉ được lấy khi cột tương ứng được chọn.
+type ExportRecord struct {
+	User         *ent.User
+	Roles        []string
+	EmployeeCode string
+}
+
+// ExportColumn mô tả một cột có thể export.
+// Sensitive: cần quyền riêng. External: dữ liệu lấy từ service khác, chỉ export khi được chọn.
+type ExportColumn struct {
+	Key       string
+	Header    string
+	HeaderVI  string
+	Sensitive bool
+	External  bool
+	value     func(*ExportRecord) string
+}
+
+// Các cột lấy từ service khác
+const (
+	ColumnRoles        = "roles"
+	ColumnEmployeeCode = "employee_code"
+)
+
+var ExportColumns = []ExportColumn{
+	{Key: "id", Header: "ID", HeaderVI: "ID", value: func(r *ExportRecord) string {
+		return strconv.Itoa(r.User.ID)
+	}},
+	{Key: FieldLastName, Header: "Last name", HeaderVI: "Họ", value: func(r *ExportRecord) string {
+		return r.User.LastName
+	}},
+	{Key: FieldFirstName, Header: "First name", HeaderVI: "Tên", value: func(r *ExportRecord) string {
+		return r.User.FirstName
+	}},
+	{Key: FieldGender, Header: "Gender", HeaderVI: "Giới tính", value: func(r *ExportRecord) string {
+		return string(r.User.Gender)
+	}},
+	{Key: FieldPhone, Header: "Phone", HeaderVI: "Số điện thoại", Sensitive: true, value: func(r *ExportRecord) string {
+		return r.User.Phone
+	}},
+	{Key: FieldEmail, Header: "Email", HeaderVI: "Email", Sensitive: true, value: func(r *ExportRecord) string {
+		return deref(r.User.Email)
+	}},
+	{Key: FieldWardCode, Header: "Ward code", HeaderVI: "Mã phường xã", value: func(r *ExportRecord) string {
+		return deref(r.User.WardCode)
+	}},
+	{Key: FieldProvinceCode, Header: "Province code", HeaderVI: "Mã tỉnh", value: func(r *ExportRecord) string {
+		return deref(r.User.ProvinceCode)
+	}},
+	{Key: FieldAddress, Header: "Address", HeaderVI: "Địa chỉ", Sensitive: true, value: func(r *ExportRecord) string {
+		return deref(r.User.Address)
+	}},
+	{Key: FieldUsername, Header: "Username", HeaderVI: "Tên đăng nhập", value: func(r *ExportRecord) string {
+		if acc := r.User.Edges.Account; acc != nil {
+			return acc.Username
+		}
+		return ""
+	}},
+	{Key: "account_status", Header: "Account status", HeaderVI: "Trạng thái tài khoản", value: func(r *ExportRecord) string {
+		if acc := r.User.Edges.Account; acc != nil {
+			return string(acc.Status)
+		}
+		return ""
+	}},
+	{Key: FieldOrgIDs, Header: "Organizations", HeaderVI: "Tổ chức", value: func(r *ExportRecord) string {
+		ids := make([]string, 0, len(r.User.Edges.Memberships))
+		for _, m := range r.User.Edges.Memberships {
+			ids = append(ids, strconv.FormatInt(m.OrgID, 10))
+		}
+		return strings.Join(ids, ";")
+	}},
+	{Key: "last_login_at", Header: "Last login", HeaderVI: "Đăng nhập lần cuối", value: func(r *ExportRecord) string {
+		if acc := r.User.Edges.Account; acc != nil && acc.LastLoginAt != nil {
+			return acc.LastLoginAt.Format(time.RFC3339)
+		}
+		return ""
+	}},
+	{Key: "created_at", Header: "Created at", HeaderVI: "Ngày tạo", value: func(r *ExportRecord) string {
+		return r.User.CreatedAt.Format(time.RFC3339)
+	}},
+	{Key: "updated_at", Header: "Updated at", HeaderVI: "Ngày cập nhật", value: func(r *ExportRecord) string {
+		return r.User.UpdatedAt.Format(time.RFC3339)
+	}},
+	{Key: ColumnRoles, Header: "Roles", HeaderVI: "Vai trò", External: true, value: func(r *ExportRecord) string {
+		return strings.Join(r.Roles, ";")
+	}},
+	{Key: ColumnEmployeeCode, Header: "Employee code", HeaderVI: "Mã nhân viên", External: true, value: func(r *ExportRecord) string {
+		return r.EmployeeCode
+	}},
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// ResolveColumns chọn cột theo thứ tự yêu cầu.
+// Không chỉ định cột thì lấy mọi cột nội bộ, bỏ các cột nhạy cảm nếu không có quyền;
+// chỉ định cột nhạy cảm mà không có quyền thì trả về ErrSensitiveColumn.
+func ResolveColumns(keys []string, allowSensitive bool) ([]ExportColumn, error) {
+	if len(keys) == 0 {
+		cols := make([]ExportColumn, 0, len(ExportColumns))
+		for _, c := range ExportColumns {
+			if c.External || (c.Sensitive && !allowSensitive) {
+				continue
+			}
+			cols = append(cols, c)
+		}
+		return cols, nil
+	}
+
+	byKey := make(map[string]ExportColumn, len(ExportColumns))
+	for _, c := range ExportColumns {
+		byKey[c.Key] = c
+	}
+	cols := make([]ExportColumn, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+		if key == "" || seen[key] {
+			continue
+		}
+		c, ok := byKey[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownColumn, key)
+		}
+		if c.Sensitive && !allowSensitive {
+			return nil, fmt.Errorf("%w: %s", ErrSensitiveColumn, key)
+		}
+		seen[key] = true
+		cols = append(cols, c)
+	}
+	return cols, nil
+}
+
+// HasColumn kiểm tra cột có được chọn không (dùng để quyết định có gọi service khác)
+func HasColumn(columns []ExportColumn, key string) bool {
+	for _, c := range columns {
+		if c.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// ContentType và phần mở rộng file của từng định dạng export
+func ContentType(format Format) string {
+	switch format {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case FormatJSONL:
+		return "application/x-ndjson"
+	}
+	return "application/octet-stream"
+}
+
+// Exporter ghi từng user ra writer; Close phải được gọi để ghi phần còn lại của file
+type Exporter interface {
+	Write(r *ExportRecord) error
+	Close() error
+}
+
+// ValidateExport kiểm tra định dạng và ngôn ngữ tiêu đề trước khi ghi bất kỳ dữ liệu nào ra writer
+func ValidateExport(format Format, lang string) error {
+	switch format {
+	case FormatCSV, FormatXLSX, FormatJSONL:
+	default:
+		return ErrUnsupportedFormat
+	}
+	switch lang {
+	case "", LangEN, LangVI:
+	default:
+		return ErrUnsupportedLang
+	}
+	return nil
+}
+
+// NewExporter tạo exporter và ghi dòng tiêu đề (CSV/XLSX) theo ngôn ngữ lang
+func NewExporter(w io.Writer, format Format, columns []ExportColumn, lang string) (Exporter, error) {
+	if err := ValidateExport(format, lang); err != nil {
+		return nil, err
+	}
+	headers := make([]string, len(columns))
+	for i, c := range columns {
+		headers[i] = c.Header
+		if lang == LangVI {
+			headers[i] = c.HeaderVI
+		}
+	}
+
+	switch format {
+	case FormatCSV:
+		// BOM để Excel nhận đúng UTF-8 (tiếng Việt)
+		if _, err := w.Write([]byte("\xef\xbb\xbf")); err != nil {
+			return nil, err
+		}
+		cw := csv.NewWriter(w)
+		if err := cw.Write(headers); err != nil {
+			return nil, err
+		}
+		return &csvExporter{w: cw, columns: columns}, nil
+	case FormatXLSX:
+		f := excelize.NewFile()
+		sw, err := f.NewStreamWriter("Sheet1")
+		if err != nil {
+			return nil, fmt.Errorf("#1 NewExporter: %w", err)
+		}
+		ex := &xlsxExporter{out: w, file: f, sw: sw, columns: columns, row: 1}
+		if err := ex.writeRow(headers); err != nil {
+			return nil, fmt.Errorf("#2 NewExporter: %w", err)
+		}
+		return ex, nil
+	default:
+		return &jsonlExporter{w: w, columns: columns}, nil
+	}
+}
+
+type csvExporter struct {
+	w       *csv.Writer
+	columns []ExportColumn
+}
+
+func (e *csvExporter) Write(r *ExportRecord) error {
+	record := make([]string, len(e.columns))
+	for i, c := range e.columns {
+		record[i] = escapeFormula(c.value(r))
+	}
+	return e.w.Write(record)
+}
+
+// Ký tự đầu ô khiến Excel/Sheets hiểu ô CSV là công thức
+const formulaPrefixes = "=+-@\t\r"
+
+// escapeFormula chặn CSV injection: ô bắt đầu bằng ký tự công thức được thêm dấu ' ở đầu để
+// bảng tính hiển thị như văn bản (số điện thoại E.164 cũng được thêm vì bắt đầu bằng +)
+func escapeFormula(v string) string {
+	if v != "" && strings.ContainsRune(formulaPrefixes, rune(v[0])) {
+		return "'" + v
+	}
+	return v
+}
+
+// unescapeFormula bỏ dấu ' do escapeFormula thêm khi import lại file CSV đã export
+func unescapeFormula(v string) string {
+	if len(v) > 1 && v[0] == '\'' && strings.ContainsRune(formulaPrefixes, rune(v[1])) {
+		return v[1:]
+	}
+	return v
+}
+
+func (e *csvExporter) Close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type xlsxExporter struct {
+	out     io.Writer
+	file    *excelize.File
+	sw      *excelize.StreamWriter
+	columns []ExportColumn
+	row     int
+}
+
+// writeRow ghi các giá trị dạng ô chuỗi (inlineStr), không bao giờ là công thức,
+// nên XLSX không cần escape như CSV
+func (e *xlsxExporter) writeRow(values []string) error {
+	cells := make([]interface{}, len(values))
+	for i, v := range values {
+		cells[i] = v
+	}
+	cell, err := excelize.CoordinatesToCellName(1, e.row)
+	if err != nil {
+		return err
+	}
+	e.row++
+	return e.sw.SetRow(cell, cells)
+}
+
+func (e *xlsxExporter) Write(r *ExportRecord) error {
+	values := make([]string, len(e.columns))
+	for i, c := range e.columns {
+		values[i] = c.value(r)
+	}
+	return e.writeRow(values)
+}
+
+// Close ghi file XLSX hoàn chỉnh: định dạng zip nên chỉ ghi ra writer được khi đã đủ dữ liệu
+func (e *xlsxExporter) Close() error {
+	defer e.file.Close()
+	if err := e.sw.Flush(); err != nil {
+		return err
+	}
+	return e.file.Write(e.out)
+}
+
+type jsonlExporter struct {
+	w       io.Writer
+	columns []ExportColumn
+	buf     bytes.Buffer
+}
+
+// Write ghi một object JSON mỗi dòng, giữ thứ tự key theo thứ tự cột
+func (e *jsonlExporter) Write(r *ExportRecord) error {
+	e.buf.Reset()
+	e.buf.WriteByte('{')
+	for i, c := range e.columns {
+		if i > 0 {
+			e.buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(c.Key)
+		value, _ := json.Marshal(c.value(r))
+		e.buf.Write(key)
+		e.buf.WriteByte(':')
+		e.buf.Write(value)
+	}
+	e.buf.WriteString("}\n")
+	_, err := e.w.Write(e.buf.Bytes())
+	return err
+}
+
+func (e *jsonlExporter) Close() error {
+	return nil
+}
@@ -0,0 +1,89 @@
+package userio
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/huynhthanhthao/hrm_user_service/ent"
+)
+
+func TestExportEscapesFormulas(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantCSV string
+	}{
+		{name: "văn bản thường", value: "Nguyễn", wantCSV: "Nguyễn"},
+		{name: "công thức", value: `=HYPERLINK("http://evil.example","x")`, wantCSV: `'=HYPERLINK("http://evil.example","x")`},
+		{name: "dấu cộng", value: "+84912345678", wantCSV: "'+84912345678"},
+		{name: "dấu trừ", value: "-2+3", wantCSV: "'-2+3"},
+		{name: "a còng", value: "@SUM(A1)", wantCSV: "'@SUM(A1)"},
+		{name: "tab", value: "\t=1", wantCSV: "'\t=1"},
+		{name: "dấu nháy sẵn có", value: "'abc", wantCSV: "'abc"},
+	}
+	columns, err := ResolveColumns([]string{FieldLastName}, false)
+	if err != nil {
+		t.Fatalf("ResolveColumns: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := &ExportRecord{User: &ent.User{LastName: tt.value}}
+
+			var csvBuf bytes.Buffer
+			ex, err := NewExporter(&csvBuf, FormatCSV, columns, "")
+			if err != nil {
+				t.Fatalf("NewExporter csv: %v", err)
+			}
+			if err := ex.Write(record); err != nil {
+				t.Fatalf("Write csv: %v", err)
+			}
+			if err := ex.Close(); err != nil {
+				t.Fatalf("Close csv: %v", err)
+			}
+			rows, err := readTable(bytes.NewReader(csvBuf.Bytes()), FormatCSV)
+			if err != nil {
+				t.Fatalf("readTable csv: %v", err)
+			}
+			if got := rows[1][0]; got != tt.value {
+				t.Errorf("csv round trip = %q, want %q", got, tt.value)
+			}
+			raw, err := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(csvBuf.Bytes(), []byte("\xef\xbb\xbf")))).ReadAll()
+			if err != nil {
+				t.Fatalf("csv: %v", err)
+			}
+			if got := raw[1][0]; got != tt.wantCSV {
+				t.Errorf("csv cell = %q, want %q", got, tt.wantCSV)
+			}
+
+			var xlsxBuf bytes.Buffer
+			ex, err = NewExporter(&xlsxBuf, FormatXLSX, columns, "")
+			if err != nil {
+				t.Fatalf("NewExporter xlsx: %v", err)
+			}
+			if err := ex.Write(record); err != nil {
+				t.Fatalf("Write xlsx: %v", err)
+			}
+			if err := ex.Close(); err != nil {
+				t.Fatalf("Close xlsx: %v", err)
+			}
+			f, err := excelize.OpenReader(&xlsxBuf)
+			if err != nil {
+				t.Fatalf("OpenReader: %v", err)
+			}
+			defer f.Close()
+			if formula, _ := f.GetCellFormula("Sheet1", "A2"); formula != "" {
+				t.Errorf("xlsx formula = %q, want none", formula)
+			}
+			if typ, _ := f.GetCellType("Sheet1", "A2"); typ != excelize.CellTypeInlineString {
+				t.Errorf("xlsx cell type = %v, want inline string", typ)
+			}
+			if v, _ := f.GetCellValue("Sheet1", "A2"); v != tt.value {
+				t.Errorf("xlsx value = %q, want %q", v, tt.value)
+			}
+		})
+	}
+}
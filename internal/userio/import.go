@@ -0,0 +1,317 @@
+package userio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/huynhthanhthao/hrm_user_service/internal/dto"
+	"github.com/huynhthanhthao/hrm_user_service/internal/phone"
+	"github.com/huynhthanhthao/hrm_user_service/internal/unaccent"
+)
+
+// Các field của dto.CreateUserDTO có thể import
+const (
+	FieldFirstName    = "first_name"
+	FieldLastName     = "last_name"
+	FieldGender       = "gender"
+	FieldEmail        = "email"
+	FieldPhone        = "phone"
+	FieldWardCode     = "ward_code"
+	FieldProvinceCode = "province_code"
+	FieldAddress      = "address"
+	FieldAvatar       = "avatar"
+	FieldUsername     = "username"
+	FieldPassword     = "password"
+	FieldPermIDs      = "perm_ids"
+	FieldRoleIDs      = "role_ids"
+	FieldOrgIDs       = "org_ids"
+)
+
+var importFields = map[string]struct{}{
+	FieldFirstName: {}, FieldLastName: {}, FieldGender: {}, FieldEmail: {}, FieldPhone: {},
+	FieldWardCode: {}, FieldProvinceCode: {}, FieldAddress: {}, FieldAvatar: {},
+	FieldUsername: {}, FieldPassword: {}, FieldPermIDs: {}, FieldRoleIDs: {}, FieldOrgIDs: {},
+}
+
+// requiredColumns phải có trong file, các ràng buộc còn lại kiểm tra theo từng dòng
+var requiredColumns = []string{FieldFirstName, FieldLastName, FieldGender, FieldPhone, FieldUsername, FieldPassword}
+
+// ColumnMapping ánh xạ tiêu đề cột trong file sang field import.
+// Tiêu đề được so khớp không phân biệt hoa thường và dấu tiếng Việt.
+type ColumnMapping map[string]string
+
+// DefaultColumnMapping nhận tiêu đề tiếng Anh (tên field) và tiêu đề tiếng Việt thường gặp
+var DefaultColumnMapping = ColumnMapping{
+	"Tên":           FieldFirstName,
+	"Họ":            FieldLastName,
+	"Họ đệm":        FieldLastName,
+	"Giới tính":     FieldGender,
+	"Số điện thoại": FieldPhone,
+	"Điện thoại":    FieldPhone,
+	"SĐT":           FieldPhone,
+	"Mã phường xã":  FieldWardCode,
+	"Mã xã":         FieldWardCode,
+	"Mã tỉnh":       FieldProvinceCode,
+	"Địa chỉ":       FieldAddress,
+	"Ảnh đại diện":  FieldAvatar,
+	"Tên đăng nhập": FieldUsername,
+	"Mật khẩu":      FieldPassword,
+	"Quyền":         FieldPermIDs,
+	"Vai trò":       FieldRoleIDs,
+	"Tổ chức":       FieldOrgIDs,
+}
+
+var ErrUnknownField = errors.New("unknown import field")
+
+func normalizeHeader(h string) string {
+	return strings.ReplaceAll(unaccent.Fold(h), "_", " ")
+}
+
+// resolve gộp mapping của người dùng lên mapping mặc định, key đã được chuẩn hóa
+func (m ColumnMapping) resolve() (map[string]string, error) {
+	res := make(map[string]string, len(importFields)+len(DefaultColumnMapping)+len(m))
+	for field := range importFields {
+		res[normalizeHeader(field)] = field
+	}
+	for header, field := range DefaultColumnMapping {
+		res[normalizeHeader(header)] = field
+	}
+	for header, field := range m {
+		if _, ok := importFields[field]; !ok {
+			return nil, fmt.Errorf("%w %q for column %q", ErrUnknownField, field, header)
+		}
+		res[normalizeHeader(header)] = field
+	}
+	return res, nil
+}
+
+// Row là một dòng dữ liệu của file import, Line tính từ 1 như trong bảng tính
+type Row struct {
+	Line   int
+	User   dto.CreateUserDTO
+	Errors []string
+}
+
+func (r *Row) addError(format string, args ...interface{}) {
+	r.Errors = append(r.Errors, fmt.Sprintf(format, args...))
+}
+
+// Parse đọc file CSV/XLSX, ánh xạ cột và kiểm tra từng dòng theo các rule của dto.CreateUserDTO.
+// Lỗi trả về là lỗi của cả file; lỗi của từng dòng nằm trong Row.Errors.
+func Parse(r io.Reader, format Format, mapping ColumnMapping) ([]Row, error) {
+	headers, err := mapping.resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	table, err := readTable(r, format)
+	if err != nil {
+		return nil, err
+	}
+
+	headerIdx := -1
+	for i, record := range table {
+		if !isBlank(record) {
+			headerIdx = i
+			break
+		}
+	}
+	if headerIdx < 0 {
+		return nil, ErrEmptyFile
+	}
+
+	columns := make(map[int]string)
+	present := make(map[string]bool)
+	var unknown []string
+	for i, h := range table[headerIdx] {
+		if strings.TrimSpace(h) == "" {
+			continue
+		}
+		field, ok := headers[normalizeHeader(h)]
+		if !ok {
+			unknown = append(unknown, h)
+			continue
+		}
+		if present[field] {
+			return nil, fmt.Errorf("#1 Parse: column for field %q appears more than once", field)
+		}
+		columns[i] = field
+		present[field] = true
+	}
+
+	var missing []string
+	for _, field := range requiredColumns {
+		if !present[field] {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("#2 Parse: missing columns %s (unrecognized: %s)",
+			strings.Join(missing, ", "), strings.Join(unknown, ", "))
+	}
+
+	if len(table)-headerIdx-1 > MaxRows {
+		return nil, ErrTooManyRows
+	}
+
+	rows := make([]Row, 0, len(table)-headerIdx-1)
+	for i := headerIdx + 1; i < len(table); i++ {
+		record := table[i]
+		if isBlank(record) {
+			continue
+		}
+		row := Row{Line: i + 1}
+		for col, field := range columns {
+			if col < len(record) {
+				row.set(field, strings.TrimSpace(record[col]))
+			}
+		}
+		row.validate()
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func (r *Row) set(field, value string) {
+	u := &r.User
+	switch field {
+	case FieldFirstName:
+		u.FirstName = value
+	case FieldLastName:
+		u.LastName = value
+	case FieldGender:
+		u.Gender = normalizeGender(value)
+	case FieldEmail:
+		u.Email = value
+	case FieldPhone:
+		u.Phone = normalizePhone(value)
+	case FieldWardCode:
+		u.WardCode = value
+	case FieldProvinceCode:
+		u.ProvinceCode = value
+	case FieldAddress:
+		u.Address = value
+	case FieldAvatar:
+		u.Avatar = value
+	case FieldUsername:
+		u.Account.Username = value
+	case FieldPassword:
+		u.Account.Password = value
+	case FieldPermIDs:
+		u.PermIDs = splitList(value)
+	case FieldRoleIDs:
+		u.RoleIDs = splitList(value)
+	case FieldOrgIDs:
+		for _, s := range splitList(value) {
+			id, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				r.addError("%s: invalid organization id %q", FieldOrgIDs, s)
+				continue
+			}
+			u.OrgIDs = append(u.OrgIDs, id)
+		}
+	}
+}
+
+// validate dùng cùng validator với gin binding để rule giống hệt API tạo user
+func (r *Row) validate() {
+	err := binding.Validator.ValidateStruct(&r.User)
+	if err == nil {
+		return
+	}
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		r.addError("%s", err.Error())
+		return
+	}
+	for _, fe := range verrs {
+		if fe.Param() != "" {
+			r.addError("%s: failed on '%s=%s'", fieldName(fe), fe.Tag(), fe.Param())
+		} else {
+			r.addError("%s: failed on '%s'", fieldName(fe), fe.Tag())
+		}
+	}
+}
+
+// fieldName trả về tên field import tương ứng với lỗi validate
+func fieldName(fe validator.FieldError) string {
+	switch fe.StructNamespace() {
+	case "CreateUserDTO.Account.Username":
+		return FieldUsername
+	case "CreateUserDTO.Account.Password":
+		return FieldPassword
+	}
+	switch fe.StructField() {
+	case "FirstName":
+		return FieldFirstName
+	case "LastName":
+		return FieldLastName
+	case "Gender":
+		return FieldGender
+	case "Email":
+		return FieldEmail
+	case "Phone":
+		return FieldPhone
+	case "WardCode":
+		return FieldWardCode
+	case "ProvinceCode":
+		return FieldProvinceCode
+	case "Address":
+		return FieldAddress
+	}
+	switch {
+	case strings.HasPrefix(fe.StructField(), "PermIDs"):
+		return FieldPermIDs
+	case strings.HasPrefix(fe.StructField(), "RoleIDs"):
+		return FieldRoleIDs
+	case strings.HasPrefix(fe.StructField(), "OrgIDs"):
+		return FieldOrgIDs
+	}
+	return fe.Field()
+}
+
+// normalizeGender chấp nhận cả giá trị tiếng Việt (Nam/Nữ/Khác)
+func normalizeGender(v string) string {
+	switch unaccent.Fold(v) {
+	case "nam", "male", "m":
+		return "male"
+	case "nu", "female", "f":
+		return "female"
+	case "khac", "other":
+		return "other"
+	}
+	return v
+}
+
+// normalizePhone chuyển phone sang E.164. Bảng tính thường làm mất số 0 đầu ("912345678")
+// nên số 9 chữ số được hiểu là số trong nước. Số không hợp lệ được giữ nguyên để validate báo lỗi.
+func normalizePhone(v string) string {
+	if n, err := phone.Normalize(v); err == nil {
+		return n
+	}
+	if n, err := phone.Normalize("0" + v); err == nil && len(strings.TrimSpace(v)) == 9 {
+		return n
+	}
+	return v
+}
+
+func splitList(v string) []string {
+	parts := strings.FieldsFunc(v, func(r rune) bool {
+		return r == ',' || r == ';' || r == '|'
+	})
+	res := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			res = append(res, p)
+		}
+	}
+	return res
+}
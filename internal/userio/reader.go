@@ -0,0 +1,107 @@
+package userio
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+	// Chỉ dùng cho export
+	FormatJSONL Format = "jsonl"
+)
+
+// Giới hạn kích thước file và số dòng cho mỗi lần import
+const (
+	MaxFileSize = 10 << 20
+	MaxRows     = 5000
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported file format, expected csv, xlsx or jsonl")
+	ErrFileTooLarge      = fmt.Errorf("file is larger than %d bytes", MaxFileSize)
+	ErrTooManyRows       = fmt.Errorf("file has more than %d rows", MaxRows)
+	ErrEmptyFile         = errors.New("file has no header row")
+)
+
+// ParseFormat nhận "csv"/"xlsx"/"jsonl"; nếu rỗng thì đoán theo phần mở rộng của tên file
+func ParseFormat(format, filename string) (Format, error) {
+	f := strings.ToLower(strings.TrimSpace(format))
+	if f == "" {
+		f = strings.TrimPrefix(strings.ToLower(filepath.Ext(filename)), ".")
+	}
+	switch Format(f) {
+	case FormatCSV:
+		return FormatCSV, nil
+	case FormatXLSX:
+		return FormatXLSX, nil
+	case FormatJSONL, "ndjson":
+		return FormatJSONL, nil
+	}
+	return "", ErrUnsupportedFormat
+}
+
+// readTable đọc toàn bộ file thành bảng chuỗi (XLSX: sheet đầu tiên)
+func readTable(r io.Reader, format Format) ([][]string, error) {
+	data, err := io.ReadAll(io.LimitReader(r, MaxFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("#1 readTable: failed to read file: %w", err)
+	}
+	if len(data) > MaxFileSize {
+		return nil, ErrFileTooLarge
+	}
+
+	switch format {
+	case FormatCSV:
+		// Excel thường lưu CSV kèm BOM UTF-8
+		data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+		reader := csv.NewReader(bytes.NewReader(data))
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("#2 readTable: invalid csv: %w", err)
+		}
+		for _, record := range records {
+			for i, cell := range record {
+				record[i] = unescapeFormula(cell)
+			}
+		}
+		return records, nil
+	case FormatXLSX:
+		f, err := excelize.OpenReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("#3 readTable: invalid xlsx: %w", err)
+		}
+		defer f.Close()
+		sheets := f.GetSheetList()
+		if len(sheets) == 0 {
+			return nil, ErrEmptyFile
+		}
+		rows, err := f.GetRows(sheets[0])
+		if err != nil {
+			return nil, fmt.Errorf("#4 readTable: failed to read sheet %q: %w", sheets[0], err)
+		}
+		return rows, nil
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+func isBlank(record []string) bool {
+	for _, cell := range record {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,26 @@
+package userio
+
+// Trạng thái của từng dòng trong báo cáo import
+const (
+	ImportStatusValid   = "valid"   // dry-run: dòng hợp lệ, sẽ được tạo khi commit
+	ImportStatusCreated = "created" // commit: đã tạo user
+	ImportStatusInvalid = "invalid" // dữ liệu không hợp lệ hoặc trùng lặp
+	ImportStatusFailed  = "failed"  // hợp lệ nhưng batch chứa dòng này bị lỗi khi ghi
+)
+
+type ImportRowResult struct {
+	Line     int
+	Username string
+	UserID   int
+	Status   string
+	Errors   []string
+}
+
+type ImportSummary struct {
+	DryRun  bool
+	Total   int
+	Valid   int
+	Invalid int
+	Created int
+	Failed  int
+}
@@ -0,0 +1,95 @@
+package viewer
+
+import "context"
+
+// Viewer mô tả người (hoặc service) đang gọi API.
+// OrgID khác nil thì mọi truy vấn User bị giới hạn trong tổ chức đó;
+// OrgID nil thì viewer chỉ thấy chính mình. Context không có viewer là lời gọi nội bộ trong tiến trình
+// hoặc service nội bộ đã xác thực, không bị giới hạn.
+type Viewer struct {
+	UserID    int
+	OrgID     *int64
+	PermCodes []string
+	// Service nội bộ đã xác thực bằng service token, làm việc thay mặt tổ chức OrgID
+	Internal bool
+	// Chỉ Unscoped đặt cờ này: bỏ giới hạn tổ chức cho các kiểm tra trên toàn hệ thống
+	allOrgs bool
+}
+
+type ctxKey struct{}
+
+func NewContext(ctx context.Context, v *Viewer) context.Context {
+	return context.WithValue(ctx, ctxKey{}, v)
+}
+
+func FromContext(ctx context.Context) *Viewer {
+	v, _ := ctx.Value(ctxKey{}).(*Viewer)
+	return v
+}
+
+// OrgFromContext trả về tổ chức đang được chọn của viewer (nếu có)
+func OrgFromContext(ctx context.Context) (int64, bool) {
+	v := FromContext(ctx)
+	if v == nil || v.OrgID == nil {
+		return 0, false
+	}
+	return *v.OrgID, true
+}
+
+// Unscoped trả về context bỏ giới hạn tổ chức của viewer (giữ nguyên user và quyền).
+// Dùng cho các kiểm tra trên toàn hệ thống như trùng phone/email, không dùng để trả dữ liệu cho người gọi.
+func Unscoped(ctx context.Context) context.Context {
+	v := FromContext(ctx)
+	if v == nil || v.allOrgs {
+		return ctx
+	}
+	cp := *v
+	cp.OrgID = nil
+	cp.allOrgs = true
+	return NewContext(ctx, &cp)
+}
+
+// AllOrgs cho biết truy vấn trong ctx không bị giới hạn tổ chức
+func AllOrgs(ctx context.Context) bool {
+	v := FromContext(ctx)
+	return v == nil || v.allOrgs
+}
+
+func (v *Viewer) HasPerm(code string) bool {
+	if v == nil {
+		return false
+	}
+	for _, c := range v.PermCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Mã quyền (perm code) do permission service cấp, dùng để kiểm tra quyền của viewer.
+// Dạng "user.<hành động>" hoặc "user.<đối tượng con>.<hành động>", các phần cách nhau bởi dấu chấm.
+const (
+	PermUserRead   = "user.read"
+	PermUserCreate = "user.create"
+	PermUserUpdate = "user.update"
+	PermUserDelete = "user.delete"
+	PermUserExport = "user.export"
+	// Export các cột nhạy cảm (số điện thoại, email, địa chỉ)
+	PermUserExportSensitive = "user.export.sensitive"
+	// Đọc/ghi hồ sơ nhân sự (ngày sinh, CCCD, mã số thuế, BHXH, người liên hệ khẩn cấp)
+	PermUserProfileRead   = "user.profile.read"
+	PermUserProfileUpdate = "user.profile.update"
+	// Tìm và gộp user trùng
+	PermUserMerge = "user.merge"
+	// Xem đầy đủ số điện thoại, email, địa chỉ của user khác (không có thì bị che)
+	PermUserPIIRead = "user.pii.read"
+	// Xuất dữ liệu cá nhân của user khác và ẩn danh hóa user (quyền được lãng quên)
+	PermUserPersonalData = "user.personal_data.manage"
+	// Xem audit log thay đổi user/account
+	PermUserAuditRead = "user.audit.read"
+	// Tạo, sửa, xóa định nghĩa thuộc tính tùy chỉnh của user trong tổ chức
+	PermUserAttributeManage = "user.attribute.manage"
+	// Quản lý mọi nhóm user trong tổ chức (owner/admin của nhóm không cần quyền này)
+	PermUserGroupManage = "user.group.manage"
+)
@@ -9,6 +9,8 @@ package user
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
@@ -24,10 +26,16 @@ const (
 )
 
 type ListUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Mỗi từ khóa khớp một phần họ tên hoặc username (không phân biệt dấu), hoặc khớp chính xác
+	// số điện thoại/email đầy đủ. Không tìm theo một phần số điện thoại/email.
+	Search string `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	// Opaque cursor từ next_cursor của trang trước; khi có cursor thì bỏ qua page
+	Cursor        string      `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Filter        *UserFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy       []*OrderBy  `protobuf:"bytes,6,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -83,26 +91,252 @@ func (x *ListUsersRequest) GetSearch() string {
 	return ""
 }
 
-type User struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstName     string                  `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName      string                  `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Gender        string                  `protobuf:"bytes,4,opt,name=gender,proto3" json:"gender,omitempty"`
-	Phone         *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email         *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	WardCode      *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=ward_code,json=wardCode,proto3" json:"ward_code,omitempty"`
-	Address       *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
-	Avatar        *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	CreatedAt     string                  `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                  `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+func (x *ListUsersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetFilter() *UserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListUsersRequest) GetOrderBy() []*OrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
+type UserFilter struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Genders []string               `protobuf:"bytes,1,rep,name=genders,proto3" json:"genders,omitempty"`
+	// active | inactive
+	AccountStatus string                 `protobuf:"bytes,2,opt,name=account_status,json=accountStatus,proto3" json:"account_status,omitempty"`
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	UpdatedFrom   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_from,json=updatedFrom,proto3" json:"updated_from,omitempty"`
+	UpdatedTo     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_to,json=updatedTo,proto3" json:"updated_to,omitempty"`
+	WardCodes     []string               `protobuf:"bytes,7,rep,name=ward_codes,json=wardCodes,proto3" json:"ward_codes,omitempty"`
+	ProvinceCodes []string               `protobuf:"bytes,8,rep,name=province_codes,json=provinceCodes,proto3" json:"province_codes,omitempty"`
+	HasAvatar     *wrapperspb.BoolValue  `protobuf:"bytes,9,opt,name=has_avatar,json=hasAvatar,proto3" json:"has_avatar,omitempty"`
+	HasEmail      *wrapperspb.BoolValue  `protobuf:"bytes,10,opt,name=has_email,json=hasEmail,proto3" json:"has_email,omitempty"`
+	// Lọc theo thuộc tính tùy chỉnh (key -> giá trị, so sánh bằng theo kiểu của định nghĩa).
+	// Viewer không chọn tổ chức phải truyền attribute_org_id
+	Attributes     map[string]string `protobuf:"bytes,11,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AttributeOrgId int64             `protobuf:"varint,12,opt,name=attribute_org_id,json=attributeOrgId,proto3" json:"attribute_org_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserFilter) Reset() {
+	*x = UserFilter{}
+	mi := &file_proto_user_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFilter) ProtoMessage() {}
+
+func (x *UserFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFilter.ProtoReflect.Descriptor instead.
+func (*UserFilter) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserFilter) GetGenders() []string {
+	if x != nil {
+		return x.Genders
+	}
+	return nil
+}
+
+func (x *UserFilter) GetAccountStatus() string {
+	if x != nil {
+		return x.AccountStatus
+	}
+	return ""
+}
+
+func (x *UserFilter) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *UserFilter) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *UserFilter) GetUpdatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedFrom
+	}
+	return nil
+}
+
+func (x *UserFilter) GetUpdatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedTo
+	}
+	return nil
+}
+
+func (x *UserFilter) GetWardCodes() []string {
+	if x != nil {
+		return x.WardCodes
+	}
+	return nil
+}
+
+func (x *UserFilter) GetProvinceCodes() []string {
+	if x != nil {
+		return x.ProvinceCodes
+	}
+	return nil
+}
+
+func (x *UserFilter) GetHasAvatar() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.HasAvatar
+	}
+	return nil
+}
+
+func (x *UserFilter) GetHasEmail() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.HasEmail
+	}
+	return nil
+}
+
+func (x *UserFilter) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UserFilter) GetAttributeOrgId() int64 {
+	if x != nil {
+		return x.AttributeOrgId
+	}
+	return 0
+}
+
+type OrderBy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// first_name | last_name | created_at | updated_at | last_login_at
+	Field         string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Desc          bool   `protobuf:"varint,2,opt,name=desc,proto3" json:"desc,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *OrderBy) Reset() {
+	*x = OrderBy{}
+	mi := &file_proto_user_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBy) ProtoMessage() {}
+
+func (x *OrderBy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBy.ProtoReflect.Descriptor instead.
+func (*OrderBy) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OrderBy) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *OrderBy) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+type User struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Gender    string                 `protobuf:"bytes,4,opt,name=gender,proto3" json:"gender,omitempty"`
+	// phone, email, address bị che (0912***678, a***@company.vn) nếu viewer không có quyền user.pii.read
+	// và không phải chính user đó; service gọi bằng x-org-id không nhận các field này
+	Phone        *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email        *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	WardCode     *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=ward_code,json=wardCode,proto3" json:"ward_code,omitempty"`
+	Address      *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	Avatar       *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	CreatedAt    string                  `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    string                  `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	OrgIds       []int64                 `protobuf:"varint,12,rep,packed,name=org_ids,json=orgIds,proto3" json:"org_ids,omitempty"`
+	ProvinceCode *wrapperspb.StringValue `protobuf:"bytes,13,opt,name=province_code,json=provinceCode,proto3" json:"province_code,omitempty"`
+	// avatar nếu có, ngược lại là URL avatar mặc định tạo từ chữ cái đầu của tên
+	AvatarUrl string `protobuf:"bytes,14,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	// Chỉ có khi viewer được phép xem hồ sơ nhân sự
+	Profile *UserProfile `protobuf:"bytes,15,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Tên đơn vị hành chính tra từ ward_code/province_code
+	Province *AdminUnit `protobuf:"bytes,16,opt,name=province,proto3" json:"province,omitempty"`
+	District *AdminUnit `protobuf:"bytes,17,opt,name=district,proto3" json:"district,omitempty"`
+	Ward     *AdminUnit `protobuf:"bytes,18,opt,name=ward,proto3" json:"ward,omitempty"`
+	// Tăng sau mỗi lần cập nhật; gửi lại trong UpdateUserRequest.expected_version để tránh ghi đè
+	Version int32 `protobuf:"varint,19,opt,name=version,proto3" json:"version,omitempty"`
+	// Thuộc tính tùy chỉnh theo tổ chức: {"<org_id>": {"badge_number": "B-001", "shoe_size": 42}};
+	// viewer đang chọn tổ chức chỉ thấy thuộc tính của tổ chức đó
+	CustomAttributes *structpb.Struct `protobuf:"bytes,20,opt,name=custom_attributes,json=customAttributes,proto3" json:"custom_attributes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_proto_user_user_proto_msgTypes[1]
+	mi := &file_proto_user_user_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -114,7 +348,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[1]
+	mi := &file_proto_user_user_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -127,7 +361,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{1}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *User) GetId() int32 {
@@ -207,34 +441,92 @@ func (x *User) GetUpdatedAt() string {
 	return ""
 }
 
-type RoleExt struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            []byte                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Code          string                  `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Name          string                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Color         *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
-	Description   *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+func (x *User) GetOrgIds() []int64 {
+	if x != nil {
+		return x.OrgIds
+	}
+	return nil
+}
+
+func (x *User) GetProvinceCode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ProvinceCode
+	}
+	return nil
+}
+
+func (x *User) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *User) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *User) GetProvince() *AdminUnit {
+	if x != nil {
+		return x.Province
+	}
+	return nil
+}
+
+func (x *User) GetDistrict() *AdminUnit {
+	if x != nil {
+		return x.District
+	}
+	return nil
+}
+
+func (x *User) GetWard() *AdminUnit {
+	if x != nil {
+		return x.Ward
+	}
+	return nil
+}
+
+func (x *User) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *User) GetCustomAttributes() *structpb.Struct {
+	if x != nil {
+		return x.CustomAttributes
+	}
+	return nil
+}
+
+type AdminUnit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoleExt) Reset() {
-	*x = RoleExt{}
-	mi := &file_proto_user_user_proto_msgTypes[2]
+func (x *AdminUnit) Reset() {
+	*x = AdminUnit{}
+	mi := &file_proto_user_user_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoleExt) String() string {
+func (x *AdminUnit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleExt) ProtoMessage() {}
+func (*AdminUnit) ProtoMessage() {}
 
-func (x *RoleExt) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[2]
+func (x *AdminUnit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,62 +537,329 @@ func (x *RoleExt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleExt.ProtoReflect.Descriptor instead.
-func (*RoleExt) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *RoleExt) GetId() []byte {
-	if x != nil {
-		return x.Id
-	}
-	return nil
+// Deprecated: Use AdminUnit.ProtoReflect.Descriptor instead.
+func (*AdminUnit) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *RoleExt) GetCode() string {
+func (x *AdminUnit) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *RoleExt) GetName() string {
+func (x *AdminUnit) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *RoleExt) GetColor() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Color
-	}
-	return nil
+type EmergencyContact struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Relationship  string                  `protobuf:"bytes,2,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	Phone         string                  `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Address       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoleExt) GetDescription() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Description
-	}
-	return nil
+func (x *EmergencyContact) Reset() {
+	*x = EmergencyContact{}
+	mi := &file_proto_user_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoleExt) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (x *EmergencyContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RoleExt) GetUpdatedAt() *timestamppb.Timestamp {
+func (*EmergencyContact) ProtoMessage() {}
+
+func (x *EmergencyContact) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[5]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type PermExt struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
+// Deprecated: Use EmergencyContact.ProtoReflect.Descriptor instead.
+func (*EmergencyContact) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EmergencyContact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EmergencyContact) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *EmergencyContact) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *EmergencyContact) GetAddress() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+// Các ngày có dạng YYYY-MM-DD
+type UserProfile struct {
+	state                protoimpl.MessageState  `protogen:"open.v1"`
+	UserId               int32                   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DateOfBirth          *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	PlaceOfBirth         *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=place_of_birth,json=placeOfBirth,proto3" json:"place_of_birth,omitempty"`
+	NationalId           *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=national_id,json=nationalId,proto3" json:"national_id,omitempty"`
+	NationalIdIssueDate  *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=national_id_issue_date,json=nationalIdIssueDate,proto3" json:"national_id_issue_date,omitempty"`
+	NationalIdIssuePlace *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=national_id_issue_place,json=nationalIdIssuePlace,proto3" json:"national_id_issue_place,omitempty"`
+	Ethnicity            *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=ethnicity,proto3" json:"ethnicity,omitempty"`
+	Religion             *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=religion,proto3" json:"religion,omitempty"`
+	// single, married, divorced, widowed
+	MaritalStatus         *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=marital_status,json=maritalStatus,proto3" json:"marital_status,omitempty"`
+	TaxCode               *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=tax_code,json=taxCode,proto3" json:"tax_code,omitempty"`
+	SocialInsuranceNumber *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=social_insurance_number,json=socialInsuranceNumber,proto3" json:"social_insurance_number,omitempty"`
+	EmergencyContacts     []*EmergencyContact     `protobuf:"bytes,12,rep,name=emergency_contacts,json=emergencyContacts,proto3" json:"emergency_contacts,omitempty"`
+	UpdatedAt             string                  `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	mi := &file_proto_user_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserProfile) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserProfile) GetDateOfBirth() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *UserProfile) GetPlaceOfBirth() *wrapperspb.StringValue {
+	if x != nil {
+		return x.PlaceOfBirth
+	}
+	return nil
+}
+
+func (x *UserProfile) GetNationalId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NationalId
+	}
+	return nil
+}
+
+func (x *UserProfile) GetNationalIdIssueDate() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NationalIdIssueDate
+	}
+	return nil
+}
+
+func (x *UserProfile) GetNationalIdIssuePlace() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NationalIdIssuePlace
+	}
+	return nil
+}
+
+func (x *UserProfile) GetEthnicity() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Ethnicity
+	}
+	return nil
+}
+
+func (x *UserProfile) GetReligion() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Religion
+	}
+	return nil
+}
+
+func (x *UserProfile) GetMaritalStatus() *wrapperspb.StringValue {
+	if x != nil {
+		return x.MaritalStatus
+	}
+	return nil
+}
+
+func (x *UserProfile) GetTaxCode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.TaxCode
+	}
+	return nil
+}
+
+func (x *UserProfile) GetSocialInsuranceNumber() *wrapperspb.StringValue {
+	if x != nil {
+		return x.SocialInsuranceNumber
+	}
+	return nil
+}
+
+func (x *UserProfile) GetEmergencyContacts() []*EmergencyContact {
+	if x != nil {
+		return x.EmergencyContacts
+	}
+	return nil
+}
+
+func (x *UserProfile) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type RoleExt struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            []byte                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                  `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Color         *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	Description   *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleExt) Reset() {
+	*x = RoleExt{}
+	mi := &file_proto_user_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleExt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleExt) ProtoMessage() {}
+
+func (x *RoleExt) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleExt.ProtoReflect.Descriptor instead.
+func (*RoleExt) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RoleExt) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *RoleExt) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RoleExt) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoleExt) GetColor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Color
+	}
+	return nil
+}
+
+func (x *RoleExt) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *RoleExt) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RoleExt) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type PermExt struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Id            []byte                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Code          string                  `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	Name          string                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
@@ -309,21 +868,5235 @@ type PermExt struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PermExt) Reset() {
-	*x = PermExt{}
-	mi := &file_proto_user_user_proto_msgTypes[3]
+func (x *PermExt) Reset() {
+	*x = PermExt{}
+	mi := &file_proto_user_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermExt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermExt) ProtoMessage() {}
+
+func (x *PermExt) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermExt.ProtoReflect.Descriptor instead.
+func (*PermExt) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PermExt) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *PermExt) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PermExt) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PermExt) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,2,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	TotalUsers    int32                  `protobuf:"varint,3,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
+	CurrentPage   int32                  `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *ListUsersResponse) GetTotalUsers() int32 {
+	if x != nil {
+		return x.TotalUsers
+	}
+	return 0
+}
+
+func (x *ListUsersResponse) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *ListUsersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetUserByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByIdRequest) Reset() {
+	*x = GetUserByIdRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByIdRequest) ProtoMessage() {}
+
+func (x *GetUserByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByIdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetUserByIdRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetUserByIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Roles         []*RoleExt             `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	Perms         []*PermExt             `protobuf:"bytes,3,rep,name=perms,proto3" json:"perms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByIdResponse) Reset() {
+	*x = GetUserByIdResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByIdResponse) ProtoMessage() {}
+
+func (x *GetUserByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetUserByIdResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetUserByIdResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetUserByIdResponse) GetRoles() []*RoleExt {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *GetUserByIdResponse) GetPerms() []*PermExt {
+	if x != nil {
+		return x.Perms
+	}
+	return nil
+}
+
+type GetUsersByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int32                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersByIDsRequest) Reset() {
+	*x = GetUsersByIDsRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByIDsRequest) ProtoMessage() {}
+
+func (x *GetUsersByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetUsersByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetUsersByIDsRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetUsersByIDsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUsersByIDsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetUsersByIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersByIDsResponse) Reset() {
+	*x = GetUsersByIDsResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByIDsResponse) ProtoMessage() {}
+
+func (x *GetUsersByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetUsersByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUsersByIDsResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type SearchUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Khớp gần đúng họ tên (không phân biệt dấu), hoặc khớp chính xác số điện thoại/email đầy đủ
+	Query         string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type UserSearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSearchHit) Reset() {
+	*x = UserSearchHit{}
+	mi := &file_proto_user_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSearchHit) ProtoMessage() {}
+
+func (x *UserSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSearchHit.ProtoReflect.Descriptor instead.
+func (*UserSearchHit) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UserSearchHit) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserSearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SearchUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*UserSearchHit       `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchUsersResponse) GetHits() []*UserSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+type Account struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_proto_user_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Account) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Account) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Account) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CreateUserRequest struct {
+	state        protoimpl.MessageState  `protogen:"open.v1"`
+	FirstName    string                  `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName     string                  `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Gender       string                  `protobuf:"bytes,3,opt,name=gender,proto3" json:"gender,omitempty"`
+	Phone        string                  `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email        *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	WardCode     *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=ward_code,json=wardCode,proto3" json:"ward_code,omitempty"`
+	Address      *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	Avatar       *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Account      *Account                `protobuf:"bytes,9,opt,name=account,proto3" json:"account,omitempty"`
+	PermIds      []string                `protobuf:"bytes,10,rep,name=perm_ids,json=permIds,proto3" json:"perm_ids,omitempty"`
+	RoleIds      []string                `protobuf:"bytes,11,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	OrgIds       []int64                 `protobuf:"varint,12,rep,packed,name=org_ids,json=orgIds,proto3" json:"org_ids,omitempty"`
+	ProvinceCode *wrapperspb.StringValue `protobuf:"bytes,13,opt,name=province_code,json=provinceCode,proto3" json:"province_code,omitempty"`
+	// Cùng dạng User.custom_attributes, kiểm tra theo định nghĩa của các tổ chức của user
+	CustomAttributes *structpb.Struct `protobuf:"bytes,14,opt,name=custom_attributes,json=customAttributes,proto3" json:"custom_attributes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateUserRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetEmail() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+func (x *CreateUserRequest) GetWardCode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.WardCode
+	}
+	return nil
+}
+
+func (x *CreateUserRequest) GetAddress() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *CreateUserRequest) GetAvatar() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+func (x *CreateUserRequest) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *CreateUserRequest) GetPermIds() []string {
+	if x != nil {
+		return x.PermIds
+	}
+	return nil
+}
+
+func (x *CreateUserRequest) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *CreateUserRequest) GetOrgIds() []int64 {
+	if x != nil {
+		return x.OrgIds
+	}
+	return nil
+}
+
+func (x *CreateUserRequest) GetProvinceCode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ProvinceCode
+	}
+	return nil
+}
+
+func (x *CreateUserRequest) GetCustomAttributes() *structpb.Struct {
+	if x != nil {
+		return x.CustomAttributes
+	}
+	return nil
+}
+
+type CreateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponse) ProtoMessage() {}
+
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UpdateUserRequest struct {
+	state        protoimpl.MessageState  `protogen:"open.v1"`
+	Id           int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName    string                  `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName     string                  `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Gender       string                  `protobuf:"bytes,4,opt,name=gender,proto3" json:"gender,omitempty"`
+	Phone        string                  `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email        *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	WardCode     *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=ward_code,json=wardCode,proto3" json:"ward_code,omitempty"`
+	Address      *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	Avatar       *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Account      *Account                `protobuf:"bytes,10,opt,name=account,proto3" json:"account,omitempty"`
+	PermIds      []string                `protobuf:"bytes,11,rep,name=perm_ids,json=permIds,proto3" json:"perm_ids,omitempty"`
+	RoleIds      []string                `protobuf:"bytes,12,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	OrgIds       []int64                 `protobuf:"varint,13,rep,packed,name=org_ids,json=orgIds,proto3" json:"org_ids,omitempty"`
+	ProvinceCode *wrapperspb.StringValue `protobuf:"bytes,14,opt,name=province_code,json=provinceCode,proto3" json:"province_code,omitempty"`
+	// Nếu có: chỉ cập nhật khi version hiện tại của user bằng giá trị này,
+	// ngược lại trả về ABORTED kèm UserVersionConflict trong details
+	ExpectedVersion *wrapperspb.Int32Value `protobuf:"bytes,15,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	// Nếu có: chỉ ghi các field được nêu, kể cả khi rỗng; field nullable (email, ward_code, province_code,
+	// address, avatar) không truyền wrapper thì bị xóa về NULL, perm_ids/role_ids/org_ids rỗng thì xóa hết.
+	// "account" tương đương "account.status" và "account.password".
+	// "custom_attributes.<org_id>" chỉ thay thuộc tính của một tổ chức, "custom_attributes.<org_id>.<key>" chỉ ghi
+	// một thuộc tính; không có giá trị trong custom_attributes thì bị xóa. Không dùng chung với "custom_attributes".
+	// Không có: giữ cách cũ, chuỗi rỗng/danh sách rỗng/wrapper không truyền được xem như không đổi
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,16,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Thay toàn bộ thuộc tính tùy chỉnh; viewer đang chọn tổ chức chỉ thay thuộc tính của tổ chức đó
+	CustomAttributes *structpb.Struct `protobuf:"bytes,17,opt,name=custom_attributes,json=customAttributes,proto3" json:"custom_attributes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateUserRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetEmail() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetWardCode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.WardCode
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetAddress() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetAvatar() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetPermIds() []string {
+	if x != nil {
+		return x.PermIds
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetOrgIds() []int64 {
+	if x != nil {
+		return x.OrgIds
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetProvinceCode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ProvinceCode
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetExpectedVersion() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetCustomAttributes() *structpb.Struct {
+	if x != nil {
+		return x.CustomAttributes
+	}
+	return nil
+}
+
+type UpdateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserResponse) Reset() {
+	*x = UpdateUserResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserResponse) ProtoMessage() {}
+
+func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Chi tiết lỗi khi user đã bị người khác cập nhật (gRPC status details, body HTTP 412)
+type UserVersionConflict struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Error           string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	ExpectedVersion int32                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	Current         *User                  `protobuf:"bytes,3,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UserVersionConflict) Reset() {
+	*x = UserVersionConflict{}
+	mi := &file_proto_user_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserVersionConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserVersionConflict) ProtoMessage() {}
+
+func (x *UserVersionConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserVersionConflict.ProtoReflect.Descriptor instead.
+func (*UserVersionConflict) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UserVersionConflict) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *UserVersionConflict) GetExpectedVersion() int32 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UserVersionConflict) GetCurrent() *User {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteUserRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RestoreUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserRequest) Reset() {
+	*x = RestoreUserRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserRequest) ProtoMessage() {}
+
+func (x *RestoreUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RestoreUserRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserResponse) Reset() {
+	*x = RestoreUserResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserResponse) ProtoMessage() {}
+
+func (x *RestoreUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserResponse.ProtoReflect.Descriptor instead.
+func (*RestoreUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RestoreUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type PurgeUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeUserRequest) Reset() {
+	*x = PurgeUserRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserRequest) ProtoMessage() {}
+
+func (x *PurgeUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserRequest.ProtoReflect.Descriptor instead.
+func (*PurgeUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PurgeUserRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PurgeUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeUserResponse) Reset() {
+	*x = PurgeUserResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserResponse) ProtoMessage() {}
+
+func (x *PurgeUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserResponse.ProtoReflect.Descriptor instead.
+func (*PurgeUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PurgeUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UploadAvatarRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Nội dung file ảnh (JPEG, PNG, GIF hoặc WebP, tối đa 5MB)
+	Data          []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAvatarRequest) Reset() {
+	*x = UploadAvatarRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAvatarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAvatarRequest) ProtoMessage() {}
+
+func (x *UploadAvatarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAvatarRequest.ProtoReflect.Descriptor instead.
+func (*UploadAvatarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UploadAvatarRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UploadAvatarRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UploadAvatarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAvatarResponse) Reset() {
+	*x = UploadAvatarResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAvatarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAvatarResponse) ProtoMessage() {}
+
+func (x *UploadAvatarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAvatarResponse.ProtoReflect.Descriptor instead.
+func (*UploadAvatarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UploadAvatarResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Kết quả của từng phần tử trong batch, cùng thứ tự với request
+type BatchItemStatus struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Index   int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Success bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// id của user (user vừa tạo với BatchCreateUsers)
+	Id            int32 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	User          *User `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemStatus) Reset() {
+	*x = BatchItemStatus{}
+	mi := &file_proto_user_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItemStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemStatus) ProtoMessage() {}
+
+func (x *BatchItemStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemStatus.ProtoReflect.Descriptor instead.
+func (*BatchItemStatus) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BatchItemStatus) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchItemStatus) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchItemStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchItemStatus) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BatchItemStatus) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type BatchCreateUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*CreateUserRequest   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// true: chỉ áp dụng khi mọi phần tử thành công; false: áp dụng từng phần tử (best-effort)
+	AllOrNothing  bool `protobuf:"varint,2,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateUsersRequest) Reset() {
+	*x = BatchCreateUsersRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateUsersRequest) ProtoMessage() {}
+
+func (x *BatchCreateUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateUsersRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BatchCreateUsersRequest) GetItems() []*CreateUserRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchCreateUsersRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+type BatchCreateUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*BatchItemStatus     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateUsersResponse) Reset() {
+	*x = BatchCreateUsersResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateUsersResponse) ProtoMessage() {}
+
+func (x *BatchCreateUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateUsersResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BatchCreateUsersResponse) GetItems() []*BatchItemStatus {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchCreateUsersResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchCreateUsersResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type BatchUpdateUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*UpdateUserRequest   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// true: chỉ lưu khi mọi phần tử thành công. perm_ids/role_ids được đồng bộ sang permission service
+	// sau khi lưu; phần tử đồng bộ lỗi có success = false nhưng vẫn trả về user đã lưu.
+	AllOrNothing  bool `protobuf:"varint,2,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateUsersRequest) Reset() {
+	*x = BatchUpdateUsersRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateUsersRequest) ProtoMessage() {}
+
+func (x *BatchUpdateUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateUsersRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BatchUpdateUsersRequest) GetItems() []*UpdateUserRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchUpdateUsersRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+type BatchUpdateUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*BatchItemStatus     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateUsersResponse) Reset() {
+	*x = BatchUpdateUsersResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateUsersResponse) ProtoMessage() {}
+
+func (x *BatchUpdateUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateUsersResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BatchUpdateUsersResponse) GetItems() []*BatchItemStatus {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchUpdateUsersResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchUpdateUsersResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type BatchDeleteUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int32                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	AllOrNothing  bool                   `protobuf:"varint,2,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteUsersRequest) Reset() {
+	*x = BatchDeleteUsersRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteUsersRequest) ProtoMessage() {}
+
+func (x *BatchDeleteUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteUsersRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BatchDeleteUsersRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchDeleteUsersRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+type BatchDeleteUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*BatchItemStatus     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteUsersResponse) Reset() {
+	*x = BatchDeleteUsersResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteUsersResponse) ProtoMessage() {}
+
+func (x *BatchDeleteUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteUsersResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BatchDeleteUsersResponse) GetItems() []*BatchItemStatus {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchDeleteUsersResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchDeleteUsersResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type ImportOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "csv" hoặc "xlsx"; để trống thì đoán theo filename
+	Format    string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Filename  string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	DryRun    bool   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	BatchSize int32  `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// Tiêu đề cột trong file -> field import (first_name, phone, username, ...)
+	ColumnMapping map[string]string `protobuf:"bytes,5,rep,name=column_mapping,json=columnMapping,proto3" json:"column_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Tổ chức mặc định cho các dòng không có cột org_ids
+	OrgIds        []int64 `protobuf:"varint,6,rep,packed,name=org_ids,json=orgIds,proto3" json:"org_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_proto_user_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImportOptions) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportOptions) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *ImportOptions) GetColumnMapping() map[string]string {
+	if x != nil {
+		return x.ColumnMapping
+	}
+	return nil
+}
+
+func (x *ImportOptions) GetOrgIds() []int64 {
+	if x != nil {
+		return x.OrgIds
+	}
+	return nil
+}
+
+type ImportUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportUsersRequest_Options
+	//	*ImportUsersRequest_Chunk
+	Payload       isImportUsersRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportUsersRequest) Reset() {
+	*x = ImportUsersRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersRequest) ProtoMessage() {}
+
+func (x *ImportUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersRequest.ProtoReflect.Descriptor instead.
+func (*ImportUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ImportUsersRequest) GetPayload() isImportUsersRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportUsersRequest) GetOptions() *ImportOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportUsersRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportUsersRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportUsersRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportUsersRequest_Payload interface {
+	isImportUsersRequest_Payload()
+}
+
+type ImportUsersRequest_Options struct {
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportUsersRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportUsersRequest_Options) isImportUsersRequest_Payload() {}
+
+func (*ImportUsersRequest_Chunk) isImportUsersRequest_Payload() {}
+
+type ImportRowResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Số dòng trong file, tính cả dòng tiêu đề
+	Line     int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	UserId   int32  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// valid | created | invalid | failed
+	Status        string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Errors        []string `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_proto_user_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ImportRowResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ImportSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Valid         int32                  `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	Invalid       int32                  `protobuf:"varint,4,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	Created       int32                  `protobuf:"varint,5,opt,name=created,proto3" json:"created,omitempty"`
+	Failed        int32                  `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSummary) Reset() {
+	*x = ImportSummary{}
+	mi := &file_proto_user_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSummary) ProtoMessage() {}
+
+func (x *ImportSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSummary.ProtoReflect.Descriptor instead.
+func (*ImportSummary) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ImportSummary) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportSummary) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetValid() int32 {
+	if x != nil {
+		return x.Valid
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetInvalid() int32 {
+	if x != nil {
+		return x.Invalid
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type ImportUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportUsersResponse_Row
+	//	*ImportUsersResponse_Summary
+	Payload       isImportUsersResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportUsersResponse) Reset() {
+	*x = ImportUsersResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersResponse) ProtoMessage() {}
+
+func (x *ImportUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersResponse.ProtoReflect.Descriptor instead.
+func (*ImportUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ImportUsersResponse) GetPayload() isImportUsersResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportUsersResponse) GetRow() *ImportRowResult {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportUsersResponse_Row); ok {
+			return x.Row
+		}
+	}
+	return nil
+}
+
+func (x *ImportUsersResponse) GetSummary() *ImportSummary {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportUsersResponse_Summary); ok {
+			return x.Summary
+		}
+	}
+	return nil
+}
+
+type isImportUsersResponse_Payload interface {
+	isImportUsersResponse_Payload()
+}
+
+type ImportUsersResponse_Row struct {
+	Row *ImportRowResult `protobuf:"bytes,1,opt,name=row,proto3,oneof"`
+}
+
+type ImportUsersResponse_Summary struct {
+	Summary *ImportSummary `protobuf:"bytes,2,opt,name=summary,proto3,oneof"`
+}
+
+func (*ImportUsersResponse_Row) isImportUsersResponse_Payload() {}
+
+func (*ImportUsersResponse_Summary) isImportUsersResponse_Payload() {}
+
+// Báo cáo import đầy đủ, dùng cho REST
+type ImportUsersReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *ImportSummary         `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	Rows          []*ImportRowResult     `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportUsersReport) Reset() {
+	*x = ImportUsersReport{}
+	mi := &file_proto_user_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUsersReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersReport) ProtoMessage() {}
+
+func (x *ImportUsersReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersReport.ProtoReflect.Descriptor instead.
+func (*ImportUsersReport) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ImportUsersReport) GetSummary() *ImportSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *ImportUsersReport) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type ExportUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Như ListUsersRequest.search
+	Search string      `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	Filter *UserFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// csv (mặc định), xlsx hoặc jsonl
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// Để trống thì export các cột mặc định; roles và employee_code phải được chọn rõ ràng
+	Columns []string `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	// Ngôn ngữ tiêu đề cột: en (mặc định) hoặc vi
+	Lang          string `protobuf:"bytes,5,opt,name=lang,proto3" json:"lang,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUsersRequest) Reset() {
+	*x = ExportUsersRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUsersRequest) ProtoMessage() {}
+
+func (x *ExportUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUsersRequest.ProtoReflect.Descriptor instead.
+func (*ExportUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExportUsersRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ExportUsersRequest) GetFilter() *UserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportUsersRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportUsersRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ExportUsersRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+type ExportUsersChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUsersChunk) Reset() {
+	*x = ExportUsersChunk{}
+	mi := &file_proto_user_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUsersChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUsersChunk) ProtoMessage() {}
+
+func (x *ExportUsersChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUsersChunk.ProtoReflect.Descriptor instead.
+func (*ExportUsersChunk) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ExportUsersChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportUsersChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type GetUserProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileRequest) Reset() {
+	*x = GetUserProfileRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileRequest) ProtoMessage() {}
+
+func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetUserProfileRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileResponse) Reset() {
+	*x = GetUserProfileResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileResponse) ProtoMessage() {}
+
+func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetUserProfileResponse) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// Ghi đè toàn bộ hồ sơ: field không truyền (hoặc rỗng) bị xóa, danh sách người liên hệ được thay mới
+type UpdateUserProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Profile       *UserProfile           `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserProfileRequest) Reset() {
+	*x = UpdateUserProfileRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserProfileRequest) ProtoMessage() {}
+
+func (x *UpdateUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateUserProfileRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateUserProfileRequest) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UpdateUserProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserProfileResponse) Reset() {
+	*x = UpdateUserProfileResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserProfileResponse) ProtoMessage() {}
+
+func (x *UpdateUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateUserProfileResponse) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type ListProvincesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProvincesRequest) Reset() {
+	*x = ListProvincesRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProvincesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvincesRequest) ProtoMessage() {}
+
+func (x *ListProvincesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvincesRequest.ProtoReflect.Descriptor instead.
+func (*ListProvincesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{50}
+}
+
+type ListDistrictsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProvinceCode  string                 `protobuf:"bytes,1,opt,name=province_code,json=provinceCode,proto3" json:"province_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDistrictsRequest) Reset() {
+	*x = ListDistrictsRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDistrictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDistrictsRequest) ProtoMessage() {}
+
+func (x *ListDistrictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDistrictsRequest.ProtoReflect.Descriptor instead.
+func (*ListDistrictsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListDistrictsRequest) GetProvinceCode() string {
+	if x != nil {
+		return x.ProvinceCode
+	}
+	return ""
+}
+
+type ListWardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DistrictCode  string                 `protobuf:"bytes,1,opt,name=district_code,json=districtCode,proto3" json:"district_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWardsRequest) Reset() {
+	*x = ListWardsRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWardsRequest) ProtoMessage() {}
+
+func (x *ListWardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWardsRequest.ProtoReflect.Descriptor instead.
+func (*ListWardsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListWardsRequest) GetDistrictCode() string {
+	if x != nil {
+		return x.DistrictCode
+	}
+	return ""
+}
+
+type ListAdminUnitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Units         []*AdminUnit           `protobuf:"bytes,1,rep,name=units,proto3" json:"units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdminUnitsResponse) Reset() {
+	*x = ListAdminUnitsResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdminUnitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdminUnitsResponse) ProtoMessage() {}
+
+func (x *ListAdminUnitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdminUnitsResponse.ProtoReflect.Descriptor instead.
+func (*ListAdminUnitsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListAdminUnitsResponse) GetUnits() []*AdminUnit {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+type FindDuplicateUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Điểm tối thiểu trong khoảng (0, 1], mặc định 0.5
+	MinScore      float64 `protobuf:"fixed64,1,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	Limit         int32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicateUsersRequest) Reset() {
+	*x = FindDuplicateUsersRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicateUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicateUsersRequest) ProtoMessage() {}
+
+func (x *FindDuplicateUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicateUsersRequest.ProtoReflect.Descriptor instead.
+func (*FindDuplicateUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *FindDuplicateUsersRequest) GetMinScore() float64 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *FindDuplicateUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Cặp user nghi trùng; reasons gồm name, similar_name, phone, email, email_local, date_of_birth
+type DuplicateCandidate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Other         *User                  `protobuf:"bytes,2,opt,name=other,proto3" json:"other,omitempty"`
+	Score         float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	Reasons       []string               `protobuf:"bytes,4,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateCandidate) Reset() {
+	*x = DuplicateCandidate{}
+	mi := &file_proto_user_user_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateCandidate) ProtoMessage() {}
+
+func (x *DuplicateCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateCandidate.ProtoReflect.Descriptor instead.
+func (*DuplicateCandidate) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DuplicateCandidate) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *DuplicateCandidate) GetOther() *User {
+	if x != nil {
+		return x.Other
+	}
+	return nil
+}
+
+func (x *DuplicateCandidate) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *DuplicateCandidate) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type FindDuplicateUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candidates    []*DuplicateCandidate  `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicateUsersResponse) Reset() {
+	*x = FindDuplicateUsersResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicateUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicateUsersResponse) ProtoMessage() {}
+
+func (x *FindDuplicateUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicateUsersResponse.ProtoReflect.Descriptor instead.
+func (*FindDuplicateUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *FindDuplicateUsersResponse) GetCandidates() []*DuplicateCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type MergeUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SurvivorId    int32                  `protobuf:"varint,1,opt,name=survivor_id,json=survivorId,proto3" json:"survivor_id,omitempty"`
+	MergedId      int32                  `protobuf:"varint,2,opt,name=merged_id,json=mergedId,proto3" json:"merged_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeUsersRequest) Reset() {
+	*x = MergeUsersRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeUsersRequest) ProtoMessage() {}
+
+func (x *MergeUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeUsersRequest.ProtoReflect.Descriptor instead.
+func (*MergeUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MergeUsersRequest) GetSurvivorId() int32 {
+	if x != nil {
+		return x.SurvivorId
+	}
+	return 0
+}
+
+func (x *MergeUsersRequest) GetMergedId() int32 {
+	if x != nil {
+		return x.MergedId
+	}
+	return 0
+}
+
+type MergeUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeUsersResponse) Reset() {
+	*x = MergeUsersResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeUsersResponse) ProtoMessage() {}
+
+func (x *MergeUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeUsersResponse.ProtoReflect.Descriptor instead.
+func (*MergeUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MergeUsersResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ExportPersonalDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPersonalDataRequest) Reset() {
+	*x = ExportPersonalDataRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPersonalDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPersonalDataRequest) ProtoMessage() {}
+
+func (x *ExportPersonalDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPersonalDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportPersonalDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ExportPersonalDataRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// Thông tin account, không bao gồm mật khẩu
+type AccountInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	LastLoginAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_login_at,json=lastLoginAt,proto3" json:"last_login_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountInfo) Reset() {
+	*x = AccountInfo{}
+	mi := &file_proto_user_user_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountInfo) ProtoMessage() {}
+
+func (x *AccountInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountInfo.ProtoReflect.Descriptor instead.
+func (*AccountInfo) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AccountInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AccountInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AccountInfo) GetLastLoginAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastLoginAt
+	}
+	return nil
+}
+
+func (x *AccountInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AccountInfo) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type LoginEvent struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	FailureReason *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	OrgId         *wrapperspb.Int64Value  `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Ip            *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent     *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Hạn của refresh token cấp ra ở lần đăng nhập thành công
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginEvent) Reset() {
+	*x = LoginEvent{}
+	mi := &file_proto_user_user_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginEvent) ProtoMessage() {}
+
+func (x *LoginEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginEvent.ProtoReflect.Descriptor instead.
+func (*LoginEvent) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LoginEvent) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LoginEvent) GetFailureReason() *wrapperspb.StringValue {
+	if x != nil {
+		return x.FailureReason
+	}
+	return nil
+}
+
+func (x *LoginEvent) GetOrgId() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.OrgId
+	}
+	return nil
+}
+
+func (x *LoginEvent) GetIp() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Ip
+	}
+	return nil
+}
+
+func (x *LoginEvent) GetUserAgent() *wrapperspb.StringValue {
+	if x != nil {
+		return x.UserAgent
+	}
+	return nil
+}
+
+func (x *LoginEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LoginEvent) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// phone, email, address của user vẫn được che theo quyền user.pii.read như mọi response khác
+type PersonalDataExport struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ExportedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	// Kèm hồ sơ nhân sự (nếu có)
+	User    *User        `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Account *AccountInfo `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	// Mới nhất trước
+	LoginHistory []*LoginEvent `protobuf:"bytes,4,rep,name=login_history,json=loginHistory,proto3" json:"login_history,omitempty"`
+	// Các lần đăng nhập thành công có refresh token còn hạn
+	Sessions     []*LoginEvent          `protobuf:"bytes,5,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	DeletedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	AnonymizedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=anonymized_at,json=anonymizedAt,proto3" json:"anonymized_at,omitempty"`
+	// Lịch sử thông tin, mới nhất trước
+	Versions      []*UserVersion `protobuf:"bytes,8,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonalDataExport) Reset() {
+	*x = PersonalDataExport{}
+	mi := &file_proto_user_user_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonalDataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonalDataExport) ProtoMessage() {}
+
+func (x *PersonalDataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonalDataExport.ProtoReflect.Descriptor instead.
+func (*PersonalDataExport) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PersonalDataExport) GetExportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return nil
+}
+
+func (x *PersonalDataExport) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *PersonalDataExport) GetAccount() *AccountInfo {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *PersonalDataExport) GetLoginHistory() []*LoginEvent {
+	if x != nil {
+		return x.LoginHistory
+	}
+	return nil
+}
+
+func (x *PersonalDataExport) GetSessions() []*LoginEvent {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *PersonalDataExport) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *PersonalDataExport) GetAnonymizedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AnonymizedAt
+	}
+	return nil
+}
+
+func (x *PersonalDataExport) GetVersions() []*UserVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type AnonymizeUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymizeUserRequest) Reset() {
+	*x = AnonymizeUserRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeUserRequest) ProtoMessage() {}
+
+func (x *AnonymizeUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeUserRequest.ProtoReflect.Descriptor instead.
+func (*AnonymizeUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AnonymizeUserRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type AnonymizeUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymizeUserResponse) Reset() {
+	*x = AnonymizeUserResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeUserResponse) ProtoMessage() {}
+
+func (x *AnonymizeUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeUserResponse.ProtoReflect.Descriptor instead.
+func (*AnonymizeUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AnonymizeUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Các điều kiện lọc để trống (0) thì bỏ qua
+type ListAuditLogsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// user.create, user.update, user.delete, account.*, user.anonymize, user.personal_data_export
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// user | account
+	EntityType    string                 `protobuf:"bytes,4,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId      int32                  `protobuf:"varint,5,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	ActorId       int32                  `protobuf:"varint,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	TargetUserId  int32                  `protobuf:"varint,7,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsRequest) Reset() {
+	*x = ListAuditLogsRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsRequest) ProtoMessage() {}
+
+func (x *ListAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListAuditLogsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetEntityId() int32 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetActorId() int32 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetTargetUserId() int32 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAuditLogsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type AuditLog struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	// Trống nếu thao tác do lời gọi nội bộ
+	ActorId      *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	OrgId        *wrapperspb.Int64Value `protobuf:"bytes,4,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	TargetUserId *wrapperspb.Int32Value `protobuf:"bytes,5,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	EntityType   string                 `protobuf:"bytes,6,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId     *wrapperspb.Int32Value `protobuf:"bytes,7,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// {"field": {"from": ..., "to": ...}}; mật khẩu bị ẩn, phone/email/địa chỉ bị che
+	Changes       *structpb.Struct       `protobuf:"bytes,8,opt,name=changes,proto3" json:"changes,omitempty"`
+	Details       *structpb.Struct       `protobuf:"bytes,9,opt,name=details,proto3" json:"details,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	mi := &file_proto_user_user_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AuditLog) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditLog) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditLog) GetActorId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ActorId
+	}
+	return nil
+}
+
+func (x *AuditLog) GetOrgId() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.OrgId
+	}
+	return nil
+}
+
+func (x *AuditLog) GetTargetUserId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return nil
+}
+
+func (x *AuditLog) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *AuditLog) GetEntityId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.EntityId
+	}
+	return nil
+}
+
+func (x *AuditLog) GetChanges() *structpb.Struct {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *AuditLog) GetDetails() *structpb.Struct {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *AuditLog) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListAuditLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logs          []*AuditLog            `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage   int32                  `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsResponse) Reset() {
+	*x = ListAuditLogsResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsResponse) ProtoMessage() {}
+
+func (x *ListAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListAuditLogsResponse) GetLogs() []*AuditLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *ListAuditLogsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListAuditLogsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *ListAuditLogsResponse) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+// phone, email, address trong from/to được che như field tương ứng của User
+type FieldChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// first_name, last_name, gender, phone, email, address, ward_code, province_code, avatar
+	Field         string                  `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	From          *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_proto_user_user_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetFrom() *wrapperspb.StringValue {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *FieldChange) GetTo() *wrapperspb.StringValue {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type UserVersion struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Version int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Thông tin của user trong phiên bản này (không có org_ids, profile)
+	User      *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	ValidFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	// Trống nếu là phiên bản hiện tại
+	ValidTo   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	ChangedBy *wrapperspb.Int32Value `protobuf:"bytes,5,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	// So với phiên bản liền trước
+	Changes       []*FieldChange `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserVersion) Reset() {
+	*x = UserVersion{}
+	mi := &file_proto_user_user_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserVersion) ProtoMessage() {}
+
+func (x *UserVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserVersion.ProtoReflect.Descriptor instead.
+func (*UserVersion) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UserVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UserVersion) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserVersion) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *UserVersion) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *UserVersion) GetChangedBy() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return nil
+}
+
+func (x *UserVersion) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type GetUserAsOfRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserAsOfRequest) Reset() {
+	*x = GetUserAsOfRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserAsOfRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserAsOfRequest) ProtoMessage() {}
+
+func (x *GetUserAsOfRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserAsOfRequest.ProtoReflect.Descriptor instead.
+func (*GetUserAsOfRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetUserAsOfRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetUserAsOfRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetUserAsOfResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       *UserVersion           `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserAsOfResponse) Reset() {
+	*x = GetUserAsOfResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserAsOfResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserAsOfResponse) ProtoMessage() {}
+
+func (x *GetUserAsOfResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserAsOfResponse.ProtoReflect.Descriptor instead.
+func (*GetUserAsOfResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetUserAsOfResponse) GetVersion() *UserVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type ListUserVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserVersionsRequest) Reset() {
+	*x = ListUserVersionsRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserVersionsRequest) ProtoMessage() {}
+
+func (x *ListUserVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListUserVersionsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListUserVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*UserVersion         `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserVersionsResponse) Reset() {
+	*x = ListUserVersionsResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserVersionsResponse) ProtoMessage() {}
+
+func (x *ListUserVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListUserVersionsResponse) GetVersions() []*UserVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type DiffUserVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromVersion   int32                  `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion     int32                  `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffUserVersionsRequest) Reset() {
+	*x = DiffUserVersionsRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffUserVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffUserVersionsRequest) ProtoMessage() {}
+
+func (x *DiffUserVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffUserVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffUserVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DiffUserVersionsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DiffUserVersionsRequest) GetFromVersion() int32 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffUserVersionsRequest) GetToVersion() int32 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+type DiffUserVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Changes       []*FieldChange         `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffUserVersionsResponse) Reset() {
+	*x = DiffUserVersionsResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffUserVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffUserVersionsResponse) ProtoMessage() {}
+
+func (x *DiffUserVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffUserVersionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffUserVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DiffUserVersionsResponse) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DiffUserVersionsResponse) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type AttributeDefinition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId int64                  `protobuf:"varint,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Key   string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Label string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	// string | number | boolean | date (YYYY-MM-DD)
+	Type     string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Required bool   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	// Chỉ dùng cho type string
+	EnumValues []string `protobuf:"bytes,7,rep,name=enum_values,json=enumValues,proto3" json:"enum_values,omitempty"`
+	// Chỉ dùng cho type string, phải khớp toàn bộ giá trị
+	Pattern       *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeDefinition) Reset() {
+	*x = AttributeDefinition{}
+	mi := &file_proto_user_user_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeDefinition) ProtoMessage() {}
+
+func (x *AttributeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeDefinition.ProtoReflect.Descriptor instead.
+func (*AttributeDefinition) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AttributeDefinition) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttributeDefinition) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *AttributeDefinition) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *AttributeDefinition) GetEnumValues() []string {
+	if x != nil {
+		return x.EnumValues
+	}
+	return nil
+}
+
+func (x *AttributeDefinition) GetPattern() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Pattern
+	}
+	return nil
+}
+
+func (x *AttributeDefinition) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AttributeDefinition) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Viewer đang chọn tổ chức luôn xem định nghĩa của tổ chức đó
+type ListAttributeDefinitionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttributeDefinitionsRequest) Reset() {
+	*x = ListAttributeDefinitionsRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttributeDefinitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttributeDefinitionsRequest) ProtoMessage() {}
+
+func (x *ListAttributeDefinitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttributeDefinitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttributeDefinitionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListAttributeDefinitionsRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+type ListAttributeDefinitionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Definitions   []*AttributeDefinition `protobuf:"bytes,1,rep,name=definitions,proto3" json:"definitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttributeDefinitionsResponse) Reset() {
+	*x = ListAttributeDefinitionsResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttributeDefinitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttributeDefinitionsResponse) ProtoMessage() {}
+
+func (x *ListAttributeDefinitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttributeDefinitionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttributeDefinitionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListAttributeDefinitionsResponse) GetDefinitions() []*AttributeDefinition {
+	if x != nil {
+		return x.Definitions
+	}
+	return nil
+}
+
+type CreateAttributeDefinitionRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	OrgId         int64                   `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Key           string                  `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Label         string                  `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Type          string                  `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Required      bool                    `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	EnumValues    []string                `protobuf:"bytes,6,rep,name=enum_values,json=enumValues,proto3" json:"enum_values,omitempty"`
+	Pattern       *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAttributeDefinitionRequest) Reset() {
+	*x = CreateAttributeDefinitionRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttributeDefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttributeDefinitionRequest) ProtoMessage() {}
+
+func (x *CreateAttributeDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttributeDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*CreateAttributeDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CreateAttributeDefinitionRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *CreateAttributeDefinitionRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CreateAttributeDefinitionRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CreateAttributeDefinitionRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateAttributeDefinitionRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CreateAttributeDefinitionRequest) GetEnumValues() []string {
+	if x != nil {
+		return x.EnumValues
+	}
+	return nil
+}
+
+func (x *CreateAttributeDefinitionRequest) GetPattern() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Pattern
+	}
+	return nil
+}
+
+// Không đổi được org_id và key
+type UpdateAttributeDefinitionRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                  `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Type          string                  `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Required      bool                    `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	EnumValues    []string                `protobuf:"bytes,5,rep,name=enum_values,json=enumValues,proto3" json:"enum_values,omitempty"`
+	Pattern       *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAttributeDefinitionRequest) Reset() {
+	*x = UpdateAttributeDefinitionRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAttributeDefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAttributeDefinitionRequest) ProtoMessage() {}
+
+func (x *UpdateAttributeDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAttributeDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAttributeDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UpdateAttributeDefinitionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAttributeDefinitionRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *UpdateAttributeDefinitionRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateAttributeDefinitionRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *UpdateAttributeDefinitionRequest) GetEnumValues() []string {
+	if x != nil {
+		return x.EnumValues
+	}
+	return nil
+}
+
+func (x *UpdateAttributeDefinitionRequest) GetPattern() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Pattern
+	}
+	return nil
+}
+
+type AttributeDefinitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Definition    *AttributeDefinition   `protobuf:"bytes,1,opt,name=definition,proto3" json:"definition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeDefinitionResponse) Reset() {
+	*x = AttributeDefinitionResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeDefinitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeDefinitionResponse) ProtoMessage() {}
+
+func (x *AttributeDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*AttributeDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AttributeDefinitionResponse) GetDefinition() *AttributeDefinition {
+	if x != nil {
+		return x.Definition
+	}
+	return nil
+}
+
+// Giá trị của thuộc tính bị xóa khỏi custom_attributes của các user
+type DeleteAttributeDefinitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttributeDefinitionRequest) Reset() {
+	*x = DeleteAttributeDefinitionRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttributeDefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttributeDefinitionRequest) ProtoMessage() {}
+
+func (x *DeleteAttributeDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttributeDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttributeDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DeleteAttributeDefinitionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteAttributeDefinitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	AffectedUsers int32                  `protobuf:"varint,2,opt,name=affected_users,json=affectedUsers,proto3" json:"affected_users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttributeDefinitionResponse) Reset() {
+	*x = DeleteAttributeDefinitionResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttributeDefinitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttributeDefinitionResponse) ProtoMessage() {}
+
+func (x *DeleteAttributeDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttributeDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttributeDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *DeleteAttributeDefinitionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteAttributeDefinitionResponse) GetAffectedUsers() int32 {
+	if x != nil {
+		return x.AffectedUsers
+	}
+	return 0
+}
+
+type Group struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId       *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Nhóm con trực tiếp
+	SubgroupIds   []int32                `protobuf:"varint,5,rep,packed,name=subgroup_ids,json=subgroupIds,proto3" json:"subgroup_ids,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_proto_user_user_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Group) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Group) GetOrgId() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.OrgId
+	}
+	return nil
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Group) GetSubgroupIds() []int32 {
+	if x != nil {
+		return x.SubgroupIds
+	}
+	return nil
+}
+
+func (x *Group) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Group) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GroupMember struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId int32                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId  int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// owner | admin | member
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMember) Reset() {
+	*x = GroupMember{}
+	mi := &file_proto_user_user_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMember) ProtoMessage() {}
+
+func (x *GroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMember.ProtoReflect.Descriptor instead.
+func (*GroupMember) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GroupMember) GetGroupId() int32 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupMember) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GroupMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GroupMember) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListGroupsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Tìm theo tên nhóm
+	Search        string `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsRequest) Reset() {
+	*x = ListGroupsRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsRequest) ProtoMessage() {}
+
+func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListGroupsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListGroupsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGroupsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type ListGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*Group               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage   int32                  `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsResponse) Reset() {
+	*x = ListGroupsResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsResponse) ProtoMessage() {}
+
+func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListGroupsResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *ListGroupsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListGroupsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *ListGroupsResponse) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+type GetGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetGroupRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Viewer đang chọn tổ chức có thể bỏ trống
+	OrgId       int64  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Để trống thì viewer là owner duy nhất
+	OwnerIds      []int32 `protobuf:"varint,4,rep,packed,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CreateGroupRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetOwnerIds() []int32 {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+// Field không gửi thì giữ nguyên
+type UpdateGroupRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRequest) ProtoMessage() {}
+
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *UpdateGroupRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateGroupRequest) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UpdateGroupRequest) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+type GroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupResponse) Reset() {
+	*x = GroupResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupResponse) ProtoMessage() {}
+
+func (x *GroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupResponse.ProtoReflect.Descriptor instead.
+func (*GroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GroupResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// Thành viên và liên kết nhóm cha/con bị xóa theo
+type DeleteGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupRequest) Reset() {
+	*x = DeleteGroupRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PermExt) String() string {
+func (x *DeleteGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PermExt) ProtoMessage() {}
+func (*DeleteGroupRequest) ProtoMessage() {}
 
-func (x *PermExt) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[3]
+func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,64 +6107,84 @@ func (x *PermExt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PermExt.ProtoReflect.Descriptor instead.
-func (*PermExt) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *PermExt) GetId() []byte {
+func (x *DeleteGroupRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *PermExt) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
+type DeleteGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PermExt) GetName() string {
+func (x *DeleteGroupResponse) Reset() {
+	*x = DeleteGroupResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupResponse) ProtoMessage() {}
+
+func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[93]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *PermExt) GetDescription() *wrapperspb.StringValue {
+// Deprecated: Use DeleteGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DeleteGroupResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Description
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type ListUsersResponse struct {
+type ListGroupMembersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,2,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	TotalUsers    int32                  `protobuf:"varint,3,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
-	CurrentPage   int32                  `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	GroupId       int32                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersResponse) Reset() {
-	*x = ListUsersResponse{}
-	mi := &file_proto_user_user_proto_msgTypes[4]
+func (x *ListGroupMembersRequest) Reset() {
+	*x = ListGroupMembersRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersResponse) String() string {
+func (x *ListGroupMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersResponse) ProtoMessage() {}
+func (*ListGroupMembersRequest) ProtoMessage() {}
 
-func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[4]
+func (x *ListGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,61 +6195,86 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
-func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *ListUsersResponse) GetUsers() []*User {
+func (x *ListGroupMembersRequest) GetGroupId() int32 {
 	if x != nil {
-		return x.Users
+		return x.GroupId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListUsersResponse) GetTotalPages() int32 {
-	if x != nil {
-		return x.TotalPages
-	}
-	return 0
+type ListGroupMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*GroupMember         `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersResponse) GetTotalUsers() int32 {
+func (x *ListGroupMembersResponse) Reset() {
+	*x = ListGroupMembersResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersResponse) ProtoMessage() {}
+
+func (x *ListGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[95]
 	if x != nil {
-		return x.TotalUsers
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListUsersResponse) GetCurrentPage() int32 {
+// Deprecated: Use ListGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListGroupMembersResponse) GetMembers() []*GroupMember {
 	if x != nil {
-		return x.CurrentPage
+		return x.Members
 	}
-	return 0
+	return nil
 }
 
-type GetUserByIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+type AddGroupMemberInput struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Mặc định member
+	Role          string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserByIdRequest) Reset() {
-	*x = GetUserByIdRequest{}
-	mi := &file_proto_user_user_proto_msgTypes[5]
+func (x *AddGroupMemberInput) Reset() {
+	*x = AddGroupMemberInput{}
+	mi := &file_proto_user_user_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserByIdRequest) String() string {
+func (x *AddGroupMemberInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserByIdRequest) ProtoMessage() {}
+func (*AddGroupMemberInput) ProtoMessage() {}
 
-func (x *GetUserByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[5]
+func (x *AddGroupMemberInput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,42 +6285,49 @@ func (x *GetUserByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetUserByIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use AddGroupMemberInput.ProtoReflect.Descriptor instead.
+func (*AddGroupMemberInput) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *GetUserByIdRequest) GetId() int32 {
+func (x *AddGroupMemberInput) GetUserId() int32 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-type GetUserByIdResponse struct {
+func (x *AddGroupMemberInput) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// User đã là thành viên thì trả lỗi ALREADY_EXISTS
+type AddGroupMembersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Roles         []*RoleExt             `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
-	Perms         []*PermExt             `protobuf:"bytes,3,rep,name=perms,proto3" json:"perms,omitempty"`
+	GroupId       int32                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Members       []*AddGroupMemberInput `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserByIdResponse) Reset() {
-	*x = GetUserByIdResponse{}
-	mi := &file_proto_user_user_proto_msgTypes[6]
+func (x *AddGroupMembersRequest) Reset() {
+	*x = AddGroupMembersRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserByIdResponse) String() string {
+func (x *AddGroupMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserByIdResponse) ProtoMessage() {}
+func (*AddGroupMembersRequest) ProtoMessage() {}
 
-func (x *GetUserByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[6]
+func (x *AddGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -513,56 +6338,49 @@ func (x *GetUserByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetUserByIdResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *GetUserByIdResponse) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
+// Deprecated: Use AddGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*AddGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *GetUserByIdResponse) GetRoles() []*RoleExt {
+func (x *AddGroupMembersRequest) GetGroupId() int32 {
 	if x != nil {
-		return x.Roles
+		return x.GroupId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetUserByIdResponse) GetPerms() []*PermExt {
+func (x *AddGroupMembersRequest) GetMembers() []*AddGroupMemberInput {
 	if x != nil {
-		return x.Perms
+		return x.Members
 	}
 	return nil
 }
 
-type GetUsersByIDsRequest struct {
+type UpdateGroupMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ids           []int32                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	GroupId       int32                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUsersByIDsRequest) Reset() {
-	*x = GetUsersByIDsRequest{}
-	mi := &file_proto_user_user_proto_msgTypes[7]
+func (x *UpdateGroupMemberRequest) Reset() {
+	*x = UpdateGroupMemberRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUsersByIDsRequest) String() string {
+func (x *UpdateGroupMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsersByIDsRequest) ProtoMessage() {}
+func (*UpdateGroupMemberRequest) ProtoMessage() {}
 
-func (x *GetUsersByIDsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[7]
+func (x *UpdateGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -573,54 +6391,54 @@ func (x *GetUsersByIDsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsersByIDsRequest.ProtoReflect.Descriptor instead.
-func (*GetUsersByIDsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use UpdateGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *GetUsersByIDsRequest) GetIds() []int32 {
+func (x *UpdateGroupMemberRequest) GetGroupId() int32 {
 	if x != nil {
-		return x.Ids
+		return x.GroupId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetUsersByIDsRequest) GetPage() int32 {
+func (x *UpdateGroupMemberRequest) GetUserId() int32 {
 	if x != nil {
-		return x.Page
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetUsersByIDsRequest) GetPageSize() int32 {
+func (x *UpdateGroupMemberRequest) GetRole() string {
 	if x != nil {
-		return x.PageSize
+		return x.Role
 	}
-	return 0
+	return ""
 }
 
-type GetUsersByIDsResponse struct {
+type GroupMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Member        *GroupMember           `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUsersByIDsResponse) Reset() {
-	*x = GetUsersByIDsResponse{}
-	mi := &file_proto_user_user_proto_msgTypes[8]
+func (x *GroupMemberResponse) Reset() {
+	*x = GroupMemberResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUsersByIDsResponse) String() string {
+func (x *GroupMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsersByIDsResponse) ProtoMessage() {}
+func (*GroupMemberResponse) ProtoMessage() {}
 
-func (x *GetUsersByIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[8]
+func (x *GroupMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,42 +6449,42 @@ func (x *GetUsersByIDsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsersByIDsResponse.ProtoReflect.Descriptor instead.
-func (*GetUsersByIDsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GroupMemberResponse.ProtoReflect.Descriptor instead.
+func (*GroupMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *GetUsersByIDsResponse) GetUsers() []*User {
+func (x *GroupMemberResponse) GetMember() *GroupMember {
 	if x != nil {
-		return x.Users
+		return x.Member
 	}
 	return nil
 }
 
-type Account struct {
+// User luôn tự rời nhóm được, trừ khi là owner cuối cùng
+type RemoveGroupMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	GroupId       int32                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Account) Reset() {
-	*x = Account{}
-	mi := &file_proto_user_user_proto_msgTypes[9]
+func (x *RemoveGroupMemberRequest) Reset() {
+	*x = RemoveGroupMemberRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Account) String() string {
+func (x *RemoveGroupMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account) ProtoMessage() {}
+func (*RemoveGroupMemberRequest) ProtoMessage() {}
 
-func (x *Account) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[9]
+func (x *RemoveGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -677,64 +6495,47 @@ func (x *Account) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account.ProtoReflect.Descriptor instead.
-func (*Account) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *Account) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+// Deprecated: Use RemoveGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *Account) GetPassword() string {
+func (x *RemoveGroupMemberRequest) GetGroupId() int32 {
 	if x != nil {
-		return x.Password
+		return x.GroupId
 	}
-	return ""
+	return 0
 }
 
-func (x *Account) GetStatus() string {
+func (x *RemoveGroupMemberRequest) GetUserId() int32 {
 	if x != nil {
-		return x.Status
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-type CreateUserRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	FirstName     string                  `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName      string                  `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Gender        string                  `protobuf:"bytes,3,opt,name=gender,proto3" json:"gender,omitempty"`
-	Phone         string                  `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email         *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
-	WardCode      *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=ward_code,json=wardCode,proto3" json:"ward_code,omitempty"`
-	Address       *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
-	Avatar        *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Account       *Account                `protobuf:"bytes,9,opt,name=account,proto3" json:"account,omitempty"`
-	PermIds       []string                `protobuf:"bytes,10,rep,name=perm_ids,json=permIds,proto3" json:"perm_ids,omitempty"`
-	RoleIds       []string                `protobuf:"bytes,11,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+type RemoveGroupMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
-	mi := &file_proto_user_user_proto_msgTypes[10]
+func (x *RemoveGroupMemberResponse) Reset() {
+	*x = RemoveGroupMemberResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserRequest) String() string {
+func (x *RemoveGroupMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (*RemoveGroupMemberResponse) ProtoMessage() {}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[10]
+func (x *RemoveGroupMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,110 +6546,93 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *CreateUserRequest) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
-}
-
-func (x *CreateUserRequest) GetLastName() string {
-	if x != nil {
-		return x.LastName
-	}
-	return ""
-}
-
-func (x *CreateUserRequest) GetGender() string {
-	if x != nil {
-		return x.Gender
-	}
-	return ""
+// Deprecated: Use RemoveGroupMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *CreateUserRequest) GetPhone() string {
+func (x *RemoveGroupMemberResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Phone
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *CreateUserRequest) GetEmail() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Email
-	}
-	return nil
+type AddSubgroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int32                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	SubgroupId    int32                  `protobuf:"varint,2,opt,name=subgroup_id,json=subgroupId,proto3" json:"subgroup_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserRequest) GetWardCode() *wrapperspb.StringValue {
-	if x != nil {
-		return x.WardCode
-	}
-	return nil
+func (x *AddSubgroupRequest) Reset() {
+	*x = AddSubgroupRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserRequest) GetAddress() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Address
-	}
-	return nil
+func (x *AddSubgroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateUserRequest) GetAvatar() *wrapperspb.StringValue {
+func (*AddSubgroupRequest) ProtoMessage() {}
+
+func (x *AddSubgroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[102]
 	if x != nil {
-		return x.Avatar
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateUserRequest) GetAccount() *Account {
-	if x != nil {
-		return x.Account
-	}
-	return nil
+// Deprecated: Use AddSubgroupRequest.ProtoReflect.Descriptor instead.
+func (*AddSubgroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *CreateUserRequest) GetPermIds() []string {
+func (x *AddSubgroupRequest) GetGroupId() int32 {
 	if x != nil {
-		return x.PermIds
+		return x.GroupId
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateUserRequest) GetRoleIds() []string {
+func (x *AddSubgroupRequest) GetSubgroupId() int32 {
 	if x != nil {
-		return x.RoleIds
+		return x.SubgroupId
 	}
-	return nil
+	return 0
 }
 
-type CreateUserResponse struct {
+type RemoveSubgroupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	GroupId       int32                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	SubgroupId    int32                  `protobuf:"varint,2,opt,name=subgroup_id,json=subgroupId,proto3" json:"subgroup_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserResponse) Reset() {
-	*x = CreateUserResponse{}
-	mi := &file_proto_user_user_proto_msgTypes[11]
+func (x *RemoveSubgroupRequest) Reset() {
+	*x = RemoveSubgroupRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserResponse) String() string {
+func (x *RemoveSubgroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserResponse) ProtoMessage() {}
+func (*RemoveSubgroupRequest) ProtoMessage() {}
 
-func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[11]
+func (x *RemoveSubgroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -859,51 +6643,47 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RemoveSubgroupRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSubgroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *CreateUserResponse) GetUser() *User {
+func (x *RemoveSubgroupRequest) GetGroupId() int32 {
 	if x != nil {
-		return x.User
+		return x.GroupId
 	}
-	return nil
+	return 0
 }
 
-type UpdateUserRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstName     string                  `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName      string                  `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Gender        string                  `protobuf:"bytes,4,opt,name=gender,proto3" json:"gender,omitempty"`
-	Phone         string                  `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email         *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	WardCode      *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=ward_code,json=wardCode,proto3" json:"ward_code,omitempty"`
-	Address       *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
-	Avatar        *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Account       *Account                `protobuf:"bytes,10,opt,name=account,proto3" json:"account,omitempty"`
-	PermIds       []string                `protobuf:"bytes,11,rep,name=perm_ids,json=permIds,proto3" json:"perm_ids,omitempty"`
-	RoleIds       []string                `protobuf:"bytes,12,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+func (x *RemoveSubgroupRequest) GetSubgroupId() int32 {
+	if x != nil {
+		return x.SubgroupId
+	}
+	return 0
+}
+
+type ListUserGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) Reset() {
-	*x = UpdateUserRequest{}
-	mi := &file_proto_user_user_proto_msgTypes[12]
+func (x *ListUserGroupsRequest) Reset() {
+	*x = ListUserGroupsRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest) String() string {
+func (x *ListUserGroupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequest) ProtoMessage() {}
+func (*ListUserGroupsRequest) ProtoMessage() {}
 
-func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[12]
+func (x *ListUserGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -914,117 +6694,146 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListUserGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *UpdateUserRequest) GetId() int32 {
+func (x *ListUserGroupsRequest) GetUserId() int32 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *UpdateUserRequest) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+type UserGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Group *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// false: user thuộc nhóm qua một nhóm con
+	Direct bool `protobuf:"varint,2,opt,name=direct,proto3" json:"direct,omitempty"`
+	// Vai trò khi là thành viên trực tiếp
+	Role          string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) GetLastName() string {
-	if x != nil {
-		return x.LastName
-	}
-	return ""
+func (x *UserGroup) Reset() {
+	*x = UserGroup{}
+	mi := &file_proto_user_user_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest) GetGender() string {
-	if x != nil {
-		return x.Gender
-	}
-	return ""
+func (x *UserGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateUserRequest) GetPhone() string {
+func (*UserGroup) ProtoMessage() {}
+
+func (x *UserGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[105]
 	if x != nil {
-		return x.Phone
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateUserRequest) GetEmail() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Email
-	}
-	return nil
+// Deprecated: Use UserGroup.ProtoReflect.Descriptor instead.
+func (*UserGroup) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *UpdateUserRequest) GetWardCode() *wrapperspb.StringValue {
+func (x *UserGroup) GetGroup() *Group {
 	if x != nil {
-		return x.WardCode
+		return x.Group
 	}
 	return nil
 }
 
-func (x *UpdateUserRequest) GetAddress() *wrapperspb.StringValue {
+func (x *UserGroup) GetDirect() bool {
 	if x != nil {
-		return x.Address
+		return x.Direct
 	}
-	return nil
+	return false
 }
 
-func (x *UpdateUserRequest) GetAvatar() *wrapperspb.StringValue {
+func (x *UserGroup) GetRole() string {
 	if x != nil {
-		return x.Avatar
+		return x.Role
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateUserRequest) GetAccount() *Account {
-	if x != nil {
-		return x.Account
-	}
-	return nil
+type ListUserGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*UserGroup           `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) GetPermIds() []string {
+func (x *ListUserGroupsResponse) Reset() {
+	*x = ListUserGroupsResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserGroupsResponse) ProtoMessage() {}
+
+func (x *ListUserGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[106]
 	if x != nil {
-		return x.PermIds
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateUserRequest) GetRoleIds() []string {
+// Deprecated: Use ListUserGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListUserGroupsResponse) GetGroups() []*UserGroup {
 	if x != nil {
-		return x.RoleIds
+		return x.Groups
 	}
 	return nil
 }
 
-type UpdateUserResponse struct {
+type ExpandGroupMembersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	GroupId       int32                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserResponse) Reset() {
-	*x = UpdateUserResponse{}
-	mi := &file_proto_user_user_proto_msgTypes[13]
+func (x *ExpandGroupMembersRequest) Reset() {
+	*x = ExpandGroupMembersRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserResponse) String() string {
+func (x *ExpandGroupMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserResponse) ProtoMessage() {}
+func (*ExpandGroupMembersRequest) ProtoMessage() {}
 
-func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[13]
+func (x *ExpandGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1035,40 +6844,45 @@ func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
-func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ExpandGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*ExpandGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *UpdateUserResponse) GetUser() *User {
+func (x *ExpandGroupMembersRequest) GetGroupId() int32 {
 	if x != nil {
-		return x.User
+		return x.GroupId
 	}
-	return nil
+	return 0
 }
 
-type DeleteUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+type ExpandedGroupMember struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Direct bool                   `protobuf:"varint,2,opt,name=direct,proto3" json:"direct,omitempty"`
+	// Vai trò khi là thành viên trực tiếp
+	Role string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	// Các nhóm con mà user là thành viên trực tiếp
+	ViaGroupIds   []int32 `protobuf:"varint,4,rep,packed,name=via_group_ids,json=viaGroupIds,proto3" json:"via_group_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserRequest) Reset() {
-	*x = DeleteUserRequest{}
-	mi := &file_proto_user_user_proto_msgTypes[14]
+func (x *ExpandedGroupMember) Reset() {
+	*x = ExpandedGroupMember{}
+	mi := &file_proto_user_user_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserRequest) String() string {
+func (x *ExpandedGroupMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserRequest) ProtoMessage() {}
+func (*ExpandedGroupMember) ProtoMessage() {}
 
-func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[14]
+func (x *ExpandedGroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1079,40 +6893,61 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ExpandedGroupMember.ProtoReflect.Descriptor instead.
+func (*ExpandedGroupMember) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *DeleteUserRequest) GetId() int32 {
+func (x *ExpandedGroupMember) GetUserId() int32 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-type DeleteUserResponse struct {
+func (x *ExpandedGroupMember) GetDirect() bool {
+	if x != nil {
+		return x.Direct
+	}
+	return false
+}
+
+func (x *ExpandedGroupMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ExpandedGroupMember) GetViaGroupIds() []int32 {
+	if x != nil {
+		return x.ViaGroupIds
+	}
+	return nil
+}
+
+type ExpandGroupMembersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Members       []*ExpandedGroupMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserResponse) Reset() {
-	*x = DeleteUserResponse{}
-	mi := &file_proto_user_user_proto_msgTypes[15]
+func (x *ExpandGroupMembersResponse) Reset() {
+	*x = ExpandGroupMembersResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserResponse) String() string {
+func (x *ExpandGroupMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserResponse) ProtoMessage() {}
+func (*ExpandGroupMembersResponse) ProtoMessage() {}
 
-func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[15]
+func (x *ExpandGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1123,27 +6958,57 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ExpandGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*ExpandGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *DeleteUserResponse) GetSuccess() bool {
+func (x *ExpandGroupMembersResponse) GetMembers() []*ExpandedGroupMember {
 	if x != nil {
-		return x.Success
+		return x.Members
 	}
-	return false
+	return nil
 }
 
 var File_proto_user_user_proto protoreflect.FileDescriptor
 
 const file_proto_user_user_proto_rawDesc = "" +
 	"\n" +
-	"\x15proto/user/user.proto\x12\x04user\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"[\n" +
+	"\x15proto/user/user.proto\x12\x04user\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a google/protobuf/field_mask.proto\"\xc7\x01\n" +
 	"\x10ListUsersRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
-	"\x06search\x18\x03 \x01(\tR\x06search\"\xb9\x03\n" +
+	"\x06search\x18\x03 \x01(\tR\x06search\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12(\n" +
+	"\x06filter\x18\x05 \x01(\v2\x10.user.UserFilterR\x06filter\x12(\n" +
+	"\border_by\x18\x06 \x03(\v2\r.user.OrderByR\aorderBy\"\xa6\x05\n" +
+	"\n" +
+	"UserFilter\x12\x18\n" +
+	"\agenders\x18\x01 \x03(\tR\agenders\x12%\n" +
+	"\x0eaccount_status\x18\x02 \x01(\tR\raccountStatus\x12=\n" +
+	"\fcreated_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12=\n" +
+	"\fupdated_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedFrom\x129\n" +
+	"\n" +
+	"updated_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedTo\x12\x1d\n" +
+	"\n" +
+	"ward_codes\x18\a \x03(\tR\twardCodes\x12%\n" +
+	"\x0eprovince_codes\x18\b \x03(\tR\rprovinceCodes\x129\n" +
+	"\n" +
+	"has_avatar\x18\t \x01(\v2\x1a.google.protobuf.BoolValueR\thasAvatar\x127\n" +
+	"\thas_email\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.BoolValueR\bhasEmail\x12@\n" +
+	"\n" +
+	"attributes\x18\v \x03(\v2 .user.UserFilter.AttributesEntryR\n" +
+	"attributes\x12(\n" +
+	"\x10attribute_org_id\x18\f \x01(\x03R\x0eattributeOrgId\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"3\n" +
+	"\aOrderBy\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04desc\x18\x02 \x01(\bR\x04desc\"\xc0\x06\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
 	"\n" +
@@ -1159,7 +7024,42 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\tR\tupdatedAt\"\xab\x02\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\x12\x17\n" +
+	"\aorg_ids\x18\f \x03(\x03R\x06orgIds\x12A\n" +
+	"\rprovince_code\x18\r \x01(\v2\x1c.google.protobuf.StringValueR\fprovinceCode\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x0e \x01(\tR\tavatarUrl\x12+\n" +
+	"\aprofile\x18\x0f \x01(\v2\x11.user.UserProfileR\aprofile\x12+\n" +
+	"\bprovince\x18\x10 \x01(\v2\x0f.user.AdminUnitR\bprovince\x12+\n" +
+	"\bdistrict\x18\x11 \x01(\v2\x0f.user.AdminUnitR\bdistrict\x12#\n" +
+	"\x04ward\x18\x12 \x01(\v2\x0f.user.AdminUnitR\x04ward\x12\x18\n" +
+	"\aversion\x18\x13 \x01(\x05R\aversion\x12D\n" +
+	"\x11custom_attributes\x18\x14 \x01(\v2\x17.google.protobuf.StructR\x10customAttributes\"3\n" +
+	"\tAdminUnit\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x98\x01\n" +
+	"\x10EmergencyContact\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\"\n" +
+	"\frelationship\x18\x02 \x01(\tR\frelationship\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x126\n" +
+	"\aaddress\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\aaddress\"\xc3\x06\n" +
+	"\vUserProfile\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12@\n" +
+	"\rdate_of_birth\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\vdateOfBirth\x12B\n" +
+	"\x0eplace_of_birth\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\fplaceOfBirth\x12=\n" +
+	"\vnational_id\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\n" +
+	"nationalId\x12Q\n" +
+	"\x16national_id_issue_date\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x13nationalIdIssueDate\x12S\n" +
+	"\x17national_id_issue_place\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\x14nationalIdIssuePlace\x12:\n" +
+	"\tethnicity\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\tethnicity\x128\n" +
+	"\breligion\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\breligion\x12C\n" +
+	"\x0emarital_status\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\rmaritalStatus\x127\n" +
+	"\btax_code\x18\n" +
+	" \x01(\v2\x1c.google.protobuf.StringValueR\ataxCode\x12T\n" +
+	"\x17social_insurance_number\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\x15socialInsuranceNumber\x12E\n" +
+	"\x12emergency_contacts\x18\f \x03(\v2\x16.user.EmergencyContactR\x11emergencyContacts\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\tR\tupdatedAt\"\xab\x02\n" +
 	"\aRoleExt\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\fR\x02id\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
@@ -1174,7 +7074,7 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\fR\x02id\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12>\n" +
-	"\vdescription\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\"\x9a\x01\n" +
+	"\vdescription\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\"\xbb\x01\n" +
 	"\x11ListUsersResponse\x12 \n" +
 	"\x05users\x18\x01 \x03(\v2\n" +
 	".user.UserR\x05users\x12\x1f\n" +
@@ -1182,7 +7082,9 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"totalPages\x12\x1f\n" +
 	"\vtotal_users\x18\x03 \x01(\x05R\n" +
 	"totalUsers\x12!\n" +
-	"\fcurrent_page\x18\x04 \x01(\x05R\vcurrentPage\"$\n" +
+	"\fcurrent_page\x18\x04 \x01(\x05R\vcurrentPage\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursor\"$\n" +
 	"\x12GetUserByIdRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"\x7f\n" +
 	"\x13GetUserByIdResponse\x12\x1e\n" +
@@ -1196,11 +7098,20 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"9\n" +
 	"\x15GetUsersByIDsResponse\x12 \n" +
 	"\x05users\x18\x01 \x03(\v2\n" +
-	".user.UserR\x05users\"Y\n" +
+	".user.UserR\x05users\"@\n" +
+	"\x12SearchUsersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"E\n" +
+	"\rUserSearchHit\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\">\n" +
+	"\x13SearchUsersResponse\x12'\n" +
+	"\x04hits\x18\x01 \x03(\v2\x13.user.UserSearchHitR\x04hits\"Y\n" +
 	"\aAccount\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"\xb9\x03\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"\xdb\x04\n" +
 	"\x11CreateUserRequest\x12\x1d\n" +
 	"\n" +
 	"first_name\x18\x01 \x01(\tR\tfirstName\x12\x1b\n" +
@@ -1214,10 +7125,13 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"\aaccount\x18\t \x01(\v2\r.user.AccountR\aaccount\x12\x19\n" +
 	"\bperm_ids\x18\n" +
 	" \x03(\tR\apermIds\x12\x19\n" +
-	"\brole_ids\x18\v \x03(\tR\aroleIds\"4\n" +
+	"\brole_ids\x18\v \x03(\tR\aroleIds\x12\x17\n" +
+	"\aorg_ids\x18\f \x03(\x03R\x06orgIds\x12A\n" +
+	"\rprovince_code\x18\r \x01(\v2\x1c.google.protobuf.StringValueR\fprovinceCode\x12D\n" +
+	"\x11custom_attributes\x18\x0e \x01(\v2\x17.google.protobuf.StructR\x10customAttributes\"4\n" +
 	"\x12CreateUserResponse\x12\x1e\n" +
 	"\x04user\x18\x01 \x01(\v2\n" +
-	".user.UserR\x04user\"\xc9\x03\n" +
+	".user.UserR\x04user\"\xf0\x05\n" +
 	"\x11UpdateUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
 	"\n" +
@@ -1232,22 +7146,433 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"\aaccount\x18\n" +
 	" \x01(\v2\r.user.AccountR\aaccount\x12\x19\n" +
 	"\bperm_ids\x18\v \x03(\tR\apermIds\x12\x19\n" +
-	"\brole_ids\x18\f \x03(\tR\aroleIds\"4\n" +
+	"\brole_ids\x18\f \x03(\tR\aroleIds\x12\x17\n" +
+	"\aorg_ids\x18\r \x03(\x03R\x06orgIds\x12A\n" +
+	"\rprovince_code\x18\x0e \x01(\v2\x1c.google.protobuf.StringValueR\fprovinceCode\x12F\n" +
+	"\x10expected_version\x18\x0f \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fexpectedVersion\x12;\n" +
+	"\vupdate_mask\x18\x10 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12D\n" +
+	"\x11custom_attributes\x18\x11 \x01(\v2\x17.google.protobuf.StructR\x10customAttributes\"4\n" +
 	"\x12UpdateUserResponse\x12\x1e\n" +
 	"\x04user\x18\x01 \x01(\v2\n" +
-	".user.UserR\x04user\"#\n" +
+	".user.UserR\x04user\"|\n" +
+	"\x13UserVersionConflict\x12\x14\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x05R\x0fexpectedVersion\x12$\n" +
+	"\acurrent\x18\x03 \x01(\v2\n" +
+	".user.UserR\acurrent\"#\n" +
 	"\x11DeleteUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\".\n" +
 	"\x12DeleteUserResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xa4\x03\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"$\n" +
+	"\x12RestoreUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"5\n" +
+	"\x13RestoreUserResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\"\"\n" +
+	"\x10PurgeUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"-\n" +
+	"\x11PurgeUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"B\n" +
+	"\x13UploadAvatarRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"6\n" +
+	"\x14UploadAvatarResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\"\x87\x01\n" +
+	"\x0fBatchItemStatus\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\x05R\x02id\x12\x1e\n" +
+	"\x04user\x18\x05 \x01(\v2\n" +
+	".user.UserR\x04user\"n\n" +
+	"\x17BatchCreateUsersRequest\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.user.CreateUserRequestR\x05items\x12$\n" +
+	"\x0eall_or_nothing\x18\x02 \x01(\bR\fallOrNothing\"}\n" +
+	"\x18BatchCreateUsersResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.user.BatchItemStatusR\x05items\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"n\n" +
+	"\x17BatchUpdateUsersRequest\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.user.UpdateUserRequestR\x05items\x12$\n" +
+	"\x0eall_or_nothing\x18\x02 \x01(\bR\fallOrNothing\"}\n" +
+	"\x18BatchUpdateUsersResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.user.BatchItemStatusR\x05items\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"Q\n" +
+	"\x17BatchDeleteUsersRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x05R\x03ids\x12$\n" +
+	"\x0eall_or_nothing\x18\x02 \x01(\bR\fallOrNothing\"}\n" +
+	"\x18BatchDeleteUsersResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.user.BatchItemStatusR\x05items\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"\xa5\x02\n" +
+	"\rImportOptions\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSize\x12M\n" +
+	"\x0ecolumn_mapping\x18\x05 \x03(\v2&.user.ImportOptions.ColumnMappingEntryR\rcolumnMapping\x12\x17\n" +
+	"\aorg_ids\x18\x06 \x03(\x03R\x06orgIds\x1a@\n" +
+	"\x12ColumnMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"h\n" +
+	"\x12ImportUsersRequest\x12/\n" +
+	"\aoptions\x18\x01 \x01(\v2\x13.user.ImportOptionsH\x00R\aoptions\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\x8a\x01\n" +
+	"\x0fImportRowResult\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
+	"\x06errors\x18\x05 \x03(\tR\x06errors\"\xa0\x01\n" +
+	"\rImportSummary\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x14\n" +
+	"\x05valid\x18\x03 \x01(\x05R\x05valid\x12\x18\n" +
+	"\ainvalid\x18\x04 \x01(\x05R\ainvalid\x12\x18\n" +
+	"\acreated\x18\x05 \x01(\x05R\acreated\x12\x16\n" +
+	"\x06failed\x18\x06 \x01(\x05R\x06failed\"|\n" +
+	"\x13ImportUsersResponse\x12)\n" +
+	"\x03row\x18\x01 \x01(\v2\x15.user.ImportRowResultH\x00R\x03row\x12/\n" +
+	"\asummary\x18\x02 \x01(\v2\x13.user.ImportSummaryH\x00R\asummaryB\t\n" +
+	"\apayload\"m\n" +
+	"\x11ImportUsersReport\x12-\n" +
+	"\asummary\x18\x01 \x01(\v2\x13.user.ImportSummaryR\asummary\x12)\n" +
+	"\x04rows\x18\x02 \x03(\v2\x15.user.ImportRowResultR\x04rows\"\x9c\x01\n" +
+	"\x12ExportUsersRequest\x12\x16\n" +
+	"\x06search\x18\x01 \x01(\tR\x06search\x12(\n" +
+	"\x06filter\x18\x02 \x01(\v2\x10.user.UserFilterR\x06filter\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x18\n" +
+	"\acolumns\x18\x04 \x03(\tR\acolumns\x12\x12\n" +
+	"\x04lang\x18\x05 \x01(\tR\x04lang\"I\n" +
+	"\x10ExportUsersChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"0\n" +
+	"\x15GetUserProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"E\n" +
+	"\x16GetUserProfileResponse\x12+\n" +
+	"\aprofile\x18\x01 \x01(\v2\x11.user.UserProfileR\aprofile\"`\n" +
+	"\x18UpdateUserProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12+\n" +
+	"\aprofile\x18\x02 \x01(\v2\x11.user.UserProfileR\aprofile\"H\n" +
+	"\x19UpdateUserProfileResponse\x12+\n" +
+	"\aprofile\x18\x01 \x01(\v2\x11.user.UserProfileR\aprofile\"\x16\n" +
+	"\x14ListProvincesRequest\";\n" +
+	"\x14ListDistrictsRequest\x12#\n" +
+	"\rprovince_code\x18\x01 \x01(\tR\fprovinceCode\"7\n" +
+	"\x10ListWardsRequest\x12#\n" +
+	"\rdistrict_code\x18\x01 \x01(\tR\fdistrictCode\"?\n" +
+	"\x16ListAdminUnitsResponse\x12%\n" +
+	"\x05units\x18\x01 \x03(\v2\x0f.user.AdminUnitR\x05units\"N\n" +
+	"\x19FindDuplicateUsersRequest\x12\x1b\n" +
+	"\tmin_score\x18\x01 \x01(\x01R\bminScore\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x86\x01\n" +
+	"\x12DuplicateCandidate\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\x12 \n" +
+	"\x05other\x18\x02 \x01(\v2\n" +
+	".user.UserR\x05other\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\x12\x18\n" +
+	"\areasons\x18\x04 \x03(\tR\areasons\"V\n" +
+	"\x1aFindDuplicateUsersResponse\x128\n" +
+	"\n" +
+	"candidates\x18\x01 \x03(\v2\x18.user.DuplicateCandidateR\n" +
+	"candidates\"Q\n" +
+	"\x11MergeUsersRequest\x12\x1f\n" +
+	"\vsurvivor_id\x18\x01 \x01(\x05R\n" +
+	"survivorId\x12\x1b\n" +
+	"\tmerged_id\x18\x02 \x01(\x05R\bmergedId\"4\n" +
+	"\x12MergeUsersResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\"4\n" +
+	"\x19ExportPersonalDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"\xf7\x01\n" +
+	"\vAccountInfo\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12>\n" +
+	"\rlast_login_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vlastLoginAt\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x80\x03\n" +
+	"\n" +
+	"LoginEvent\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12C\n" +
+	"\x0efailure_reason\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\rfailureReason\x122\n" +
+	"\x06org_id\x18\x03 \x01(\v2\x1b.google.protobuf.Int64ValueR\x05orgId\x12,\n" +
+	"\x02ip\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x02ip\x12;\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\tuserAgent\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xae\x03\n" +
+	"\x12PersonalDataExport\x12;\n" +
+	"\vexported_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportedAt\x12\x1e\n" +
+	"\x04user\x18\x02 \x01(\v2\n" +
+	".user.UserR\x04user\x12+\n" +
+	"\aaccount\x18\x03 \x01(\v2\x11.user.AccountInfoR\aaccount\x125\n" +
+	"\rlogin_history\x18\x04 \x03(\v2\x10.user.LoginEventR\floginHistory\x12,\n" +
+	"\bsessions\x18\x05 \x03(\v2\x10.user.LoginEventR\bsessions\x129\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12?\n" +
+	"\ranonymized_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fanonymizedAt\x12-\n" +
+	"\bversions\x18\b \x03(\v2\x11.user.UserVersionR\bversions\"/\n" +
+	"\x14AnonymizeUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"7\n" +
+	"\x15AnonymizeUserResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\"\xba\x02\n" +
+	"\x14ListAuditLogsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x1f\n" +
+	"\ventity_type\x18\x04 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x05 \x01(\x05R\bentityId\x12\x19\n" +
+	"\bactor_id\x18\x06 \x01(\x05R\aactorId\x12$\n" +
+	"\x0etarget_user_id\x18\a \x01(\x05R\ftargetUserId\x12.\n" +
+	"\x04from\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xdd\x03\n" +
+	"\bAuditLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x126\n" +
+	"\bactor_id\x18\x03 \x01(\v2\x1b.google.protobuf.Int32ValueR\aactorId\x122\n" +
+	"\x06org_id\x18\x04 \x01(\v2\x1b.google.protobuf.Int64ValueR\x05orgId\x12A\n" +
+	"\x0etarget_user_id\x18\x05 \x01(\v2\x1b.google.protobuf.Int32ValueR\ftargetUserId\x12\x1f\n" +
+	"\ventity_type\x18\x06 \x01(\tR\n" +
+	"entityType\x128\n" +
+	"\tentity_id\x18\a \x01(\v2\x1b.google.protobuf.Int32ValueR\bentityId\x121\n" +
+	"\achanges\x18\b \x01(\v2\x17.google.protobuf.StructR\achanges\x121\n" +
+	"\adetails\x18\t \x01(\v2\x17.google.protobuf.StructR\adetails\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x95\x01\n" +
+	"\x15ListAuditLogsResponse\x12\"\n" +
+	"\x04logs\x18\x01 \x03(\v2\x0e.user.AuditLogR\x04logs\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x1f\n" +
+	"\vtotal_pages\x18\x03 \x01(\x05R\n" +
+	"totalPages\x12!\n" +
+	"\fcurrent_page\x18\x04 \x01(\x05R\vcurrentPage\"\x83\x01\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x120\n" +
+	"\x04from\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x04from\x12,\n" +
+	"\x02to\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x02to\"\xa2\x02\n" +
+	"\vUserVersion\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1e\n" +
+	"\x04user\x18\x02 \x01(\v2\n" +
+	".user.UserR\x04user\x129\n" +
+	"\n" +
+	"valid_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\x12:\n" +
+	"\n" +
+	"changed_by\x18\x05 \x01(\v2\x1b.google.protobuf.Int32ValueR\tchangedBy\x12+\n" +
+	"\achanges\x18\x06 \x03(\v2\x11.user.FieldChangeR\achanges\"P\n" +
+	"\x12GetUserAsOfRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"B\n" +
+	"\x13GetUserAsOfResponse\x12+\n" +
+	"\aversion\x18\x01 \x01(\v2\x11.user.UserVersionR\aversion\")\n" +
+	"\x17ListUserVersionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"I\n" +
+	"\x18ListUserVersionsResponse\x12-\n" +
+	"\bversions\x18\x01 \x03(\v2\x11.user.UserVersionR\bversions\"k\n" +
+	"\x17DiffUserVersionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12!\n" +
+	"\ffrom_version\x18\x02 \x01(\x05R\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\x05R\ttoVersion\"`\n" +
+	"\x18DiffUserVersionsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12+\n" +
+	"\achanges\x18\x02 \x03(\v2\x11.user.FieldChangeR\achanges\"\xe3\x02\n" +
+	"\x13AttributeDefinition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\x03R\x05orgId\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x1a\n" +
+	"\brequired\x18\x06 \x01(\bR\brequired\x12\x1f\n" +
+	"\venum_values\x18\a \x03(\tR\n" +
+	"enumValues\x126\n" +
+	"\apattern\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\apattern\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"8\n" +
+	"\x1fListAttributeDefinitionsRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\"_\n" +
+	" ListAttributeDefinitionsResponse\x12;\n" +
+	"\vdefinitions\x18\x01 \x03(\v2\x19.user.AttributeDefinitionR\vdefinitions\"\xea\x01\n" +
+	" CreateAttributeDefinitionRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12\x1f\n" +
+	"\venum_values\x18\x06 \x03(\tR\n" +
+	"enumValues\x126\n" +
+	"\apattern\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\apattern\"\xd1\x01\n" +
+	" UpdateAttributeDefinitionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\x1f\n" +
+	"\venum_values\x18\x05 \x03(\tR\n" +
+	"enumValues\x126\n" +
+	"\apattern\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\apattern\"X\n" +
+	"\x1bAttributeDefinitionResponse\x129\n" +
+	"\n" +
+	"definition\x18\x01 \x01(\v2\x19.user.AttributeDefinitionR\n" +
+	"definition\"2\n" +
+	" DeleteAttributeDefinitionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"d\n" +
+	"!DeleteAttributeDefinitionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
+	"\x0eaffected_users\x18\x02 \x01(\x05R\raffectedUsers\"\x9a\x02\n" +
+	"\x05Group\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x122\n" +
+	"\x06org_id\x18\x02 \x01(\v2\x1b.google.protobuf.Int64ValueR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12!\n" +
+	"\fsubgroup_ids\x18\x05 \x03(\x05R\vsubgroupIds\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x90\x01\n" +
+	"\vGroupMember\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x05R\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\\\n" +
+	"\x11ListGroupsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06search\x18\x03 \x01(\tR\x06search\"\x93\x01\n" +
+	"\x12ListGroupsResponse\x12#\n" +
+	"\x06groups\x18\x01 \x03(\v2\v.user.GroupR\x06groups\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x1f\n" +
+	"\vtotal_pages\x18\x03 \x01(\x05R\n" +
+	"totalPages\x12!\n" +
+	"\fcurrent_page\x18\x04 \x01(\x05R\vcurrentPage\"!\n" +
+	"\x0fGetGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"~\n" +
+	"\x12CreateGroupRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\towner_ids\x18\x04 \x03(\x05R\bownerIds\"\x96\x01\n" +
+	"\x12UpdateGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x120\n" +
+	"\x04name\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x12>\n" +
+	"\vdescription\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\"2\n" +
+	"\rGroupResponse\x12!\n" +
+	"\x05group\x18\x01 \x01(\v2\v.user.GroupR\x05group\"$\n" +
+	"\x12DeleteGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"/\n" +
+	"\x13DeleteGroupResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"4\n" +
+	"\x17ListGroupMembersRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x05R\agroupId\"G\n" +
+	"\x18ListGroupMembersResponse\x12+\n" +
+	"\amembers\x18\x01 \x03(\v2\x11.user.GroupMemberR\amembers\"B\n" +
+	"\x13AddGroupMemberInput\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"h\n" +
+	"\x16AddGroupMembersRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x05R\agroupId\x123\n" +
+	"\amembers\x18\x02 \x03(\v2\x19.user.AddGroupMemberInputR\amembers\"b\n" +
+	"\x18UpdateGroupMemberRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x05R\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"@\n" +
+	"\x13GroupMemberResponse\x12)\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.user.GroupMemberR\x06member\"N\n" +
+	"\x18RemoveGroupMemberRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x05R\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"5\n" +
+	"\x19RemoveGroupMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"P\n" +
+	"\x12AddSubgroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x05R\agroupId\x12\x1f\n" +
+	"\vsubgroup_id\x18\x02 \x01(\x05R\n" +
+	"subgroupId\"S\n" +
+	"\x15RemoveSubgroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x05R\agroupId\x12\x1f\n" +
+	"\vsubgroup_id\x18\x02 \x01(\x05R\n" +
+	"subgroupId\"0\n" +
+	"\x15ListUserGroupsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"Z\n" +
+	"\tUserGroup\x12!\n" +
+	"\x05group\x18\x01 \x01(\v2\v.user.GroupR\x05group\x12\x16\n" +
+	"\x06direct\x18\x02 \x01(\bR\x06direct\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"A\n" +
+	"\x16ListUserGroupsResponse\x12'\n" +
+	"\x06groups\x18\x01 \x03(\v2\x0f.user.UserGroupR\x06groups\"6\n" +
+	"\x19ExpandGroupMembersRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x05R\agroupId\"~\n" +
+	"\x13ExpandedGroupMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x16\n" +
+	"\x06direct\x18\x02 \x01(\bR\x06direct\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\"\n" +
+	"\rvia_group_ids\x18\x04 \x03(\x05R\vviaGroupIds\"Q\n" +
+	"\x1aExpandGroupMembersResponse\x123\n" +
+	"\amembers\x18\x01 \x03(\v2\x19.user.ExpandedGroupMemberR\amembers2\xee\x1a\n" +
 	"\vUserService\x12<\n" +
 	"\tListUsers\x12\x16.user.ListUsersRequest\x1a\x17.user.ListUsersResponse\x12B\n" +
 	"\vGetUserById\x12\x18.user.GetUserByIdRequest\x1a\x19.user.GetUserByIdResponse\x12H\n" +
-	"\rGetUsersByIDs\x12\x1a.user.GetUsersByIDsRequest\x1a\x1b.user.GetUsersByIDsResponse\x12?\n" +
+	"\rGetUsersByIDs\x12\x1a.user.GetUsersByIDsRequest\x1a\x1b.user.GetUsersByIDsResponse\x12B\n" +
+	"\vSearchUsers\x12\x18.user.SearchUsersRequest\x1a\x19.user.SearchUsersResponse\x12?\n" +
 	"\n" +
 	"CreateUser\x12\x17.user.CreateUserRequest\x1a\x18.user.CreateUserResponse\x12C\n" +
 	"\x0eUpdateUserByID\x12\x17.user.UpdateUserRequest\x1a\x18.user.UpdateUserResponse\x12C\n" +
-	"\x0eDeleteUserByID\x12\x17.user.DeleteUserRequest\x1a\x18.user.DeleteUserResponseB\fZ\n" +
+	"\x0eDeleteUserByID\x12\x17.user.DeleteUserRequest\x1a\x18.user.DeleteUserResponse\x12B\n" +
+	"\vRestoreUser\x12\x18.user.RestoreUserRequest\x1a\x19.user.RestoreUserResponse\x12<\n" +
+	"\tPurgeUser\x12\x16.user.PurgeUserRequest\x1a\x17.user.PurgeUserResponse\x12E\n" +
+	"\fUploadAvatar\x12\x19.user.UploadAvatarRequest\x1a\x1a.user.UploadAvatarResponse\x12K\n" +
+	"\x0eGetUserProfile\x12\x1b.user.GetUserProfileRequest\x1a\x1c.user.GetUserProfileResponse\x12T\n" +
+	"\x11UpdateUserProfile\x12\x1e.user.UpdateUserProfileRequest\x1a\x1f.user.UpdateUserProfileResponse\x12I\n" +
+	"\rListProvinces\x12\x1a.user.ListProvincesRequest\x1a\x1c.user.ListAdminUnitsResponse\x12I\n" +
+	"\rListDistricts\x12\x1a.user.ListDistrictsRequest\x1a\x1c.user.ListAdminUnitsResponse\x12A\n" +
+	"\tListWards\x12\x16.user.ListWardsRequest\x1a\x1c.user.ListAdminUnitsResponse\x12W\n" +
+	"\x12FindDuplicateUsers\x12\x1f.user.FindDuplicateUsersRequest\x1a .user.FindDuplicateUsersResponse\x12?\n" +
+	"\n" +
+	"MergeUsers\x12\x17.user.MergeUsersRequest\x1a\x18.user.MergeUsersResponse\x12O\n" +
+	"\x12ExportPersonalData\x12\x1f.user.ExportPersonalDataRequest\x1a\x18.user.PersonalDataExport\x12H\n" +
+	"\rAnonymizeUser\x12\x1a.user.AnonymizeUserRequest\x1a\x1b.user.AnonymizeUserResponse\x12H\n" +
+	"\rListAuditLogs\x12\x1a.user.ListAuditLogsRequest\x1a\x1b.user.ListAuditLogsResponse\x12B\n" +
+	"\vGetUserAsOf\x12\x18.user.GetUserAsOfRequest\x1a\x19.user.GetUserAsOfResponse\x12Q\n" +
+	"\x10ListUserVersions\x12\x1d.user.ListUserVersionsRequest\x1a\x1e.user.ListUserVersionsResponse\x12Q\n" +
+	"\x10DiffUserVersions\x12\x1d.user.DiffUserVersionsRequest\x1a\x1e.user.DiffUserVersionsResponse\x12i\n" +
+	"\x18ListAttributeDefinitions\x12%.user.ListAttributeDefinitionsRequest\x1a&.user.ListAttributeDefinitionsResponse\x12f\n" +
+	"\x19CreateAttributeDefinition\x12&.user.CreateAttributeDefinitionRequest\x1a!.user.AttributeDefinitionResponse\x12f\n" +
+	"\x19UpdateAttributeDefinition\x12&.user.UpdateAttributeDefinitionRequest\x1a!.user.AttributeDefinitionResponse\x12l\n" +
+	"\x19DeleteAttributeDefinition\x12&.user.DeleteAttributeDefinitionRequest\x1a'.user.DeleteAttributeDefinitionResponse\x12?\n" +
+	"\n" +
+	"ListGroups\x12\x17.user.ListGroupsRequest\x1a\x18.user.ListGroupsResponse\x126\n" +
+	"\bGetGroup\x12\x15.user.GetGroupRequest\x1a\x13.user.GroupResponse\x12<\n" +
+	"\vCreateGroup\x12\x18.user.CreateGroupRequest\x1a\x13.user.GroupResponse\x12<\n" +
+	"\vUpdateGroup\x12\x18.user.UpdateGroupRequest\x1a\x13.user.GroupResponse\x12B\n" +
+	"\vDeleteGroup\x12\x18.user.DeleteGroupRequest\x1a\x19.user.DeleteGroupResponse\x12Q\n" +
+	"\x10ListGroupMembers\x12\x1d.user.ListGroupMembersRequest\x1a\x1e.user.ListGroupMembersResponse\x12O\n" +
+	"\x0fAddGroupMembers\x12\x1c.user.AddGroupMembersRequest\x1a\x1e.user.ListGroupMembersResponse\x12N\n" +
+	"\x11UpdateGroupMember\x12\x1e.user.UpdateGroupMemberRequest\x1a\x19.user.GroupMemberResponse\x12T\n" +
+	"\x11RemoveGroupMember\x12\x1e.user.RemoveGroupMemberRequest\x1a\x1f.user.RemoveGroupMemberResponse\x12<\n" +
+	"\vAddSubgroup\x12\x18.user.AddSubgroupRequest\x1a\x13.user.GroupResponse\x12B\n" +
+	"\x0eRemoveSubgroup\x12\x1b.user.RemoveSubgroupRequest\x1a\x13.user.GroupResponse\x12K\n" +
+	"\x0eListUserGroups\x12\x1b.user.ListUserGroupsRequest\x1a\x1c.user.ListUserGroupsResponse\x12W\n" +
+	"\x12ExpandGroupMembers\x12\x1f.user.ExpandGroupMembersRequest\x1a .user.ExpandGroupMembersResponse\x12Q\n" +
+	"\x10BatchCreateUsers\x12\x1d.user.BatchCreateUsersRequest\x1a\x1e.user.BatchCreateUsersResponse\x12Q\n" +
+	"\x10BatchUpdateUsers\x12\x1d.user.BatchUpdateUsersRequest\x1a\x1e.user.BatchUpdateUsersResponse\x12Q\n" +
+	"\x10BatchDeleteUsers\x12\x1d.user.BatchDeleteUsersRequest\x1a\x1e.user.BatchDeleteUsersResponse\x12F\n" +
+	"\vImportUsers\x12\x18.user.ImportUsersRequest\x1a\x19.user.ImportUsersResponse(\x010\x01\x12A\n" +
+	"\vExportUsers\x12\x18.user.ExportUsersRequest\x1a\x16.user.ExportUsersChunk0\x01B\fZ\n" +
 	"proto/userb\x06proto3"
 
 var (
@@ -1262,72 +7587,370 @@ func file_proto_user_user_proto_rawDescGZIP() []byte {
 	return file_proto_user_user_proto_rawDescData
 }
 
-var file_proto_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_proto_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 112)
 var file_proto_user_user_proto_goTypes = []any{
-	(*ListUsersRequest)(nil),       // 0: user.ListUsersRequest
-	(*User)(nil),                   // 1: user.User
-	(*RoleExt)(nil),                // 2: user.RoleExt
-	(*PermExt)(nil),                // 3: user.PermExt
-	(*ListUsersResponse)(nil),      // 4: user.ListUsersResponse
-	(*GetUserByIdRequest)(nil),     // 5: user.GetUserByIdRequest
-	(*GetUserByIdResponse)(nil),    // 6: user.GetUserByIdResponse
-	(*GetUsersByIDsRequest)(nil),   // 7: user.GetUsersByIDsRequest
-	(*GetUsersByIDsResponse)(nil),  // 8: user.GetUsersByIDsResponse
-	(*Account)(nil),                // 9: user.Account
-	(*CreateUserRequest)(nil),      // 10: user.CreateUserRequest
-	(*CreateUserResponse)(nil),     // 11: user.CreateUserResponse
-	(*UpdateUserRequest)(nil),      // 12: user.UpdateUserRequest
-	(*UpdateUserResponse)(nil),     // 13: user.UpdateUserResponse
-	(*DeleteUserRequest)(nil),      // 14: user.DeleteUserRequest
-	(*DeleteUserResponse)(nil),     // 15: user.DeleteUserResponse
-	(*wrapperspb.StringValue)(nil), // 16: google.protobuf.StringValue
-	(*timestamppb.Timestamp)(nil),  // 17: google.protobuf.Timestamp
+	(*ListUsersRequest)(nil),                  // 0: user.ListUsersRequest
+	(*UserFilter)(nil),                        // 1: user.UserFilter
+	(*OrderBy)(nil),                           // 2: user.OrderBy
+	(*User)(nil),                              // 3: user.User
+	(*AdminUnit)(nil),                         // 4: user.AdminUnit
+	(*EmergencyContact)(nil),                  // 5: user.EmergencyContact
+	(*UserProfile)(nil),                       // 6: user.UserProfile
+	(*RoleExt)(nil),                           // 7: user.RoleExt
+	(*PermExt)(nil),                           // 8: user.PermExt
+	(*ListUsersResponse)(nil),                 // 9: user.ListUsersResponse
+	(*GetUserByIdRequest)(nil),                // 10: user.GetUserByIdRequest
+	(*GetUserByIdResponse)(nil),               // 11: user.GetUserByIdResponse
+	(*GetUsersByIDsRequest)(nil),              // 12: user.GetUsersByIDsRequest
+	(*GetUsersByIDsResponse)(nil),             // 13: user.GetUsersByIDsResponse
+	(*SearchUsersRequest)(nil),                // 14: user.SearchUsersRequest
+	(*UserSearchHit)(nil),                     // 15: user.UserSearchHit
+	(*SearchUsersResponse)(nil),               // 16: user.SearchUsersResponse
+	(*Account)(nil),                           // 17: user.Account
+	(*CreateUserRequest)(nil),                 // 18: user.CreateUserRequest
+	(*CreateUserResponse)(nil),                // 19: user.CreateUserResponse
+	(*UpdateUserRequest)(nil),                 // 20: user.UpdateUserRequest
+	(*UpdateUserResponse)(nil),                // 21: user.UpdateUserResponse
+	(*UserVersionConflict)(nil),               // 22: user.UserVersionConflict
+	(*DeleteUserRequest)(nil),                 // 23: user.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                // 24: user.DeleteUserResponse
+	(*RestoreUserRequest)(nil),                // 25: user.RestoreUserRequest
+	(*RestoreUserResponse)(nil),               // 26: user.RestoreUserResponse
+	(*PurgeUserRequest)(nil),                  // 27: user.PurgeUserRequest
+	(*PurgeUserResponse)(nil),                 // 28: user.PurgeUserResponse
+	(*UploadAvatarRequest)(nil),               // 29: user.UploadAvatarRequest
+	(*UploadAvatarResponse)(nil),              // 30: user.UploadAvatarResponse
+	(*BatchItemStatus)(nil),                   // 31: user.BatchItemStatus
+	(*BatchCreateUsersRequest)(nil),           // 32: user.BatchCreateUsersRequest
+	(*BatchCreateUsersResponse)(nil),          // 33: user.BatchCreateUsersResponse
+	(*BatchUpdateUsersRequest)(nil),           // 34: user.BatchUpdateUsersRequest
+	(*BatchUpdateUsersResponse)(nil),          // 35: user.BatchUpdateUsersResponse
+	(*BatchDeleteUsersRequest)(nil),           // 36: user.BatchDeleteUsersRequest
+	(*BatchDeleteUsersResponse)(nil),          // 37: user.BatchDeleteUsersResponse
+	(*ImportOptions)(nil),                     // 38: user.ImportOptions
+	(*ImportUsersRequest)(nil),                // 39: user.ImportUsersRequest
+	(*ImportRowResult)(nil),                   // 40: user.ImportRowResult
+	(*ImportSummary)(nil),                     // 41: user.ImportSummary
+	(*ImportUsersResponse)(nil),               // 42: user.ImportUsersResponse
+	(*ImportUsersReport)(nil),                 // 43: user.ImportUsersReport
+	(*ExportUsersRequest)(nil),                // 44: user.ExportUsersRequest
+	(*ExportUsersChunk)(nil),                  // 45: user.ExportUsersChunk
+	(*GetUserProfileRequest)(nil),             // 46: user.GetUserProfileRequest
+	(*GetUserProfileResponse)(nil),            // 47: user.GetUserProfileResponse
+	(*UpdateUserProfileRequest)(nil),          // 48: user.UpdateUserProfileRequest
+	(*UpdateUserProfileResponse)(nil),         // 49: user.UpdateUserProfileResponse
+	(*ListProvincesRequest)(nil),              // 50: user.ListProvincesRequest
+	(*ListDistrictsRequest)(nil),              // 51: user.ListDistrictsRequest
+	(*ListWardsRequest)(nil),                  // 52: user.ListWardsRequest
+	(*ListAdminUnitsResponse)(nil),            // 53: user.ListAdminUnitsResponse
+	(*FindDuplicateUsersRequest)(nil),         // 54: user.FindDuplicateUsersRequest
+	(*DuplicateCandidate)(nil),                // 55: user.DuplicateCandidate
+	(*FindDuplicateUsersResponse)(nil),        // 56: user.FindDuplicateUsersResponse
+	(*MergeUsersRequest)(nil),                 // 57: user.MergeUsersRequest
+	(*MergeUsersResponse)(nil),                // 58: user.MergeUsersResponse
+	(*ExportPersonalDataRequest)(nil),         // 59: user.ExportPersonalDataRequest
+	(*AccountInfo)(nil),                       // 60: user.AccountInfo
+	(*LoginEvent)(nil),                        // 61: user.LoginEvent
+	(*PersonalDataExport)(nil),                // 62: user.PersonalDataExport
+	(*AnonymizeUserRequest)(nil),              // 63: user.AnonymizeUserRequest
+	(*AnonymizeUserResponse)(nil),             // 64: user.AnonymizeUserResponse
+	(*ListAuditLogsRequest)(nil),              // 65: user.ListAuditLogsRequest
+	(*AuditLog)(nil),                          // 66: user.AuditLog
+	(*ListAuditLogsResponse)(nil),             // 67: user.ListAuditLogsResponse
+	(*FieldChange)(nil),                       // 68: user.FieldChange
+	(*UserVersion)(nil),                       // 69: user.UserVersion
+	(*GetUserAsOfRequest)(nil),                // 70: user.GetUserAsOfRequest
+	(*GetUserAsOfResponse)(nil),               // 71: user.GetUserAsOfResponse
+	(*ListUserVersionsRequest)(nil),           // 72: user.ListUserVersionsRequest
+	(*ListUserVersionsResponse)(nil),          // 73: user.ListUserVersionsResponse
+	(*DiffUserVersionsRequest)(nil),           // 74: user.DiffUserVersionsRequest
+	(*DiffUserVersionsResponse)(nil),          // 75: user.DiffUserVersionsResponse
+	(*AttributeDefinition)(nil),               // 76: user.AttributeDefinition
+	(*ListAttributeDefinitionsRequest)(nil),   // 77: user.ListAttributeDefinitionsRequest
+	(*ListAttributeDefinitionsResponse)(nil),  // 78: user.ListAttributeDefinitionsResponse
+	(*CreateAttributeDefinitionRequest)(nil),  // 79: user.CreateAttributeDefinitionRequest
+	(*UpdateAttributeDefinitionRequest)(nil),  // 80: user.UpdateAttributeDefinitionRequest
+	(*AttributeDefinitionResponse)(nil),       // 81: user.AttributeDefinitionResponse
+	(*DeleteAttributeDefinitionRequest)(nil),  // 82: user.DeleteAttributeDefinitionRequest
+	(*DeleteAttributeDefinitionResponse)(nil), // 83: user.DeleteAttributeDefinitionResponse
+	(*Group)(nil),                             // 84: user.Group
+	(*GroupMember)(nil),                       // 85: user.GroupMember
+	(*ListGroupsRequest)(nil),                 // 86: user.ListGroupsRequest
+	(*ListGroupsResponse)(nil),                // 87: user.ListGroupsResponse
+	(*GetGroupRequest)(nil),                   // 88: user.GetGroupRequest
+	(*CreateGroupRequest)(nil),                // 89: user.CreateGroupRequest
+	(*UpdateGroupRequest)(nil),                // 90: user.UpdateGroupRequest
+	(*GroupResponse)(nil),                     // 91: user.GroupResponse
+	(*DeleteGroupRequest)(nil),                // 92: user.DeleteGroupRequest
+	(*DeleteGroupResponse)(nil),               // 93: user.DeleteGroupResponse
+	(*ListGroupMembersRequest)(nil),           // 94: user.ListGroupMembersRequest
+	(*ListGroupMembersResponse)(nil),          // 95: user.ListGroupMembersResponse
+	(*AddGroupMemberInput)(nil),               // 96: user.AddGroupMemberInput
+	(*AddGroupMembersRequest)(nil),            // 97: user.AddGroupMembersRequest
+	(*UpdateGroupMemberRequest)(nil),          // 98: user.UpdateGroupMemberRequest
+	(*GroupMemberResponse)(nil),               // 99: user.GroupMemberResponse
+	(*RemoveGroupMemberRequest)(nil),          // 100: user.RemoveGroupMemberRequest
+	(*RemoveGroupMemberResponse)(nil),         // 101: user.RemoveGroupMemberResponse
+	(*AddSubgroupRequest)(nil),                // 102: user.AddSubgroupRequest
+	(*RemoveSubgroupRequest)(nil),             // 103: user.RemoveSubgroupRequest
+	(*ListUserGroupsRequest)(nil),             // 104: user.ListUserGroupsRequest
+	(*UserGroup)(nil),                         // 105: user.UserGroup
+	(*ListUserGroupsResponse)(nil),            // 106: user.ListUserGroupsResponse
+	(*ExpandGroupMembersRequest)(nil),         // 107: user.ExpandGroupMembersRequest
+	(*ExpandedGroupMember)(nil),               // 108: user.ExpandedGroupMember
+	(*ExpandGroupMembersResponse)(nil),        // 109: user.ExpandGroupMembersResponse
+	nil,                                       // 110: user.UserFilter.AttributesEntry
+	nil,                                       // 111: user.ImportOptions.ColumnMappingEntry
+	(*timestamppb.Timestamp)(nil),             // 112: google.protobuf.Timestamp
+	(*wrapperspb.BoolValue)(nil),              // 113: google.protobuf.BoolValue
+	(*wrapperspb.StringValue)(nil),            // 114: google.protobuf.StringValue
+	(*structpb.Struct)(nil),                   // 115: google.protobuf.Struct
+	(*wrapperspb.Int32Value)(nil),             // 116: google.protobuf.Int32Value
+	(*fieldmaskpb.FieldMask)(nil),             // 117: google.protobuf.FieldMask
+	(*wrapperspb.Int64Value)(nil),             // 118: google.protobuf.Int64Value
 }
 var file_proto_user_user_proto_depIdxs = []int32{
-	16, // 0: user.User.phone:type_name -> google.protobuf.StringValue
-	16, // 1: user.User.email:type_name -> google.protobuf.StringValue
-	16, // 2: user.User.ward_code:type_name -> google.protobuf.StringValue
-	16, // 3: user.User.address:type_name -> google.protobuf.StringValue
-	16, // 4: user.User.avatar:type_name -> google.protobuf.StringValue
-	16, // 5: user.RoleExt.color:type_name -> google.protobuf.StringValue
-	16, // 6: user.RoleExt.description:type_name -> google.protobuf.StringValue
-	17, // 7: user.RoleExt.created_at:type_name -> google.protobuf.Timestamp
-	17, // 8: user.RoleExt.updated_at:type_name -> google.protobuf.Timestamp
-	16, // 9: user.PermExt.description:type_name -> google.protobuf.StringValue
-	1,  // 10: user.ListUsersResponse.users:type_name -> user.User
-	1,  // 11: user.GetUserByIdResponse.user:type_name -> user.User
-	2,  // 12: user.GetUserByIdResponse.roles:type_name -> user.RoleExt
-	3,  // 13: user.GetUserByIdResponse.perms:type_name -> user.PermExt
-	1,  // 14: user.GetUsersByIDsResponse.users:type_name -> user.User
-	16, // 15: user.CreateUserRequest.email:type_name -> google.protobuf.StringValue
-	16, // 16: user.CreateUserRequest.ward_code:type_name -> google.protobuf.StringValue
-	16, // 17: user.CreateUserRequest.address:type_name -> google.protobuf.StringValue
-	16, // 18: user.CreateUserRequest.avatar:type_name -> google.protobuf.StringValue
-	9,  // 19: user.CreateUserRequest.account:type_name -> user.Account
-	1,  // 20: user.CreateUserResponse.user:type_name -> user.User
-	16, // 21: user.UpdateUserRequest.email:type_name -> google.protobuf.StringValue
-	16, // 22: user.UpdateUserRequest.ward_code:type_name -> google.protobuf.StringValue
-	16, // 23: user.UpdateUserRequest.address:type_name -> google.protobuf.StringValue
-	16, // 24: user.UpdateUserRequest.avatar:type_name -> google.protobuf.StringValue
-	9,  // 25: user.UpdateUserRequest.account:type_name -> user.Account
-	1,  // 26: user.UpdateUserResponse.user:type_name -> user.User
-	0,  // 27: user.UserService.ListUsers:input_type -> user.ListUsersRequest
-	5,  // 28: user.UserService.GetUserById:input_type -> user.GetUserByIdRequest
-	7,  // 29: user.UserService.GetUsersByIDs:input_type -> user.GetUsersByIDsRequest
-	10, // 30: user.UserService.CreateUser:input_type -> user.CreateUserRequest
-	12, // 31: user.UserService.UpdateUserByID:input_type -> user.UpdateUserRequest
-	14, // 32: user.UserService.DeleteUserByID:input_type -> user.DeleteUserRequest
-	4,  // 33: user.UserService.ListUsers:output_type -> user.ListUsersResponse
-	6,  // 34: user.UserService.GetUserById:output_type -> user.GetUserByIdResponse
-	8,  // 35: user.UserService.GetUsersByIDs:output_type -> user.GetUsersByIDsResponse
-	11, // 36: user.UserService.CreateUser:output_type -> user.CreateUserResponse
-	13, // 37: user.UserService.UpdateUserByID:output_type -> user.UpdateUserResponse
-	15, // 38: user.UserService.DeleteUserByID:output_type -> user.DeleteUserResponse
-	33, // [33:39] is the sub-list for method output_type
-	27, // [27:33] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	1,   // 0: user.ListUsersRequest.filter:type_name -> user.UserFilter
+	2,   // 1: user.ListUsersRequest.order_by:type_name -> user.OrderBy
+	112, // 2: user.UserFilter.created_from:type_name -> google.protobuf.Timestamp
+	112, // 3: user.UserFilter.created_to:type_name -> google.protobuf.Timestamp
+	112, // 4: user.UserFilter.updated_from:type_name -> google.protobuf.Timestamp
+	112, // 5: user.UserFilter.updated_to:type_name -> google.protobuf.Timestamp
+	113, // 6: user.UserFilter.has_avatar:type_name -> google.protobuf.BoolValue
+	113, // 7: user.UserFilter.has_email:type_name -> google.protobuf.BoolValue
+	110, // 8: user.UserFilter.attributes:type_name -> user.UserFilter.AttributesEntry
+	114, // 9: user.User.phone:type_name -> google.protobuf.StringValue
+	114, // 10: user.User.email:type_name -> google.protobuf.StringValue
+	114, // 11: user.User.ward_code:type_name -> google.protobuf.StringValue
+	114, // 12: user.User.address:type_name -> google.protobuf.StringValue
+	114, // 13: user.User.avatar:type_name -> google.protobuf.StringValue
+	114, // 14: user.User.province_code:type_name -> google.protobuf.StringValue
+	6,   // 15: user.User.profile:type_name -> user.UserProfile
+	4,   // 16: user.User.province:type_name -> user.AdminUnit
+	4,   // 17: user.User.district:type_name -> user.AdminUnit
+	4,   // 18: user.User.ward:type_name -> user.AdminUnit
+	115, // 19: user.User.custom_attributes:type_name -> google.protobuf.Struct
+	114, // 20: user.EmergencyContact.address:type_name -> google.protobuf.StringValue
+	114, // 21: user.UserProfile.date_of_birth:type_name -> google.protobuf.StringValue
+	114, // 22: user.UserProfile.place_of_birth:type_name -> google.protobuf.StringValue
+	114, // 23: user.UserProfile.national_id:type_name -> google.protobuf.StringValue
+	114, // 24: user.UserProfile.national_id_issue_date:type_name -> google.protobuf.StringValue
+	114, // 25: user.UserProfile.national_id_issue_place:type_name -> google.protobuf.StringValue
+	114, // 26: user.UserProfile.ethnicity:type_name -> google.protobuf.StringValue
+	114, // 27: user.UserProfile.religion:type_name -> google.protobuf.StringValue
+	114, // 28: user.UserProfile.marital_status:type_name -> google.protobuf.StringValue
+	114, // 29: user.UserProfile.tax_code:type_name -> google.protobuf.StringValue
+	114, // 30: user.UserProfile.social_insurance_number:type_name -> google.protobuf.StringValue
+	5,   // 31: user.UserProfile.emergency_contacts:type_name -> user.EmergencyContact
+	114, // 32: user.RoleExt.color:type_name -> google.protobuf.StringValue
+	114, // 33: user.RoleExt.description:type_name -> google.protobuf.StringValue
+	112, // 34: user.RoleExt.created_at:type_name -> google.protobuf.Timestamp
+	112, // 35: user.RoleExt.updated_at:type_name -> google.protobuf.Timestamp
+	114, // 36: user.PermExt.description:type_name -> google.protobuf.StringValue
+	3,   // 37: user.ListUsersResponse.users:type_name -> user.User
+	3,   // 38: user.GetUserByIdResponse.user:type_name -> user.User
+	7,   // 39: user.GetUserByIdResponse.roles:type_name -> user.RoleExt
+	8,   // 40: user.GetUserByIdResponse.perms:type_name -> user.PermExt
+	3,   // 41: user.GetUsersByIDsResponse.users:type_name -> user.User
+	3,   // 42: user.UserSearchHit.user:type_name -> user.User
+	15,  // 43: user.SearchUsersResponse.hits:type_name -> user.UserSearchHit
+	114, // 44: user.CreateUserRequest.email:type_name -> google.protobuf.StringValue
+	114, // 45: user.CreateUserRequest.ward_code:type_name -> google.protobuf.StringValue
+	114, // 46: user.CreateUserRequest.address:type_name -> google.protobuf.StringValue
+	114, // 47: user.CreateUserRequest.avatar:type_name -> google.protobuf.StringValue
+	17,  // 48: user.CreateUserRequest.account:type_name -> user.Account
+	114, // 49: user.CreateUserRequest.province_code:type_name -> google.protobuf.StringValue
+	115, // 50: user.CreateUserRequest.custom_attributes:type_name -> google.protobuf.Struct
+	3,   // 51: user.CreateUserResponse.user:type_name -> user.User
+	114, // 52: user.UpdateUserRequest.email:type_name -> google.protobuf.StringValue
+	114, // 53: user.UpdateUserRequest.ward_code:type_name -> google.protobuf.StringValue
+	114, // 54: user.UpdateUserRequest.address:type_name -> google.protobuf.StringValue
+	114, // 55: user.UpdateUserRequest.avatar:type_name -> google.protobuf.StringValue
+	17,  // 56: user.UpdateUserRequest.account:type_name -> user.Account
+	114, // 57: user.UpdateUserRequest.province_code:type_name -> google.protobuf.StringValue
+	116, // 58: user.UpdateUserRequest.expected_version:type_name -> google.protobuf.Int32Value
+	117, // 59: user.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	115, // 60: user.UpdateUserRequest.custom_attributes:type_name -> google.protobuf.Struct
+	3,   // 61: user.UpdateUserResponse.user:type_name -> user.User
+	3,   // 62: user.UserVersionConflict.current:type_name -> user.User
+	3,   // 63: user.RestoreUserResponse.user:type_name -> user.User
+	3,   // 64: user.UploadAvatarResponse.user:type_name -> user.User
+	3,   // 65: user.BatchItemStatus.user:type_name -> user.User
+	18,  // 66: user.BatchCreateUsersRequest.items:type_name -> user.CreateUserRequest
+	31,  // 67: user.BatchCreateUsersResponse.items:type_name -> user.BatchItemStatus
+	20,  // 68: user.BatchUpdateUsersRequest.items:type_name -> user.UpdateUserRequest
+	31,  // 69: user.BatchUpdateUsersResponse.items:type_name -> user.BatchItemStatus
+	31,  // 70: user.BatchDeleteUsersResponse.items:type_name -> user.BatchItemStatus
+	111, // 71: user.ImportOptions.column_mapping:type_name -> user.ImportOptions.ColumnMappingEntry
+	38,  // 72: user.ImportUsersRequest.options:type_name -> user.ImportOptions
+	40,  // 73: user.ImportUsersResponse.row:type_name -> user.ImportRowResult
+	41,  // 74: user.ImportUsersResponse.summary:type_name -> user.ImportSummary
+	41,  // 75: user.ImportUsersReport.summary:type_name -> user.ImportSummary
+	40,  // 76: user.ImportUsersReport.rows:type_name -> user.ImportRowResult
+	1,   // 77: user.ExportUsersRequest.filter:type_name -> user.UserFilter
+	6,   // 78: user.GetUserProfileResponse.profile:type_name -> user.UserProfile
+	6,   // 79: user.UpdateUserProfileRequest.profile:type_name -> user.UserProfile
+	6,   // 80: user.UpdateUserProfileResponse.profile:type_name -> user.UserProfile
+	4,   // 81: user.ListAdminUnitsResponse.units:type_name -> user.AdminUnit
+	3,   // 82: user.DuplicateCandidate.user:type_name -> user.User
+	3,   // 83: user.DuplicateCandidate.other:type_name -> user.User
+	55,  // 84: user.FindDuplicateUsersResponse.candidates:type_name -> user.DuplicateCandidate
+	3,   // 85: user.MergeUsersResponse.user:type_name -> user.User
+	112, // 86: user.AccountInfo.last_login_at:type_name -> google.protobuf.Timestamp
+	112, // 87: user.AccountInfo.created_at:type_name -> google.protobuf.Timestamp
+	112, // 88: user.AccountInfo.updated_at:type_name -> google.protobuf.Timestamp
+	114, // 89: user.LoginEvent.failure_reason:type_name -> google.protobuf.StringValue
+	118, // 90: user.LoginEvent.org_id:type_name -> google.protobuf.Int64Value
+	114, // 91: user.LoginEvent.ip:type_name -> google.protobuf.StringValue
+	114, // 92: user.LoginEvent.user_agent:type_name -> google.protobuf.StringValue
+	112, // 93: user.LoginEvent.created_at:type_name -> google.protobuf.Timestamp
+	112, // 94: user.LoginEvent.expires_at:type_name -> google.protobuf.Timestamp
+	112, // 95: user.PersonalDataExport.exported_at:type_name -> google.protobuf.Timestamp
+	3,   // 96: user.PersonalDataExport.user:type_name -> user.User
+	60,  // 97: user.PersonalDataExport.account:type_name -> user.AccountInfo
+	61,  // 98: user.PersonalDataExport.login_history:type_name -> user.LoginEvent
+	61,  // 99: user.PersonalDataExport.sessions:type_name -> user.LoginEvent
+	112, // 100: user.PersonalDataExport.deleted_at:type_name -> google.protobuf.Timestamp
+	112, // 101: user.PersonalDataExport.anonymized_at:type_name -> google.protobuf.Timestamp
+	69,  // 102: user.PersonalDataExport.versions:type_name -> user.UserVersion
+	3,   // 103: user.AnonymizeUserResponse.user:type_name -> user.User
+	112, // 104: user.ListAuditLogsRequest.from:type_name -> google.protobuf.Timestamp
+	112, // 105: user.ListAuditLogsRequest.to:type_name -> google.protobuf.Timestamp
+	116, // 106: user.AuditLog.actor_id:type_name -> google.protobuf.Int32Value
+	118, // 107: user.AuditLog.org_id:type_name -> google.protobuf.Int64Value
+	116, // 108: user.AuditLog.target_user_id:type_name -> google.protobuf.Int32Value
+	116, // 109: user.AuditLog.entity_id:type_name -> google.protobuf.Int32Value
+	115, // 110: user.AuditLog.changes:type_name -> google.protobuf.Struct
+	115, // 111: user.AuditLog.details:type_name -> google.protobuf.Struct
+	112, // 112: user.AuditLog.created_at:type_name -> google.protobuf.Timestamp
+	66,  // 113: user.ListAuditLogsResponse.logs:type_name -> user.AuditLog
+	114, // 114: user.FieldChange.from:type_name -> google.protobuf.StringValue
+	114, // 115: user.FieldChange.to:type_name -> google.protobuf.StringValue
+	3,   // 116: user.UserVersion.user:type_name -> user.User
+	112, // 117: user.UserVersion.valid_from:type_name -> google.protobuf.Timestamp
+	112, // 118: user.UserVersion.valid_to:type_name -> google.protobuf.Timestamp
+	116, // 119: user.UserVersion.changed_by:type_name -> google.protobuf.Int32Value
+	68,  // 120: user.UserVersion.changes:type_name -> user.FieldChange
+	112, // 121: user.GetUserAsOfRequest.at:type_name -> google.protobuf.Timestamp
+	69,  // 122: user.GetUserAsOfResponse.version:type_name -> user.UserVersion
+	69,  // 123: user.ListUserVersionsResponse.versions:type_name -> user.UserVersion
+	68,  // 124: user.DiffUserVersionsResponse.changes:type_name -> user.FieldChange
+	114, // 125: user.AttributeDefinition.pattern:type_name -> google.protobuf.StringValue
+	112, // 126: user.AttributeDefinition.created_at:type_name -> google.protobuf.Timestamp
+	112, // 127: user.AttributeDefinition.updated_at:type_name -> google.protobuf.Timestamp
+	76,  // 128: user.ListAttributeDefinitionsResponse.definitions:type_name -> user.AttributeDefinition
+	114, // 129: user.CreateAttributeDefinitionRequest.pattern:type_name -> google.protobuf.StringValue
+	114, // 130: user.UpdateAttributeDefinitionRequest.pattern:type_name -> google.protobuf.StringValue
+	76,  // 131: user.AttributeDefinitionResponse.definition:type_name -> user.AttributeDefinition
+	118, // 132: user.Group.org_id:type_name -> google.protobuf.Int64Value
+	112, // 133: user.Group.created_at:type_name -> google.protobuf.Timestamp
+	112, // 134: user.Group.updated_at:type_name -> google.protobuf.Timestamp
+	112, // 135: user.GroupMember.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 136: user.ListGroupsResponse.groups:type_name -> user.Group
+	114, // 137: user.UpdateGroupRequest.name:type_name -> google.protobuf.StringValue
+	114, // 138: user.UpdateGroupRequest.description:type_name -> google.protobuf.StringValue
+	84,  // 139: user.GroupResponse.group:type_name -> user.Group
+	85,  // 140: user.ListGroupMembersResponse.members:type_name -> user.GroupMember
+	96,  // 141: user.AddGroupMembersRequest.members:type_name -> user.AddGroupMemberInput
+	85,  // 142: user.GroupMemberResponse.member:type_name -> user.GroupMember
+	84,  // 143: user.UserGroup.group:type_name -> user.Group
+	105, // 144: user.ListUserGroupsResponse.groups:type_name -> user.UserGroup
+	108, // 145: user.ExpandGroupMembersResponse.members:type_name -> user.ExpandedGroupMember
+	0,   // 146: user.UserService.ListUsers:input_type -> user.ListUsersRequest
+	10,  // 147: user.UserService.GetUserById:input_type -> user.GetUserByIdRequest
+	12,  // 148: user.UserService.GetUsersByIDs:input_type -> user.GetUsersByIDsRequest
+	14,  // 149: user.UserService.SearchUsers:input_type -> user.SearchUsersRequest
+	18,  // 150: user.UserService.CreateUser:input_type -> user.CreateUserRequest
+	20,  // 151: user.UserService.UpdateUserByID:input_type -> user.UpdateUserRequest
+	23,  // 152: user.UserService.DeleteUserByID:input_type -> user.DeleteUserRequest
+	25,  // 153: user.UserService.RestoreUser:input_type -> user.RestoreUserRequest
+	27,  // 154: user.UserService.PurgeUser:input_type -> user.PurgeUserRequest
+	29,  // 155: user.UserService.UploadAvatar:input_type -> user.UploadAvatarRequest
+	46,  // 156: user.UserService.GetUserProfile:input_type -> user.GetUserProfileRequest
+	48,  // 157: user.UserService.UpdateUserProfile:input_type -> user.UpdateUserProfileRequest
+	50,  // 158: user.UserService.ListProvinces:input_type -> user.ListProvincesRequest
+	51,  // 159: user.UserService.ListDistricts:input_type -> user.ListDistrictsRequest
+	52,  // 160: user.UserService.ListWards:input_type -> user.ListWardsRequest
+	54,  // 161: user.UserService.FindDuplicateUsers:input_type -> user.FindDuplicateUsersRequest
+	57,  // 162: user.UserService.MergeUsers:input_type -> user.MergeUsersRequest
+	59,  // 163: user.UserService.ExportPersonalData:input_type -> user.ExportPersonalDataRequest
+	63,  // 164: user.UserService.AnonymizeUser:input_type -> user.AnonymizeUserRequest
+	65,  // 165: user.UserService.ListAuditLogs:input_type -> user.ListAuditLogsRequest
+	70,  // 166: user.UserService.GetUserAsOf:input_type -> user.GetUserAsOfRequest
+	72,  // 167: user.UserService.ListUserVersions:input_type -> user.ListUserVersionsRequest
+	74,  // 168: user.UserService.DiffUserVersions:input_type -> user.DiffUserVersionsRequest
+	77,  // 169: user.UserService.ListAttributeDefinitions:input_type -> user.ListAttributeDefinitionsRequest
+	79,  // 170: user.UserService.CreateAttributeDefinition:input_type -> user.CreateAttributeDefinitionRequest
+	80,  // 171: user.UserService.UpdateAttributeDefinition:input_type -> user.UpdateAttributeDefinitionRequest
+	82,  // 172: user.UserService.DeleteAttributeDefinition:input_type -> user.DeleteAttributeDefinitionRequest
+	86,  // 173: user.UserService.ListGroups:input_type -> user.ListGroupsRequest
+	88,  // 174: user.UserService.GetGroup:input_type -> user.GetGroupRequest
+	89,  // 175: user.UserService.CreateGroup:input_type -> user.CreateGroupRequest
+	90,  // 176: user.UserService.UpdateGroup:input_type -> user.UpdateGroupRequest
+	92,  // 177: user.UserService.DeleteGroup:input_type -> user.DeleteGroupRequest
+	94,  // 178: user.UserService.ListGroupMembers:input_type -> user.ListGroupMembersRequest
+	97,  // 179: user.UserService.AddGroupMembers:input_type -> user.AddGroupMembersRequest
+	98,  // 180: user.UserService.UpdateGroupMember:input_type -> user.UpdateGroupMemberRequest
+	100, // 181: user.UserService.RemoveGroupMember:input_type -> user.RemoveGroupMemberRequest
+	102, // 182: user.UserService.AddSubgroup:input_type -> user.AddSubgroupRequest
+	103, // 183: user.UserService.RemoveSubgroup:input_type -> user.RemoveSubgroupRequest
+	104, // 184: user.UserService.ListUserGroups:input_type -> user.ListUserGroupsRequest
+	107, // 185: user.UserService.ExpandGroupMembers:input_type -> user.ExpandGroupMembersRequest
+	32,  // 186: user.UserService.BatchCreateUsers:input_type -> user.BatchCreateUsersRequest
+	34,  // 187: user.UserService.BatchUpdateUsers:input_type -> user.BatchUpdateUsersRequest
+	36,  // 188: user.UserService.BatchDeleteUsers:input_type -> user.BatchDeleteUsersRequest
+	39,  // 189: user.UserService.ImportUsers:input_type -> user.ImportUsersRequest
+	44,  // 190: user.UserService.ExportUsers:input_type -> user.ExportUsersRequest
+	9,   // 191: user.UserService.ListUsers:output_type -> user.ListUsersResponse
+	11,  // 192: user.UserService.GetUserById:output_type -> user.GetUserByIdResponse
+	13,  // 193: user.UserService.GetUsersByIDs:output_type -> user.GetUsersByIDsResponse
+	16,  // 194: user.UserService.SearchUsers:output_type -> user.SearchUsersResponse
+	19,  // 195: user.UserService.CreateUser:output_type -> user.CreateUserResponse
+	21,  // 196: user.UserService.UpdateUserByID:output_type -> user.UpdateUserResponse
+	24,  // 197: user.UserService.DeleteUserByID:output_type -> user.DeleteUserResponse
+	26,  // 198: user.UserService.RestoreUser:output_type -> user.RestoreUserResponse
+	28,  // 199: user.UserService.PurgeUser:output_type -> user.PurgeUserResponse
+	30,  // 200: user.UserService.UploadAvatar:output_type -> user.UploadAvatarResponse
+	47,  // 201: user.UserService.GetUserProfile:output_type -> user.GetUserProfileResponse
+	49,  // 202: user.UserService.UpdateUserProfile:output_type -> user.UpdateUserProfileResponse
+	53,  // 203: user.UserService.ListProvinces:output_type -> user.ListAdminUnitsResponse
+	53,  // 204: user.UserService.ListDistricts:output_type -> user.ListAdminUnitsResponse
+	53,  // 205: user.UserService.ListWards:output_type -> user.ListAdminUnitsResponse
+	56,  // 206: user.UserService.FindDuplicateUsers:output_type -> user.FindDuplicateUsersResponse
+	58,  // 207: user.UserService.MergeUsers:output_type -> user.MergeUsersResponse
+	62,  // 208: user.UserService.ExportPersonalData:output_type -> user.PersonalDataExport
+	64,  // 209: user.UserService.AnonymizeUser:output_type -> user.AnonymizeUserResponse
+	67,  // 210: user.UserService.ListAuditLogs:output_type -> user.ListAuditLogsResponse
+	71,  // 211: user.UserService.GetUserAsOf:output_type -> user.GetUserAsOfResponse
+	73,  // 212: user.UserService.ListUserVersions:output_type -> user.ListUserVersionsResponse
+	75,  // 213: user.UserService.DiffUserVersions:output_type -> user.DiffUserVersionsResponse
+	78,  // 214: user.UserService.ListAttributeDefinitions:output_type -> user.ListAttributeDefinitionsResponse
+	81,  // 215: user.UserService.CreateAttributeDefinition:output_type -> user.AttributeDefinitionResponse
+	81,  // 216: user.UserService.UpdateAttributeDefinition:output_type -> user.AttributeDefinitionResponse
+	83,  // 217: user.UserService.DeleteAttributeDefinition:output_type -> user.DeleteAttributeDefinitionResponse
+	87,  // 218: user.UserService.ListGroups:output_type -> user.ListGroupsResponse
+	91,  // 219: user.UserService.GetGroup:output_type -> user.GroupResponse
+	91,  // 220: user.UserService.CreateGroup:output_type -> user.GroupResponse
+	91,  // 221: user.UserService.UpdateGroup:output_type -> user.GroupResponse
+	93,  // 222: user.UserService.DeleteGroup:output_type -> user.DeleteGroupResponse
+	95,  // 223: user.UserService.ListGroupMembers:output_type -> user.ListGroupMembersResponse
+	95,  // 224: user.UserService.AddGroupMembers:output_type -> user.ListGroupMembersResponse
+	99,  // 225: user.UserService.UpdateGroupMember:output_type -> user.GroupMemberResponse
+	101, // 226: user.UserService.RemoveGroupMember:output_type -> user.RemoveGroupMemberResponse
+	91,  // 227: user.UserService.AddSubgroup:output_type -> user.GroupResponse
+	91,  // 228: user.UserService.RemoveSubgroup:output_type -> user.GroupResponse
+	106, // 229: user.UserService.ListUserGroups:output_type -> user.ListUserGroupsResponse
+	109, // 230: user.UserService.ExpandGroupMembers:output_type -> user.ExpandGroupMembersResponse
+	33,  // 231: user.UserService.BatchCreateUsers:output_type -> user.BatchCreateUsersResponse
+	35,  // 232: user.UserService.BatchUpdateUsers:output_type -> user.BatchUpdateUsersResponse
+	37,  // 233: user.UserService.BatchDeleteUsers:output_type -> user.BatchDeleteUsersResponse
+	42,  // 234: user.UserService.ImportUsers:output_type -> user.ImportUsersResponse
+	45,  // 235: user.UserService.ExportUsers:output_type -> user.ExportUsersChunk
+	191, // [191:236] is the sub-list for method output_type
+	146, // [146:191] is the sub-list for method input_type
+	146, // [146:146] is the sub-list for extension type_name
+	146, // [146:146] is the sub-list for extension extendee
+	0,   // [0:146] is the sub-list for field type_name
 }
 
 func init() { file_proto_user_user_proto_init() }
@@ -1335,13 +7958,21 @@ func file_proto_user_user_proto_init() {
 	if File_proto_user_user_proto != nil {
 		return
 	}
+	file_proto_user_user_proto_msgTypes[39].OneofWrappers = []any{
+		(*ImportUsersRequest_Options)(nil),
+		(*ImportUsersRequest_Chunk)(nil),
+	}
+	file_proto_user_user_proto_msgTypes[42].OneofWrappers = []any{
+		(*ImportUsersResponse_Row)(nil),
+		(*ImportUsersResponse_Summary)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_user_user_proto_rawDesc), len(file_proto_user_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   112,
 			NumExtensions: 0,
 			NumServices:   1,
 		},